@@ -0,0 +1,133 @@
+// Команда histories выгружает истории работающих workflow напоминаний в JSON,
+// который читает TestReplayHistories (internal/workflows/reminder). Запускается
+// перед выкладкой изменений workflow, чтобы проверить их на реальных историях:
+//
+//	task workflows:histories
+//	task workflows:replay
+//
+// Конфиг ищется так же, как в cmd/template: по умолчанию ./app/config (путь
+// в контейнере), локально Taskfile передаёт --config=./config.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/client"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/vovanwin/template/config"
+	reminderv1 "github.com/vovanwin/template/pkg/temporal/reminder"
+)
+
+func main() {
+	configDir := flag.String("config", "./app/config", "путь к директории с конфигами")
+	query := flag.String("query",
+		fmt.Sprintf("WorkflowType = '%s' AND ExecutionStatus = 'Running'", reminderv1.ScheduleReminderWorkflowName),
+		"visibility-запрос для отбора workflow")
+	out := flag.String("out", "internal/workflows/reminder/testdata/histories", "куда сохранить истории")
+	limit := flag.Int("limit", 20, "сколько историй выгрузить, 0 — все")
+	flag.Parse()
+
+	cfg, err := config.Load(&config.LoadOptions{ConfigDir: *configDir})
+	if err != nil {
+		log.Fatalf("загрузка конфига: %v", err)
+	}
+
+	c, err := client.Dial(client.Options{
+		HostPort:  fmt.Sprintf("%s:%d", cfg.Temporal.Host, cfg.Temporal.Port),
+		Namespace: cfg.Temporal.Namespace,
+	})
+	if err != nil {
+		log.Fatalf("подключение к temporal: %v", err)
+	}
+	defer c.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	n, err := export(ctx, c, cfg.Temporal.Namespace, *query, *out, *limit)
+	if err != nil {
+		log.Fatalf("выгрузка историй: %v", err)
+	}
+	log.Printf("выгружено историй: %d в %s", n, *out)
+}
+
+// export сохраняет истории workflow, найденных по query, в dir — по файлу
+// на workflow. Возвращает число сохранённых историй.
+func export(ctx context.Context, c client.Client, namespace, query, dir string, limit int) (int, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return 0, fmt.Errorf("create %s: %w", dir, err)
+	}
+
+	var (
+		n     int
+		token []byte
+	)
+	for {
+		resp, err := c.ListWorkflow(ctx, &workflowservice.ListWorkflowExecutionsRequest{
+			Namespace:     namespace,
+			Query:         query,
+			NextPageToken: token,
+		})
+		if err != nil {
+			return n, fmt.Errorf("list workflows: %w", err)
+		}
+		for _, info := range resp.GetExecutions() {
+			if limit > 0 && n >= limit {
+				return n, nil
+			}
+			exec := info.GetExecution()
+			if err := saveHistory(ctx, c, exec.GetWorkflowId(), exec.GetRunId(), dir); err != nil {
+				return n, err
+			}
+			n++
+		}
+		token = resp.GetNextPageToken()
+		if len(token) == 0 {
+			return n, nil
+		}
+	}
+}
+
+// saveHistory выгружает полную историю запуска в <dir>/<workflow_id>.json.
+func saveHistory(ctx context.Context, c client.Client, workflowID, runID, dir string) error {
+	iter := c.GetWorkflowHistory(ctx, workflowID, runID, false, 0)
+	history := &historypb.History{}
+	for iter.HasNext() {
+		event, err := iter.Next()
+		if err != nil {
+			return fmt.Errorf("history of %s: %w", workflowID, err)
+		}
+		history.Events = append(history.Events, event)
+	}
+
+	data, err := protojson.MarshalOptions{Multiline: true, Indent: "  "}.Marshal(history)
+	if err != nil {
+		return fmt.Errorf("marshal history of %s: %w", workflowID, err)
+	}
+	path := filepath.Join(dir, fileName(workflowID)+".json")
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("write %s: %w", path, err)
+	}
+	return nil
+}
+
+// fileName заменяет в ID workflow символы, недопустимые в имени файла.
+func fileName(workflowID string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '/', '\\', ':', '*', '?', '"', '<', '>', '|', ' ':
+			return '_'
+		}
+		return r
+	}, workflowID)
+}
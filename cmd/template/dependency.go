@@ -13,15 +13,21 @@ import (
 	"github.com/vovanwin/platform/server"
 	"github.com/vovanwin/template/api"
 	"github.com/vovanwin/template/config"
+	"github.com/vovanwin/template/internal/pkg/blob"
 	"github.com/vovanwin/template/internal/pkg/centrifugo"
+	"github.com/vovanwin/template/internal/pkg/dpop"
 	"github.com/vovanwin/template/internal/pkg/etcdstore"
 	"github.com/vovanwin/template/internal/pkg/events"
 	"github.com/vovanwin/template/internal/pkg/flagsui"
 	"github.com/vovanwin/template/internal/pkg/jwt"
 	"github.com/vovanwin/template/internal/pkg/logx"
 	authmw "github.com/vovanwin/template/internal/pkg/middleware"
+	"github.com/vovanwin/template/internal/pkg/notify"
+	"github.com/vovanwin/template/internal/pkg/outbox"
 	"github.com/vovanwin/template/internal/pkg/storage/postgres"
 	"github.com/vovanwin/template/internal/pkg/temporal"
+	"github.com/vovanwin/template/internal/repository"
+	"github.com/vovanwin/template/internal/service"
 	"github.com/vovanwin/template/pkg"
 
 	"go.uber.org/fx"
@@ -87,18 +93,25 @@ func ProvideFlags(cfg *config.Config) (*config.Flags, func()) {
 	return config.NewFlags(config.NewMemoryStore(config.DefaultFlagValues())), func() {}
 }
 
-func ProvideServerModule(cfg *config.Config, flags *config.Flags, jwtService jwt.JWTService) fx.Option {
+func ProvideServerModule(cfg *config.Config, flags *config.Flags, jwtService jwt.JWTService, dpopVerifier *dpop.Verifier) fx.Option {
 	csrfMiddleware := func(next http.Handler) http.Handler {
 		return next
 	} // Временно отключаем CSRF для теста
 
+	// Проверка DPoP для access токенов включается конфигом, refresh проверяется всегда
+	accessVerifier := dpopVerifier
+	if !cfg.Dpop.EnforceAccessTokens {
+		accessVerifier = nil
+	}
+
 	opts := []server.Option{
 		server.WithHTTPMiddleware(middleware.RequestID),
 		server.WithHTTPMiddleware(authmw.CookieAuthMiddleware),
+		server.WithHTTPMiddleware(authmw.DPoPMiddleware),
 		server.WithHTTPMiddleware(csrfMiddleware),
 		server.WithDebugHandler("/flags", flagsui.Handler(flags)),
 		server.WithDebugHandler("/flags/", flagsui.Handler(flags)),
-		server.WithGRPCOptions(grpc.ChainUnaryInterceptor(authmw.AuthInterceptor(jwtService, cfg.Server.AuthBypass))),
+		server.WithGRPCOptions(grpc.ChainUnaryInterceptor(authmw.AuthInterceptor(jwtService, accessVerifier, cfg.Server.AuthBypass))),
 	}
 
 	if cfg.Metrics.EnableMetrics {
@@ -122,14 +135,70 @@ func ProvideCentrifugoClient(cfg *config.Config, log *slog.Logger) *centrifugo.C
 	)
 }
 
-func ProvideEventBus(client *centrifugo.Client, log *slog.Logger) *events.Bus {
-	return events.NewBus(client, log.With("component", "events"))
+func ProvideEventBus(outboxRepo *repository.OutboxRepo, client *centrifugo.Client, log *slog.Logger) *events.Bus {
+	return events.NewBus(outboxRepo, client, log.With("component", "events"))
 }
 
 func ProvideCentrifugoURL(cfg *config.Config) string {
 	return cfg.Centrifugo.Addr
 }
 
+func ProvideInAppNotifier(client *centrifugo.Client) *notify.InAppNotifier {
+	return notify.NewInAppNotifier(client)
+}
+
+func ProvideEmailNotifier(cfg *config.Config) *notify.EmailNotifier {
+	return notify.NewEmailNotifier(notify.EmailConfig{
+		Host:     cfg.Smtp.Host,
+		Port:     cfg.Smtp.Port,
+		Username: cfg.Smtp.Username,
+		Password: cfg.Smtp.Password,
+		From:     cfg.Smtp.From,
+	})
+}
+
+func ProvideWebhookNotifier(cfg *config.Config) *notify.WebhookNotifier {
+	return notify.NewWebhookNotifier(cfg.Webhook.SigningSecret, cfg.Webhook.Timeout)
+}
+
+// ProvideBlobStorage создаёт хранилище вложений по blob.driver: local или s3.
+func ProvideBlobStorage(cfg *config.Config) (blob.Storage, error) {
+	switch cfg.Blob.Driver {
+	case "", "local":
+		return blob.NewLocal(cfg.Blob.Dir)
+	case "s3":
+		return blob.NewS3(blob.S3Config{
+			Endpoint:  cfg.Blob.S3Endpoint,
+			Region:    cfg.Blob.S3Region,
+			Bucket:    cfg.Blob.S3Bucket,
+			AccessKey: cfg.Blob.S3AccessKey,
+			SecretKey: cfg.Blob.S3SecretKey,
+			PathStyle: cfg.Blob.S3PathStyle,
+		}, nil)
+	default:
+		return nil, fmt.Errorf("unknown blob driver %q", cfg.Blob.Driver)
+	}
+}
+
+func ProvideOutboxRelay(cfg *config.Config, outboxRepo *repository.OutboxRepo, reminders *service.ReminderService, bus *events.Bus, log *slog.Logger) *outbox.Relay {
+	relay := outbox.NewRelay(outboxRepo, outbox.Config{
+		PollInterval: cfg.Outbox.PollInterval,
+		BatchSize:    cfg.Outbox.BatchSize,
+		Lease:        cfg.Outbox.Lease,
+	}, log.With("component", "outbox"))
+	reminders.RegisterOutboxHandlers(relay)
+	relay.Handle(events.OutboxKind, bus.Deliver)
+	return relay
+}
+
+func ProvideReminderReconciler(cfg *config.Config, reminders *service.ReminderService, repo *repository.ReminderRepo, log *slog.Logger) (*service.ReminderReconciler, error) {
+	return service.NewReminderReconciler(reminders, repo, service.ReconcilerConfig{
+		Interval:    cfg.Reconciler.Interval,
+		GracePeriod: cfg.Reconciler.GracePeriod,
+		BatchSize:   cfg.Reconciler.BatchSize,
+	}, log.With("component", "reconciler"))
+}
+
 func ProvideTemporalService(cfg *config.Config, log *slog.Logger) (*temporal.Service, error) {
 	return temporal.NewService(temporal.ServiceConfig{
 		Client: temporal.Config{
@@ -141,6 +210,10 @@ func ProvideTemporalService(cfg *config.Config, log *slog.Logger) (*temporal.Ser
 	}, log.With("component", "temporal"))
 }
 
+func ProvideTxManager(pg *postgres.Postgres) *postgres.TxManager {
+	return pg.TxManager
+}
+
 func ProvidePgx(c *config.Config, log *slog.Logger) (*postgres.Postgres, error) {
 	opt := postgres.NewOptions(
 		c.PG.Host,
@@ -8,9 +8,13 @@ import (
 
 	"github.com/vovanwin/template/config"
 	"github.com/vovanwin/template/internal/controller/auth"
+	"github.com/vovanwin/template/internal/controller/calendar"
+	"github.com/vovanwin/template/internal/controller/reminders"
 	"github.com/vovanwin/template/internal/controller/template"
 	"github.com/vovanwin/template/internal/controller/ui"
+	"github.com/vovanwin/template/internal/pkg/dpop"
 	"github.com/vovanwin/template/internal/pkg/jwt"
+	"github.com/vovanwin/template/internal/pkg/outbox"
 	"github.com/vovanwin/template/internal/pkg/telegram"
 	"github.com/vovanwin/template/internal/pkg/temporal"
 	"github.com/vovanwin/template/internal/repository"
@@ -29,6 +33,7 @@ func inject(configDir string) fx.Option {
 
 	flags, closeFn := ProvideFlags(cfg)
 	jwtService := jwt.NewJWTService(cfg.JWT.SignKey, cfg.JWT.TokenTtl, cfg.JWT.RefreshTokenTtl)
+	dpopVerifier := dpop.NewVerifier(cfg.Dpop.ProofMaxAge)
 
 	return fx.Options(
 		fx.Supply(cfg),
@@ -37,18 +42,32 @@ func inject(configDir string) fx.Option {
 			ProvideCentrifugoClient,
 			ProvideEventBus,
 			fx.Annotate(ProvideCentrifugoURL, fx.ResultTags(`name:"centrifugo_url"`)),
+			ProvideInAppNotifier,
+			ProvideEmailNotifier,
+			ProvideWebhookNotifier,
+			ProvideBlobStorage,
 			ProvideLogger,
 			ProvideTemporalService,
 			ProvideServerConfig,
 			ProvidePgx,
+			ProvideTxManager,
 			repository.NewUserRepo,
 			repository.NewSessionRepo,
 			repository.NewReminderRepo,
+			repository.NewListRepo,
+			repository.NewOutboxRepo,
 			service.NewAuthService,
 			service.NewReminderService,
+			service.NewListService,
+			service.NewCalendarService,
+			ProvideOutboxRelay,
+			ProvideReminderReconciler,
 			func() jwt.JWTService {
 				return jwtService
 			},
+			func() *dpop.Verifier {
+				return dpopVerifier
+			},
 		),
 		fx.Invoke(func(lc fx.Lifecycle) {
 			lc.Append(fx.StopHook(closeFn))
@@ -64,6 +83,30 @@ func inject(configDir string) fx.Option {
 				},
 			})
 		}),
+		fx.Invoke(func(lc fx.Lifecycle, relay *outbox.Relay) {
+			lc.Append(fx.Hook{
+				OnStart: func(context.Context) error {
+					relay.Start()
+					return nil
+				},
+				OnStop: func(ctx context.Context) error {
+					relay.Stop(ctx)
+					return nil
+				},
+			})
+		}),
+		fx.Invoke(func(lc fx.Lifecycle, reconciler *service.ReminderReconciler) {
+			lc.Append(fx.Hook{
+				OnStart: func(context.Context) error {
+					reconciler.Start()
+					return nil
+				},
+				OnStop: func(ctx context.Context) error {
+					reconciler.Stop(ctx)
+					return nil
+				},
+			})
+		}),
 
 		// Telegram бот (модульная архитектура)
 		telegram.Module(),
@@ -71,13 +114,15 @@ func inject(configDir string) fx.Option {
 		// gRPC сервисы
 		template.Module(),
 		auth.Module(),
+		reminders.Module(),
+		calendar.Module(),
 		ui.Module(),
 
 		// Workflows
 		workflows.Module,
 
 		// Сервер (автоматически собирает все registrators)
-		ProvideServerModule(cfg, flags, jwtService),
+		ProvideServerModule(cfg, flags, jwtService, dpopVerifier),
 	)
 }
 
@@ -22,15 +22,21 @@ type Config struct {
 	PG  PG          `toml:"PG"`
 	// Информация о приложении
 	App        App        `toml:"app"`
+	Blob       Blob       `toml:"blob"`
 	Centrifugo Centrifugo `toml:"centrifugo"`
+	Dpop       Dpop       `toml:"dpop"`
 	Etcd       Etcd       `toml:"etcd"`
 	Log        Log        `toml:"log"`
 	Metrics    Metrics    `toml:"metrics"`
 	Otel       Otel       `toml:"otel"`
+	Outbox     Outbox     `toml:"outbox"`
 	Rabbit     Rabbit     `toml:"rabbit"`
+	Reconciler Reconciler `toml:"reconciler"`
 	Server     Server     `toml:"server"`
+	Smtp       Smtp       `toml:"smtp"`
 	Telegram   Telegram   `toml:"telegram"`
 	Temporal   Temporal   `toml:"temporal"`
+	Webhook    Webhook    `toml:"webhook"`
 }
 
 func (c *Config) IsProduction() bool { return c.Env == EnvProduction }
@@ -83,6 +89,26 @@ type App struct {
 	Version string `toml:"version"`
 }
 
+// Blob секция конфигурации
+type Blob struct {
+	// Каталог вложений для driver = local
+	Dir string `toml:"dir"`
+	// Хранилище вложений: local (каталог на диске) или s3
+	Driver string `toml:"driver"`
+	// Access key S3
+	S3AccessKey string `toml:"s3_access_key"`
+	// Бакет S3
+	S3Bucket string `toml:"s3_bucket"`
+	// Адрес S3 API, например https://s3.amazonaws.com или http://localhost:9000
+	S3Endpoint string `toml:"s3_endpoint"`
+	// Бакет в пути URL, а не в имени хоста (нужно для MinIO)
+	S3PathStyle bool `toml:"s3_path_style"`
+	// Регион S3
+	S3Region string `toml:"s3_region"`
+	// Secret key S3
+	S3SecretKey string `toml:"s3_secret_key"`
+}
+
 // Centrifugo секция конфигурации
 type Centrifugo struct {
 	// HTTP адрес Centrifugo API
@@ -95,6 +121,14 @@ type Centrifugo struct {
 	TokenTtl time.Duration `toml:"token_ttl"`
 }
 
+// Dpop секция конфигурации
+type Dpop struct {
+	// Требовать DPoP proof для access токенов, привязанных к ключу
+	EnforceAccessTokens bool `toml:"enforce_access_tokens"`
+	// Максимальный возраст DPoP proof
+	ProofMaxAge time.Duration `toml:"proof_max_age"`
+}
+
 // Etcd секция конфигурации
 type Etcd struct {
 	// Адреса etcd серверов
@@ -129,12 +163,32 @@ type Otel struct {
 	SampleRate float64 `toml:"sample_rate"`
 }
 
+// Outbox секция конфигурации
+type Outbox struct {
+	// Сколько сообщений забирать за раз
+	BatchSize int `toml:"batch_size"`
+	// На сколько скрывать сообщение от других экземпляров во время доставки
+	Lease time.Duration `toml:"lease"`
+	// Как часто проверять таблицу outbox
+	PollInterval time.Duration `toml:"poll_interval"`
+}
+
 // Rabbit секция конфигурации
 type Rabbit struct {
 	// AMQP URI для подключения к RabbitMQ
 	AmqpUrl string `toml:"amqp_url"`
 }
 
+// Reconciler секция конфигурации
+type Reconciler struct {
+	// Сколько напоминаний читать из БД за раз
+	BatchSize int `toml:"batch_size"`
+	// Не трогать напоминания, изменённые за последний период
+	GracePeriod time.Duration `toml:"grace_period"`
+	// Период сверки напоминаний с Temporal; 0 — сверка выключена
+	Interval time.Duration `toml:"interval"`
+}
+
 // Server секция конфигурации
 type Server struct {
 	// Разрешить обход авторизации (только для локальной разработки)
@@ -157,6 +211,20 @@ type Server struct {
 	SwaggerPort string `toml:"swagger_port"`
 }
 
+// Smtp секция конфигурации
+type Smtp struct {
+	// Адрес отправителя
+	From string `toml:"from"`
+	// Хост SMTP сервера (пусто = канал email отключён)
+	Host string `toml:"host"`
+	// Пароль SMTP
+	Password string `toml:"password"`
+	// Порт SMTP сервера
+	Port int `toml:"port"`
+	// Пользователь SMTP (пусто = без авторизации)
+	Username string `toml:"username"`
+}
+
 // Telegram секция конфигурации
 type Telegram struct {
 	// URL Mini App (WebApp) для открытия в Telegram WebView
@@ -178,3 +246,11 @@ type Temporal struct {
 	// Очередь задач
 	TaskQueue string `toml:"task_queue"`
 }
+
+// Webhook секция конфигурации
+type Webhook struct {
+	// Секрет HMAC-подписи запросов (заголовок X-Reminder-Signature)
+	SigningSecret string `toml:"signing_secret"`
+	// Таймаут запроса к webhook пользователя
+	Timeout time.Duration `toml:"timeout"`
+}
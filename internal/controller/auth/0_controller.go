@@ -3,6 +3,7 @@ package auth
 import (
 	"log/slog"
 
+	"github.com/vovanwin/template/internal/pkg/dpop"
 	"github.com/vovanwin/template/internal/service"
 	authpb "github.com/vovanwin/template/pkg/auth"
 	"go.uber.org/fx"
@@ -14,6 +15,7 @@ type Deps struct {
 
 	Log         *slog.Logger
 	AuthService *service.AuthService
+	DPoP        *dpop.Verifier
 }
 
 // AuthGRPCServer реализует gRPC сервис AuthService.
@@ -21,6 +23,7 @@ type AuthGRPCServer struct {
 	authpb.UnimplementedAuthServiceServer
 	log         *slog.Logger
 	authService *service.AuthService
+	dpop        *dpop.Verifier
 }
 
 // NewAuthGRPCServer создаёт новый AuthGRPCServer.
@@ -28,5 +31,6 @@ func NewAuthGRPCServer(deps Deps) *AuthGRPCServer {
 	return &AuthGRPCServer{
 		log:         deps.Log,
 		authService: deps.AuthService,
+		dpop:        deps.DPoP,
 	}
 }
@@ -3,6 +3,7 @@ package auth
 import (
 	"context"
 
+	"github.com/vovanwin/template/internal/pkg/middleware"
 	authpb "github.com/vovanwin/template/pkg/auth"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -31,7 +32,14 @@ func (s *AuthGRPCServer) Login(ctx context.Context, req *authpb.LoginRequest) (*
 		}
 	}
 
-	result, err := s.authService.Login(ctx, req.GetEmail(), req.GetPassword(), ip, userAgent)
+	// DPoP proof необязателен: без него сессия остаётся bearer
+	jkt, err := middleware.VerifyDPoP(ctx, s.dpop, "")
+	if err != nil {
+		s.log.Warn("invalid dpop proof", "error", err)
+		return nil, status.Errorf(codes.Unauthenticated, "invalid dpop proof: %v", err)
+	}
+
+	result, err := s.authService.Login(ctx, req.GetEmail(), req.GetPassword(), ip, userAgent, jkt)
 	if err != nil {
 		s.log.Error("login failed", "error", err)
 		return nil, status.Errorf(codes.Unauthenticated, "login failed: %v", err)
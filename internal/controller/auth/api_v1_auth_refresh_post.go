@@ -3,6 +3,7 @@ package auth
 import (
 	"context"
 
+	"github.com/vovanwin/template/internal/pkg/middleware"
 	authpb "github.com/vovanwin/template/pkg/auth"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
@@ -30,7 +31,14 @@ func (s *AuthGRPCServer) RefreshToken(ctx context.Context, req *authpb.RefreshTo
 		}
 	}
 
-	result, err := s.authService.RefreshToken(ctx, req.GetRefreshToken(), ip, userAgent)
+	// Наличие proof для привязанной к ключу сессии проверяет сервис
+	jkt, err := middleware.VerifyDPoP(ctx, s.dpop, "")
+	if err != nil {
+		s.log.Warn("invalid dpop proof", "error", err)
+		return nil, status.Errorf(codes.Unauthenticated, "invalid dpop proof: %v", err)
+	}
+
+	result, err := s.authService.RefreshToken(ctx, req.GetRefreshToken(), ip, userAgent, jkt)
 	if err != nil {
 		s.log.Error("refresh token failed", "error", err)
 		return nil, status.Errorf(codes.Unauthenticated, "refresh failed: %v", err)
@@ -0,0 +1,52 @@
+package calendar
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/vovanwin/template/internal/service"
+	"go.uber.org/fx"
+)
+
+// Deps содержит зависимости для CalendarController.
+type Deps struct {
+	fx.In
+
+	Log             *slog.Logger
+	CalendarService *service.CalendarService
+}
+
+// CalendarController отдаёт ленту iCalendar по секретной ссылке без авторизации:
+// календарные приложения не умеют передавать токены доступа.
+type CalendarController struct {
+	log             *slog.Logger
+	calendarService *service.CalendarService
+}
+
+// NewCalendarController создаёт новый CalendarController.
+func NewCalendarController(deps Deps) *CalendarController {
+	return &CalendarController{
+		log:             deps.Log,
+		calendarService: deps.CalendarService,
+	}
+}
+
+// handleFeed — GET /calendar/{token}/reminders.ics.
+func (c *CalendarController) handleFeed(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	body, err := c.calendarService.Feed(r.Context(), params["token"])
+	if errors.Is(err, service.ErrCalendarNotFound) {
+		http.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		c.log.Error("calendar feed", slog.Any("err", err))
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Content-Disposition", `inline; filename="reminders.ics"`)
+	w.Header().Set("Cache-Control", "no-cache, private")
+	w.Write(body)
+}
@@ -0,0 +1,32 @@
+package calendar
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/vovanwin/platform/server"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.uber.org/fx"
+	"google.golang.org/grpc"
+)
+
+// Module возвращает fx.Option для подключения ленты iCalendar.
+func Module() fx.Option {
+	return fx.Module("api:calendar",
+		fx.Decorate(func(log *slog.Logger) *slog.Logger {
+			return log.With("component", "calendar")
+		}),
+		fx.Provide(NewCalendarController),
+		fx.Provide(
+			fx.Annotate(
+				func(c *CalendarController) server.GatewayRegistrator {
+					return func(_ context.Context, mux *runtime.ServeMux, _ *grpc.Server) error {
+						return mux.HandlePath("GET", "/calendar/{token}/reminders.ics", c.handleFeed)
+					}
+				},
+				fx.ResultTags(`group:"gateway_registrators"`),
+			),
+		),
+	)
+}
@@ -0,0 +1,154 @@
+package reminders
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/vovanwin/template/internal/model"
+	"github.com/vovanwin/template/internal/pkg/importer"
+	"github.com/vovanwin/template/internal/pkg/jwt"
+	"github.com/vovanwin/template/internal/pkg/recurrence"
+	"github.com/vovanwin/template/internal/repository"
+	"github.com/vovanwin/template/internal/service"
+	reminderspb "github.com/vovanwin/template/pkg/reminders"
+	"go.uber.org/fx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Deps содержит зависимости для RemindersGRPCServer.
+type Deps struct {
+	fx.In
+
+	Log             *slog.Logger
+	ReminderService *service.ReminderService
+	AuthService     *service.AuthService
+}
+
+// reminderService — методы сервиса напоминаний, которые вызывает API.
+type reminderService interface {
+	CreateReminder(ctx context.Context, in service.CreateReminderInput) (*repository.Reminder, error)
+	GetReminder(ctx context.Context, userID, reminderID uuid.UUID) (*repository.Reminder, error)
+	UpdateReminder(ctx context.Context, userID, reminderID uuid.UUID, in service.UpdateReminderInput) (*repository.Reminder, error)
+	ListRemindersPaged(ctx context.Context, userID uuid.UUID, page, pageSize int, sortField, sortOrder string, filters []model.ActiveFilter) (*repository.PagedReminders, error)
+	ListRemindersFeed(ctx context.Context, userID uuid.UUID, after string, limit int, sortField, sortOrder string, filters []model.ActiveFilter) (*repository.PagedReminders, error)
+	CancelReminder(ctx context.Context, userID, reminderID uuid.UUID) error
+	AcknowledgeReminder(ctx context.Context, userID, reminderID uuid.UUID) error
+	DeleteReminder(ctx context.Context, userID, reminderID uuid.UUID) error
+	ListDeliveries(ctx context.Context, userID, reminderID uuid.UUID) ([]repository.Delivery, error)
+	PreviewImport(ctx context.Context, userID uuid.UUID, filename string, data []byte) ([]importer.Row, error)
+	ImportReminders(ctx context.Context, userID uuid.UUID, filename string, data []byte) (*service.ImportResult, error)
+}
+
+// profileService отдаёт профиль пользователя, из которого берётся Telegram chat ID.
+type profileService interface {
+	GetProfile(ctx context.Context, userID uuid.UUID) (*service.Profile, error)
+}
+
+// RemindersGRPCServer реализует gRPC сервис ReminderService.
+type RemindersGRPCServer struct {
+	reminderspb.UnimplementedReminderServiceServer
+	log             *slog.Logger
+	reminderService reminderService
+	authService     profileService
+}
+
+// NewRemindersGRPCServer создаёт новый RemindersGRPCServer.
+func NewRemindersGRPCServer(deps Deps) *RemindersGRPCServer {
+	return &RemindersGRPCServer{
+		log:             deps.Log,
+		reminderService: deps.ReminderService,
+		authService:     deps.AuthService,
+	}
+}
+
+// currentUser возвращает ID пользователя из контекста запроса.
+func currentUser(ctx context.Context) (uuid.UUID, error) {
+	userIDStr, ok := jwt.GetUserIDFromContext(ctx)
+	if !ok {
+		return uuid.Nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		return uuid.Nil, status.Error(codes.Internal, "invalid user id")
+	}
+	return userID, nil
+}
+
+// parseReminderID разбирает ID напоминания из запроса.
+func parseReminderID(id string) (uuid.UUID, error) {
+	reminderID, err := uuid.Parse(id)
+	if err != nil {
+		return uuid.Nil, status.Error(codes.InvalidArgument, "invalid id")
+	}
+	return reminderID, nil
+}
+
+// toStatus переводит ошибку сервиса напоминаний в gRPC-статус.
+func (s *RemindersGRPCServer) toStatus(op string, err error) error {
+	switch {
+	case errors.Is(err, service.ErrReminderNotFound), errors.Is(err, service.ErrReminderForbidden):
+		// Чужое напоминание неотличимо от отсутствующего
+		return status.Error(codes.NotFound, "reminder not found")
+	case errors.Is(err, service.ErrListNotFound):
+		return status.Error(codes.NotFound, "list not found")
+	case errors.Is(err, service.ErrActionNotAllowed):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, service.ErrInvalidReminder),
+		errors.Is(err, recurrence.ErrEmptyRule),
+		errors.Is(err, recurrence.ErrInvalidRule),
+		errors.Is(err, recurrence.ErrUnsupported),
+		errors.Is(err, importer.ErrUnknownFormat),
+		errors.Is(err, importer.ErrInvalidFile),
+		errors.Is(err, importer.ErrTooLarge):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	s.log.Error(op+" failed", "error", err)
+	return status.Errorf(codes.Internal, "%s failed: %v", op, err)
+}
+
+// toProto конвертирует напоминание в proto-сообщение.
+func toProto(rem *repository.Reminder) *reminderspb.Reminder {
+	out := &reminderspb.Reminder{
+		Id:                    rem.ID.String(),
+		Title:                 rem.Title,
+		Description:           rem.Description,
+		RemindAt:              timestamppb.New(rem.RemindAt),
+		Status:                rem.Status,
+		RequireConfirmation:   rem.RequireConfirmation,
+		RepeatIntervalMinutes: int32(rem.RepeatIntervalMinutes),
+		RecurrenceRule:        rem.RecurrenceRule,
+		Channels:              rem.Channels,
+		EscalationPolicy:      rem.EscalationPolicy,
+		EscalationLevel:       int32(rem.EscalationLevel),
+		OccurrenceCount:       int32(rem.OccurrenceCount),
+		SnoozeCount:           int32(rem.SnoozeCount),
+		CreatedAt:             timestamppb.New(rem.CreatedAt),
+		UpdatedAt:             timestamppb.New(rem.UpdatedAt),
+		UserId:                rem.UserID.String(),
+		ListName:              rem.ListName,
+		AssigneeEmail:         rem.AssigneeEmail,
+		Priority:              rem.Priority.String(),
+		Tags:                  rem.Tags,
+		OverrideQuietHours:    rem.OverrideQuietHours,
+		Timezone:              rem.Timezone,
+		ConfirmWindowMinutes:  int32(rem.ConfirmWindowMinutes),
+		ResendBackoff:         rem.ResendBackoff,
+		MaxResends:            int32(rem.MaxResends),
+	}
+	if rem.ListID != nil {
+		out.ListId = rem.ListID.String()
+	}
+	if rem.AssigneeID != nil {
+		out.AssigneeId = rem.AssigneeID.String()
+	}
+	if rem.SnoozedUntil != nil {
+		out.SnoozedUntil = timestamppb.New(*rem.SnoozedUntil)
+	}
+	return out
+}
@@ -0,0 +1,304 @@
+package reminders
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vovanwin/template/internal/model"
+	"github.com/vovanwin/template/internal/pkg/importer"
+	"github.com/vovanwin/template/internal/pkg/recurrence"
+	"github.com/vovanwin/template/internal/repository"
+	"github.com/vovanwin/template/internal/service"
+	reminderspb "github.com/vovanwin/template/pkg/reminders"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// fakeService отвечает заранее заданной ошибкой или данными и запоминает
+// аргументы последнего вызова.
+type fakeService struct {
+	err     error
+	paged   *repository.PagedReminders
+	profile *service.Profile
+
+	calls    int
+	feed     bool
+	page     int
+	pageSize int
+	cursor   string
+	filters  []model.ActiveFilter
+	created  service.CreateReminderInput
+}
+
+func (f *fakeService) reminder(userID uuid.UUID) (*repository.Reminder, error) {
+	f.calls++
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &repository.Reminder{ID: uuid.New(), UserID: userID, Title: "Позвонить врачу", Status: "pending"}, nil
+}
+
+func (f *fakeService) CreateReminder(_ context.Context, in service.CreateReminderInput) (*repository.Reminder, error) {
+	f.created = in
+	return f.reminder(in.UserID)
+}
+
+func (f *fakeService) GetReminder(_ context.Context, userID, _ uuid.UUID) (*repository.Reminder, error) {
+	return f.reminder(userID)
+}
+
+func (f *fakeService) UpdateReminder(_ context.Context, userID, _ uuid.UUID, _ service.UpdateReminderInput) (*repository.Reminder, error) {
+	return f.reminder(userID)
+}
+
+func (f *fakeService) ListRemindersPaged(_ context.Context, _ uuid.UUID, page, pageSize int, _, _ string, filters []model.ActiveFilter) (*repository.PagedReminders, error) {
+	f.calls++
+	f.page, f.pageSize, f.filters = page, pageSize, filters
+	return f.paged, f.err
+}
+
+func (f *fakeService) ListRemindersFeed(_ context.Context, _ uuid.UUID, after string, limit int, _, _ string, filters []model.ActiveFilter) (*repository.PagedReminders, error) {
+	f.calls++
+	f.feed, f.cursor, f.pageSize, f.filters = true, after, limit, filters
+	return f.paged, f.err
+}
+
+func (f *fakeService) CancelReminder(context.Context, uuid.UUID, uuid.UUID) error {
+	f.calls++
+	return f.err
+}
+
+func (f *fakeService) AcknowledgeReminder(context.Context, uuid.UUID, uuid.UUID) error {
+	f.calls++
+	return f.err
+}
+
+func (f *fakeService) DeleteReminder(context.Context, uuid.UUID, uuid.UUID) error {
+	f.calls++
+	return f.err
+}
+
+func (f *fakeService) ListDeliveries(context.Context, uuid.UUID, uuid.UUID) ([]repository.Delivery, error) {
+	f.calls++
+	return nil, f.err
+}
+
+func (f *fakeService) PreviewImport(context.Context, uuid.UUID, string, []byte) ([]importer.Row, error) {
+	f.calls++
+	return nil, f.err
+}
+
+func (f *fakeService) ImportReminders(context.Context, uuid.UUID, string, []byte) (*service.ImportResult, error) {
+	f.calls++
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &service.ImportResult{}, nil
+}
+
+func (f *fakeService) GetProfile(_ context.Context, userID uuid.UUID) (*service.Profile, error) {
+	if f.profile == nil {
+		return &service.Profile{ID: userID}, nil
+	}
+	return f.profile, nil
+}
+
+func newTestServer(f *fakeService) *RemindersGRPCServer {
+	return &RemindersGRPCServer{
+		log:             slog.New(slog.NewTextHandler(io.Discard, nil)),
+		reminderService: f,
+		authService:     f,
+	}
+}
+
+// userContext — контекст запроса, прошедшего JWT-интерцептор; ключ тот же,
+// что читает jwt.GetUserIDFromContext.
+func userContext(userID string) context.Context {
+	return context.WithValue(context.Background(), "user_id", userID)
+}
+
+func checkCode(t *testing.T, name string, err error, want codes.Code) {
+	t.Helper()
+	if got := status.Code(err); got != want {
+		t.Errorf("%s: code = %s (%v), want %s", name, got, err, want)
+	}
+}
+
+func TestServiceErrorCodes(t *testing.T) {
+	for _, tt := range []struct {
+		err  error
+		want codes.Code
+	}{
+		{service.ErrReminderNotFound, codes.NotFound},
+		{service.ErrReminderForbidden, codes.NotFound},
+		{service.ErrListNotFound, codes.NotFound},
+		{service.ErrActionNotAllowed, codes.PermissionDenied},
+		{service.ErrInvalidReminder, codes.InvalidArgument},
+		{fmt.Errorf("%w: reminder is not recurring", service.ErrInvalidReminder), codes.InvalidArgument},
+		{fmt.Errorf("%w: snooze duration must be positive", service.ErrInvalidReminder), codes.InvalidArgument},
+		{fmt.Errorf("%w: reminder has no running workflow", service.ErrInvalidReminder), codes.InvalidArgument},
+		{fmt.Errorf("parse recurrence: %w", recurrence.ErrInvalidRule), codes.InvalidArgument},
+		{recurrence.ErrUnsupported, codes.InvalidArgument},
+		{importer.ErrTooLarge, codes.InvalidArgument},
+		{importer.ErrUnknownFormat, codes.InvalidArgument},
+		{errors.New("reminder is not recurring"), codes.Internal},
+		{fmt.Errorf("get reminder: %w", errors.New("connection refused")), codes.Internal},
+	} {
+		srv := newTestServer(&fakeService{err: tt.err})
+		ctx := userContext(uuid.NewString())
+		id := uuid.NewString()
+
+		_, err := srv.GetReminder(ctx, &reminderspb.GetReminderRequest{Id: id})
+		checkCode(t, "get "+tt.err.Error(), err, tt.want)
+		_, err = srv.CancelReminder(ctx, &reminderspb.CancelReminderRequest{Id: id})
+		checkCode(t, "cancel "+tt.err.Error(), err, tt.want)
+		_, err = srv.ListReminders(ctx, &reminderspb.ListRemindersRequest{})
+		checkCode(t, "list "+tt.err.Error(), err, tt.want)
+	}
+}
+
+func TestAuthentication(t *testing.T) {
+	for name, ctx := range map[string]context.Context{
+		"no user":      context.Background(),
+		"invalid user": userContext("not-a-uuid"),
+	} {
+		f := &fakeService{}
+		srv := newTestServer(f)
+		want := codes.Unauthenticated
+		if name == "invalid user" {
+			want = codes.Internal
+		}
+
+		_, err := srv.GetReminder(ctx, &reminderspb.GetReminderRequest{Id: uuid.NewString()})
+		checkCode(t, name+" get", err, want)
+		_, err = srv.ListReminders(ctx, &reminderspb.ListRemindersRequest{})
+		checkCode(t, name+" list", err, want)
+		_, err = srv.CreateReminder(ctx, &reminderspb.CreateReminderRequest{Title: "Позвонить врачу", RemindAt: timestamppb.Now()})
+		checkCode(t, name+" create", err, want)
+		if f.calls != 0 {
+			t.Errorf("%s: service called %d times", name, f.calls)
+		}
+	}
+}
+
+func TestRequestValidation(t *testing.T) {
+	remindAt := timestamppb.New(time.Now().Add(time.Hour))
+	for name, call := range map[string]func(*RemindersGRPCServer, context.Context) error{
+		"invalid id": func(s *RemindersGRPCServer, ctx context.Context) error {
+			_, err := s.GetReminder(ctx, &reminderspb.GetReminderRequest{Id: "42"})
+			return err
+		},
+		"update invalid id": func(s *RemindersGRPCServer, ctx context.Context) error {
+			_, err := s.UpdateReminder(ctx, &reminderspb.UpdateReminderRequest{Id: ""})
+			return err
+		},
+		"blank title": func(s *RemindersGRPCServer, ctx context.Context) error {
+			_, err := s.CreateReminder(ctx, &reminderspb.CreateReminderRequest{Title: "  ", RemindAt: remindAt})
+			return err
+		},
+		"no remind_at": func(s *RemindersGRPCServer, ctx context.Context) error {
+			_, err := s.CreateReminder(ctx, &reminderspb.CreateReminderRequest{Title: "Позвонить врачу"})
+			return err
+		},
+		"negative interval": func(s *RemindersGRPCServer, ctx context.Context) error {
+			_, err := s.CreateReminder(ctx, &reminderspb.CreateReminderRequest{Title: "Позвонить врачу", RemindAt: remindAt, RepeatIntervalMinutes: -1})
+			return err
+		},
+		"invalid list_id": func(s *RemindersGRPCServer, ctx context.Context) error {
+			_, err := s.CreateReminder(ctx, &reminderspb.CreateReminderRequest{Title: "Позвонить врачу", RemindAt: remindAt, ListId: "family"})
+			return err
+		},
+		"unknown priority": func(s *RemindersGRPCServer, ctx context.Context) error {
+			_, err := s.CreateReminder(ctx, &reminderspb.CreateReminderRequest{Title: "Позвонить врачу", RemindAt: remindAt, Priority: "urgent"})
+			return err
+		},
+		"empty import": func(s *RemindersGRPCServer, ctx context.Context) error {
+			_, err := s.ImportReminders(ctx, &reminderspb.ImportRemindersRequest{Filename: "reminders.csv"})
+			return err
+		},
+	} {
+		f := &fakeService{}
+		err := call(newTestServer(f), userContext(uuid.NewString()))
+		checkCode(t, name, err, codes.InvalidArgument)
+		if f.calls != 0 {
+			t.Errorf("%s: service called %d times", name, f.calls)
+		}
+	}
+}
+
+func TestCreateReminder(t *testing.T) {
+	f := &fakeService{profile: &service.Profile{TelegramChatID: 123456789}}
+	userID := uuid.New()
+	listID := uuid.New()
+
+	_, err := newTestServer(f).CreateReminder(userContext(userID.String()), &reminderspb.CreateReminderRequest{
+		Title:    "  Позвонить врачу ",
+		RemindAt: timestamppb.New(time.Now().Add(time.Hour)),
+		ListId:   listID.String(),
+		Priority: "high",
+	})
+	if err != nil {
+		t.Fatalf("CreateReminder: %v", err)
+	}
+	in := f.created
+	if in.UserID != userID || in.Title != "Позвонить врачу" || in.TelegramChatID != 123456789 ||
+		in.ListID == nil || *in.ListID != listID || in.Priority != model.ReminderPriorityHigh {
+		t.Errorf("service input = %+v", in)
+	}
+}
+
+func TestListRemindersPagination(t *testing.T) {
+	items := []repository.Reminder{{ID: uuid.New(), Title: "Первое"}, {ID: uuid.New(), Title: "Второе"}}
+	for _, tt := range []struct {
+		name     string
+		req      *reminderspb.ListRemindersRequest
+		feed     bool
+		page     int
+		pageSize int
+		cursor   string
+	}{
+		{name: "default page size", req: &reminderspb.ListRemindersRequest{Page: 3}, page: 3, pageSize: defaultPageSize},
+		{name: "explicit page size", req: &reminderspb.ListRemindersRequest{Page: 1, PageSize: 50}, page: 1, pageSize: 50},
+		{name: "keyset first page", req: &reminderspb.ListRemindersRequest{Keyset: true, PageSize: 10}, feed: true, pageSize: 10},
+		{name: "cursor implies keyset", req: &reminderspb.ListRemindersRequest{Cursor: "opaque"}, feed: true, pageSize: defaultPageSize, cursor: "opaque"},
+	} {
+		f := &fakeService{paged: &repository.PagedReminders{Items: items, TotalItems: 42, TotalPages: 3, NextCursor: "next"}}
+		resp, err := newTestServer(f).ListReminders(userContext(uuid.NewString()), tt.req)
+		if err != nil {
+			t.Fatalf("%s: ListReminders: %v", tt.name, err)
+		}
+		if f.feed != tt.feed || f.page != tt.page || f.pageSize != tt.pageSize || f.cursor != tt.cursor {
+			t.Errorf("%s: service called with feed=%v page=%d size=%d cursor=%q", tt.name, f.feed, f.page, f.pageSize, f.cursor)
+		}
+		if len(resp.GetReminders()) != 2 || resp.GetTotalItems() != 42 || resp.GetTotalPages() != 3 || resp.GetNextCursor() != "next" {
+			t.Errorf("%s: response = %v", tt.name, resp)
+		}
+	}
+}
+
+func TestListRemindersFilters(t *testing.T) {
+	f := &fakeService{paged: &repository.PagedReminders{}}
+	_, err := newTestServer(f).ListReminders(userContext(uuid.NewString()), &reminderspb.ListRemindersRequest{
+		Status:       "pending",
+		Priority:     "high",
+		Tag:          "дом",
+		RemindAtFrom: "2026-03-01",
+	})
+	if err != nil {
+		t.Fatalf("ListReminders: %v", err)
+	}
+	keys := make([]string, len(f.filters))
+	for i, filter := range f.filters {
+		keys[i] = filter.Key
+	}
+	if fmt.Sprint(keys) != "[status priority tags remind_at]" {
+		t.Errorf("filters = %v", keys)
+	}
+}
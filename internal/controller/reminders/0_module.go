@@ -0,0 +1,43 @@
+package reminders
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/vovanwin/platform/server"
+	reminderspb "github.com/vovanwin/template/pkg/reminders"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.uber.org/fx"
+	"google.golang.org/grpc"
+)
+
+// Module возвращает fx.Option для подключения ReminderService.
+func Module() fx.Option {
+	return fx.Module("api:reminders",
+		fx.Decorate(func(log *slog.Logger) *slog.Logger {
+			return log.With("component", "api")
+		}),
+		fx.Provide(NewRemindersGRPCServer),
+		fx.Provide(
+			fx.Annotate(
+				func(srv *RemindersGRPCServer) server.GRPCRegistrator {
+					return func(s *grpc.Server) {
+						reminderspb.RegisterReminderServiceServer(s, srv)
+					}
+				},
+				fx.ResultTags(`group:"grpc_registrators"`),
+			),
+		),
+		fx.Provide(
+			fx.Annotate(
+				func(srv *RemindersGRPCServer) server.GatewayRegistrator {
+					return func(ctx context.Context, mux *runtime.ServeMux, _ *grpc.Server) error {
+						return reminderspb.RegisterReminderServiceHandlerServer(ctx, mux, srv)
+					}
+				},
+				fx.ResultTags(`group:"gateway_registrators"`),
+			),
+		),
+	)
+}
@@ -0,0 +1,26 @@
+package reminders
+
+import (
+	"context"
+
+	reminderspb "github.com/vovanwin/template/pkg/reminders"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *RemindersGRPCServer) AcknowledgeReminder(ctx context.Context, req *reminderspb.AcknowledgeReminderRequest) (*emptypb.Empty, error) {
+	userID, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	reminderID, err := parseReminderID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.reminderService.AcknowledgeReminder(ctx, userID, reminderID); err != nil {
+		return nil, s.toStatus("acknowledge reminder", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
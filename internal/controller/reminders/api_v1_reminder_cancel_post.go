@@ -0,0 +1,26 @@
+package reminders
+
+import (
+	"context"
+
+	reminderspb "github.com/vovanwin/template/pkg/reminders"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *RemindersGRPCServer) CancelReminder(ctx context.Context, req *reminderspb.CancelReminderRequest) (*emptypb.Empty, error) {
+	userID, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	reminderID, err := parseReminderID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.reminderService.CancelReminder(ctx, userID, reminderID); err != nil {
+		return nil, s.toStatus("cancel reminder", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,26 @@
+package reminders
+
+import (
+	"context"
+
+	reminderspb "github.com/vovanwin/template/pkg/reminders"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *RemindersGRPCServer) DeleteReminder(ctx context.Context, req *reminderspb.DeleteReminderRequest) (*emptypb.Empty, error) {
+	userID, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	reminderID, err := parseReminderID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.reminderService.DeleteReminder(ctx, userID, reminderID); err != nil {
+		return nil, s.toStatus("delete reminder", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
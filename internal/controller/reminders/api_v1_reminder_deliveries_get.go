@@ -0,0 +1,42 @@
+package reminders
+
+import (
+	"context"
+
+	reminderspb "github.com/vovanwin/template/pkg/reminders"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *RemindersGRPCServer) ListReminderDeliveries(ctx context.Context, req *reminderspb.ListReminderDeliveriesRequest) (*reminderspb.ListReminderDeliveriesResponse, error) {
+	userID, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	reminderID, err := parseReminderID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries, err := s.reminderService.ListDeliveries(ctx, userID, reminderID)
+	if err != nil {
+		return nil, s.toStatus("list reminder deliveries", err)
+	}
+
+	resp := &reminderspb.ListReminderDeliveriesResponse{
+		Deliveries: make([]*reminderspb.ReminderDelivery, 0, len(deliveries)),
+	}
+	for _, d := range deliveries {
+		resp.Deliveries = append(resp.Deliveries, &reminderspb.ReminderDelivery{
+			WorkflowId:        d.WorkflowID,
+			Delivery:          int32(d.Delivery),
+			Channel:           d.Channel,
+			Attempt:           int32(d.Attempt),
+			Status:            d.Status,
+			Error:             d.Error,
+			TelegramMessageId: d.TelegramMessageID,
+			CreatedAt:         timestamppb.New(d.CreatedAt),
+		})
+	}
+	return resp, nil
+}
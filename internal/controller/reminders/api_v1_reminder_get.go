@@ -0,0 +1,26 @@
+package reminders
+
+import (
+	"context"
+
+	reminderspb "github.com/vovanwin/template/pkg/reminders"
+)
+
+func (s *RemindersGRPCServer) GetReminder(ctx context.Context, req *reminderspb.GetReminderRequest) (*reminderspb.Reminder, error) {
+	userID, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	reminderID, err := parseReminderID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	rem, err := s.reminderService.GetReminder(ctx, userID, reminderID)
+	if err != nil {
+		return nil, s.toStatus("get reminder", err)
+	}
+
+	return toProto(rem), nil
+}
@@ -0,0 +1,36 @@
+package reminders
+
+import (
+	"context"
+
+	"github.com/vovanwin/template/internal/service"
+	reminderspb "github.com/vovanwin/template/pkg/reminders"
+)
+
+func (s *RemindersGRPCServer) UpdateReminder(ctx context.Context, req *reminderspb.UpdateReminderRequest) (*reminderspb.Reminder, error) {
+	userID, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	reminderID, err := parseReminderID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	in := service.UpdateReminderInput{
+		Title:       req.GetTitle(),
+		Description: req.GetDescription(),
+	}
+	if req.GetRemindAt() != nil {
+		remindAt := req.GetRemindAt().AsTime()
+		in.RemindAt = &remindAt
+	}
+
+	rem, err := s.reminderService.UpdateReminder(ctx, userID, reminderID, in)
+	if err != nil {
+		return nil, s.toStatus("update reminder", err)
+	}
+
+	return toProto(rem), nil
+}
@@ -0,0 +1,82 @@
+package reminders
+
+import (
+	"context"
+
+	"github.com/vovanwin/template/internal/model"
+	"github.com/vovanwin/template/internal/pkg/search"
+	"github.com/vovanwin/template/internal/repository"
+	reminderspb "github.com/vovanwin/template/pkg/reminders"
+)
+
+// defaultPageSize — размер страницы, если он не указан в запросе.
+const defaultPageSize = 20
+
+func (s *RemindersGRPCServer) ListReminders(ctx context.Context, req *reminderspb.ListRemindersRequest) (*reminderspb.ListRemindersResponse, error) {
+	userID, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := int(req.GetPageSize())
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+
+	var paged *repository.PagedReminders
+	if req.GetKeyset() || req.GetCursor() != "" {
+		paged, err = s.reminderService.ListRemindersFeed(ctx, userID, req.GetCursor(), pageSize,
+			req.GetSortField(), req.GetSortOrder(), listFilters(req))
+	} else {
+		paged, err = s.reminderService.ListRemindersPaged(ctx, userID, int(req.GetPage()), pageSize,
+			req.GetSortField(), req.GetSortOrder(), listFilters(req))
+	}
+	if err != nil {
+		return nil, s.toStatus("list reminders", err)
+	}
+
+	resp := &reminderspb.ListRemindersResponse{
+		Reminders:  make([]*reminderspb.Reminder, 0, len(paged.Items)),
+		TotalItems: int32(paged.TotalItems),
+		TotalPages: int32(paged.TotalPages),
+		NextCursor: paged.NextCursor,
+	}
+	for i := range paged.Items {
+		resp.Reminders = append(resp.Reminders, toProto(&paged.Items[i]))
+		if h, ok := paged.Highlights[paged.Items[i].ID]; ok {
+			resp.Highlights = append(resp.Highlights, &reminderspb.SearchHighlight{
+				ReminderId: paged.Items[i].ID.String(),
+				Title:      search.HTML(h.Title),
+				Snippet:    search.HTML(h.Snippet),
+			})
+		}
+	}
+	return resp, nil
+}
+
+// listFilters собирает фильтры репозитория из параметров запроса.
+func listFilters(req *reminderspb.ListRemindersRequest) []model.ActiveFilter {
+	var filters []model.ActiveFilter
+	if req.GetStatus() != "" {
+		filters = append(filters, model.ActiveFilter{Key: "status", Type: model.FilterEnum, Value: req.GetStatus()})
+	}
+	if req.GetTitle() != "" {
+		filters = append(filters, model.ActiveFilter{Key: "title", Type: model.FilterString, Value: req.GetTitle()})
+	}
+	if req.GetPriority() != "" {
+		filters = append(filters, model.ActiveFilter{Key: "priority", Type: model.FilterEnum, Value: req.GetPriority()})
+	}
+	if q := search.Normalize(req.GetQuery()); q != "" {
+		filters = append(filters, model.ActiveFilter{Key: "q", Type: model.FilterSearch, Value: q})
+	}
+	if req.GetTag() != "" {
+		filters = append(filters, model.ActiveFilter{Key: "tags", Type: model.FilterString, Value: req.GetTag()})
+	}
+	if req.GetRemindAtFrom() != "" || req.GetRemindAtTo() != "" {
+		filters = append(filters, model.ActiveFilter{Key: "remind_at", Type: model.FilterDateRange, Value: req.GetRemindAtFrom(), ValueTo: req.GetRemindAtTo()})
+	}
+	if req.GetCreatedAtFrom() != "" || req.GetCreatedAtTo() != "" {
+		filters = append(filters, model.ActiveFilter{Key: "created_at", Type: model.FilterDateRange, Value: req.GetCreatedAtFrom(), ValueTo: req.GetCreatedAtTo()})
+	}
+	return filters
+}
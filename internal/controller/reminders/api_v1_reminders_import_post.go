@@ -0,0 +1,57 @@
+package reminders
+
+import (
+	"context"
+
+	"github.com/vovanwin/template/internal/pkg/importer"
+	reminderspb "github.com/vovanwin/template/pkg/reminders"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *RemindersGRPCServer) ImportReminders(ctx context.Context, req *reminderspb.ImportRemindersRequest) (*reminderspb.ImportRemindersResponse, error) {
+	userID, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.GetContent()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "content is required")
+	}
+
+	if req.GetDryRun() {
+		rows, err := s.reminderService.PreviewImport(ctx, userID, req.GetFilename(), req.GetContent())
+		if err != nil {
+			return nil, s.toStatus("preview import", err)
+		}
+		return &reminderspb.ImportRemindersResponse{Rows: importRowsToProto(rows)}, nil
+	}
+
+	result, err := s.reminderService.ImportReminders(ctx, userID, req.GetFilename(), req.GetContent())
+	if err != nil {
+		return nil, s.toStatus("import reminders", err)
+	}
+	return &reminderspb.ImportRemindersResponse{
+		Rows:    importRowsToProto(result.Rows),
+		Created: int32(result.Created),
+	}, nil
+}
+
+func importRowsToProto(rows []importer.Row) []*reminderspb.ImportRow {
+	out := make([]*reminderspb.ImportRow, len(rows))
+	for i, r := range rows {
+		out[i] = &reminderspb.ImportRow{
+			Line:           int32(r.Line),
+			Title:          r.Title,
+			Description:    r.Description,
+			RecurrenceRule: r.RecurrenceRule,
+			Tags:           r.Tags,
+			Priority:       r.Priority.String(),
+			Errors:         r.Errors,
+		}
+		if !r.RemindAt.IsZero() {
+			out[i].RemindAt = timestamppb.New(r.RemindAt)
+		}
+	}
+	return out
+}
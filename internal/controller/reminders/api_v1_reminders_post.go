@@ -0,0 +1,78 @@
+package reminders
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/vovanwin/template/internal/model"
+	"github.com/vovanwin/template/internal/service"
+	reminderspb "github.com/vovanwin/template/pkg/reminders"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *RemindersGRPCServer) CreateReminder(ctx context.Context, req *reminderspb.CreateReminderRequest) (*reminderspb.Reminder, error) {
+	userID, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.TrimSpace(req.GetTitle()) == "" {
+		return nil, status.Error(codes.InvalidArgument, "title is required")
+	}
+	if req.GetRemindAt() == nil {
+		return nil, status.Error(codes.InvalidArgument, "remind_at is required")
+	}
+	if req.GetRepeatIntervalMinutes() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "repeat_interval_minutes must not be negative")
+	}
+
+	var listID *uuid.UUID
+	if raw := req.GetListId(); raw != "" {
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid list_id")
+		}
+		listID = &id
+	}
+
+	var priority model.ReminderPriority
+	if raw := req.GetPriority(); raw != "" {
+		if priority, err = model.ReminderPriorityString(raw); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "priority must be low, normal or high")
+		}
+	}
+
+	profile, err := s.authService.GetProfile(ctx, userID)
+	if err != nil {
+		s.log.Error("get profile failed", "error", err)
+		return nil, status.Errorf(codes.Internal, "get profile failed: %v", err)
+	}
+
+	rem, err := s.reminderService.CreateReminder(ctx, service.CreateReminderInput{
+		UserID:                userID,
+		Title:                 strings.TrimSpace(req.GetTitle()),
+		Description:           req.GetDescription(),
+		RemindAt:              req.GetRemindAt().AsTime(),
+		TelegramChatID:        profile.TelegramChatID,
+		RequireConfirmation:   req.GetRequireConfirmation(),
+		RepeatIntervalMinutes: int(req.GetRepeatIntervalMinutes()),
+		RecurrenceRule:        req.GetRecurrenceRule(),
+		Channels:              req.GetChannels(),
+		EscalationPolicy:      req.GetEscalationPolicy(),
+		ListID:                listID,
+		AssigneeEmail:         req.GetAssigneeEmail(),
+		Priority:              priority,
+		Tags:                  req.GetTags(),
+		OverrideQuietHours:    req.GetOverrideQuietHours(),
+		ConfirmWindowMinutes:  int(req.GetConfirmWindowMinutes()),
+		ResendBackoff:         req.GetResendBackoff(),
+		MaxResends:            int(req.GetMaxResends()),
+	})
+	if err != nil {
+		return nil, s.toStatus("create reminder", err)
+	}
+
+	return toProto(rem), nil
+}
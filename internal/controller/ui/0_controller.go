@@ -3,9 +3,13 @@ package ui
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
 	"log/slog"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/a-h/templ"
@@ -18,6 +22,7 @@ import (
 	"github.com/vovanwin/template/internal/pkg/centrifugo"
 	"github.com/vovanwin/template/internal/pkg/events"
 	"github.com/vovanwin/template/internal/pkg/jwt"
+	"github.com/vovanwin/template/internal/pkg/tags"
 	"github.com/vovanwin/template/internal/pkg/timezone"
 	"github.com/vovanwin/template/internal/service"
 	"go.uber.org/fx"
@@ -30,6 +35,8 @@ type UIController struct {
 	centrifugoURL    string
 	authService      *service.AuthService
 	reminderService  *service.ReminderService
+	listService      *service.ListService
+	calendarService  *service.CalendarService
 	jwtService       jwt.JWTService
 	log              *slog.Logger
 }
@@ -41,6 +48,8 @@ type Deps struct {
 	CentrifugoURL    string `name:"centrifugo_url"`
 	AuthService      *service.AuthService
 	ReminderService  *service.ReminderService
+	ListService      *service.ListService
+	CalendarService  *service.CalendarService
 	JWTService       jwt.JWTService
 	Log              *slog.Logger
 }
@@ -52,6 +61,8 @@ func NewUIController(deps Deps) *UIController {
 		centrifugoURL:    deps.CentrifugoURL,
 		authService:      deps.AuthService,
 		reminderService:  deps.ReminderService,
+		listService:      deps.ListService,
+		calendarService:  deps.CalendarService,
 		jwtService:       deps.JWTService,
 		log:              deps.Log,
 	}
@@ -86,6 +97,21 @@ func (c *UIController) requireAuth(w http.ResponseWriter, r *http.Request) (user
 	return uid, false
 }
 
+// withLocation кладёт в контекст запроса таймзону авторизованного пользователя:
+// шаблоны и обработчики показывают и разбирают время в ней.
+func (c *UIController) withLocation(next runtime.HandlerFunc) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		if uid, _, ok := c.extractUser(r); ok {
+			if userID, err := uuid.Parse(uid); err == nil {
+				if loc, err := c.reminderService.Location(r.Context(), userID); err == nil {
+					r = r.WithContext(timezone.WithLocation(r.Context(), loc))
+				}
+			}
+		}
+		next(w, r, pathParams)
+	}
+}
+
 func (c *UIController) RegisterRoutes(ctx context.Context, mux *runtime.ServeMux, _ *grpc.Server) error {
 	routes := []struct {
 		method  string
@@ -98,10 +124,45 @@ func (c *UIController) RegisterRoutes(ctx context.Context, mux *runtime.ServeMux
 		{"GET", "/logout", c.handleLogout},
 		{"GET", "/dashboard", c.handleDashboard},
 		{"GET", "/profile", c.handleProfile},
+		{"POST", "/profile/calendar-token", c.handleRotateCalendarToken},
+		{"DELETE", "/profile/calendar-token", c.handleDisableCalendarFeed},
 		{"GET", "/reminders", c.handleReminders},
 		{"POST", "/reminders", c.handleCreateReminder},
+		{"POST", "/reminders/parse-date", c.handleParseReminderDate},
+		{"POST", "/reminders/import/preview", c.handlePreviewImport},
+		{"POST", "/reminders/import", c.handleImportReminders},
+		{"POST", "/reminders/bulk/cancel", c.handleBulkCancel},
+		{"POST", "/reminders/bulk/delete", c.handleBulkDelete},
+		{"POST", "/reminders/bulk/retag", c.handleBulkRetag},
+		{"POST", "/reminders/bulk/reschedule", c.handleBulkReschedule},
 		{"DELETE", "/reminders/{id}", c.handleDeleteReminder},
+		{"POST", "/reminders/{id}/pause", c.handlePauseReminder},
+		{"POST", "/reminders/{id}/resume", c.handleResumeReminder},
+		{"POST", "/reminders/{id}/recurrence", c.handleUpdateRecurrence},
+		{"POST", "/reminders/{id}/snooze/{option}", c.handleSnoozeReminder},
+		{"GET", "/reminders/{id}/edit", c.handleEditReminderForm},
+		{"POST", "/reminders/{id}/edit", c.handleEditReminder},
+		{"GET", "/reminders/{id}/escalations", c.handleReminderEscalations},
+		{"GET", "/reminders/{id}/deliveries", c.handleReminderDeliveries},
+		{"POST", "/reminders/{id}/attachments", c.handleUploadAttachment},
+		{"GET", "/reminders/{id}/attachments/{attachment_id}", c.handleDownloadAttachment},
+		{"DELETE", "/reminders/{id}/attachments/{attachment_id}", c.handleDeleteAttachment},
+		{"GET", "/reminders/{id}/checklist", c.handleReminderChecklist},
+		{"POST", "/reminders/{id}/checklist", c.handleAddChecklistItem},
+		{"POST", "/reminders/{id}/checklist/{item_id}/toggle", c.handleToggleChecklistItem},
+		{"DELETE", "/reminders/{id}/checklist/{item_id}", c.handleDeleteChecklistItem},
+		{"GET", "/lists", c.handleLists},
+		{"POST", "/lists", c.handleCreateList},
+		{"DELETE", "/lists/{id}", c.handleDeleteList},
+		{"POST", "/lists/{id}/members", c.handleAddListMember},
+		{"DELETE", "/lists/{id}/members/{user_id}", c.handleRemoveListMember},
 		{"GET", "/settings", c.handleSettings},
+		{"POST", "/settings/notifications", c.handleUpdateNotificationSettings},
+		{"POST", "/settings/quiet-hours", c.handleUpdateQuietHours},
+		{"POST", "/settings/dnd", c.handleSetDND},
+		{"POST", "/settings/timezone", c.handleUpdateTimezone},
+		{"POST", "/settings/timezone/detect", c.handleDetectTimezone},
+		{"POST", "/settings/digest", c.handleUpdateDigest},
 		{"GET", "/events-log", c.handleEventsLog},
 		{"GET", "/notifications-demo", c.handleNotificationsDemo},
 		{"POST", "/api/v1/notifications-demo/send", c.handleNotificationsDemoSend},
@@ -111,7 +172,7 @@ func (c *UIController) RegisterRoutes(ctx context.Context, mux *runtime.ServeMux
 
 	for _, r := range routes {
 		r := r // захват для замыкания
-		if err := mux.HandlePath(r.method, r.pattern, r.handler); err != nil {
+		if err := mux.HandlePath(r.method, r.pattern, c.withLocation(r.handler)); err != nil {
 			return err
 		}
 	}
@@ -148,7 +209,7 @@ func (c *UIController) handleLoginSubmit(w http.ResponseWriter, r *http.Request,
 		return
 	}
 
-	result, err := c.authService.Login(r.Context(), req.Email, req.Password, r.RemoteAddr, r.UserAgent())
+	result, err := c.authService.Login(r.Context(), req.Email, req.Password, r.RemoteAddr, r.UserAgent(), "")
 	if err != nil {
 		c.log.Debug("login failed", slog.String("email", req.Email), slog.Any("err", err))
 		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
@@ -215,8 +276,71 @@ func (c *UIController) handleProfile(w http.ResponseWriter, r *http.Request, _ m
 		return
 	}
 
+	feedEnabled, err := c.calendarService.FeedEnabled(r.Context(), userID)
+	if err != nil {
+		c.log.Error("get calendar feed", slog.Any("err", err))
+		http.Error(w, "Ошибка загрузки профиля", http.StatusInternalServerError)
+		return
+	}
+
 	token := csrf.Token(r)
-	c.Render(w, r, pages.ProfilePage(profile, token), pages.ProfileContent(profile, token))
+	c.Render(w, r, pages.ProfilePage(profile, feedEnabled, token), pages.ProfileContent(profile, feedEnabled, token))
+}
+
+// handleRotateCalendarToken — POST /profile/calendar-token: новая ссылка на ленту календаря.
+// Ссылка показывается только в этом ответе: в БД хранится хэш токена.
+func (c *UIController) handleRotateCalendarToken(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	userIDStr, stop := c.requireAuth(w, r)
+	if stop {
+		return
+	}
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		http.Error(w, "Ошибка авторизации", http.StatusInternalServerError)
+		return
+	}
+
+	calendarToken, err := c.calendarService.RotateFeedToken(r.Context(), userID)
+	if err != nil {
+		c.log.Error("rotate calendar token", slog.Any("err", err))
+		http.Error(w, "Не удалось создать ссылку", http.StatusInternalServerError)
+		return
+	}
+	templ.Handler(pages.CalendarFeedCard(true, calendarFeedURL(r, calendarToken))).ServeHTTP(w, r)
+}
+
+// handleDisableCalendarFeed — DELETE /profile/calendar-token: выключает ленту календаря.
+func (c *UIController) handleDisableCalendarFeed(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	userIDStr, stop := c.requireAuth(w, r)
+	if stop {
+		return
+	}
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		http.Error(w, "Ошибка авторизации", http.StatusInternalServerError)
+		return
+	}
+
+	if err := c.calendarService.DisableFeed(r.Context(), userID); err != nil {
+		c.log.Error("disable calendar feed", slog.Any("err", err))
+		http.Error(w, "Не удалось отключить ленту", http.StatusInternalServerError)
+		return
+	}
+	templ.Handler(pages.CalendarFeedCard(false, "")).ServeHTTP(w, r)
+}
+
+// calendarFeedURL собирает абсолютную ссылку на ленту календаря по адресу текущего запроса.
+func calendarFeedURL(r *http.Request, calendarToken string) string {
+	if calendarToken == "" {
+		return ""
+	}
+	scheme := "http"
+	if proto := r.Header.Get("X-Forwarded-Proto"); proto != "" {
+		scheme = proto
+	} else if r.TLS != nil {
+		scheme = "https"
+	}
+	return scheme + "://" + r.Host + "/calendar/" + calendarToken + "/reminders.ics"
 }
 
 // parseIntParam парсит целочисленный GET-параметр с дефолтным значением.
@@ -236,6 +360,7 @@ func parseIntParam(r *http.Request, name string, defaultVal int) int {
 func parseSortParams(r *http.Request) (sortField, sortOrder string) {
 	allowedFields := map[string]bool{
 		"remind_at": true, "created_at": true, "title": true, "status": true,
+		model.SortRank: true,
 	}
 	sortField = r.URL.Query().Get("sort")
 	if !allowedFields[sortField] {
@@ -260,13 +385,32 @@ var reminderFilters = []model.FilterDef{
 			{Value: "sent", Label: "Отправлено"},
 			{Value: "cancelled", Label: "Отменено"},
 			{Value: "failed", Label: "Ошибка"},
+			{Value: "paused", Label: "Приостановлено"},
+			{Value: "snoozed", Label: "Отложено"},
+			{Value: "expired", Label: "Не подтверждено"},
+		},
+	},
+	{
+		Type:        model.FilterSearch,
+		Key:         "q",
+		Label:       "Поиск",
+		Placeholder: "Поиск по названию и описанию…",
+	},
+	{
+		Type:  model.FilterEnum,
+		Key:   "priority",
+		Label: "Приоритет",
+		Options: []model.FilterOption{
+			{Value: "high", Label: "Высокий"},
+			{Value: "normal", Label: "Обычный"},
+			{Value: "low", Label: "Низкий"},
 		},
 	},
 	{
 		Type:        model.FilterString,
-		Key:         "title",
-		Label:       "Название",
-		Placeholder: "Поиск по названию...",
+		Key:         "tags",
+		Label:       "Теги",
+		Placeholder: "Тег...",
 	},
 	{
 		Type:  model.FilterDateRange,
@@ -293,45 +437,40 @@ func (c *UIController) handleReminders(w http.ResponseWriter, r *http.Request, _
 		return
 	}
 
-	page := parseIntParam(r, "page", 1)
-	limit := parseIntParam(r, "limit", 20)
-	if limit < 5 {
-		limit = 5
-	}
-	if limit > 100 {
-		limit = 100
-	}
-	sortField, sortOrder := parseSortParams(r)
-	activeFilters := ParseFilters(r, reminderFilters)
-
-	paged, err := c.reminderService.ListRemindersPaged(r.Context(), userID, page, limit, sortField, sortOrder, activeFilters)
+	items, tableParams, err := c.listReminders(r, userID, parseIntParam(r, "page", 1), parseIntParam(r, "limit", 20))
 	if err != nil {
+		if errors.Is(err, service.ErrInvalidReminder) {
+			http.Error(w, "Неверный курсор ленты", http.StatusBadRequest)
+			return
+		}
 		c.log.Error("list reminders", slog.Any("err", err))
 		http.Error(w, "Ошибка загрузки напоминаний", http.StatusInternalServerError)
 		return
 	}
 
-	tableParams := pages.TableParams{
-		CurrentPage:   page,
-		TotalPages:    paged.TotalPages,
-		TotalItems:    paged.TotalItems,
-		PageSize:      limit,
-		SortField:     sortField,
-		SortOrder:     sortOrder,
-		Filters:       reminderFilters,
-		ActiveFilters: activeFilters,
+	// Следующая порция ленты заменяет строку «Показать ещё»
+	if isFeedChunk(r) {
+		templ.Handler(pages.RemindersTableRows(items, tableParams)).ServeHTTP(w, r)
+		return
 	}
 
 	// Если это HTMX-запрос пагинации (не boosted навигация), возвращаем только таблицу
 	if isHTMX(r) && r.Header.Get("HX-Boosted") != "true" {
-		templ.Handler(pages.RemindersTablePaged(paged.Items, tableParams)).ServeHTTP(w, r)
+		templ.Handler(pages.RemindersTablePaged(items, tableParams)).ServeHTTP(w, r)
+		return
+	}
+
+	lists, err := c.listService.ListLists(r.Context(), userID)
+	if err != nil {
+		c.log.Error("list reminder lists", slog.Any("err", err))
+		http.Error(w, "Ошибка загрузки списков", http.StatusInternalServerError)
 		return
 	}
 
 	token := csrf.Token(r)
 	c.Render(w, r,
-		pages.RemindersPagePaged(paged.Items, token, tableParams),
-		pages.RemindersContentPaged(paged.Items, token, tableParams),
+		pages.RemindersPagePaged(items, lists, token, tableParams),
+		pages.RemindersContentPaged(items, lists, token, tableParams),
 	)
 }
 
@@ -354,20 +493,70 @@ func (c *UIController) handleCreateReminder(w http.ResponseWriter, r *http.Reque
 		RemindAt              string      `json:"remind_at"`
 		RequireConfirmation   bool        `json:"require_confirmation"`
 		RepeatIntervalMinutes json.Number `json:"repeat_interval_minutes"`
+		Recurrence            string      `json:"recurrence"`
+		RecurrenceRule        string      `json:"recurrence_rule"`
+		Channels              formList    `json:"channels"`
+		EscalationPolicy      string      `json:"escalation_policy"`
+		Priority              string      `json:"priority"`
+		Tags                  string      `json:"tags"`
+		ListID                string      `json:"list_id"`
+		AssigneeEmail         string      `json:"assignee_email"`
+		OverrideQuietHours    bool        `json:"override_quiet_hours"`
+		ConfirmWindowMinutes  json.Number `json:"confirm_window_minutes"`
+		ResendBackoff         string      `json:"resend_backoff"`
+		MaxResends            string      `json:"max_resends"`
+		Checklist             string      `json:"checklist"`
 	}
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		http.Error(w, "Неверный формат запроса", http.StatusBadRequest)
 		return
 	}
 	repeatInterval, _ := req.RepeatIntervalMinutes.Int64()
+	confirmWindow, _ := req.ConfirmWindowMinutes.Int64()
+	var maxResends int
+	if req.MaxResends != "" {
+		if maxResends, err = strconv.Atoi(req.MaxResends); err != nil {
+			http.Error(w, "Неверное число повторов", http.StatusBadRequest)
+			return
+		}
+	}
 
 	// Парсим как локальное время пользователя → автоматически конвертируется в UTC при сохранении
-	remindAt, err := timezone.FromUser("2006-01-02T15:04", req.RemindAt)
+	remindAt, err := timezone.FromUser(r.Context(), "2006-01-02T15:04", req.RemindAt)
 	if err != nil {
 		http.Error(w, "Неверный формат даты", http.StatusBadRequest)
 		return
 	}
 
+	rule, err := recurrenceFromForm(req.Recurrence, req.RecurrenceRule)
+	if err != nil {
+		http.Error(w, "Неверное правило повторения", http.StatusBadRequest)
+		return
+	}
+
+	var priority model.ReminderPriority
+	if req.Priority != "" {
+		if priority, err = model.ReminderPriorityString(req.Priority); err != nil {
+			http.Error(w, "Неизвестный приоритет", http.StatusBadRequest)
+			return
+		}
+	}
+	reminderTags, err := tags.Parse(req.Tags)
+	if err != nil {
+		http.Error(w, "Неверные теги: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var listID *uuid.UUID
+	if req.ListID != "" {
+		id, err := uuid.Parse(req.ListID)
+		if err != nil {
+			http.Error(w, "Неверный ID списка", http.StatusBadRequest)
+			return
+		}
+		listID = &id
+	}
+
 	profile, err := c.authService.GetProfile(r.Context(), userID)
 	if err != nil {
 		c.log.Error("get profile for telegram_chat_id", slog.Any("err", err))
@@ -375,33 +564,52 @@ func (c *UIController) handleCreateReminder(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	_, err = c.reminderService.CreateReminder(r.Context(), userID, req.Title, req.Description, remindAt, profile.TelegramChatID, req.RequireConfirmation, int(repeatInterval))
+	_, err = c.reminderService.CreateReminder(r.Context(), service.CreateReminderInput{
+		UserID:                userID,
+		Title:                 req.Title,
+		Description:           req.Description,
+		RemindAt:              remindAt,
+		TelegramChatID:        profile.TelegramChatID,
+		RequireConfirmation:   req.RequireConfirmation,
+		RepeatIntervalMinutes: int(repeatInterval),
+		RecurrenceRule:        rule,
+		Channels:              req.Channels,
+		EscalationPolicy:      req.EscalationPolicy,
+		Priority:              priority,
+		Tags:                  reminderTags,
+		ListID:                listID,
+		AssigneeEmail:         req.AssigneeEmail,
+		OverrideQuietHours:    req.OverrideQuietHours,
+		ConfirmWindowMinutes:  int(confirmWindow),
+		ResendBackoff:         req.ResendBackoff,
+		MaxResends:            maxResends,
+		Checklist:             strings.Split(req.Checklist, "\n"),
+	})
 	if err != nil {
+		if deniedError(w, err) {
+			return
+		}
+		if isRecurrenceError(err) {
+			http.Error(w, "Неверное правило повторения: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		if errors.Is(err, service.ErrInvalidReminder) {
+			http.Error(w, "Напоминание отклонено: "+err.Error(), http.StatusBadRequest)
+			return
+		}
 		c.log.Error("create reminder", slog.Any("err", err))
 		http.Error(w, "Ошибка создания напоминания", http.StatusInternalServerError)
 		return
 	}
 
 	// Возвращаем обновлённый список (page=1, новый элемент сверху при created_at DESC)
-	sortField, sortOrder := parseSortParams(r)
-	activeFilters := ParseFilters(r, reminderFilters)
-	paged, err := c.reminderService.ListRemindersPaged(r.Context(), userID, 1, 20, sortField, sortOrder, activeFilters)
+	items, tableParams, err := c.listReminders(r, userID, 1, 20)
 	if err != nil {
 		c.log.Error("list reminders", slog.Any("err", err))
 		http.Error(w, "Ошибка загрузки", http.StatusInternalServerError)
 		return
 	}
-	tableParams := pages.TableParams{
-		CurrentPage:   1,
-		TotalPages:    paged.TotalPages,
-		TotalItems:    paged.TotalItems,
-		PageSize:      20,
-		SortField:     sortField,
-		SortOrder:     sortOrder,
-		Filters:       reminderFilters,
-		ActiveFilters: activeFilters,
-	}
-	templ.Handler(pages.RemindersTablePaged(paged.Items, tableParams)).ServeHTTP(w, r)
+	templ.Handler(pages.RemindersTablePaged(items, tableParams)).ServeHTTP(w, r)
 }
 
 // handleDeleteReminder — удаление напоминания (DELETE /reminders/{id}).
@@ -424,42 +632,264 @@ func (c *UIController) handleDeleteReminder(w http.ResponseWriter, r *http.Reque
 	}
 
 	if err := c.reminderService.DeleteReminder(r.Context(), userID, reminderID); err != nil {
+		if deniedError(w, err) {
+			return
+		}
 		c.log.Error("delete reminder", slog.Any("err", err))
 		http.Error(w, "Ошибка удаления", http.StatusInternalServerError)
 		return
 	}
 
 	// Возвращаем обновлённый список, оставаясь на текущей странице
-	page := parseIntParam(r, "page", 1)
-	limit := parseIntParam(r, "limit", 20)
-	sortField, sortOrder := parseSortParams(r)
-	activeFilters := ParseFilters(r, reminderFilters)
-	paged, err := c.reminderService.ListRemindersPaged(r.Context(), userID, page, limit, sortField, sortOrder, activeFilters)
+	c.renderRemindersTable(w, r, userID)
+}
+
+// handleSettings — страница настроек (GET /settings).
+func (c *UIController) handleSettings(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	userIDStr, stop := c.requireAuth(w, r)
+	if stop {
+		return
+	}
+
+	userID, err := uuid.Parse(userIDStr)
 	if err != nil {
-		c.log.Error("list reminders", slog.Any("err", err))
-		http.Error(w, "Ошибка загрузки", http.StatusInternalServerError)
+		http.Error(w, "Ошибка авторизации", http.StatusInternalServerError)
 		return
 	}
-	tableParams := pages.TableParams{
-		CurrentPage:   page,
-		TotalPages:    paged.TotalPages,
-		TotalItems:    paged.TotalItems,
-		PageSize:      limit,
-		SortField:     sortField,
-		SortOrder:     sortOrder,
-		Filters:       reminderFilters,
-		ActiveFilters: activeFilters,
+
+	settings, err := c.reminderService.GetNotificationSettings(r.Context(), userID)
+	if err != nil {
+		c.log.Error("get notification settings", slog.Any("err", err))
+		http.Error(w, "Ошибка загрузки настроек", http.StatusInternalServerError)
+		return
 	}
-	templ.Handler(pages.RemindersTablePaged(paged.Items, tableParams)).ServeHTTP(w, r)
+
+	token := csrf.Token(r)
+	c.Render(w, r, pages.SettingsPage(settings, token), pages.SettingsContent(settings))
 }
 
-// handleSettings — страница настроек (GET /settings).
-func (c *UIController) handleSettings(w http.ResponseWriter, r *http.Request, _ map[string]string) {
-	if _, stop := c.requireAuth(w, r); stop {
+// handleUpdateNotificationSettings — порядок каналов и URL webhook (POST /settings/notifications).
+func (c *UIController) handleUpdateNotificationSettings(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	userIDStr, stop := c.requireAuth(w, r)
+	if stop {
 		return
 	}
-	token := csrf.Token(r)
-	c.Render(w, r, pages.SettingsPage(token), pages.SettingsContent())
+
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		http.Error(w, "Ошибка авторизации", http.StatusInternalServerError)
+		return
+	}
+
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "Неверный формат запроса", http.StatusBadRequest)
+		return
+	}
+
+	// Пустые позиции («—») пропускаем, порядок остальных сохраняем.
+	var channels []string
+	for _, ch := range r.PostForm["channels"] {
+		if ch != "" {
+			channels = append(channels, ch)
+		}
+	}
+
+	err = c.reminderService.UpdateNotificationSettings(r.Context(), userID, channels, r.PostForm.Get("webhook_url"))
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidReminder) {
+			http.Error(w, "Настройки отклонены: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		c.log.Error("update notification settings", slog.Any("err", err))
+		http.Error(w, "Ошибка сохранения настроек", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Write([]byte(`<span class="text-green-600">Настройки сохранены</span>`))
+}
+
+// handleUpdateQuietHours — расписание тихих часов (POST /settings/quiet-hours).
+func (c *UIController) handleUpdateQuietHours(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	userIDStr, stop := c.requireAuth(w, r)
+	if stop {
+		return
+	}
+
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		http.Error(w, "Ошибка авторизации", http.StatusInternalServerError)
+		return
+	}
+
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "Неверный формат запроса", http.StatusBadRequest)
+		return
+	}
+
+	if err := c.reminderService.UpdateQuietHours(r.Context(), userID, r.PostForm.Get("quiet_hours")); err != nil {
+		if errors.Is(err, service.ErrInvalidReminder) {
+			http.Error(w, "Расписание отклонено: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		c.log.Error("update quiet hours", slog.Any("err", err))
+		http.Error(w, "Ошибка сохранения настроек", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Write([]byte(`<span class="text-green-600">Тихие часы сохранены</span>`))
+}
+
+// handleSetDND — включение и выключение режима «не беспокоить» (POST /settings/dnd).
+func (c *UIController) handleSetDND(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	userIDStr, stop := c.requireAuth(w, r)
+	if stop {
+		return
+	}
+
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		http.Error(w, "Ошибка авторизации", http.StatusInternalServerError)
+		return
+	}
+
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "Неверный формат запроса", http.StatusBadRequest)
+		return
+	}
+	minutes, err := strconv.Atoi(r.PostForm.Get("minutes"))
+	if err != nil || minutes < 0 || minutes > 7*24*60 {
+		http.Error(w, "Неверная длительность", http.StatusBadRequest)
+		return
+	}
+
+	until, err := c.reminderService.SetDND(r.Context(), userID, time.Duration(minutes)*time.Minute)
+	if err != nil {
+		c.log.Error("set dnd", slog.Any("err", err))
+		http.Error(w, "Ошибка сохранения настроек", http.StatusInternalServerError)
+		return
+	}
+
+	templ.Handler(pages.DNDStatus(until)).ServeHTTP(w, r)
+}
+
+// handleUpdateTimezone — таймзона пользователя и поведение напоминаний при её смене (POST /settings/timezone).
+func (c *UIController) handleUpdateTimezone(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	userIDStr, stop := c.requireAuth(w, r)
+	if stop {
+		return
+	}
+
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		http.Error(w, "Ошибка авторизации", http.StatusInternalServerError)
+		return
+	}
+
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "Неверный формат запроса", http.StatusBadRequest)
+		return
+	}
+
+	moved, err := c.reminderService.UpdateTimezone(r.Context(), userID, r.PostForm.Get("timezone"), r.PostForm.Get("follow") != "")
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidReminder) {
+			http.Error(w, "Часовой пояс отклонён: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		c.log.Error("update timezone", slog.Any("err", err))
+		http.Error(w, "Ошибка сохранения настроек", http.StatusInternalServerError)
+		return
+	}
+
+	msg := "Часовой пояс сохранён"
+	if moved > 0 {
+		msg += fmt.Sprintf(", напоминаний перенесено на то же местное время: %d", moved)
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Write([]byte(`<span class="text-green-600">` + html.EscapeString(msg) + `</span>`))
+}
+
+// handleUpdateDigest — утренняя сводка: включение и местное время (POST /settings/digest).
+func (c *UIController) handleUpdateDigest(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	userIDStr, stop := c.requireAuth(w, r)
+	if stop {
+		return
+	}
+
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		http.Error(w, "Ошибка авторизации", http.StatusInternalServerError)
+		return
+	}
+
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "Неверный формат запроса", http.StatusBadRequest)
+		return
+	}
+
+	enabled := r.PostForm.Get("enabled") != ""
+	if err := c.reminderService.UpdateDigest(r.Context(), userID, enabled, r.PostForm.Get("time")); err != nil {
+		if errors.Is(err, service.ErrInvalidReminder) {
+			http.Error(w, "Время сводки отклонено: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		c.log.Error("update digest", slog.Any("err", err))
+		http.Error(w, "Ошибка сохранения настроек", http.StatusInternalServerError)
+		return
+	}
+
+	msg := "Сводка выключена"
+	if enabled {
+		msg = "Сводка включена"
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Write([]byte(`<span class="text-green-600">` + html.EscapeString(msg) + `</span>`))
+}
+
+// handleDetectTimezone — таймзона, определённая браузером (POST /settings/timezone/detect).
+// Сохраняется, только если пользователь ещё не выбрал свою; иначе в ответе
+// сообщается о расхождении, чтобы страница предложила сменить пояс.
+func (c *UIController) handleDetectTimezone(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	userIDStr, stop := c.requireAuth(w, r)
+	if stop {
+		return
+	}
+
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		http.Error(w, "Ошибка авторизации", http.StatusInternalServerError)
+		return
+	}
+
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "Неверный формат запроса", http.StatusBadRequest)
+		return
+	}
+	detected := r.PostForm.Get("timezone")
+	if !timezone.Valid(detected) {
+		http.Error(w, "Неизвестный часовой пояс", http.StatusBadRequest)
+		return
+	}
+
+	saved, err := c.reminderService.DetectTimezone(r.Context(), userID, detected)
+	if err != nil {
+		c.log.Error("detect timezone", slog.Any("err", err))
+		http.Error(w, "Ошибка сохранения настроек", http.StatusInternalServerError)
+		return
+	}
+
+	current := timezone.Location(r.Context()).String()
+	if saved {
+		current = detected
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]any{
+		"saved":    saved,
+		"timezone": current,
+		"detected": detected,
+	})
 }
 
 // handleEventsLog — пример использования универсальной таблицы (GET /events-log).
@@ -5,6 +5,7 @@ import (
 	"regexp"
 
 	"github.com/vovanwin/template/internal/model"
+	"github.com/vovanwin/template/internal/pkg/search"
 )
 
 var dateRegexp = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
@@ -49,6 +50,17 @@ func ParseFilters(r *http.Request, filters []model.FilterDef) []model.ActiveFilt
 				Value: val,
 			})
 
+		case model.FilterSearch:
+			val := search.Normalize(q.Get("f_" + fd.Key))
+			if val == "" {
+				continue
+			}
+			result = append(result, model.ActiveFilter{
+				Key:   fd.Key,
+				Type:  model.FilterSearch,
+				Value: val,
+			})
+
 		case model.FilterDateRange:
 			from := q.Get("f_" + fd.Key + "_from")
 			to := q.Get("f_" + fd.Key + "_to")
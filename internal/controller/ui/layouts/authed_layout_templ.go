@@ -164,7 +164,7 @@ func AuthedLayout(title string, activeURL string, content templ.Component, csrfT
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
-		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 13, "</div></div></div><!-- Контейнер для уведомлений --><div id=\"notifications\" hx-preserve=\"true\" class=\"fixed bottom-20 md:bottom-4 right-4 z-50 flex flex-col space-y-2 max-w-sm pointer-events-none\"></div><script>\n\t\t\t\tdocument.addEventListener('htmx:configRequest', (event) => {\n\t\t\t\t\tconst meta = document.querySelector('meta[name=\"csrf-token\"]');\n\t\t\t\t\tif (meta) event.detail.headers['X-CSRF-Token'] = meta.content;\n\t\t\t\t});\n\n\t\t\t\tdocument.addEventListener('htmx:responseError', (event) => {\n\t\t\t\t\tif (event.detail.xhr.status === 401) {\n\t\t\t\t\t\twindow.location.href = '/login';\n\t\t\t\t\t}\n\t\t\t\t});\n\n\t\t\t\t// Подсветка активного пункта меню при HTMX-навигации\n\t\t\t\tfunction updateMenuActive() {\n\t\t\t\t\tvar path = window.location.pathname;\n\t\t\t\t\t// Desktop sidebar + mobile drawer\n\t\t\t\t\tdocument.querySelectorAll('aside nav a, .fixed.inset-0 aside nav a').forEach(function(a) {\n\t\t\t\t\t\tvar href = a.getAttribute('href');\n\t\t\t\t\t\tif (href === path) {\n\t\t\t\t\t\t\ta.className = 'flex items-center gap-3 px-4 py-2 rounded-lg bg-indigo-600 text-white font-medium';\n\t\t\t\t\t\t} else {\n\t\t\t\t\t\t\ta.className = 'flex items-center gap-3 px-4 py-2 rounded-lg text-gray-300 hover:bg-gray-700 hover:text-white transition-colors';\n\t\t\t\t\t\t}\n\t\t\t\t\t});\n\t\t\t\t\t// Mobile bottom nav\n\t\t\t\t\tdocument.querySelectorAll('nav.md\\\\:hidden a').forEach(function(a) {\n\t\t\t\t\t\tvar href = a.getAttribute('href');\n\t\t\t\t\t\ta.classList.remove('text-indigo-600', 'text-gray-500');\n\t\t\t\t\t\ta.classList.add(href === path ? 'text-indigo-600' : 'text-gray-500');\n\t\t\t\t\t});\n\t\t\t\t}\n\t\t\t\tdocument.addEventListener('htmx:pushedIntoHistory', updateMenuActive);\n\n\t\t\t\t// Centrifugo realtime notifications (uni_sse — нативный EventSource)\n\t\t\t\t(function() {\n\t\t\t\t\tif (window.__centrifugeConnected) return;\n\t\t\t\t\twindow.__centrifugeConnected = true;\n\n\t\t\t\t\tfetch('/api/v1/centrifugo/token')\n\t\t\t\t\t\t.then(function(r) { return r.json(); })\n\t\t\t\t\t\t.then(function(data) {\n\t\t\t\t\t\t\tvar url = new URL(data.url + '/connection/uni_sse');\n\t\t\t\t\t\t\turl.searchParams.append('cf_connect', JSON.stringify({\n\t\t\t\t\t\t\t\ttoken: data.token\n\t\t\t\t\t\t\t}));\n\n\t\t\t\t\t\t\tvar es = new EventSource(url);\n\t\t\t\t\t\t\tes.onmessage = function(event) {\n\t\t\t\t\t\t\t\ttry {\n\t\t\t\t\t\t\t\t\tvar msg = JSON.parse(event.data);\n\t\t\t\t\t\t\t\t\tconsole.log('Centrifugo SSE raw:', msg);\n\n\t\t\t\t\t\t\t\t\tvar pubData = null;\n\t\t\t\t\t\t\t\t\tif (msg.push && msg.push.pub && msg.push.pub.data) {\n\t\t\t\t\t\t\t\t\t\tpubData = msg.push.pub.data;\n\t\t\t\t\t\t\t\t\t} else if (msg.pub && msg.pub.data) {\n\t\t\t\t\t\t\t\t\t\tpubData = msg.pub.data;\n\t\t\t\t\t\t\t\t\t} else if (msg.data) {\n\t\t\t\t\t\t\t\t\t\tpubData = msg.data;\n\t\t\t\t\t\t\t\t\t} else if (msg.message) {\n\t\t\t\t\t\t\t\t\t\tpubData = msg;\n\t\t\t\t\t\t\t\t\t}\n\n\t\t\t\t\t\t\t\t\tconsole.log('Centrifugo pubData:', pubData);\n\t\t\t\t\t\t\t\t\tif (!pubData || !pubData.message) return;\n\n\t\t\t\t\t\t\t\t\tvar container = document.getElementById('notifications');\n\t\t\t\t\t\t\t\t\tconsole.log('Centrifugo container:', container);\n\t\t\t\t\t\t\t\t\tif (!container) return;\n\n\t\t\t\t\t\t\t\t\tvar colors = {\n\t\t\t\t\t\t\t\t\t\tsuccess: {bg: '#22c55e', border: '#15803d'},\n\t\t\t\t\t\t\t\t\t\tinfo:    {bg: '#3b82f6', border: '#1d4ed8'},\n\t\t\t\t\t\t\t\t\t\twarning: {bg: '#eab308', border: '#a16207'},\n\t\t\t\t\t\t\t\t\t\terror:   {bg: '#ef4444', border: '#b91c1c'},\n\t\t\t\t\t\t\t\t\t\treminder:{bg: '#a855f7', border: '#7e22ce'}\n\t\t\t\t\t\t\t\t\t};\n\t\t\t\t\t\t\t\t\tvar ntype = pubData.type || 'info';\n\t\t\t\t\t\t\t\t\tvar c = colors[ntype] || colors.info;\n\n\t\t\t\t\t\t\t\t\tvar div = document.createElement('div');\n\t\t\t\t\t\t\t\t\tdiv.style.cssText = 'color:white;padding:12px;border-radius:8px;box-shadow:0 10px 15px rgba(0,0,0,0.2);margin-bottom:12px;border-left:4px solid ' + c.border + ';background:' + c.bg + ';pointer-events:auto;';\n\t\t\t\t\t\t\t\t\tdiv.textContent = pubData.message;\n\t\t\t\t\t\t\t\t\tcontainer.appendChild(div);\n\t\t\t\t\t\t\t\t\tsetTimeout(function() { div.remove(); }, 5000);\n\t\t\t\t\t\t\t\t} catch(e) {\n\t\t\t\t\t\t\t\t\tconsole.warn('Centrifugo SSE parse error:', e, event.data);\n\t\t\t\t\t\t\t\t}\n\t\t\t\t\t\t\t};\n\t\t\t\t\t\t\tes.onerror = function() {\n\t\t\t\t\t\t\t\tconsole.warn('Centrifugo SSE connection error');\n\t\t\t\t\t\t\t};\n\t\t\t\t\t\t})\n\t\t\t\t\t\t.catch(function(err) {\n\t\t\t\t\t\t\tconsole.warn('Centrifugo token fetch failed:', err);\n\t\t\t\t\t\t});\n\t\t\t\t})();\n\t\t\t</script></body></html>")
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 13, "</div></div></div><!-- Контейнер для уведомлений --><div id=\"notifications\" hx-preserve=\"true\" class=\"fixed bottom-20 md:bottom-4 right-4 z-50 flex flex-col space-y-2 max-w-sm pointer-events-none\"></div><script>\n\t\t\t\tdocument.addEventListener('htmx:configRequest', (event) => {\n\t\t\t\t\tconst meta = document.querySelector('meta[name=\"csrf-token\"]');\n\t\t\t\t\tif (meta) event.detail.headers['X-CSRF-Token'] = meta.content;\n\t\t\t\t});\n\n\t\t\t\tdocument.addEventListener('htmx:responseError', (event) => {\n\t\t\t\t\tif (event.detail.xhr.status === 401) {\n\t\t\t\t\t\twindow.location.href = '/login';\n\t\t\t\t\t} else if ((event.detail.xhr.status === 400 || event.detail.xhr.status === 403) && event.detail.xhr.responseText) {\n\t\t\t\t\t\talert(event.detail.xhr.responseText);\n\t\t\t\t\t}\n\t\t\t\t});\n\n\t\t\t\t// Подсветка активного пункта меню при HTMX-навигации\n\t\t\t\tfunction updateMenuActive() {\n\t\t\t\t\tvar path = window.location.pathname;\n\t\t\t\t\t// Desktop sidebar + mobile drawer\n\t\t\t\t\tdocument.querySelectorAll('aside nav a, .fixed.inset-0 aside nav a').forEach(function(a) {\n\t\t\t\t\t\tvar href = a.getAttribute('href');\n\t\t\t\t\t\tif (href === path) {\n\t\t\t\t\t\t\ta.className = 'flex items-center gap-3 px-4 py-2 rounded-lg bg-indigo-600 text-white font-medium';\n\t\t\t\t\t\t} else {\n\t\t\t\t\t\t\ta.className = 'flex items-center gap-3 px-4 py-2 rounded-lg text-gray-300 hover:bg-gray-700 hover:text-white transition-colors';\n\t\t\t\t\t\t}\n\t\t\t\t\t});\n\t\t\t\t\t// Mobile bottom nav\n\t\t\t\t\tdocument.querySelectorAll('nav.md\\\\:hidden a').forEach(function(a) {\n\t\t\t\t\t\tvar href = a.getAttribute('href');\n\t\t\t\t\t\ta.classList.remove('text-indigo-600', 'text-gray-500');\n\t\t\t\t\t\ta.classList.add(href === path ? 'text-indigo-600' : 'text-gray-500');\n\t\t\t\t\t});\n\t\t\t\t}\n\t\t\t\tdocument.addEventListener('htmx:pushedIntoHistory', updateMenuActive);\n\n\t\t\t\t// Часовой пояс браузера: сохраняется, если пользователь ещё не выбрал свой,\n\t\t\t\t// иначе при расхождении предлагаем сменить его в настройках (раз за сессию).\n\t\t\t\t(function() {\n\t\t\t\t\tif (sessionStorage.getItem('tz-checked')) return;\n\t\t\t\t\tsessionStorage.setItem('tz-checked', '1');\n\t\t\t\t\tvar tz = Intl.DateTimeFormat().resolvedOptions().timeZone;\n\t\t\t\t\tif (!tz) return;\n\t\t\t\t\tvar meta = document.querySelector('meta[name=\"csrf-token\"]');\n\t\t\t\t\tfetch('/settings/timezone/detect', {\n\t\t\t\t\t\tmethod: 'POST',\n\t\t\t\t\t\theaders: {'X-CSRF-Token': meta ? meta.content : ''},\n\t\t\t\t\t\tbody: new URLSearchParams({timezone: tz})\n\t\t\t\t\t})\n\t\t\t\t\t\t.then(function(r) { return r.ok ? r.json() : null; })\n\t\t\t\t\t\t.then(function(data) {\n\t\t\t\t\t\t\tif (!data) return;\n\t\t\t\t\t\t\tif (data.saved) {\n\t\t\t\t\t\t\t\twindow.location.reload();\n\t\t\t\t\t\t\t\treturn;\n\t\t\t\t\t\t\t}\n\t\t\t\t\t\t\tif (data.timezone === data.detected) return;\n\t\t\t\t\t\t\tvar container = document.getElementById('notifications');\n\t\t\t\t\t\t\tif (!container) return;\n\t\t\t\t\t\t\tvar div = document.createElement('div');\n\t\t\t\t\t\t\tdiv.style.cssText = 'color:white;padding:12px;border-radius:8px;box-shadow:0 10px 15px rgba(0,0,0,0.2);border-left:4px solid #1d4ed8;background:#3b82f6;pointer-events:auto;';\n\t\t\t\t\t\t\tdiv.textContent = 'Часовой пояс браузера — ' + data.detected + ', в настройках — ' + data.timezone + '. ';\n\t\t\t\t\t\t\tvar link = document.createElement('a');\n\t\t\t\t\t\t\tlink.href = '/settings';\n\t\t\t\t\t\t\tlink.style.textDecoration = 'underline';\n\t\t\t\t\t\t\tlink.textContent = 'Изменить';\n\t\t\t\t\t\t\tdiv.appendChild(link);\n\t\t\t\t\t\t\tcontainer.appendChild(div);\n\t\t\t\t\t\t\tsetTimeout(function() { div.remove(); }, 15000);\n\t\t\t\t\t\t})\n\t\t\t\t\t\t.catch(function(err) {\n\t\t\t\t\t\t\tconsole.warn('Timezone detection failed:', err);\n\t\t\t\t\t\t});\n\t\t\t\t})();\n\n\t\t\t\t// Centrifugo realtime notifications (uni_sse — нативный EventSource)\n\t\t\t\t(function() {\n\t\t\t\t\tif (window.__centrifugeConnected) return;\n\t\t\t\t\twindow.__centrifugeConnected = true;\n\n\t\t\t\t\tfetch('/api/v1/centrifugo/token')\n\t\t\t\t\t\t.then(function(r) { return r.json(); })\n\t\t\t\t\t\t.then(function(data) {\n\t\t\t\t\t\t\tvar url = new URL(data.url + '/connection/uni_sse');\n\t\t\t\t\t\t\turl.searchParams.append('cf_connect', JSON.stringify({\n\t\t\t\t\t\t\t\ttoken: data.token\n\t\t\t\t\t\t\t}));\n\n\t\t\t\t\t\t\tvar es = new EventSource(url);\n\t\t\t\t\t\t\tes.onmessage = function(event) {\n\t\t\t\t\t\t\t\ttry {\n\t\t\t\t\t\t\t\t\tvar msg = JSON.parse(event.data);\n\t\t\t\t\t\t\t\t\tconsole.log('Centrifugo SSE raw:', msg);\n\n\t\t\t\t\t\t\t\t\tvar pubData = null;\n\t\t\t\t\t\t\t\t\tif (msg.push && msg.push.pub && msg.push.pub.data) {\n\t\t\t\t\t\t\t\t\t\tpubData = msg.push.pub.data;\n\t\t\t\t\t\t\t\t\t} else if (msg.pub && msg.pub.data) {\n\t\t\t\t\t\t\t\t\t\tpubData = msg.pub.data;\n\t\t\t\t\t\t\t\t\t} else if (msg.data) {\n\t\t\t\t\t\t\t\t\t\tpubData = msg.data;\n\t\t\t\t\t\t\t\t\t} else if (msg.message) {\n\t\t\t\t\t\t\t\t\t\tpubData = msg;\n\t\t\t\t\t\t\t\t\t}\n\n\t\t\t\t\t\t\t\t\tconsole.log('Centrifugo pubData:', pubData);\n\t\t\t\t\t\t\t\t\tif (!pubData || !pubData.message) return;\n\n\t\t\t\t\t\t\t\t\tvar container = document.getElementById('notifications');\n\t\t\t\t\t\t\t\t\tconsole.log('Centrifugo container:', container);\n\t\t\t\t\t\t\t\t\tif (!container) return;\n\n\t\t\t\t\t\t\t\t\tvar colors = {\n\t\t\t\t\t\t\t\t\t\tsuccess: {bg: '#22c55e', border: '#15803d'},\n\t\t\t\t\t\t\t\t\t\tinfo:    {bg: '#3b82f6', border: '#1d4ed8'},\n\t\t\t\t\t\t\t\t\t\twarning: {bg: '#eab308', border: '#a16207'},\n\t\t\t\t\t\t\t\t\t\terror:   {bg: '#ef4444', border: '#b91c1c'},\n\t\t\t\t\t\t\t\t\t\treminder:{bg: '#a855f7', border: '#7e22ce'}\n\t\t\t\t\t\t\t\t\t};\n\t\t\t\t\t\t\t\t\tvar ntype = pubData.type || 'info';\n\t\t\t\t\t\t\t\t\tvar c = colors[ntype] || colors.info;\n\n\t\t\t\t\t\t\t\t\tvar div = document.createElement('div');\n\t\t\t\t\t\t\t\t\tdiv.style.cssText = 'color:white;padding:12px;border-radius:8px;box-shadow:0 10px 15px rgba(0,0,0,0.2);margin-bottom:12px;border-left:4px solid ' + c.border + ';background:' + c.bg + ';pointer-events:auto;';\n\t\t\t\t\t\t\t\t\tdiv.textContent = pubData.message;\n\t\t\t\t\t\t\t\t\tcontainer.appendChild(div);\n\t\t\t\t\t\t\t\t\tsetTimeout(function() { div.remove(); }, 5000);\n\t\t\t\t\t\t\t\t} catch(e) {\n\t\t\t\t\t\t\t\t\tconsole.warn('Centrifugo SSE parse error:', e, event.data);\n\t\t\t\t\t\t\t\t}\n\t\t\t\t\t\t\t};\n\t\t\t\t\t\t\tes.onerror = function() {\n\t\t\t\t\t\t\t\tconsole.warn('Centrifugo SSE connection error');\n\t\t\t\t\t\t\t};\n\t\t\t\t\t\t})\n\t\t\t\t\t\t.catch(function(err) {\n\t\t\t\t\t\t\tconsole.warn('Centrifugo token fetch failed:', err);\n\t\t\t\t\t\t});\n\t\t\t\t})();\n\t\t\t</script></body></html>")
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
@@ -0,0 +1,217 @@
+package ui
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/a-h/templ"
+	"github.com/google/uuid"
+	"github.com/gorilla/csrf"
+
+	"github.com/vovanwin/template/internal/controller/ui/pages"
+	"github.com/vovanwin/template/internal/model"
+	"github.com/vovanwin/template/internal/service"
+)
+
+// listViews загружает списки пользователя вместе с участниками.
+func (c *UIController) listViews(ctx context.Context, userID uuid.UUID) ([]pages.ListView, error) {
+	lists, err := c.listService.ListLists(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	views := make([]pages.ListView, 0, len(lists))
+	for _, l := range lists {
+		members, err := c.listService.ListMembers(ctx, userID, l.ID)
+		if err != nil {
+			return nil, err
+		}
+		views = append(views, pages.ListView{List: l, Members: members})
+	}
+	return views, nil
+}
+
+// renderListsGrid возвращает карточки списков после изменения.
+func (c *UIController) renderListsGrid(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
+	views, err := c.listViews(r.Context(), userID)
+	if err != nil {
+		c.log.Error("list reminder lists", slog.Any("err", err))
+		http.Error(w, "Ошибка загрузки", http.StatusInternalServerError)
+		return
+	}
+	templ.Handler(pages.ListsGrid(views)).ServeHTTP(w, r)
+}
+
+// listError отвечает на ошибку сервиса списков. Возвращает false, если ошибка неожиданная.
+func listError(w http.ResponseWriter, err error) bool {
+	switch {
+	case errors.Is(err, service.ErrInvalidList):
+		http.Error(w, "Отклонено: "+err.Error(), http.StatusBadRequest)
+	case errors.Is(err, service.ErrListNotFound):
+		http.Error(w, "Список не найден", http.StatusNotFound)
+	case errors.Is(err, service.ErrActionNotAllowed):
+		http.Error(w, "Управлять списком может только владелец", http.StatusForbidden)
+	default:
+		return false
+	}
+	return true
+}
+
+// listAction разбирает userID и ID списка из запроса.
+// Возвращает stop=true, если ответ уже записан.
+func (c *UIController) listAction(w http.ResponseWriter, r *http.Request, pathParams map[string]string) (userID, listID uuid.UUID, stop bool) {
+	userIDStr, stop := c.requireAuth(w, r)
+	if stop {
+		return uuid.Nil, uuid.Nil, true
+	}
+
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		http.Error(w, "Ошибка авторизации", http.StatusInternalServerError)
+		return uuid.Nil, uuid.Nil, true
+	}
+
+	listID, err = uuid.Parse(pathParams["id"])
+	if err != nil {
+		http.Error(w, "Неверный ID", http.StatusBadRequest)
+		return uuid.Nil, uuid.Nil, true
+	}
+
+	return userID, listID, false
+}
+
+// handleLists — общие списки пользователя (GET /lists).
+func (c *UIController) handleLists(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	userIDStr, stop := c.requireAuth(w, r)
+	if stop {
+		return
+	}
+
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		http.Error(w, "Ошибка авторизации", http.StatusInternalServerError)
+		return
+	}
+
+	views, err := c.listViews(r.Context(), userID)
+	if err != nil {
+		c.log.Error("list reminder lists", slog.Any("err", err))
+		http.Error(w, "Ошибка загрузки списков", http.StatusInternalServerError)
+		return
+	}
+
+	token := csrf.Token(r)
+	c.Render(w, r, pages.ListsPage(views, token), pages.ListsContent(views))
+}
+
+// handleCreateList — создание списка (POST /lists).
+func (c *UIController) handleCreateList(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	userIDStr, stop := c.requireAuth(w, r)
+	if stop {
+		return
+	}
+
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		http.Error(w, "Ошибка авторизации", http.StatusInternalServerError)
+		return
+	}
+
+	var req struct {
+		Name string `json:"name"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Неверный формат запроса", http.StatusBadRequest)
+		return
+	}
+
+	if _, err := c.listService.CreateList(r.Context(), userID, req.Name); err != nil {
+		if listError(w, err) {
+			return
+		}
+		c.log.Error("create list", slog.Any("err", err))
+		http.Error(w, "Ошибка создания списка", http.StatusInternalServerError)
+		return
+	}
+
+	c.renderListsGrid(w, r, userID)
+}
+
+// handleAddListMember — добавление участника или смена роли (POST /lists/{id}/members).
+func (c *UIController) handleAddListMember(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	userID, listID, stop := c.listAction(w, r, pathParams)
+	if stop {
+		return
+	}
+
+	var req struct {
+		Email string `json:"email"`
+		Role  string `json:"role"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Неверный формат запроса", http.StatusBadRequest)
+		return
+	}
+	role, err := model.ListRoleString(req.Role)
+	if err != nil {
+		http.Error(w, "Неизвестная роль", http.StatusBadRequest)
+		return
+	}
+
+	if err := c.listService.AddMember(r.Context(), userID, listID, req.Email, role); err != nil {
+		if listError(w, err) {
+			return
+		}
+		c.log.Error("add list member", slog.Any("err", err))
+		http.Error(w, "Ошибка добавления участника", http.StatusInternalServerError)
+		return
+	}
+
+	c.renderListsGrid(w, r, userID)
+}
+
+// handleRemoveListMember — удаление участника (DELETE /lists/{id}/members/{user_id}).
+func (c *UIController) handleRemoveListMember(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	userID, listID, stop := c.listAction(w, r, pathParams)
+	if stop {
+		return
+	}
+
+	memberID, err := uuid.Parse(pathParams["user_id"])
+	if err != nil {
+		http.Error(w, "Неверный ID", http.StatusBadRequest)
+		return
+	}
+
+	if err := c.listService.RemoveMember(r.Context(), userID, listID, memberID); err != nil {
+		if listError(w, err) {
+			return
+		}
+		c.log.Error("remove list member", slog.Any("err", err))
+		http.Error(w, "Ошибка удаления участника", http.StatusInternalServerError)
+		return
+	}
+
+	c.renderListsGrid(w, r, userID)
+}
+
+// handleDeleteList — удаление списка (DELETE /lists/{id}).
+func (c *UIController) handleDeleteList(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	userID, listID, stop := c.listAction(w, r, pathParams)
+	if stop {
+		return
+	}
+
+	if err := c.listService.DeleteList(r.Context(), userID, listID); err != nil {
+		if listError(w, err) {
+			return
+		}
+		c.log.Error("delete list", slog.Any("err", err))
+		http.Error(w, "Ошибка удаления списка", http.StatusInternalServerError)
+		return
+	}
+
+	c.renderListsGrid(w, r, userID)
+}
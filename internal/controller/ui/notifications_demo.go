@@ -1,6 +1,7 @@
 package ui
 
 import (
+	"context"
 	"net/http"
 	"time"
 
@@ -36,7 +37,7 @@ func (c *UIController) handleNotificationsDemoSend(w http.ResponseWriter, r *htt
 		return
 	}
 
-	if err := c.bus.Publish(events.Event{
+	if err := c.bus.Publish(r.Context(), events.Event{
 		UserID:  userID,
 		Message: message,
 		Type:    msgType,
@@ -66,9 +67,10 @@ func (c *UIController) handleNotificationsDemoBurst(w http.ResponseWriter, r *ht
 		{"Новое сообщение от администратора", "info"},
 	}
 
+	ctx := context.WithoutCancel(r.Context())
 	go func() {
 		for _, m := range messages {
-			_ = c.bus.Publish(events.Event{
+			_ = c.bus.Publish(ctx, events.Event{
 				UserID:  userID,
 				Message: m.msg,
 				Type:    m.typ,
@@ -9,9 +9,26 @@ import "github.com/a-h/templ"
 import templruntime "github.com/a-h/templ/runtime"
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
 	"github.com/vovanwin/template/internal/controller/ui/components"
 	"github.com/vovanwin/template/internal/controller/ui/layouts"
 	"github.com/vovanwin/template/internal/model"
+	"github.com/vovanwin/template/internal/pkg/confirm"
+	"github.com/vovanwin/template/internal/pkg/escalation"
+	"github.com/vovanwin/template/internal/pkg/nldate"
+	"github.com/vovanwin/template/internal/pkg/notify"
+	"github.com/vovanwin/template/internal/pkg/recurrence"
+	"github.com/vovanwin/template/internal/pkg/search"
+	"github.com/vovanwin/template/internal/pkg/snooze"
+	"github.com/vovanwin/template/internal/pkg/tags"
 	"github.com/vovanwin/template/internal/pkg/timezone"
 	"github.com/vovanwin/template/internal/repository"
 )
@@ -26,6 +43,14 @@ type TableParams struct {
 	SortOrder     string
 	Filters       []model.FilterDef
 	ActiveFilters []model.ActiveFilter
+	// Highlights — подсветка совпадений поиска по ID напоминания.
+	Highlights map[uuid.UUID]search.Highlight
+	// Feed — режим ленты (keyset-пагинация) вместо страниц.
+	Feed bool
+	// NextCursor — курсор следующей порции ленты.
+	NextCursor string
+	// Shown — сколько строк ленты показано до текущей порции.
+	Shown int
 }
 
 func RemindersPage(reminders []repository.Reminder, csrfToken string) templ.Component {
@@ -49,7 +74,7 @@ func RemindersPage(reminders []repository.Reminder, csrfToken string) templ.Comp
 			templ_7745c5c3_Var1 = templ.NopComponent
 		}
 		ctx = templ.ClearChildren(ctx)
-		templ_7745c5c3_Err = RemindersPagePaged(reminders, csrfToken, TableParams{CurrentPage: 1, TotalPages: 1, PageSize: 20, SortField: "created_at", SortOrder: "desc"}).Render(ctx, templ_7745c5c3_Buffer)
+		templ_7745c5c3_Err = RemindersPagePaged(reminders, nil, csrfToken, TableParams{CurrentPage: 1, TotalPages: 1, PageSize: 20, SortField: "created_at", SortOrder: "desc"}).Render(ctx, templ_7745c5c3_Buffer)
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
@@ -57,7 +82,7 @@ func RemindersPage(reminders []repository.Reminder, csrfToken string) templ.Comp
 	})
 }
 
-func RemindersPagePaged(reminders []repository.Reminder, csrfToken string, params TableParams) templ.Component {
+func RemindersPagePaged(reminders []repository.Reminder, lists []repository.ReminderList, csrfToken string, params TableParams) templ.Component {
 	return templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
 		templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
 		if templ_7745c5c3_CtxErr := ctx.Err(); templ_7745c5c3_CtxErr != nil {
@@ -78,7 +103,7 @@ func RemindersPagePaged(reminders []repository.Reminder, csrfToken string, param
 			templ_7745c5c3_Var2 = templ.NopComponent
 		}
 		ctx = templ.ClearChildren(ctx)
-		templ_7745c5c3_Err = layouts.AuthedLayout("Напоминания", "/reminders", RemindersContentPaged(reminders, csrfToken, params), csrfToken).Render(ctx, templ_7745c5c3_Buffer)
+		templ_7745c5c3_Err = layouts.AuthedLayout("Напоминания", "/reminders", RemindersContentPaged(reminders, lists, csrfToken, params), csrfToken).Render(ctx, templ_7745c5c3_Buffer)
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
@@ -107,7 +132,7 @@ func RemindersContent(reminders []repository.Reminder, csrfToken string) templ.C
 			templ_7745c5c3_Var3 = templ.NopComponent
 		}
 		ctx = templ.ClearChildren(ctx)
-		templ_7745c5c3_Err = RemindersContentPaged(reminders, csrfToken, TableParams{CurrentPage: 1, TotalPages: 1, PageSize: 20, SortField: "created_at", SortOrder: "desc"}).Render(ctx, templ_7745c5c3_Buffer)
+		templ_7745c5c3_Err = RemindersContentPaged(reminders, nil, csrfToken, TableParams{CurrentPage: 1, TotalPages: 1, PageSize: 20, SortField: "created_at", SortOrder: "desc"}).Render(ctx, templ_7745c5c3_Buffer)
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
@@ -115,7 +140,9 @@ func RemindersContent(reminders []repository.Reminder, csrfToken string) templ.C
 	})
 }
 
-func RemindersContentPaged(reminders []repository.Reminder, csrfToken string, params TableParams) templ.Component {
+// RemindersContentPaged — форма и таблица напоминаний. lists — списки пользователя;
+// в форме предлагаются те, где он может создавать напоминания.
+func RemindersContentPaged(reminders []repository.Reminder, lists []repository.ReminderList, csrfToken string, params TableParams) templ.Component {
 	return templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
 		templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
 		if templ_7745c5c3_CtxErr := ctx.Err(); templ_7745c5c3_CtxErr != nil {
@@ -136,114 +163,211 @@ func RemindersContentPaged(reminders []repository.Reminder, csrfToken string, pa
 			templ_7745c5c3_Var4 = templ.NopComponent
 		}
 		ctx = templ.ClearChildren(ctx)
-		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 1, "<div class=\"max-w-4xl space-y-6\"><!-- Форма создания --><div class=\"bg-white rounded-xl shadow-sm p-6 border border-gray-200\" x-data=\"{ title: '', remind_at: '', error: '' }\"><h2 class=\"text-lg font-semibold text-gray-800 mb-4\">Новое напоминание</h2><form hx-post=\"/reminders\" hx-target=\"#reminders-table\" hx-swap=\"innerHTML\" hx-ext=\"json-enc\" class=\"space-y-4\" @submit=\"\n\t\t\t\t\tif (!title) { error = 'Введите название!'; $event.preventDefault(); return; }\n\t\t\t\t\tif (!remind_at) { error = 'Выберите дату!'; $event.preventDefault(); return; }\n\t\t\t\t\terror = '';\n\t\t\t\t\" @htmx:after-request=\"if ($event.detail.successful) { title = ''; remind_at = ''; error = '' }\"><div class=\"grid grid-cols-1 md:grid-cols-2 gap-4\"><div><label class=\"block text-sm font-medium text-gray-700 mb-1\">Название</label> <input type=\"text\" name=\"title\" x-model=\"title\" required placeholder=\"Что напомнить?\" class=\"w-full px-4 py-2 border border-gray-300 rounded-md focus:ring-2 focus:ring-indigo-500 focus:border-indigo-500 outline-none transition-all\"></div><div><label class=\"block text-sm font-medium text-gray-700 mb-1\">Дата и время</label> <input type=\"datetime-local\" name=\"remind_at\" x-model=\"remind_at\" required class=\"w-full px-4 py-2 border border-gray-300 rounded-md focus:ring-2 focus:ring-indigo-500 focus:border-indigo-500 outline-none transition-all\"></div></div><template x-if=\"error\"><div class=\"text-red-500 text-sm\" x-text=\"error\"></div></template><div><label class=\"block text-sm font-medium text-gray-700 mb-1\">Описание</label> <textarea name=\"description\" rows=\"2\" placeholder=\"Подробности (необязательно)\" class=\"w-full px-4 py-2 border border-gray-300 rounded-md focus:ring-2 focus:ring-indigo-500 focus:border-indigo-500 outline-none transition-all resize-none\"></textarea></div><div class=\"flex items-center gap-4\" x-data=\"{ confirmEnabled: false }\"><label class=\"flex items-center gap-2 text-sm font-medium text-gray-700\"><input type=\"checkbox\" name=\"require_confirmation\" x-model=\"confirmEnabled\" class=\"rounded border-gray-300 text-indigo-600 focus:ring-indigo-500\"> Требовать подтверждение</label><div x-show=\"confirmEnabled\" x-cloak><select name=\"repeat_interval_minutes\" class=\"px-3 py-1.5 border border-gray-300 rounded-md text-sm focus:ring-2 focus:ring-indigo-500 focus:border-indigo-500 outline-none\"><option value=\"5\">Каждые 5 мин</option> <option value=\"10\">Каждые 10 мин</option> <option value=\"15\" selected>Каждые 15 мин</option> <option value=\"30\">Каждые 30 мин</option> <option value=\"60\">Каждые 60 мин</option></select></div></div><button type=\"submit\" class=\"bg-indigo-600 text-white px-6 py-2 rounded-md hover:bg-indigo-700 transition-colors font-medium\">Создать</button><div id=\"reminder-message\" class=\"mt-2 text-sm\"></div></form></div><!-- Список напоминаний (Таблица) --><div class=\"bg-white rounded-xl shadow-sm p-6 border border-gray-200\"><h2 class=\"text-lg font-semibold text-gray-800 mb-4\">История напоминаний</h2><div id=\"reminders-table\">")
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 1, "<div class=\"max-w-4xl space-y-6\"><!-- Форма создания --><div class=\"bg-white rounded-xl shadow-sm p-6 border border-gray-200\" x-data=\"{ title: '', when: '', remind_at: '', recurrence: 'none', rule: '', error: '' }\"><h2 class=\"text-lg font-semibold text-gray-800 mb-4\">Новое напоминание</h2><form hx-post=\"/reminders\" hx-target=\"#reminders-table\" hx-swap=\"innerHTML\" hx-ext=\"json-enc\" class=\"space-y-4\" @submit=\"\n\t\t\t\t\tif (!title) { error = 'Введите название!'; $event.preventDefault(); return; }\n\t\t\t\t\tif (!remind_at) { error = 'Выберите дату!'; $event.preventDefault(); return; }\n\t\t\t\t\terror = '';\n\t\t\t\t\" @htmx:after-request=\"if ($event.detail.successful && $event.detail.elt === $el) { title = ''; when = ''; remind_at = ''; error = ''; $refs.whenPreview.innerHTML = '' }\"><div><label class=\"block text-sm font-medium text-gray-700 mb-1\">Когда (текстом)</label> <input type=\"text\" name=\"when\" x-model=\"when\" placeholder=\"завтра в 9, через 2 часа, в пятницу 18:30, каждый понедельник\" hx-post=\"/reminders/parse-date\" hx-trigger=\"input changed delay:500ms\" hx-target=\"#when-preview\" hx-swap=\"innerHTML\" class=\"w-full px-4 py-2 border border-gray-300 rounded-md focus:ring-2 focus:ring-indigo-500 focus:border-indigo-500 outline-none transition-all\"><div id=\"when-preview\" x-ref=\"whenPreview\" class=\"mt-1\"></div></div><div class=\"grid grid-cols-1 md:grid-cols-2 gap-4\"><div><label class=\"block text-sm font-medium text-gray-700 mb-1\">Название</label> <input type=\"text\" name=\"title\" x-model=\"title\" required placeholder=\"Что напомнить?\" class=\"w-full px-4 py-2 border border-gray-300 rounded-md focus:ring-2 focus:ring-indigo-500 focus:border-indigo-500 outline-none transition-all\"></div><div><label class=\"block text-sm font-medium text-gray-700 mb-1\">Дата и время</label> <input type=\"datetime-local\" name=\"remind_at\" x-model=\"remind_at\" required class=\"w-full px-4 py-2 border border-gray-300 rounded-md focus:ring-2 focus:ring-indigo-500 focus:border-indigo-500 outline-none transition-all\"></div></div><template x-if=\"error\"><div class=\"text-red-500 text-sm\" x-text=\"error\"></div></template><div><label class=\"block text-sm font-medium text-gray-700 mb-1\">Описание</label> <textarea name=\"description\" rows=\"2\" placeholder=\"Подробности (необязательно)\" class=\"w-full px-4 py-2 border border-gray-300 rounded-md focus:ring-2 focus:ring-indigo-500 focus:border-indigo-500 outline-none transition-all resize-none\"></textarea></div><div><label class=\"block text-sm font-medium text-gray-700 mb-1\">Чек-лист</label> <textarea name=\"checklist\" rows=\"2\" placeholder=\"Пункт на строку (необязательно); когда отмечены все, напоминание подтверждается\" class=\"w-full px-4 py-2 border border-gray-300 rounded-md focus:ring-2 focus:ring-indigo-500 focus:border-indigo-500 outline-none transition-all resize-none\"></textarea></div><div class=\"flex flex-wrap items-center gap-4\"><label class=\"text-sm font-medium text-gray-700\">Приоритет</label> <select name=\"priority\" class=\"px-3 py-1.5 border border-gray-300 rounded-md text-sm focus:ring-2 focus:ring-indigo-500 focus:border-indigo-500 outline-none\">")
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
-		templ_7745c5c3_Err = RemindersTablePaged(reminders, params).Render(ctx, templ_7745c5c3_Buffer)
+		for _, p := range priorityOptions {
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 2, "<option value=\"")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			var templ_7745c5c3_Var5 string
+			templ_7745c5c3_Var5, templ_7745c5c3_Err = templ.JoinStringErrs(p.String())
+			if templ_7745c5c3_Err != nil {
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/controller/ui/pages/reminders.templ`, Line: 146, Col: 33}
+			}
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var5))
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 3, "\"")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			if p == model.ReminderPriorityNormal {
+				templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 4, " selected")
+				if templ_7745c5c3_Err != nil {
+					return templ_7745c5c3_Err
+				}
+			}
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 5, ">")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			var templ_7745c5c3_Var6 string
+			templ_7745c5c3_Var6, templ_7745c5c3_Err = templ.JoinStringErrs(priorityLabel(p))
+			if templ_7745c5c3_Err != nil {
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/controller/ui/pages/reminders.templ`, Line: 146, Col: 102}
+			}
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var6))
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 6, "</option>")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+		}
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 7, "</select><div class=\"flex-1 min-w-[16rem]\"><input type=\"text\" name=\"tags\" placeholder=\"Теги: работа, дом\" title=\"Через запятую или пробел; высокий приоритет всегда требует подтверждения\" class=\"w-full px-3 py-1.5 border border-gray-300 rounded-md text-sm focus:ring-2 focus:ring-indigo-500 focus:border-indigo-500 outline-none\"></div><label class=\"flex items-center gap-2 text-sm font-medium text-gray-700\" title=\"Отправить, даже если у получателя тихие часы или «не беспокоить»; высокий приоритет игнорирует их всегда\"><input type=\"checkbox\" name=\"override_quiet_hours\" class=\"rounded border-gray-300 text-indigo-600 focus:ring-indigo-500\"> Игнорировать тихие часы</label></div><div class=\"flex items-center gap-4\" x-data=\"{ confirmEnabled: false }\"><label class=\"flex items-center gap-2 text-sm font-medium text-gray-700\"><input type=\"checkbox\" name=\"require_confirmation\" x-model=\"confirmEnabled\" class=\"rounded border-gray-300 text-indigo-600 focus:ring-indigo-500\"> Требовать подтверждение</label><div x-show=\"confirmEnabled\" x-cloak><select name=\"repeat_interval_minutes\" class=\"px-3 py-1.5 border border-gray-300 rounded-md text-sm focus:ring-2 focus:ring-indigo-500 focus:border-indigo-500 outline-none\"><option value=\"5\">Каждые 5 мин</option> <option value=\"10\">Каждые 10 мин</option> <option value=\"15\" selected>Каждые 15 мин</option> <option value=\"30\">Каждые 30 мин</option> <option value=\"60\">Каждые 60 мин</option></select></div><div x-show=\"confirmEnabled\" x-cloak class=\"flex items-center gap-2\"><select name=\"resend_backoff\" title=\"Фиксированный интервал или удвоение интервала после каждого повтора\" class=\"px-3 py-1.5 border border-gray-300 rounded-md text-sm focus:ring-2 focus:ring-indigo-500 focus:border-indigo-500 outline-none\"><option value=\"fixed\" selected>Интервал постоянный</option> <option value=\"exponential\">Интервал удваивается</option></select> <input type=\"number\" name=\"max_resends\" min=\"0\" max=\"")
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
-		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 2, "</div></div></div>")
+		var templ_7745c5c3_Var7 string
+		templ_7745c5c3_Var7, templ_7745c5c3_Err = templ.JoinStringErrs(strconv.Itoa(confirm.MaxResendsLimit))
 		if templ_7745c5c3_Err != nil {
-			return templ_7745c5c3_Err
+			return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/controller/ui/pages/reminders.templ`, Line: 205, Col: 50}
 		}
-		return nil
-	})
-}
-
-func RemindersTable(reminders []repository.Reminder) templ.Component {
-	return RemindersTablePaged(reminders, TableParams{CurrentPage: 1, TotalPages: 1, PageSize: 20, SortField: "created_at", SortOrder: "desc"})
-}
-
-func RemindersTablePaged(reminders []repository.Reminder, params TableParams) templ.Component {
-	rows := make([]map[string]any, len(reminders))
-	for i, rem := range reminders {
-		rows[i] = map[string]any{
-			"id":          rem.ID,
-			"title":       rem.Title,
-			"status":      statusLabel(rem.Status),
-			"remind_at":   timezone.FormatUser(rem.RemindAt, "02.01.2006 15:04"),
-			"created_at":  timezone.FormatUser(rem.CreatedAt, "02.01.2006 15:04"),
-			"description": rem.Description,
+		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var7))
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
 		}
-	}
-
-	config := components.TableConfig{
-		Columns: []components.Column{
-			{Title: "Название", Key: "title", Sortable: true},
-			{Title: "Статус", Key: "status", Sortable: true},
-			{Title: "Время", Key: "remind_at", Sortable: true},
-			{Title: "Создано", Key: "created_at", Sortable: true},
-			{Title: "Описание", Key: "description"},
-		},
-		Rows: rows,
-		Actions: []components.Action{
-			{Label: "Удалить", Icon: "🗑", HxMethod: "hx-delete", URLPath: "/reminders/{id}", Confirm: "Удалить напоминание?", Variant: "danger"},
-		},
-		BaseURL:       "/reminders",
-		TableID:       "reminders-table",
-		TotalPages:    params.TotalPages,
-		TotalItems:    params.TotalItems,
-		CurrentPage:   params.CurrentPage,
-		PageSize:      params.PageSize,
-		SortField:     params.SortField,
-		SortOrder:     params.SortOrder,
-		ShowNumbers:   true,
-		Filters:       params.Filters,
-		ActiveFilters: params.ActiveFilters,
-	}
-
-	return components.Table(config)
-}
-
-func RemindersList(reminders []repository.Reminder) templ.Component {
-	return templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
-		templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
-		if templ_7745c5c3_CtxErr := ctx.Err(); templ_7745c5c3_CtxErr != nil {
-			return templ_7745c5c3_CtxErr
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 8, "\" placeholder=\"Повторов: без ограничения\" title=\"Сколько раз повторить уведомление; пусто — до конца окна подтверждения\" class=\"w-52 px-3 py-1.5 border border-gray-300 rounded-md text-sm focus:ring-2 focus:ring-indigo-500 focus:border-indigo-500 outline-none\"> <select name=\"confirm_window_minutes\" title=\"Сколько ждать подтверждения; не подтверждённое напоминание станет просроченным\" class=\"px-3 py-1.5 border border-gray-300 rounded-md text-sm focus:ring-2 focus:ring-indigo-500 focus:border-indigo-500 outline-none\"><option value=\"60\">Ждать 1 ч</option> <option value=\"180\">Ждать 3 ч</option> <option value=\"0\" selected>Ждать 10 ч</option> <option value=\"1440\">Ждать сутки</option> <option value=\"4320\">Ждать 3 суток</option></select></div><div x-show=\"confirmEnabled\" x-cloak class=\"flex-1 min-w-[16rem]\"><input type=\"text\" name=\"escalation_policy\" placeholder=\"Эскалация: 3 telegram:123456789; 6 email:lead@example.com\" title=\"После скольких повторов без подтверждения кого уведомить: <повторов> <telegram|email|webhook>:<адрес>, шаги через «;»\" class=\"w-full px-3 py-1.5 border border-gray-300 rounded-md text-sm font-mono focus:ring-2 focus:ring-indigo-500 focus:border-indigo-500 outline-none\"></div></div><div class=\"flex flex-wrap items-center gap-4\"><label class=\"text-sm font-medium text-gray-700\">Повторять</label> <select name=\"recurrence\" x-model=\"recurrence\" class=\"px-3 py-1.5 border border-gray-300 rounded-md text-sm focus:ring-2 focus:ring-indigo-500 focus:border-indigo-500 outline-none\"><option value=\"none\" selected>Не повторять</option> ")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
 		}
-		templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
-		if !templ_7745c5c3_IsBuffer {
-			defer func() {
-				templ_7745c5c3_BufErr := templruntime.ReleaseBuffer(templ_7745c5c3_Buffer)
-				if templ_7745c5c3_Err == nil {
-					templ_7745c5c3_Err = templ_7745c5c3_BufErr
-				}
-			}()
+		for _, p := range recurrence.Presets {
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 9, "<option value=\"")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			var templ_7745c5c3_Var8 string
+			templ_7745c5c3_Var8, templ_7745c5c3_Err = templ.JoinStringErrs(p.Key)
+			if templ_7745c5c3_Err != nil {
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/controller/ui/pages/reminders.templ`, Line: 241, Col: 28}
+			}
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var8))
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 10, "\">")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			var templ_7745c5c3_Var9 string
+			templ_7745c5c3_Var9, templ_7745c5c3_Err = templ.JoinStringErrs(p.Label)
+			if templ_7745c5c3_Err != nil {
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/controller/ui/pages/reminders.templ`, Line: 241, Col: 40}
+			}
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var9))
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 11, "</option> ")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
 		}
-		ctx = templ.InitializeContext(ctx)
-		templ_7745c5c3_Var5 := templ.GetChildren(ctx)
-		if templ_7745c5c3_Var5 == nil {
-			templ_7745c5c3_Var5 = templ.NopComponent
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 12, "<option value=\"custom\">Своё правило (RRULE или cron)</option></select><div x-show=\"recurrence === 'custom'\" x-cloak class=\"flex-1 min-w-[16rem]\"><input type=\"text\" name=\"recurrence_rule\" x-model=\"rule\" placeholder=\"FREQ=WEEKLY;INTERVAL=2;BYDAY=MO или 0 9 * * 1-5\" class=\"w-full px-3 py-1.5 border border-gray-300 rounded-md text-sm font-mono focus:ring-2 focus:ring-indigo-500 focus:border-indigo-500 outline-none\"></div></div><div class=\"flex flex-wrap items-center gap-4\"><span class=\"text-sm font-medium text-gray-700\">Каналы</span> ")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
 		}
-		ctx = templ.ClearChildren(ctx)
-		if len(reminders) == 0 {
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 3, "<div class=\"text-gray-400 text-sm text-center py-8\">Нет напоминаний</div>")
+		for _, ch := range notify.All {
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 13, "<label class=\"flex items-center gap-2 text-sm text-gray-700\"><input type=\"checkbox\" name=\"channels\" value=\"")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-		} else {
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 4, "<div class=\"divide-y divide-gray-100\">")
+			var templ_7745c5c3_Var10 string
+			templ_7745c5c3_Var10, templ_7745c5c3_Err = templ.JoinStringErrs(string(ch))
+			if templ_7745c5c3_Err != nil {
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/controller/ui/pages/reminders.templ`, Line: 262, Col: 26}
+			}
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var10))
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			for _, rem := range reminders {
-				templ_7745c5c3_Err = reminderRow(rem).Render(ctx, templ_7745c5c3_Buffer)
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 14, "\" class=\"rounded border-gray-300 text-indigo-600 focus:ring-indigo-500\"> ")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			var templ_7745c5c3_Var11 string
+			templ_7745c5c3_Var11, templ_7745c5c3_Err = templ.JoinStringErrs(ch.Label())
+			if templ_7745c5c3_Err != nil {
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/controller/ui/pages/reminders.templ`, Line: 265, Col: 19}
+			}
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var11))
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 15, "</label> ")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+		}
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 16, "<span class=\"text-xs text-gray-400\">Не выбрано — порядок из настроек</span></div>")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		if editable := editableLists(lists); len(editable) > 0 {
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 17, "<div class=\"flex flex-wrap items-center gap-4\" x-data=\"{ list: '' }\"><label class=\"text-sm font-medium text-gray-700\">Список</label> <select name=\"list_id\" x-model=\"list\" class=\"px-3 py-1.5 border border-gray-300 rounded-md text-sm focus:ring-2 focus:ring-indigo-500 focus:border-indigo-500 outline-none\"><option value=\"\" selected>Личное</option> ")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			for _, l := range editable {
+				templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 18, "<option value=\"")
+				if templ_7745c5c3_Err != nil {
+					return templ_7745c5c3_Err
+				}
+				var templ_7745c5c3_Var12 string
+				templ_7745c5c3_Var12, templ_7745c5c3_Err = templ.JoinStringErrs(l.ID.String())
+				if templ_7745c5c3_Err != nil {
+					return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/controller/ui/pages/reminders.templ`, Line: 280, Col: 37}
+				}
+				_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var12))
+				if templ_7745c5c3_Err != nil {
+					return templ_7745c5c3_Err
+				}
+				templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 19, "\">")
+				if templ_7745c5c3_Err != nil {
+					return templ_7745c5c3_Err
+				}
+				var templ_7745c5c3_Var13 string
+				templ_7745c5c3_Var13, templ_7745c5c3_Err = templ.JoinStringErrs(l.Name)
+				if templ_7745c5c3_Err != nil {
+					return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/controller/ui/pages/reminders.templ`, Line: 280, Col: 48}
+				}
+				_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var13))
+				if templ_7745c5c3_Err != nil {
+					return templ_7745c5c3_Err
+				}
+				templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 20, "</option>")
 				if templ_7745c5c3_Err != nil {
 					return templ_7745c5c3_Err
 				}
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 5, "</div>")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 21, "</select><div x-show=\"list\" x-cloak class=\"flex-1 min-w-[16rem]\"><input type=\"email\" name=\"assignee_email\" placeholder=\"Исполнитель: email участника списка (пусто — вы)\" class=\"w-full px-3 py-1.5 border border-gray-300 rounded-md text-sm focus:ring-2 focus:ring-indigo-500 focus:border-indigo-500 outline-none\"></div></div>")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
 		}
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 22, "<button type=\"submit\" class=\"bg-indigo-600 text-white px-6 py-2 rounded-md hover:bg-indigo-700 transition-colors font-medium\">Создать</button><p class=\"text-xs text-gray-400\">Файлы и фото можно приложить после создания — в окне «Изменить».</p><div id=\"reminder-message\" class=\"mt-2 text-sm\"></div></form></div>")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = ReminderImportCard().Render(ctx, templ_7745c5c3_Buffer)
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 23, "<!-- Список напоминаний (Таблица) --><div class=\"bg-white rounded-xl shadow-sm p-6 border border-gray-200\"><h2 class=\"text-lg font-semibold text-gray-800 mb-4\">История напоминаний</h2><div id=\"reminders-table\">")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = RemindersTablePaged(reminders, params).Render(ctx, templ_7745c5c3_Buffer)
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 24, "</div></div><!-- Модальное окно редактирования, загружается по действию «Изменить» --><div id=\"reminder-modal\"></div></div>")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
 		return nil
 	})
 }
 
-func reminderRow(rem repository.Reminder) templ.Component {
+// ReminderEditModal — форма изменения заголовка, описания и времени напоминания.
+// Время можно менять, только пока напоминание ещё не отправлено (или у повторяющегося).
+// Под формой — вложения: они сохраняются сразу, отдельно от кнопки «Сохранить».
+func ReminderEditModal(rem repository.Reminder, attachments []repository.Attachment) templ.Component {
 	return templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
 		templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
 		if templ_7745c5c3_CtxErr := ctx.Err(); templ_7745c5c3_CtxErr != nil {
@@ -259,132 +383,770 @@ func reminderRow(rem repository.Reminder) templ.Component {
 			}()
 		}
 		ctx = templ.InitializeContext(ctx)
-		templ_7745c5c3_Var6 := templ.GetChildren(ctx)
-		if templ_7745c5c3_Var6 == nil {
-			templ_7745c5c3_Var6 = templ.NopComponent
+		templ_7745c5c3_Var14 := templ.GetChildren(ctx)
+		if templ_7745c5c3_Var14 == nil {
+			templ_7745c5c3_Var14 = templ.NopComponent
 		}
 		ctx = templ.ClearChildren(ctx)
-		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 6, "<div class=\"flex items-center justify-between py-3\" id=\"")
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 25, "<div x-data=\"{ open: true }\" x-show=\"open\" @keydown.escape.window=\"open = false\" class=\"fixed inset-0 z-50 flex items-center justify-center bg-black/40\"><div class=\"bg-white rounded-xl shadow-lg w-full max-w-lg p-6\" @click.outside=\"open = false\"><h2 class=\"text-lg font-semibold text-gray-800 mb-4\">Изменить напоминание</h2><form hx-post=\"")
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
-		var templ_7745c5c3_Var7 string
-		templ_7745c5c3_Var7, templ_7745c5c3_Err = templ.JoinStringErrs("reminder-" + rem.ID.String())
+		var templ_7745c5c3_Var15 string
+		templ_7745c5c3_Var15, templ_7745c5c3_Err = templ.JoinStringErrs("/reminders/" + rem.ID.String() + "/edit")
 		if templ_7745c5c3_Err != nil {
-			return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/controller/ui/pages/reminders.templ`, Line: 190, Col: 87}
+			return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/controller/ui/pages/reminders.templ`, Line: 329, Col: 55}
 		}
-		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var7))
+		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var15))
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
-		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 7, "\"><div class=\"flex-1 min-w-0\"><p class=\"text-sm font-medium text-gray-800 truncate\">")
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 26, "\" hx-target=\"#reminders-table\" hx-swap=\"innerHTML\" hx-ext=\"json-enc\" class=\"space-y-4\" @htmx:after-request=\"if ($event.detail.successful) open = false\"><div><label class=\"block text-sm font-medium text-gray-700 mb-1\">Название</label> <input type=\"text\" name=\"title\" value=\"")
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
-		var templ_7745c5c3_Var8 string
-		templ_7745c5c3_Var8, templ_7745c5c3_Err = templ.JoinStringErrs(rem.Title)
+		var templ_7745c5c3_Var16 string
+		templ_7745c5c3_Var16, templ_7745c5c3_Err = templ.JoinStringErrs(rem.Title)
 		if templ_7745c5c3_Err != nil {
-			return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/controller/ui/pages/reminders.templ`, Line: 192, Col: 68}
+			return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/controller/ui/pages/reminders.templ`, Line: 341, Col: 23}
 		}
-		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var8))
+		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var16))
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
-		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 8, "</p>")
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 27, "\" required maxlength=\"255\" class=\"w-full px-4 py-2 border border-gray-300 rounded-md focus:ring-2 focus:ring-indigo-500 focus:border-indigo-500 outline-none transition-all\"></div><div><label class=\"block text-sm font-medium text-gray-700 mb-1\">Описание</label> <textarea name=\"description\" rows=\"3\" class=\"w-full px-4 py-2 border border-gray-300 rounded-md focus:ring-2 focus:ring-indigo-500 focus:border-indigo-500 outline-none transition-all resize-none\">")
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
-		if rem.Description != "" {
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 9, "<p class=\"text-xs text-gray-500 truncate\">")
+		var templ_7745c5c3_Var17 string
+		templ_7745c5c3_Var17, templ_7745c5c3_Err = templ.JoinStringErrs(rem.Description)
+		if templ_7745c5c3_Err != nil {
+			return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/controller/ui/pages/reminders.templ`, Line: 353, Col: 23}
+		}
+		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var17))
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 28, "</textarea></div>")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		if canReschedule(rem) {
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 29, "<div><label class=\"block text-sm font-medium text-gray-700 mb-1\">")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			var templ_7745c5c3_Var9 string
-			templ_7745c5c3_Var9, templ_7745c5c3_Err = templ.JoinStringErrs(rem.Description)
+			if rem.IsRecurring() {
+				templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 30, "Первое срабатывание")
+				if templ_7745c5c3_Err != nil {
+					return templ_7745c5c3_Err
+				}
+			} else {
+				templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 31, "Дата и время")
+				if templ_7745c5c3_Err != nil {
+					return templ_7745c5c3_Err
+				}
+			}
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 32, "</label> <input type=\"datetime-local\" name=\"remind_at\" value=\"")
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/controller/ui/pages/reminders.templ`, Line: 194, Col: 63}
+				return templ_7745c5c3_Err
 			}
-			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var9))
+			var templ_7745c5c3_Var18 string
+			templ_7745c5c3_Var18, templ_7745c5c3_Err = templ.JoinStringErrs(timezone.FormatUser(ctx, rem.RemindAt, "2006-01-02T15:04"))
+			if templ_7745c5c3_Err != nil {
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/controller/ui/pages/reminders.templ`, Line: 367, Col: 73}
+			}
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var18))
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 10, "</p>")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 33, "\" required class=\"w-full px-4 py-2 border border-gray-300 rounded-md focus:ring-2 focus:ring-indigo-500 focus:border-indigo-500 outline-none transition-all\"></div>")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
 		}
-		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 11, "<p class=\"text-xs text-gray-400 mt-1\">")
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 34, "<div class=\"flex justify-end gap-3\"><button type=\"button\" @click=\"open = false\" class=\"px-4 py-2 rounded-md text-gray-700 hover:bg-gray-100 transition-colors\">Отмена</button> <button type=\"submit\" class=\"bg-indigo-600 text-white px-6 py-2 rounded-md hover:bg-indigo-700 transition-colors font-medium\">Сохранить</button></div></form>")
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
-		var templ_7745c5c3_Var10 string
-		templ_7745c5c3_Var10, templ_7745c5c3_Err = templ.JoinStringErrs(timezone.FormatUser(rem.RemindAt, "02.01.2006 15:04"))
-		if templ_7745c5c3_Err != nil {
-			return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/controller/ui/pages/reminders.templ`, Line: 197, Col: 59}
-		}
-		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var10))
+		templ_7745c5c3_Err = ReminderAttachments(rem.ID, attachments).Render(ctx, templ_7745c5c3_Buffer)
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
-		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 12, " ")
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 35, "</div></div>")
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
-		var templ_7745c5c3_Var11 = []any{"ml-2 inline-block px-2 py-0.5 rounded-full text-xs font-medium", statusClass(rem.Status)}
-		templ_7745c5c3_Err = templ.RenderCSSItems(ctx, templ_7745c5c3_Buffer, templ_7745c5c3_Var11...)
-		if templ_7745c5c3_Err != nil {
-			return templ_7745c5c3_Err
+		return nil
+	})
+}
+
+// ReminderEscalationsModal — политика эскалации и история сработавших шагов.
+func ReminderEscalationsModal(rem repository.Reminder, escalations []repository.Escalation) templ.Component {
+	return templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+		templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
+		if templ_7745c5c3_CtxErr := ctx.Err(); templ_7745c5c3_CtxErr != nil {
+			return templ_7745c5c3_CtxErr
 		}
-		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 13, "<span class=\"")
+		templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
+		if !templ_7745c5c3_IsBuffer {
+			defer func() {
+				templ_7745c5c3_BufErr := templruntime.ReleaseBuffer(templ_7745c5c3_Buffer)
+				if templ_7745c5c3_Err == nil {
+					templ_7745c5c3_Err = templ_7745c5c3_BufErr
+				}
+			}()
+		}
+		ctx = templ.InitializeContext(ctx)
+		templ_7745c5c3_Var19 := templ.GetChildren(ctx)
+		if templ_7745c5c3_Var19 == nil {
+			templ_7745c5c3_Var19 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 36, "<div x-data=\"{ open: true }\" x-show=\"open\" @keydown.escape.window=\"open = false\" class=\"fixed inset-0 z-50 flex items-center justify-center bg-black/40\"><div class=\"bg-white rounded-xl shadow-lg w-full max-w-lg p-6\" @click.outside=\"open = false\"><h2 class=\"text-lg font-semibold text-gray-800 mb-2\">Эскалация «")
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
-		var templ_7745c5c3_Var12 string
-		templ_7745c5c3_Var12, templ_7745c5c3_Err = templ.JoinStringErrs(templ.CSSClasses(templ_7745c5c3_Var11).String())
+		var templ_7745c5c3_Var20 string
+		templ_7745c5c3_Var20, templ_7745c5c3_Err = templ.JoinStringErrs(rem.Title)
 		if templ_7745c5c3_Err != nil {
-			return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/controller/ui/pages/reminders.templ`, Line: 1, Col: 0}
+			return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/controller/ui/pages/reminders.templ`, Line: 403, Col: 88}
 		}
-		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var12))
+		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var20))
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
-		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 14, "\">")
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 37, "»</h2><p class=\"text-xs text-gray-500 font-mono mb-4\">")
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
-		var templ_7745c5c3_Var13 string
-		templ_7745c5c3_Var13, templ_7745c5c3_Err = templ.JoinStringErrs(statusLabel(rem.Status))
+		var templ_7745c5c3_Var21 string
+		templ_7745c5c3_Var21, templ_7745c5c3_Err = templ.JoinStringErrs(rem.EscalationPolicy)
 		if templ_7745c5c3_Err != nil {
-			return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/controller/ui/pages/reminders.templ`, Line: 199, Col: 30}
+			return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/controller/ui/pages/reminders.templ`, Line: 404, Col: 73}
 		}
-		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var13))
+		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var21))
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
-		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 15, "</span></p></div>")
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 38, "</p>")
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
-		if rem.Status == model.ReminderStatusPending.String() {
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 16, "<button hx-delete=\"")
+		if len(escalations) == 0 {
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 39, "<div class=\"text-gray-400 text-sm text-center py-6\">Эскалаций ещё не было</div>")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			var templ_7745c5c3_Var14 string
-			templ_7745c5c3_Var14, templ_7745c5c3_Err = templ.JoinStringErrs("/reminders/" + rem.ID.String())
+		} else {
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 40, "<div class=\"divide-y divide-gray-100 max-h-80 overflow-y-auto\">")
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/controller/ui/pages/reminders.templ`, Line: 205, Col: 47}
+				return templ_7745c5c3_Err
+			}
+			for _, e := range escalations {
+				templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 41, "<div class=\"py-2 text-sm\"><div class=\"flex justify-between\"><span class=\"font-medium text-gray-800\">")
+				if templ_7745c5c3_Err != nil {
+					return templ_7745c5c3_Err
+				}
+				var templ_7745c5c3_Var22 string
+				templ_7745c5c3_Var22, templ_7745c5c3_Err = templ.JoinStringErrs(fmt.Sprintf("Шаг %d · %s", e.Step, notify.Channel(e.Channel).Label()))
+				if templ_7745c5c3_Err != nil {
+					return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/controller/ui/pages/reminders.templ`, Line: 412, Col: 123}
+				}
+				_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var22))
+				if templ_7745c5c3_Err != nil {
+					return templ_7745c5c3_Err
+				}
+				templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 42, "</span> <span class=\"text-gray-400\">")
+				if templ_7745c5c3_Err != nil {
+					return templ_7745c5c3_Err
+				}
+				var templ_7745c5c3_Var23 string
+				templ_7745c5c3_Var23, templ_7745c5c3_Err = templ.JoinStringErrs(timezone.FormatUser(ctx, e.CreatedAt, "02.01.2006 15:04"))
+				if templ_7745c5c3_Err != nil {
+					return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/controller/ui/pages/reminders.templ`, Line: 413, Col: 95}
+				}
+				_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var23))
+				if templ_7745c5c3_Err != nil {
+					return templ_7745c5c3_Err
+				}
+				templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 43, "</span></div><div class=\"text-gray-500 truncate\">")
+				if templ_7745c5c3_Err != nil {
+					return templ_7745c5c3_Err
+				}
+				var templ_7745c5c3_Var24 string
+				templ_7745c5c3_Var24, templ_7745c5c3_Err = templ.JoinStringErrs(e.Address)
+				if templ_7745c5c3_Err != nil {
+					return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/controller/ui/pages/reminders.templ`, Line: 415, Col: 54}
+				}
+				_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var24))
+				if templ_7745c5c3_Err != nil {
+					return templ_7745c5c3_Err
+				}
+				templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 44, "</div>")
+				if templ_7745c5c3_Err != nil {
+					return templ_7745c5c3_Err
+				}
+				if e.Status == "sent" {
+					templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 45, "<div class=\"text-green-600 text-xs\">Отправлено</div>")
+					if templ_7745c5c3_Err != nil {
+						return templ_7745c5c3_Err
+					}
+				} else {
+					templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 46, "<div class=\"text-red-500 text-xs\">Ошибка: ")
+					if templ_7745c5c3_Err != nil {
+						return templ_7745c5c3_Err
+					}
+					var templ_7745c5c3_Var25 string
+					templ_7745c5c3_Var25, templ_7745c5c3_Err = templ.JoinStringErrs(e.Error)
+					if templ_7745c5c3_Err != nil {
+						return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/controller/ui/pages/reminders.templ`, Line: 419, Col: 65}
+					}
+					_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var25))
+					if templ_7745c5c3_Err != nil {
+						return templ_7745c5c3_Err
+					}
+					templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 47, "</div>")
+					if templ_7745c5c3_Err != nil {
+						return templ_7745c5c3_Err
+					}
+				}
+				templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 48, "</div>")
+				if templ_7745c5c3_Err != nil {
+					return templ_7745c5c3_Err
+				}
 			}
-			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var14))
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 49, "</div>")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 17, "\" hx-target=\"#reminders-table\" hx-swap=\"innerHTML\" hx-confirm=\"Удалить напоминание?\" class=\"ml-4 text-red-500 hover:text-red-700 text-sm font-medium shrink-0\">Удалить</button>")
+		}
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 50, "<div class=\"flex justify-end mt-4\"><button type=\"button\" @click=\"open = false\" class=\"px-4 py-2 rounded-md text-gray-700 hover:bg-gray-100 transition-colors\">Закрыть</button></div></div></div>")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		return nil
+	})
+}
+
+// ReminderDeliveriesModal — история доставки: отправки по каналам и подтверждение.
+func ReminderDeliveriesModal(rem repository.Reminder, deliveries []repository.Delivery) templ.Component {
+	return templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+		templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
+		if templ_7745c5c3_CtxErr := ctx.Err(); templ_7745c5c3_CtxErr != nil {
+			return templ_7745c5c3_CtxErr
+		}
+		templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
+		if !templ_7745c5c3_IsBuffer {
+			defer func() {
+				templ_7745c5c3_BufErr := templruntime.ReleaseBuffer(templ_7745c5c3_Buffer)
+				if templ_7745c5c3_Err == nil {
+					templ_7745c5c3_Err = templ_7745c5c3_BufErr
+				}
+			}()
+		}
+		ctx = templ.InitializeContext(ctx)
+		templ_7745c5c3_Var26 := templ.GetChildren(ctx)
+		if templ_7745c5c3_Var26 == nil {
+			templ_7745c5c3_Var26 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 51, "<div x-data=\"{ open: true }\" x-show=\"open\" @keydown.escape.window=\"open = false\" class=\"fixed inset-0 z-50 flex items-center justify-center bg-black/40\"><div class=\"bg-white rounded-xl shadow-lg w-full max-w-lg p-6\" @click.outside=\"open = false\"><h2 class=\"text-lg font-semibold text-gray-800 mb-4\">История «")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		var templ_7745c5c3_Var27 string
+		templ_7745c5c3_Var27, templ_7745c5c3_Err = templ.JoinStringErrs(rem.Title)
+		if templ_7745c5c3_Err != nil {
+			return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/controller/ui/pages/reminders.templ`, Line: 447, Col: 84}
+		}
+		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var27))
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 52, "»</h2>")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		if len(deliveries) == 0 {
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 53, "<div class=\"text-gray-400 text-sm text-center py-6\">Уведомление ещё не отправлялось</div>")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+		} else {
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 54, "<ol class=\"relative border-l border-gray-200 ml-2 max-h-80 overflow-y-auto\">")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			for _, d := range deliveries {
+				templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 55, "<li class=\"ml-4 py-2 text-sm\">")
+				if templ_7745c5c3_Err != nil {
+					return templ_7745c5c3_Err
+				}
+				var templ_7745c5c3_Var28 = []any{"absolute -left-1.5 mt-1.5 h-3 w-3 rounded-full", deliveryDotClass(d.Status)}
+				templ_7745c5c3_Err = templ.RenderCSSItems(ctx, templ_7745c5c3_Buffer, templ_7745c5c3_Var28...)
+				if templ_7745c5c3_Err != nil {
+					return templ_7745c5c3_Err
+				}
+				templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 56, "<span class=\"")
+				if templ_7745c5c3_Err != nil {
+					return templ_7745c5c3_Err
+				}
+				var templ_7745c5c3_Var29 string
+				templ_7745c5c3_Var29, templ_7745c5c3_Err = templ.JoinStringErrs(templ.CSSClasses(templ_7745c5c3_Var28).String())
+				if templ_7745c5c3_Err != nil {
+					return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/controller/ui/pages/reminders.templ`, Line: 1, Col: 0}
+				}
+				_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var29))
+				if templ_7745c5c3_Err != nil {
+					return templ_7745c5c3_Err
+				}
+				templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 57, "\"></span><div class=\"flex justify-between\"><span class=\"font-medium text-gray-800\">")
+				if templ_7745c5c3_Err != nil {
+					return templ_7745c5c3_Err
+				}
+				var templ_7745c5c3_Var30 string
+				templ_7745c5c3_Var30, templ_7745c5c3_Err = templ.JoinStringErrs(deliveryTitle(d))
+				if templ_7745c5c3_Err != nil {
+					return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/controller/ui/pages/reminders.templ`, Line: 456, Col: 66}
+				}
+				_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var30))
+				if templ_7745c5c3_Err != nil {
+					return templ_7745c5c3_Err
+				}
+				templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 58, "</span> <span class=\"text-gray-400\">")
+				if templ_7745c5c3_Err != nil {
+					return templ_7745c5c3_Err
+				}
+				var templ_7745c5c3_Var31 string
+				templ_7745c5c3_Var31, templ_7745c5c3_Err = templ.JoinStringErrs(timezone.FormatUser(ctx, d.CreatedAt, "02.01.2006 15:04:05"))
+				if templ_7745c5c3_Err != nil {
+					return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/controller/ui/pages/reminders.templ`, Line: 457, Col: 98}
+				}
+				_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var31))
+				if templ_7745c5c3_Err != nil {
+					return templ_7745c5c3_Err
+				}
+				templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 59, "</span></div>")
+				if templ_7745c5c3_Err != nil {
+					return templ_7745c5c3_Err
+				}
+				switch d.Status {
+				case repository.DeliverySent:
+					templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 60, "<div class=\"text-green-600 text-xs\">Доставлено ")
+					if templ_7745c5c3_Err != nil {
+						return templ_7745c5c3_Err
+					}
+					if d.TelegramMessageID != 0 {
+						var templ_7745c5c3_Var32 string
+						templ_7745c5c3_Var32, templ_7745c5c3_Err = templ.JoinStringErrs(fmt.Sprintf(" · сообщение #%d", d.TelegramMessageID))
+						if templ_7745c5c3_Err != nil {
+							return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/controller/ui/pages/reminders.templ`, Line: 464, Col: 75}
+						}
+						_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var32))
+						if templ_7745c5c3_Err != nil {
+							return templ_7745c5c3_Err
+						}
+					}
+					templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 61, "</div>")
+					if templ_7745c5c3_Err != nil {
+						return templ_7745c5c3_Err
+					}
+				case repository.DeliveryFailed:
+					templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 62, "<div class=\"text-red-500 text-xs\">Ошибка: ")
+					if templ_7745c5c3_Err != nil {
+						return templ_7745c5c3_Err
+					}
+					var templ_7745c5c3_Var33 string
+					templ_7745c5c3_Var33, templ_7745c5c3_Err = templ.JoinStringErrs(d.Error)
+					if templ_7745c5c3_Err != nil {
+						return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/controller/ui/pages/reminders.templ`, Line: 468, Col: 66}
+					}
+					_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var33))
+					if templ_7745c5c3_Err != nil {
+						return templ_7745c5c3_Err
+					}
+					templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 63, "</div>")
+					if templ_7745c5c3_Err != nil {
+						return templ_7745c5c3_Err
+					}
+				}
+				templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 64, "</li>")
+				if templ_7745c5c3_Err != nil {
+					return templ_7745c5c3_Err
+				}
+			}
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 65, "</ol>")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+		}
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 66, "<div class=\"flex justify-end mt-4\"><button type=\"button\" @click=\"open = false\" class=\"px-4 py-2 rounded-md text-gray-700 hover:bg-gray-100 transition-colors\">Закрыть</button></div></div></div>")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		return nil
+	})
+}
+
+func RemindersTable(reminders []repository.Reminder) templ.Component {
+	return RemindersTablePaged(reminders, TableParams{CurrentPage: 1, TotalPages: 1, PageSize: 20, SortField: "created_at", SortOrder: "desc"})
+}
+
+// RemindersTablePaged строит таблицу при рендеринге: время показывается
+// в таймзоне пользователя из контекста.
+func RemindersTablePaged(reminders []repository.Reminder, params TableParams) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		return remindersTable(ctx, reminders, params).Render(ctx, w)
+	})
+}
+
+// RemindersTableRows рендерит только строки следующей порции ленты.
+func RemindersTableRows(reminders []repository.Reminder, params TableParams) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		return components.TableRows(remindersTableConfig(ctx, reminders, params)).Render(ctx, w)
+	})
+}
+
+func remindersTable(ctx context.Context, reminders []repository.Reminder, params TableParams) templ.Component {
+	return components.Table(remindersTableConfig(ctx, reminders, params))
+}
+
+func remindersTableConfig(ctx context.Context, reminders []repository.Reminder, params TableParams) components.TableConfig {
+	rows := make([]map[string]any, len(reminders))
+	for i, rem := range reminders {
+		rows[i] = map[string]any{
+			"id":          rem.ID,
+			"title":       rem.Title,
+			"priority":    priorityLabel(rem.Priority),
+			"tags":        orDash(tags.String(rem.Tags)),
+			"status":      statusText(ctx, rem),
+			"remind_at":   timezone.FormatUser(ctx, rem.RemindAt, "02.01.2006 15:04"),
+			"created_at":  timezone.FormatUser(ctx, rem.CreatedAt, "02.01.2006 15:04"),
+			"description": rem.Description,
+			"recurrence":  recurrenceLabel(ctx, rem),
+			"can_pause":   rem.ScheduleID != "" && rem.Status == model.ReminderStatusPending.String(),
+			"can_resume":  rem.ScheduleID != "" && rem.Status == model.ReminderStatusPaused.String(),
+			"recurring":   rem.ScheduleID != "" && rem.Status != model.ReminderStatusCancelled.String(),
+			"can_snooze":  canSnooze(rem),
+			"escalation":  escalationLabel(rem),
+			"escalates":   rem.EscalationPolicy != "",
+			"list":        orDash(rem.ListName),
+			"assignee":    orDash(rem.AssigneeEmail),
+			"checklist":   checklistLabel(rem),
+		}
+		if h, ok := params.Highlights[rem.ID]; ok {
+			rows[i]["title"] = highlighted(h.Title)
+			if h.Snippet != "" {
+				rows[i]["description"] = highlighted(h.Snippet)
+			}
+		}
+	}
+
+	return components.TableConfig{
+		Columns: []components.Column{
+			{Title: "Название", Key: "title", Sortable: true},
+			{Title: "Приоритет", Key: "priority", Sortable: true},
+			{Title: "Статус", Key: "status", Sortable: true},
+			{Title: "Время", Key: "remind_at", Sortable: true},
+			{Title: "Повтор", Key: "recurrence"},
+			{Title: "Эскалация", Key: "escalation"},
+			{Title: "Теги", Key: "tags"},
+			{Title: "Список", Key: "list"},
+			{Title: "Исполнитель", Key: "assignee"},
+			{Title: "Чек-лист", Key: "checklist"},
+			{Title: "Создано", Key: "created_at", Sortable: true},
+			{Title: "Описание", Key: "description"},
+		},
+		Rows: rows,
+		Actions: append(snoozeActions(), []components.Action{
+			{Label: "Изменить", Icon: "✏", HxMethod: "hx-get", URLPath: "/reminders/{id}/edit", Target: "#reminder-modal"},
+			{Label: "Чек-лист", Icon: "☑", HxMethod: "hx-get", URLPath: "/reminders/{id}/checklist", Target: "#reminder-modal"},
+			{Label: "История", Icon: "🕓", HxMethod: "hx-get", URLPath: "/reminders/{id}/deliveries", Target: "#reminder-modal"},
+			{Label: "Эскалации", Icon: "📣", HxMethod: "hx-get", URLPath: "/reminders/{id}/escalations", Target: "#reminder-modal", ShowIf: "escalates"},
+			{Label: "Приостановить", Icon: "⏸", HxMethod: "hx-post", URLPath: "/reminders/{id}/pause", ShowIf: "can_pause"},
+			{Label: "Возобновить", Icon: "▶", HxMethod: "hx-post", URLPath: "/reminders/{id}/resume", ShowIf: "can_resume"},
+			{Label: "Изменить повтор", Icon: "🔁", HxMethod: "hx-post", URLPath: "/reminders/{id}/recurrence", Prompt: "Новое правило (RRULE или cron), например FREQ=DAILY;BYHOUR=9;BYMINUTE=0", ShowIf: "recurring"},
+			{Label: "Удалить", Icon: "🗑", HxMethod: "hx-delete", URLPath: "/reminders/{id}", Confirm: "Удалить напоминание?", Variant: "danger"},
+		}...),
+		BulkActions: []components.BulkAction{
+			{Label: "Перенести", Icon: "📅", URLPath: "/reminders/bulk/reschedule", Prompt: "Сдвиг (+1h, -30m, +2d) или новое время («завтра в 9:00»)"},
+			{Label: "Теги", Icon: "🏷", URLPath: "/reminders/bulk/retag", Prompt: "Теги через пробел: «работа» — добавить, «-дом» — снять"},
+			{Label: "Отменить", Icon: "⏹", URLPath: "/reminders/bulk/cancel", Confirm: "Отменить выбранные напоминания?"},
+			{Label: "Удалить", Icon: "🗑", URLPath: "/reminders/bulk/delete", Confirm: "Удалить выбранные напоминания?", Variant: "danger"},
+		},
+		BaseURL:       "/reminders",
+		TableID:       "reminders-table",
+		TotalPages:    params.TotalPages,
+		TotalItems:    params.TotalItems,
+		CurrentPage:   params.CurrentPage,
+		PageSize:      params.PageSize,
+		SortField:     params.SortField,
+		SortOrder:     params.SortOrder,
+		ShowNumbers:   true,
+		Filters:       params.Filters,
+		ActiveFilters: params.ActiveFilters,
+		SearchSort:    model.SortRank,
+		AllowFeed:     true,
+		Feed:          params.Feed,
+		NextCursor:    params.NextCursor,
+		Shown:         params.Shown,
+	}
+}
+
+// checklistLabel — отмеченные пункты чек-листа из всех, например «2/5».
+func checklistLabel(rem repository.Reminder) string {
+	if rem.ChecklistTotal == 0 {
+		return "—"
+	}
+	return fmt.Sprintf("%d/%d", rem.ChecklistDone, rem.ChecklistTotal)
+}
+
+// highlighted выводит текст с подсвеченными совпадениями поиска.
+func highlighted(s string) templ.Component {
+	return templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+		templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
+		if templ_7745c5c3_CtxErr := ctx.Err(); templ_7745c5c3_CtxErr != nil {
+			return templ_7745c5c3_CtxErr
+		}
+		templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
+		if !templ_7745c5c3_IsBuffer {
+			defer func() {
+				templ_7745c5c3_BufErr := templruntime.ReleaseBuffer(templ_7745c5c3_Buffer)
+				if templ_7745c5c3_Err == nil {
+					templ_7745c5c3_Err = templ_7745c5c3_BufErr
+				}
+			}()
+		}
+		ctx = templ.InitializeContext(ctx)
+		templ_7745c5c3_Var34 := templ.GetChildren(ctx)
+		if templ_7745c5c3_Var34 == nil {
+			templ_7745c5c3_Var34 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		for _, p := range search.Parts(s) {
+			if p.Match {
+				templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 67, "<mark class=\"bg-yellow-100 text-gray-900 rounded-sm\">")
+				if templ_7745c5c3_Err != nil {
+					return templ_7745c5c3_Err
+				}
+				var templ_7745c5c3_Var35 string
+				templ_7745c5c3_Var35, templ_7745c5c3_Err = templ.JoinStringErrs(p.Text)
+				if templ_7745c5c3_Err != nil {
+					return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/controller/ui/pages/reminders.templ`, Line: 604, Col: 64}
+				}
+				_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var35))
+				if templ_7745c5c3_Err != nil {
+					return templ_7745c5c3_Err
+				}
+				templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 68, "</mark>")
+				if templ_7745c5c3_Err != nil {
+					return templ_7745c5c3_Err
+				}
+			} else {
+				var templ_7745c5c3_Var36 string
+				templ_7745c5c3_Var36, templ_7745c5c3_Err = templ.JoinStringErrs(p.Text)
+				if templ_7745c5c3_Err != nil {
+					return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/controller/ui/pages/reminders.templ`, Line: 606, Col: 11}
+				}
+				_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var36))
+				if templ_7745c5c3_Err != nil {
+					return templ_7745c5c3_Err
+				}
+			}
+		}
+		return nil
+	})
+}
+
+func RemindersList(reminders []repository.Reminder) templ.Component {
+	return templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+		templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
+		if templ_7745c5c3_CtxErr := ctx.Err(); templ_7745c5c3_CtxErr != nil {
+			return templ_7745c5c3_CtxErr
+		}
+		templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
+		if !templ_7745c5c3_IsBuffer {
+			defer func() {
+				templ_7745c5c3_BufErr := templruntime.ReleaseBuffer(templ_7745c5c3_Buffer)
+				if templ_7745c5c3_Err == nil {
+					templ_7745c5c3_Err = templ_7745c5c3_BufErr
+				}
+			}()
+		}
+		ctx = templ.InitializeContext(ctx)
+		templ_7745c5c3_Var37 := templ.GetChildren(ctx)
+		if templ_7745c5c3_Var37 == nil {
+			templ_7745c5c3_Var37 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		if len(reminders) == 0 {
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 69, "<div class=\"text-gray-400 text-sm text-center py-8\">Нет напоминаний</div>")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+		} else {
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 70, "<div class=\"divide-y divide-gray-100\">")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			for _, rem := range reminders {
+				templ_7745c5c3_Err = reminderRow(rem).Render(ctx, templ_7745c5c3_Buffer)
+				if templ_7745c5c3_Err != nil {
+					return templ_7745c5c3_Err
+				}
+			}
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 71, "</div>")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+		}
+		return nil
+	})
+}
+
+func reminderRow(rem repository.Reminder) templ.Component {
+	return templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+		templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
+		if templ_7745c5c3_CtxErr := ctx.Err(); templ_7745c5c3_CtxErr != nil {
+			return templ_7745c5c3_CtxErr
+		}
+		templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
+		if !templ_7745c5c3_IsBuffer {
+			defer func() {
+				templ_7745c5c3_BufErr := templruntime.ReleaseBuffer(templ_7745c5c3_Buffer)
+				if templ_7745c5c3_Err == nil {
+					templ_7745c5c3_Err = templ_7745c5c3_BufErr
+				}
+			}()
+		}
+		ctx = templ.InitializeContext(ctx)
+		templ_7745c5c3_Var38 := templ.GetChildren(ctx)
+		if templ_7745c5c3_Var38 == nil {
+			templ_7745c5c3_Var38 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 72, "<div class=\"flex items-center justify-between py-3\" id=\"")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		var templ_7745c5c3_Var39 string
+		templ_7745c5c3_Var39, templ_7745c5c3_Err = templ.JoinStringErrs("reminder-" + rem.ID.String())
+		if templ_7745c5c3_Err != nil {
+			return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/controller/ui/pages/reminders.templ`, Line: 626, Col: 87}
+		}
+		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var39))
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 73, "\"><div class=\"flex-1 min-w-0\"><p class=\"text-sm font-medium text-gray-800 truncate\">")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		var templ_7745c5c3_Var40 string
+		templ_7745c5c3_Var40, templ_7745c5c3_Err = templ.JoinStringErrs(rem.Title)
+		if templ_7745c5c3_Err != nil {
+			return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/controller/ui/pages/reminders.templ`, Line: 628, Col: 68}
+		}
+		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var40))
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 74, "</p>")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		if rem.Description != "" {
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 75, "<p class=\"text-xs text-gray-500 truncate\">")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			var templ_7745c5c3_Var41 string
+			templ_7745c5c3_Var41, templ_7745c5c3_Err = templ.JoinStringErrs(rem.Description)
+			if templ_7745c5c3_Err != nil {
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/controller/ui/pages/reminders.templ`, Line: 630, Col: 63}
+			}
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var41))
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 76, "</p>")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+		}
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 77, "<p class=\"text-xs text-gray-400 mt-1\">")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		var templ_7745c5c3_Var42 string
+		templ_7745c5c3_Var42, templ_7745c5c3_Err = templ.JoinStringErrs(timezone.FormatUser(ctx, rem.RemindAt, "02.01.2006 15:04"))
+		if templ_7745c5c3_Err != nil {
+			return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/controller/ui/pages/reminders.templ`, Line: 633, Col: 64}
+		}
+		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var42))
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 78, " ")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		var templ_7745c5c3_Var43 = []any{"ml-2 inline-block px-2 py-0.5 rounded-full text-xs font-medium", statusClass(rem.Status)}
+		templ_7745c5c3_Err = templ.RenderCSSItems(ctx, templ_7745c5c3_Buffer, templ_7745c5c3_Var43...)
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 79, "<span class=\"")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		var templ_7745c5c3_Var44 string
+		templ_7745c5c3_Var44, templ_7745c5c3_Err = templ.JoinStringErrs(templ.CSSClasses(templ_7745c5c3_Var43).String())
+		if templ_7745c5c3_Err != nil {
+			return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/controller/ui/pages/reminders.templ`, Line: 1, Col: 0}
+		}
+		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var44))
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 80, "\">")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		var templ_7745c5c3_Var45 string
+		templ_7745c5c3_Var45, templ_7745c5c3_Err = templ.JoinStringErrs(statusLabel(rem.Status))
+		if templ_7745c5c3_Err != nil {
+			return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/controller/ui/pages/reminders.templ`, Line: 635, Col: 30}
+		}
+		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var45))
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 81, "</span></p></div>")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		if rem.Status == model.ReminderStatusPending.String() {
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 82, "<button hx-delete=\"")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			var templ_7745c5c3_Var46 string
+			templ_7745c5c3_Var46, templ_7745c5c3_Err = templ.JoinStringErrs("/reminders/" + rem.ID.String())
+			if templ_7745c5c3_Err != nil {
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/controller/ui/pages/reminders.templ`, Line: 641, Col: 47}
+			}
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var46))
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 83, "\" hx-target=\"#reminders-table\" hx-swap=\"innerHTML\" hx-confirm=\"Удалить напоминание?\" class=\"ml-4 text-red-500 hover:text-red-700 text-sm font-medium shrink-0\">Удалить</button>")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
 		}
-		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 18, "</div>")
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 84, "</div>")
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
@@ -392,6 +1154,314 @@ func reminderRow(rem repository.Reminder) templ.Component {
 	})
 }
 
+// priorityOptions — порядок приоритетов в форме создания.
+var priorityOptions = []model.ReminderPriority{
+	model.ReminderPriorityLow,
+	model.ReminderPriorityNormal,
+	model.ReminderPriorityHigh,
+}
+
+// priorityLabel возвращает название приоритета.
+func priorityLabel(p model.ReminderPriority) string {
+	switch p {
+	case model.ReminderPriorityHigh:
+		return "❗ Высокий"
+	case model.ReminderPriorityLow:
+		return "Низкий"
+	default:
+		return "Обычный"
+	}
+}
+
+// editableLists оставляет списки, в которых пользователь может создавать напоминания.
+func editableLists(lists []repository.ReminderList) []repository.ReminderList {
+	var out []repository.ReminderList
+	for _, l := range lists {
+		if l.Role.CanEdit() {
+			out = append(out, l)
+		}
+	}
+	return out
+}
+
+// orDash заменяет пустое значение прочерком.
+func orDash(s string) string {
+	if s == "" {
+		return "—"
+	}
+	return s
+}
+
+// escalationLabel показывает, сколько шагов эскалации сработало из заданных.
+func escalationLabel(rem repository.Reminder) string {
+	if rem.EscalationPolicy == "" {
+		return "—"
+	}
+	policy, err := escalation.Parse(rem.EscalationPolicy)
+	if err != nil {
+		return rem.EscalationPolicy
+	}
+	return fmt.Sprintf("%d/%d", rem.EscalationLevel, len(policy))
+}
+
+// deliveryTitle — заголовок записи истории доставки.
+func deliveryTitle(d repository.Delivery) string {
+	if d.Status == repository.DeliveryAcknowledged {
+		return "✅ Подтверждено"
+	}
+	title := "Отправка"
+	if d.Delivery > 1 {
+		title = fmt.Sprintf("Повтор %d", d.Delivery-1)
+	}
+	title += " · " + notify.Channel(d.Channel).Label()
+	if d.Attempt > 1 {
+		title += fmt.Sprintf(" · попытка %d", d.Attempt)
+	}
+	return title
+}
+
+// deliveryDotClass — цвет отметки на шкале истории доставки.
+func deliveryDotClass(status string) string {
+	switch status {
+	case repository.DeliverySent:
+		return "bg-green-500"
+	case repository.DeliveryAcknowledged:
+		return "bg-blue-500"
+	default:
+		return "bg-red-500"
+	}
+}
+
+// WhenPreview — время, распознанное из текста в форме создания.
+// «Подставить» заполняет дату, повтор и пустое название.
+func WhenPreview(res nldate.Result, err error) templ.Component {
+	return templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+		templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
+		if templ_7745c5c3_CtxErr := ctx.Err(); templ_7745c5c3_CtxErr != nil {
+			return templ_7745c5c3_CtxErr
+		}
+		templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
+		if !templ_7745c5c3_IsBuffer {
+			defer func() {
+				templ_7745c5c3_BufErr := templruntime.ReleaseBuffer(templ_7745c5c3_Buffer)
+				if templ_7745c5c3_Err == nil {
+					templ_7745c5c3_Err = templ_7745c5c3_BufErr
+				}
+			}()
+		}
+		ctx = templ.InitializeContext(ctx)
+		templ_7745c5c3_Var47 := templ.GetChildren(ctx)
+		if templ_7745c5c3_Var47 == nil {
+			templ_7745c5c3_Var47 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		if err != nil {
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 85, "<span class=\"text-sm text-red-500\">")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			var templ_7745c5c3_Var48 string
+			templ_7745c5c3_Var48, templ_7745c5c3_Err = templ.JoinStringErrs(whenError(err))
+			if templ_7745c5c3_Err != nil {
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/controller/ui/pages/reminders.templ`, Line: 735, Col: 53}
+			}
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var48))
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 86, "</span>")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+		} else if !res.At.After(time.Now()) {
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 87, "<span class=\"text-sm text-red-500\">Время ")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			var templ_7745c5c3_Var49 string
+			templ_7745c5c3_Var49, templ_7745c5c3_Err = templ.JoinStringErrs(timezone.FormatUser(ctx, res.At, "02.01.2006 15:04"))
+			if templ_7745c5c3_Err != nil {
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/controller/ui/pages/reminders.templ`, Line: 737, Col: 102}
+			}
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var49))
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 88, " уже прошло</span>")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+		} else {
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 89, "<div class=\"flex flex-wrap items-center gap-3 text-sm\"><span class=\"text-gray-600\">Распознано: <span class=\"font-medium text-gray-800\">")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			var templ_7745c5c3_Var50 string
+			templ_7745c5c3_Var50, templ_7745c5c3_Err = templ.JoinStringErrs(timezone.FormatUser(ctx, res.At, "02.01.2006 15:04"))
+			if templ_7745c5c3_Err != nil {
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/controller/ui/pages/reminders.templ`, Line: 742, Col: 98}
+			}
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var50))
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 90, "</span> ")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			if label := ruleLabel(res.Rule); label != "" {
+				templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 91, "· ")
+				if templ_7745c5c3_Err != nil {
+					return templ_7745c5c3_Err
+				}
+				var templ_7745c5c3_Var51 string
+				templ_7745c5c3_Var51, templ_7745c5c3_Err = templ.JoinStringErrs(label)
+				if templ_7745c5c3_Err != nil {
+					return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/controller/ui/pages/reminders.templ`, Line: 744, Col: 15}
+				}
+				_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var51))
+				if templ_7745c5c3_Err != nil {
+					return templ_7745c5c3_Err
+				}
+			}
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 92, "</span> <button type=\"button\" @click=\"")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			var templ_7745c5c3_Var52 string
+			templ_7745c5c3_Var52, templ_7745c5c3_Err = templ.JoinStringErrs(applyParsedDate(ctx, res))
+			if templ_7745c5c3_Err != nil {
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/controller/ui/pages/reminders.templ`, Line: 747, Col: 59}
+			}
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var52))
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 93, "\" class=\"text-indigo-600 hover:text-indigo-800 font-medium\">Подставить</button></div>")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+		}
+		return nil
+	})
+}
+
+// whenError объясняет, почему время не распознано.
+func whenError(err error) string {
+	switch {
+	case errors.Is(err, nldate.ErrAmbiguous):
+		return "Во фразе несколько разных дат"
+	case errors.Is(err, nldate.ErrInvalidDate):
+		return "Такой даты нет"
+	default:
+		return "Не удалось распознать время"
+	}
+}
+
+// ruleLabel описывает распознанное правило повторения.
+func ruleLabel(raw string) string {
+	if raw == "" {
+		return ""
+	}
+	rule, err := recurrence.Parse(raw)
+	if err != nil {
+		return raw
+	}
+	return rule.Describe()
+}
+
+// applyParsedDate — выражение Alpine, переносящее распознанное время в поля формы.
+// Правило, совпадающее с готовым вариантом, выбирается в списке, иначе — как своё.
+func applyParsedDate(ctx context.Context, res nldate.Result) string {
+	recurrenceKey, rule := "none", ""
+	if res.Rule != "" {
+		recurrenceKey, rule = "custom", res.Rule
+		for _, p := range recurrence.Presets {
+			if p.Rule == res.Rule {
+				recurrenceKey, rule = p.Key, ""
+			}
+		}
+	}
+	return fmt.Sprintf("remind_at = %s; recurrence = %s; rule = %s; if (!title) { title = %s }",
+		jsString(timezone.FormatUser(ctx, res.At, "2006-01-02T15:04")), jsString(recurrenceKey), jsString(rule), jsString(res.Rest))
+}
+
+// jsString кодирует строку как литерал JavaScript.
+func jsString(s string) string {
+	b, _ := json.Marshal(s)
+	return string(b)
+}
+
+// recurrenceLabel описывает правило повторения и ближайшее срабатывание.
+func recurrenceLabel(ctx context.Context, rem repository.Reminder) string {
+	if !rem.IsRecurring() {
+		return "—"
+	}
+	rule, err := recurrence.Parse(rem.RecurrenceRule)
+	if err != nil {
+		return rem.RecurrenceRule
+	}
+	label := rule.Describe()
+	if rem.OccurrenceCount > 0 {
+		label += fmt.Sprintf(" · было %d", rem.OccurrenceCount)
+	}
+	if rem.Status != model.ReminderStatusPending.String() {
+		return label
+	}
+	if next := rule.Next(rem.RemindAt, time.Now(), timezone.Load(rem.Timezone)); !next.IsZero() {
+		label += " · след. " + timezone.FormatUser(ctx, next, "02.01 15:04")
+	}
+	return label
+}
+
+// snoozeActions — действия откладывания для таблицы, по одному на вариант.
+func snoozeActions() []components.Action {
+	actions := make([]components.Action, 0, len(snooze.Options))
+	for _, o := range snooze.Options {
+		actions = append(actions, components.Action{
+			Label:    "Отложить: " + o.Label,
+			Icon:     "⏰",
+			HxMethod: "hx-post",
+			URLPath:  "/reminders/{id}/snooze/" + o.Key,
+			ShowIf:   "can_snooze",
+		})
+	}
+	return actions
+}
+
+// canSnooze сообщает, можно ли отложить напоминание: его workflow ещё ждёт.
+func canSnooze(rem repository.Reminder) bool {
+	if rem.WorkflowID == "" {
+		return false
+	}
+	switch rem.Status {
+	case model.ReminderStatusPending.String(), model.ReminderStatusProcessing.String(), model.ReminderStatusSnoozed.String():
+		return true
+	}
+	return false
+}
+
+// canReschedule сообщает, можно ли перенести время напоминания:
+// разовое ещё ждёт в workflow, повторяющееся не отменено.
+func canReschedule(rem repository.Reminder) bool {
+	if rem.IsRecurring() {
+		return rem.Status != model.ReminderStatusCancelled.String()
+	}
+	return canSnooze(rem)
+}
+
+// statusText — статус для таблицы с учётом откладываний.
+func statusText(ctx context.Context, rem repository.Reminder) string {
+	label := statusLabel(rem.Status)
+	if rem.SnoozedUntil != nil && rem.SnoozedUntil.After(time.Now()) {
+		label = "Отложено до " + timezone.FormatUser(ctx, *rem.SnoozedUntil, "02.01 15:04")
+	}
+	if rem.SnoozeCount > 0 {
+		label += fmt.Sprintf(" · откладывали %d", rem.SnoozeCount)
+	}
+	return label
+}
+
 func statusClass(status string) string {
 	switch status {
 	case model.ReminderStatusPending.String():
@@ -402,6 +1472,12 @@ func statusClass(status string) string {
 		return "bg-gray-100 text-gray-500"
 	case model.ReminderStatusFailed.String():
 		return "bg-red-100 text-red-700"
+	case model.ReminderStatusPaused.String():
+		return "bg-blue-100 text-blue-700"
+	case model.ReminderStatusSnoozed.String():
+		return "bg-purple-100 text-purple-700"
+	case model.ReminderStatusExpired.String():
+		return "bg-orange-100 text-orange-700"
 	default:
 		return "bg-gray-100 text-gray-500"
 	}
@@ -419,6 +1495,12 @@ func statusLabel(status string) string {
 		return "Отменено"
 	case model.ReminderStatusFailed.String():
 		return "Ошибка"
+	case model.ReminderStatusPaused.String():
+		return "Приостановлено"
+	case model.ReminderStatusSnoozed.String():
+		return "Отложено"
+	case model.ReminderStatusExpired.String():
+		return "Не подтверждено"
 	default:
 		return status
 	}
@@ -0,0 +1,521 @@
+package ui
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/a-h/templ"
+	"github.com/google/uuid"
+
+	"github.com/vovanwin/template/internal/controller/ui/pages"
+	"github.com/vovanwin/template/internal/pkg/importer"
+	"github.com/vovanwin/template/internal/pkg/nldate"
+	"github.com/vovanwin/template/internal/pkg/recurrence"
+	"github.com/vovanwin/template/internal/pkg/snooze"
+	"github.com/vovanwin/template/internal/pkg/timezone"
+	"github.com/vovanwin/template/internal/repository"
+	"github.com/vovanwin/template/internal/service"
+)
+
+// reminderAction разбирает userID и ID напоминания из запроса.
+// Возвращает stop=true, если ответ уже записан.
+func (c *UIController) reminderAction(w http.ResponseWriter, r *http.Request, pathParams map[string]string) (userID, reminderID uuid.UUID, stop bool) {
+	userIDStr, stop := c.requireAuth(w, r)
+	if stop {
+		return uuid.Nil, uuid.Nil, true
+	}
+
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		http.Error(w, "Ошибка авторизации", http.StatusInternalServerError)
+		return uuid.Nil, uuid.Nil, true
+	}
+
+	reminderID, err = uuid.Parse(pathParams["id"])
+	if err != nil {
+		http.Error(w, "Неверный ID", http.StatusBadRequest)
+		return uuid.Nil, uuid.Nil, true
+	}
+
+	return userID, reminderID, false
+}
+
+// renderRemindersTable возвращает таблицу напоминаний с текущими параметрами запроса.
+func (c *UIController) renderRemindersTable(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
+	table, err := c.remindersTable(r, userID)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidReminder) {
+			http.Error(w, "Неверный курсор ленты", http.StatusBadRequest)
+			return
+		}
+		c.log.Error("list reminders", slog.Any("err", err))
+		http.Error(w, "Ошибка загрузки", http.StatusInternalServerError)
+		return
+	}
+	templ.Handler(table).ServeHTTP(w, r)
+}
+
+// remindersTable собирает таблицу напоминаний по параметрам запроса.
+func (c *UIController) remindersTable(r *http.Request, userID uuid.UUID) (templ.Component, error) {
+	items, tableParams, err := c.listReminders(r, userID, parseIntParam(r, "page", 1), parseIntParam(r, "limit", 20))
+	if err != nil {
+		return nil, err
+	}
+	return pages.RemindersTablePaged(items, tableParams), nil
+}
+
+// listReminders загружает напоминания по параметрам запроса: страницу page
+// или, при view=feed, порцию ленты после курсора cursor.
+func (c *UIController) listReminders(r *http.Request, userID uuid.UUID, page, limit int) ([]repository.Reminder, pages.TableParams, error) {
+	limit = min(max(limit, 5), 100)
+	sortField, sortOrder := parseSortParams(r)
+	activeFilters := ParseFilters(r, reminderFilters)
+	tableParams := pages.TableParams{
+		CurrentPage:   page,
+		PageSize:      limit,
+		SortField:     sortField,
+		SortOrder:     sortOrder,
+		Filters:       reminderFilters,
+		ActiveFilters: activeFilters,
+		Feed:          r.URL.Query().Get("view") == "feed",
+	}
+
+	if tableParams.Feed {
+		cur := r.URL.Query().Get("cursor")
+		paged, err := c.reminderService.ListRemindersFeed(r.Context(), userID, cur, limit, sortField, sortOrder, activeFilters)
+		if err != nil {
+			return nil, tableParams, err
+		}
+		if cur != "" {
+			tableParams.Shown = parseIntParam(r, "shown", 0)
+		}
+		tableParams.NextCursor = paged.NextCursor
+		tableParams.Highlights = paged.Highlights
+		return paged.Items, tableParams, nil
+	}
+
+	paged, err := c.reminderService.ListRemindersPaged(r.Context(), userID, page, limit, sortField, sortOrder, activeFilters)
+	if err != nil {
+		return nil, tableParams, err
+	}
+	tableParams.TotalPages = paged.TotalPages
+	tableParams.TotalItems = paged.TotalItems
+	tableParams.Highlights = paged.Highlights
+	return paged.Items, tableParams, nil
+}
+
+// isFeedChunk сообщает, что запрошена следующая порция ленты: в ответ
+// рендерятся только её строки.
+func isFeedChunk(r *http.Request) bool {
+	return r.URL.Query().Get("view") == "feed" && r.URL.Query().Get("cursor") != ""
+}
+
+// recurrenceFromForm превращает выбор в форме (готовый вариант или своё правило) в строку правила.
+func recurrenceFromForm(preset, custom string) (string, error) {
+	switch preset {
+	case "", "none":
+		return "", nil
+	case "custom":
+		if custom == "" {
+			return "", recurrence.ErrEmptyRule
+		}
+		return custom, nil
+	default:
+		rule, ok := recurrence.PresetRule(preset)
+		if !ok {
+			return "", recurrence.ErrInvalidRule
+		}
+		return rule, nil
+	}
+}
+
+// formList — поле формы с несколькими значениями. json-enc отправляет
+// одно отмеченное значение строкой, а несколько — массивом.
+type formList []string
+
+func (l *formList) UnmarshalJSON(data []byte) error {
+	var one string
+	if err := json.Unmarshal(data, &one); err == nil {
+		if one != "" {
+			*l = formList{one}
+		}
+		return nil
+	}
+	var many []string
+	if err := json.Unmarshal(data, &many); err != nil {
+		return err
+	}
+	*l = many
+	return nil
+}
+
+// deniedError отвечает 403, если действие с напоминанием запрещено правами
+// (подтверждает исполнитель, изменяют создатель и редакторы списка).
+func deniedError(w http.ResponseWriter, err error) bool {
+	switch {
+	case errors.Is(err, service.ErrActionNotAllowed):
+		http.Error(w, "Недостаточно прав для этого действия", http.StatusForbidden)
+	case errors.Is(err, service.ErrListNotFound):
+		http.Error(w, "Список не найден", http.StatusBadRequest)
+	default:
+		return false
+	}
+	return true
+}
+
+// isRecurrenceError сообщает, что ошибка вызвана неверным правилом повторения.
+func isRecurrenceError(err error) bool {
+	return errors.Is(err, recurrence.ErrEmptyRule) ||
+		errors.Is(err, recurrence.ErrInvalidRule) ||
+		errors.Is(err, recurrence.ErrUnsupported)
+}
+
+// handlePauseReminder — пауза повторяющегося напоминания (POST /reminders/{id}/pause).
+func (c *UIController) handlePauseReminder(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	userID, reminderID, stop := c.reminderAction(w, r, pathParams)
+	if stop {
+		return
+	}
+
+	if err := c.reminderService.PauseReminder(r.Context(), userID, reminderID); err != nil {
+		if deniedError(w, err) {
+			return
+		}
+		if errors.Is(err, service.ErrInvalidReminder) {
+			http.Error(w, "Напоминание нельзя приостановить", http.StatusBadRequest)
+			return
+		}
+		c.log.Error("pause reminder", slog.Any("err", err))
+		http.Error(w, "Ошибка приостановки", http.StatusInternalServerError)
+		return
+	}
+
+	c.renderRemindersTable(w, r, userID)
+}
+
+// handleResumeReminder — возобновление повторяющегося напоминания (POST /reminders/{id}/resume).
+func (c *UIController) handleResumeReminder(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	userID, reminderID, stop := c.reminderAction(w, r, pathParams)
+	if stop {
+		return
+	}
+
+	if err := c.reminderService.ResumeReminder(r.Context(), userID, reminderID); err != nil {
+		if deniedError(w, err) {
+			return
+		}
+		if errors.Is(err, service.ErrInvalidReminder) {
+			http.Error(w, "Напоминание нельзя возобновить", http.StatusBadRequest)
+			return
+		}
+		c.log.Error("resume reminder", slog.Any("err", err))
+		http.Error(w, "Ошибка возобновления", http.StatusInternalServerError)
+		return
+	}
+
+	c.renderRemindersTable(w, r, userID)
+}
+
+// handleUpdateRecurrence — смена правила повторения (POST /reminders/{id}/recurrence).
+// Новое правило приходит из hx-prompt в заголовке HX-Prompt.
+func (c *UIController) handleUpdateRecurrence(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	userID, reminderID, stop := c.reminderAction(w, r, pathParams)
+	if stop {
+		return
+	}
+
+	rule := r.Header.Get("HX-Prompt")
+	if rule == "" {
+		http.Error(w, "Правило повторения не задано", http.StatusBadRequest)
+		return
+	}
+
+	if _, err := c.reminderService.UpdateRecurrence(r.Context(), userID, reminderID, rule); err != nil {
+		if deniedError(w, err) {
+			return
+		}
+		if errors.Is(err, service.ErrInvalidReminder) || isRecurrenceError(err) {
+			http.Error(w, "Неверное правило повторения: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		c.log.Error("update recurrence", slog.Any("err", err))
+		http.Error(w, "Ошибка изменения правила", http.StatusInternalServerError)
+		return
+	}
+
+	c.renderRemindersTable(w, r, userID)
+}
+
+// handleSnoozeReminder — откладывание уведомления (POST /reminders/{id}/snooze/{option}).
+func (c *UIController) handleSnoozeReminder(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	userID, reminderID, stop := c.reminderAction(w, r, pathParams)
+	if stop {
+		return
+	}
+
+	d, err := snooze.Duration(pathParams["option"], time.Now(), timezone.Location(r.Context()))
+	if err != nil {
+		http.Error(w, "Неизвестный вариант откладывания", http.StatusBadRequest)
+		return
+	}
+
+	if err := c.reminderService.SnoozeReminder(r.Context(), userID, reminderID, d); err != nil {
+		if deniedError(w, err) {
+			return
+		}
+		if errors.Is(err, service.ErrInvalidReminder) {
+			http.Error(w, "Напоминание нельзя отложить", http.StatusBadRequest)
+			return
+		}
+		c.log.Error("snooze reminder", slog.Any("err", err))
+		http.Error(w, "Ошибка откладывания", http.StatusInternalServerError)
+		return
+	}
+
+	c.renderRemindersTable(w, r, userID)
+}
+
+// handleEditReminderForm — модальное окно редактирования (GET /reminders/{id}/edit).
+func (c *UIController) handleEditReminderForm(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	userID, reminderID, stop := c.reminderAction(w, r, pathParams)
+	if stop {
+		return
+	}
+
+	rem, err := c.reminderService.GetReminder(r.Context(), userID, reminderID)
+	if err != nil {
+		c.log.Error("get reminder", slog.Any("err", err))
+		http.Error(w, "Напоминание не найдено", http.StatusNotFound)
+		return
+	}
+
+	attachments, err := c.reminderService.ListAttachments(r.Context(), userID, reminderID)
+	if err != nil {
+		c.log.Error("list attachments", slog.Any("err", err))
+		http.Error(w, "Ошибка загрузки", http.StatusInternalServerError)
+		return
+	}
+
+	templ.Handler(pages.ReminderEditModal(*rem, attachments)).ServeHTTP(w, r)
+}
+
+// handleReminderEscalations — история эскалации напоминания (GET /reminders/{id}/escalations).
+func (c *UIController) handleReminderEscalations(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	userID, reminderID, stop := c.reminderAction(w, r, pathParams)
+	if stop {
+		return
+	}
+
+	rem, err := c.reminderService.GetReminder(r.Context(), userID, reminderID)
+	if err != nil {
+		c.log.Error("get reminder", slog.Any("err", err))
+		http.Error(w, "Напоминание не найдено", http.StatusNotFound)
+		return
+	}
+
+	escalations, err := c.reminderService.ListEscalations(r.Context(), userID, reminderID)
+	if err != nil {
+		c.log.Error("list escalations", slog.Any("err", err))
+		http.Error(w, "Ошибка загрузки", http.StatusInternalServerError)
+		return
+	}
+
+	templ.Handler(pages.ReminderEscalationsModal(*rem, escalations)).ServeHTTP(w, r)
+}
+
+// handleReminderDeliveries — история доставки напоминания (GET /reminders/{id}/deliveries).
+func (c *UIController) handleReminderDeliveries(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	userID, reminderID, stop := c.reminderAction(w, r, pathParams)
+	if stop {
+		return
+	}
+
+	rem, err := c.reminderService.GetReminder(r.Context(), userID, reminderID)
+	if err != nil {
+		c.log.Error("get reminder", slog.Any("err", err))
+		http.Error(w, "Напоминание не найдено", http.StatusNotFound)
+		return
+	}
+
+	deliveries, err := c.reminderService.ListDeliveries(r.Context(), userID, reminderID)
+	if err != nil {
+		c.log.Error("list deliveries", slog.Any("err", err))
+		http.Error(w, "Ошибка загрузки", http.StatusInternalServerError)
+		return
+	}
+
+	templ.Handler(pages.ReminderDeliveriesModal(*rem, deliveries)).ServeHTTP(w, r)
+}
+
+// handleEditReminder — сохранение изменений напоминания (POST /reminders/{id}/edit).
+// Время передаётся в сервис, только если пользователь его поменял.
+func (c *UIController) handleEditReminder(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	userID, reminderID, stop := c.reminderAction(w, r, pathParams)
+	if stop {
+		return
+	}
+
+	var req struct {
+		Title       string `json:"title"`
+		Description string `json:"description"`
+		RemindAt    string `json:"remind_at"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Неверный формат запроса", http.StatusBadRequest)
+		return
+	}
+
+	rem, err := c.reminderService.GetReminder(r.Context(), userID, reminderID)
+	if err != nil {
+		c.log.Error("get reminder", slog.Any("err", err))
+		http.Error(w, "Напоминание не найдено", http.StatusNotFound)
+		return
+	}
+
+	in := service.UpdateReminderInput{Title: req.Title, Description: req.Description}
+	if req.RemindAt != "" && req.RemindAt != timezone.FormatUser(r.Context(), rem.RemindAt, "2006-01-02T15:04") {
+		remindAt, err := timezone.FromUser(r.Context(), "2006-01-02T15:04", req.RemindAt)
+		if err != nil {
+			http.Error(w, "Неверный формат даты", http.StatusBadRequest)
+			return
+		}
+		in.RemindAt = &remindAt
+	}
+
+	if _, err := c.reminderService.UpdateReminder(r.Context(), userID, reminderID, in); err != nil {
+		if deniedError(w, err) {
+			return
+		}
+		if errors.Is(err, service.ErrInvalidReminder) || isRecurrenceError(err) {
+			http.Error(w, "Изменение отклонено: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		c.log.Error("update reminder", slog.Any("err", err))
+		http.Error(w, "Ошибка сохранения", http.StatusInternalServerError)
+		return
+	}
+
+	c.renderRemindersTable(w, r, userID)
+}
+
+// handleParseReminderDate распознаёт время из текста для формы создания (POST /reminders/parse-date).
+func (c *UIController) handleParseReminderDate(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	if _, stop := c.requireAuth(w, r); stop {
+		return
+	}
+
+	var req struct {
+		When string `json:"when"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Неверный формат запроса", http.StatusBadRequest)
+		return
+	}
+	if strings.TrimSpace(req.When) == "" {
+		return
+	}
+
+	res, err := nldate.Parse(req.When, time.Now(), timezone.Location(r.Context()))
+	templ.Handler(pages.WhenPreview(res, err)).ServeHTTP(w, r)
+}
+
+// importFile читает загруженный файл импорта из multipart-формы.
+// Возвращает stop=true, если ответ уже записан.
+func importFile(w http.ResponseWriter, r *http.Request) (name string, data []byte, stop bool) {
+	r.Body = http.MaxBytesReader(w, r.Body, importer.MaxFileSize+64<<10)
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		http.Error(w, "Выберите файл не больше 1 МБ", http.StatusBadRequest)
+		return "", nil, true
+	}
+	defer file.Close()
+
+	data, err = io.ReadAll(file)
+	if err != nil {
+		http.Error(w, "Не удалось прочитать файл", http.StatusBadRequest)
+		return "", nil, true
+	}
+	return header.Filename, data, false
+}
+
+// importError отвечает 400 на ошибку разбора файла импорта.
+func importError(w http.ResponseWriter, err error) bool {
+	switch {
+	case errors.Is(err, importer.ErrUnknownFormat):
+		http.Error(w, "Поддерживаются файлы .ics и .csv", http.StatusBadRequest)
+	case errors.Is(err, importer.ErrTooLarge):
+		http.Error(w, fmt.Sprintf("Файл слишком большой: не больше 1 МБ и %d напоминаний", importer.MaxRows), http.StatusBadRequest)
+	case errors.Is(err, importer.ErrInvalidFile):
+		http.Error(w, "Не удалось разобрать файл: "+err.Error(), http.StatusBadRequest)
+	default:
+		return false
+	}
+	return true
+}
+
+// handlePreviewImport показывает строки файла с ошибками (POST /reminders/import/preview).
+func (c *UIController) handlePreviewImport(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	userIDStr, stop := c.requireAuth(w, r)
+	if stop {
+		return
+	}
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		http.Error(w, "Ошибка авторизации", http.StatusInternalServerError)
+		return
+	}
+	name, data, stop := importFile(w, r)
+	if stop {
+		return
+	}
+
+	rows, err := c.reminderService.PreviewImport(r.Context(), userID, name, data)
+	if err != nil {
+		if !importError(w, err) {
+			c.log.Error("preview import", slog.Any("err", err))
+			http.Error(w, "Ошибка импорта", http.StatusInternalServerError)
+		}
+		return
+	}
+	templ.Handler(pages.ImportPreview(rows)).ServeHTTP(w, r)
+}
+
+// handleImportReminders создаёт напоминания из корректных строк файла (POST /reminders/import).
+func (c *UIController) handleImportReminders(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	userIDStr, stop := c.requireAuth(w, r)
+	if stop {
+		return
+	}
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		http.Error(w, "Ошибка авторизации", http.StatusInternalServerError)
+		return
+	}
+	name, data, stop := importFile(w, r)
+	if stop {
+		return
+	}
+
+	result, err := c.reminderService.ImportReminders(r.Context(), userID, name, data)
+	if err != nil {
+		if !importError(w, err) {
+			c.log.Error("import reminders", slog.Any("err", err))
+			http.Error(w, "Ошибка импорта", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	table, err := c.remindersTable(r, userID)
+	if err != nil {
+		c.log.Error("list reminders", slog.Any("err", err))
+		http.Error(w, "Ошибка загрузки", http.StatusInternalServerError)
+		return
+	}
+	templ.Handler(pages.ImportDone(result.Created, result.Rows, table)).ServeHTTP(w, r)
+}
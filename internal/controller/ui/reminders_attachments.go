@@ -0,0 +1,139 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/a-h/templ"
+	"github.com/google/uuid"
+	"github.com/vovanwin/template/internal/controller/ui/pages"
+	"github.com/vovanwin/template/internal/service"
+)
+
+// attachmentID разбирает ID вложения из пути. Возвращает stop=true, если ответ уже записан.
+func attachmentID(w http.ResponseWriter, pathParams map[string]string) (uuid.UUID, bool) {
+	id, err := uuid.Parse(pathParams["attachment_id"])
+	if err != nil {
+		http.Error(w, "Неверный ID вложения", http.StatusBadRequest)
+		return uuid.Nil, true
+	}
+	return id, false
+}
+
+// renderAttachments возвращает блок вложений напоминания для модального окна.
+func (c *UIController) renderAttachments(w http.ResponseWriter, r *http.Request, userID, reminderID uuid.UUID) {
+	attachments, err := c.reminderService.ListAttachments(r.Context(), userID, reminderID)
+	if err != nil {
+		if deniedError(w, err) {
+			return
+		}
+		c.log.Error("list attachments", slog.Any("err", err))
+		http.Error(w, "Ошибка загрузки", http.StatusInternalServerError)
+		return
+	}
+	templ.Handler(pages.ReminderAttachments(reminderID, attachments)).ServeHTTP(w, r)
+}
+
+// handleUploadAttachment прикладывает файл к напоминанию (POST /reminders/{id}/attachments).
+func (c *UIController) handleUploadAttachment(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	userID, reminderID, stop := c.reminderAction(w, r, pathParams)
+	if stop {
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, service.MaxAttachmentSize+64<<10)
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Выберите файл не больше %d МБ", service.MaxAttachmentSize>>20), http.StatusBadRequest)
+		return
+	}
+	defer file.Close()
+
+	_, err = c.reminderService.AddAttachment(r.Context(), userID, reminderID, service.AttachmentUpload{
+		Filename:    header.Filename,
+		ContentType: header.Header.Get("Content-Type"),
+		Size:        header.Size,
+		Data:        file,
+	})
+	if err != nil {
+		if deniedError(w, err) {
+			return
+		}
+		if errors.Is(err, service.ErrInvalidReminder) {
+			http.Error(w, "Файл не приложен: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		c.log.Error("add attachment", slog.Any("err", err))
+		http.Error(w, "Ошибка загрузки файла", http.StatusInternalServerError)
+		return
+	}
+
+	c.renderAttachments(w, r, userID, reminderID)
+}
+
+// handleDownloadAttachment отдаёт файл вложения (GET /reminders/{id}/attachments/{attachment_id}).
+func (c *UIController) handleDownloadAttachment(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	userID, reminderID, stop := c.reminderAction(w, r, pathParams)
+	if stop {
+		return
+	}
+	id, stop := attachmentID(w, pathParams)
+	if stop {
+		return
+	}
+
+	a, data, err := c.reminderService.OpenAttachment(r.Context(), userID, reminderID, id)
+	if err != nil {
+		if deniedError(w, err) {
+			return
+		}
+		if errors.Is(err, service.ErrReminderNotFound) {
+			http.Error(w, "Вложение не найдено", http.StatusNotFound)
+			return
+		}
+		c.log.Error("open attachment", slog.Any("err", err))
+		http.Error(w, "Ошибка загрузки файла", http.StatusInternalServerError)
+		return
+	}
+	defer data.Close()
+
+	w.Header().Set("Content-Type", a.ContentType)
+	w.Header().Set("Content-Length", strconv.FormatInt(a.Size, 10))
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": a.Filename}))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	if _, err := io.Copy(w, data); err != nil {
+		c.log.Warn("send attachment", slog.Any("err", err))
+	}
+}
+
+// handleDeleteAttachment удаляет вложение (DELETE /reminders/{id}/attachments/{attachment_id}).
+func (c *UIController) handleDeleteAttachment(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	userID, reminderID, stop := c.reminderAction(w, r, pathParams)
+	if stop {
+		return
+	}
+	id, stop := attachmentID(w, pathParams)
+	if stop {
+		return
+	}
+
+	if err := c.reminderService.DeleteAttachment(r.Context(), userID, reminderID, id); err != nil {
+		if deniedError(w, err) {
+			return
+		}
+		if errors.Is(err, service.ErrReminderNotFound) {
+			http.Error(w, "Вложение не найдено", http.StatusNotFound)
+			return
+		}
+		c.log.Error("delete attachment", slog.Any("err", err))
+		http.Error(w, "Ошибка удаления", http.StatusInternalServerError)
+		return
+	}
+
+	c.renderAttachments(w, r, userID, reminderID)
+}
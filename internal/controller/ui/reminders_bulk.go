@@ -0,0 +1,104 @@
+package ui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/a-h/templ"
+	"github.com/google/uuid"
+
+	"github.com/vovanwin/template/internal/controller/ui/pages"
+	"github.com/vovanwin/template/internal/service"
+)
+
+// bulkFunc — массовое действие сервиса; value — значение из формы (теги, время).
+type bulkFunc func(ctx context.Context, userID uuid.UUID, target service.BulkTarget, value string) (*service.BulkResult, error)
+
+// handleBulkCancel — отмена выбранных напоминаний (POST /reminders/bulk/cancel).
+func (c *UIController) handleBulkCancel(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	c.handleBulk(w, r, "Отмена", func(ctx context.Context, userID uuid.UUID, target service.BulkTarget, _ string) (*service.BulkResult, error) {
+		return c.reminderService.BulkCancel(ctx, userID, target)
+	})
+}
+
+// handleBulkDelete — удаление выбранных напоминаний (POST /reminders/bulk/delete).
+func (c *UIController) handleBulkDelete(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	c.handleBulk(w, r, "Удаление", func(ctx context.Context, userID uuid.UUID, target service.BulkTarget, _ string) (*service.BulkResult, error) {
+		return c.reminderService.BulkDelete(ctx, userID, target)
+	})
+}
+
+// handleBulkRetag — смена тегов выбранных напоминаний (POST /reminders/bulk/retag).
+func (c *UIController) handleBulkRetag(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	c.handleBulk(w, r, "Теги", c.reminderService.BulkRetag)
+}
+
+// handleBulkReschedule — перенос выбранных напоминаний (POST /reminders/bulk/reschedule).
+func (c *UIController) handleBulkReschedule(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	c.handleBulk(w, r, "Перенос", c.reminderService.BulkReschedule)
+}
+
+// handleBulk выполняет массовое действие и возвращает его итог вместе с таблицей.
+// Выбранные ids, all и value приходят в теле формы; фильтры, страница и
+// сортировка — в query, как у запросов самой таблицы.
+func (c *UIController) handleBulk(w http.ResponseWriter, r *http.Request, action string, run bulkFunc) {
+	userIDStr, stop := c.requireAuth(w, r)
+	if stop {
+		return
+	}
+
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		http.Error(w, "Ошибка авторизации", http.StatusInternalServerError)
+		return
+	}
+
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "Неверный запрос", http.StatusBadRequest)
+		return
+	}
+
+	target := service.BulkTarget{All: r.PostForm.Get("all") == "1"}
+	if target.All {
+		target.Filters = ParseFilters(r, reminderFilters)
+	} else {
+		for _, raw := range r.PostForm["ids"] {
+			id, err := uuid.Parse(raw)
+			if err != nil {
+				http.Error(w, "Неверный ID", http.StatusBadRequest)
+				return
+			}
+			target.IDs = append(target.IDs, id)
+		}
+		if len(target.IDs) == 0 {
+			http.Error(w, "Не выбрано ни одного напоминания", http.StatusBadRequest)
+			return
+		}
+	}
+
+	res, err := run(r.Context(), userID, target, strings.TrimSpace(r.PostForm.Get("value")))
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrInvalidReminder):
+			http.Error(w, "Действие отклонено: "+err.Error(), http.StatusBadRequest)
+		case errors.Is(err, service.ErrTooManyReminders):
+			http.Error(w, fmt.Sprintf("За один раз можно изменить не больше %d напоминаний — уточните фильтр", service.MaxBulkReminders), http.StatusBadRequest)
+		default:
+			c.log.Error("bulk reminder action", slog.String("action", action), slog.Any("err", err))
+			http.Error(w, "Ошибка массового действия", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	table, err := c.remindersTable(r, userID)
+	if err != nil {
+		c.log.Error("list reminders", slog.Any("err", err))
+		http.Error(w, "Ошибка загрузки", http.StatusInternalServerError)
+		return
+	}
+	templ.Handler(pages.RemindersBulkDone(action, res, table)).ServeHTTP(w, r)
+}
@@ -0,0 +1,134 @@
+package ui
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/a-h/templ"
+	"github.com/google/uuid"
+	"github.com/vovanwin/template/internal/controller/ui/pages"
+	"github.com/vovanwin/template/internal/service"
+)
+
+// checklistItemID разбирает ID пункта чек-листа из пути. Возвращает stop=true, если ответ уже записан.
+func checklistItemID(w http.ResponseWriter, pathParams map[string]string) (uuid.UUID, bool) {
+	id, err := uuid.Parse(pathParams["item_id"])
+	if err != nil {
+		http.Error(w, "Неверный ID пункта", http.StatusBadRequest)
+		return uuid.Nil, true
+	}
+	return id, false
+}
+
+// checklistError отвечает на ошибку действия с чек-листом.
+func (c *UIController) checklistError(w http.ResponseWriter, err error, action string) {
+	if deniedError(w, err) {
+		return
+	}
+	switch {
+	case errors.Is(err, service.ErrReminderNotFound), errors.Is(err, service.ErrReminderForbidden):
+		http.Error(w, "Пункт не найден", http.StatusNotFound)
+	case errors.Is(err, service.ErrInvalidReminder):
+		http.Error(w, "Пункт отклонён: "+err.Error(), http.StatusBadRequest)
+	default:
+		c.log.Error(action, slog.Any("err", err))
+		http.Error(w, "Ошибка сохранения чек-листа", http.StatusInternalServerError)
+	}
+}
+
+// renderChecklist возвращает блок чек-листа напоминания для модального окна.
+func (c *UIController) renderChecklist(w http.ResponseWriter, r *http.Request, userID, reminderID uuid.UUID, notice string) {
+	items, err := c.reminderService.ListChecklist(r.Context(), userID, reminderID)
+	if err != nil {
+		c.checklistError(w, err, "list checklist")
+		return
+	}
+	templ.Handler(pages.ReminderChecklist(reminderID, items, notice)).ServeHTTP(w, r)
+}
+
+// handleReminderChecklist — чек-лист напоминания (GET /reminders/{id}/checklist).
+func (c *UIController) handleReminderChecklist(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	userID, reminderID, stop := c.reminderAction(w, r, pathParams)
+	if stop {
+		return
+	}
+
+	rem, err := c.reminderService.GetReminder(r.Context(), userID, reminderID)
+	if err != nil {
+		c.log.Error("get reminder", slog.Any("err", err))
+		http.Error(w, "Напоминание не найдено", http.StatusNotFound)
+		return
+	}
+
+	items, err := c.reminderService.ListChecklist(r.Context(), userID, reminderID)
+	if err != nil {
+		c.checklistError(w, err, "list checklist")
+		return
+	}
+
+	templ.Handler(pages.ReminderChecklistModal(*rem, items)).ServeHTTP(w, r)
+}
+
+// handleAddChecklistItem добавляет пункт в чек-лист (POST /reminders/{id}/checklist).
+func (c *UIController) handleAddChecklistItem(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	userID, reminderID, stop := c.reminderAction(w, r, pathParams)
+	if stop {
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "Неверный формат запроса", http.StatusBadRequest)
+		return
+	}
+
+	if err := c.reminderService.AddChecklistItem(r.Context(), userID, reminderID, r.FormValue("text")); err != nil {
+		c.checklistError(w, err, "add checklist item")
+		return
+	}
+
+	c.renderChecklist(w, r, userID, reminderID, "")
+}
+
+// handleToggleChecklistItem отмечает пункт или снимает отметку
+// (POST /reminders/{id}/checklist/{item_id}/toggle).
+func (c *UIController) handleToggleChecklistItem(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	// Права проверяются по напоминанию самого пункта.
+	userID, _, stop := c.reminderAction(w, r, pathParams)
+	if stop {
+		return
+	}
+	id, stop := checklistItemID(w, pathParams)
+	if stop {
+		return
+	}
+
+	res, err := c.reminderService.ToggleChecklistItem(r.Context(), userID, id)
+	if err != nil {
+		c.checklistError(w, err, "toggle checklist item")
+		return
+	}
+	var notice string
+	if res.Acknowledged {
+		notice = "Все пункты выполнены — напоминание подтверждено"
+	}
+	templ.Handler(pages.ReminderChecklist(res.ReminderID, res.Items, notice)).ServeHTTP(w, r)
+}
+
+// handleDeleteChecklistItem удаляет пункт (DELETE /reminders/{id}/checklist/{item_id}).
+func (c *UIController) handleDeleteChecklistItem(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	userID, reminderID, stop := c.reminderAction(w, r, pathParams)
+	if stop {
+		return
+	}
+	id, stop := checklistItemID(w, pathParams)
+	if stop {
+		return
+	}
+
+	if err := c.reminderService.DeleteChecklistItem(r.Context(), userID, reminderID, id); err != nil {
+		c.checklistError(w, err, "delete checklist item")
+		return
+	}
+
+	c.renderChecklist(w, r, userID, reminderID, "")
+}
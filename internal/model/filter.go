@@ -7,8 +7,14 @@ const (
 	FilterDateRange FilterType = "date_range"
 	FilterString    FilterType = "string"
 	FilterNumber    FilterType = "number"
+	// FilterSearch — полнотекстовый поиск; колонка фильтра — tsvector.
+	FilterSearch FilterType = "search"
 )
 
+// SortRank — сортировка по релевантности полнотекстового поиска (FilterSearch);
+// без поискового запроса — по дате создания.
+const SortRank = "rank"
+
 type FilterOption struct {
 	Value string
 	Label string
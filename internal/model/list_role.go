@@ -0,0 +1,17 @@
+package model
+
+// ListRole — роль участника общего списка напоминаний.
+//
+//go:generate enumer -type=ListRole -trimprefix=ListRole -transform=snake -json -sql -text -output=list_role_enumer.go
+type ListRole int
+
+const (
+	ListRoleViewer ListRole = iota // viewer: видит напоминания списка
+	ListRoleEditor                 // editor: создаёт и меняет напоминания списка
+	ListRoleOwner                  // owner: управляет участниками и удаляет список
+)
+
+// CanEdit сообщает, может ли роль создавать и менять напоминания списка.
+func (r ListRole) CanEdit() bool {
+	return r >= ListRoleEditor
+}
@@ -0,0 +1,18 @@
+package model
+
+// ReminderPriority — приоритет напоминания. Нулевое значение — обычный приоритет.
+//
+//go:generate enumer -type=ReminderPriority -trimprefix=ReminderPriority -transform=snake -json -sql -text -output=reminder_priority_enumer.go
+type ReminderPriority int
+
+const (
+	ReminderPriorityNormal ReminderPriority = iota // normal
+	ReminderPriorityLow                            // low
+	ReminderPriorityHigh                           // high: всегда с подтверждением
+)
+
+// ForcesConfirmation сообщает, что напоминание с таким приоритетом
+// повторяется до подтверждения, даже если подтверждение не выбрано.
+func (p ReminderPriority) ForcesConfirmation() bool {
+	return p == ReminderPriorityHigh
+}
@@ -9,4 +9,7 @@ const (
 	ReminderStatusSent                             // sent
 	ReminderStatusCancelled                        // cancelled
 	ReminderStatusFailed                           // failed
+	ReminderStatusPaused                           // paused
+	ReminderStatusSnoozed                          // snoozed
+	ReminderStatusExpired                          // expired: окно подтверждения истекло без подтверждения
 )
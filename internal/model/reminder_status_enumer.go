@@ -9,11 +9,11 @@ import (
 	"strings"
 )
 
-const _ReminderStatusName = "pendingprocessingsentcancelledfailed"
+const _ReminderStatusName = "pendingprocessingsentcancelledfailedpausedsnoozedexpired"
 
-var _ReminderStatusIndex = [...]uint8{0, 7, 17, 21, 30, 36}
+var _ReminderStatusIndex = [...]uint8{0, 7, 17, 21, 30, 36, 42, 49, 56}
 
-const _ReminderStatusLowerName = "pendingprocessingsentcancelledfailed"
+const _ReminderStatusLowerName = "pendingprocessingsentcancelledfailedpausedsnoozedexpired"
 
 func (i ReminderStatus) String() string {
 	if i < 0 || i >= ReminderStatus(len(_ReminderStatusIndex)-1) {
@@ -31,9 +31,12 @@ func _ReminderStatusNoOp() {
 	_ = x[ReminderStatusSent-(2)]
 	_ = x[ReminderStatusCancelled-(3)]
 	_ = x[ReminderStatusFailed-(4)]
+	_ = x[ReminderStatusPaused-(5)]
+	_ = x[ReminderStatusSnoozed-(6)]
+	_ = x[ReminderStatusExpired-(7)]
 }
 
-var _ReminderStatusValues = []ReminderStatus{ReminderStatusPending, ReminderStatusProcessing, ReminderStatusSent, ReminderStatusCancelled, ReminderStatusFailed}
+var _ReminderStatusValues = []ReminderStatus{ReminderStatusPending, ReminderStatusProcessing, ReminderStatusSent, ReminderStatusCancelled, ReminderStatusFailed, ReminderStatusPaused, ReminderStatusSnoozed, ReminderStatusExpired}
 
 var _ReminderStatusNameToValueMap = map[string]ReminderStatus{
 	_ReminderStatusName[0:7]:        ReminderStatusPending,
@@ -46,6 +49,12 @@ var _ReminderStatusNameToValueMap = map[string]ReminderStatus{
 	_ReminderStatusLowerName[21:30]: ReminderStatusCancelled,
 	_ReminderStatusName[30:36]:      ReminderStatusFailed,
 	_ReminderStatusLowerName[30:36]: ReminderStatusFailed,
+	_ReminderStatusName[36:42]:      ReminderStatusPaused,
+	_ReminderStatusLowerName[36:42]: ReminderStatusPaused,
+	_ReminderStatusName[42:49]:      ReminderStatusSnoozed,
+	_ReminderStatusLowerName[42:49]: ReminderStatusSnoozed,
+	_ReminderStatusName[49:56]:      ReminderStatusExpired,
+	_ReminderStatusLowerName[49:56]: ReminderStatusExpired,
 }
 
 var _ReminderStatusNames = []string{
@@ -54,6 +63,9 @@ var _ReminderStatusNames = []string{
 	_ReminderStatusName[17:21],
 	_ReminderStatusName[21:30],
 	_ReminderStatusName[30:36],
+	_ReminderStatusName[36:42],
+	_ReminderStatusName[42:49],
+	_ReminderStatusName[49:56],
 }
 
 // ReminderStatusString retrieves an enum value from the enum constants string name.
@@ -0,0 +1,44 @@
+// Package blob хранит файлы вложений напоминаний.
+//
+// Storage — общий интерфейс хранилища; реализации — каталог на диске (Local)
+// и S3-совместимое хранилище (S3: AWS S3, MinIO, Yandex Object Storage).
+// Ключ — путь объекта из сегментов через «/», например
+// «reminders/<reminder_id>/<attachment_id>».
+package blob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ErrNotFound — объекта с таким ключом нет.
+var ErrNotFound = errors.New("blob: not found")
+
+// ErrInvalidKey — ключ пустой, абсолютный или выходит за пределы хранилища.
+var ErrInvalidKey = errors.New("blob: invalid key")
+
+// Storage — хранилище файлов.
+type Storage interface {
+	// Put сохраняет size байт из data под ключом key, заменяя прежний объект.
+	Put(ctx context.Context, key, contentType string, data io.Reader, size int64) error
+	// Get открывает объект на чтение; закрыть его должен вызывающий.
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete удаляет объект; отсутствие объекта не ошибка.
+	Delete(ctx context.Context, key string) error
+}
+
+// validateKey проверяет, что ключ состоит из непустых сегментов без «.» и «..».
+func validateKey(key string) error {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, "\\") {
+		return fmt.Errorf("%w: %q", ErrInvalidKey, key)
+	}
+	for _, part := range strings.Split(key, "/") {
+		if part == "" || part == "." || part == ".." {
+			return fmt.Errorf("%w: %q", ErrInvalidKey, key)
+		}
+	}
+	return nil
+}
@@ -33,13 +33,20 @@ func NewClient(baseURL, apiKey, tokenSecret string, tokenTTL time.Duration, log
 }
 
 type publishRequest struct {
-	Channel string `json:"channel"`
-	Data    any    `json:"data"`
+	Channel        string `json:"channel"`
+	Data           any    `json:"data"`
+	IdempotencyKey string `json:"idempotency_key,omitempty"`
 }
 
 // Publish отправляет данные в канал через POST /api/publish.
 func (c *Client) Publish(ctx context.Context, channel string, data any) error {
-	body, err := json.Marshal(publishRequest{Channel: channel, Data: data})
+	return c.PublishIdempotent(ctx, channel, data, "")
+}
+
+// PublishIdempotent — Publish с ключом идемпотентности: повторная публикация
+// с тем же ключом в течение нескольких минут не доставляется подписчикам.
+func (c *Client) PublishIdempotent(ctx context.Context, channel string, data any, key string) error {
+	body, err := json.Marshal(publishRequest{Channel: channel, Data: data, IdempotencyKey: key})
 	if err != nil {
 		return fmt.Errorf("centrifugo: marshal publish: %w", err)
 	}
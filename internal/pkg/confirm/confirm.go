@@ -0,0 +1,109 @@
+// Package confirm описывает политику ожидания подтверждения напоминания:
+// сколько ждать, как часто повторять уведомление и сколько раз.
+//
+// Повторы идут через Interval после предыдущей отправки. При BackoffExponential
+// интервал удваивается после каждого повтора. Когда повторы исчерпаны
+// (MaxResends), напоминание ещё ждёт подтверждения до конца окна Window;
+// не подтверждённое в окно напоминание считается просроченным (expired).
+package confirm
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Backoff — как растёт интервал между повторами.
+type Backoff string
+
+const (
+	// BackoffFixed — повтор каждые Interval.
+	BackoffFixed Backoff = "fixed"
+	// BackoffExponential — Interval, 2·Interval, 4·Interval…
+	BackoffExponential Backoff = "exponential"
+)
+
+const (
+	// DefaultWindow — окно подтверждения, если оно не задано.
+	DefaultWindow = 10 * time.Hour
+	// MaxWindow — самое длинное окно подтверждения.
+	MaxWindow = 7 * 24 * time.Hour
+	// MaxResendsLimit — больше повторов за окно не отправляется.
+	MaxResendsLimit = 100
+)
+
+// ErrInvalidPolicy — параметры политики вне допустимых значений.
+var ErrInvalidPolicy = errors.New("confirm: invalid policy")
+
+// ParseBackoff разбирает способ роста интервала; пустая строка — BackoffFixed.
+func ParseBackoff(s string) (Backoff, error) {
+	switch b := Backoff(s); b {
+	case "":
+		return BackoffFixed, nil
+	case BackoffFixed, BackoffExponential:
+		return b, nil
+	default:
+		return "", fmt.Errorf("%w: unknown backoff %q", ErrInvalidPolicy, s)
+	}
+}
+
+// Policy — политика ожидания подтверждения.
+type Policy struct {
+	// Interval — задержка перед первым повтором.
+	Interval time.Duration
+	// Window — сколько ждать подтверждения после первой отправки; 0 — DefaultWindow.
+	Window  time.Duration
+	Backoff Backoff
+	// MaxResends — сколько раз повторить уведомление; 0 — пока не закончится окно.
+	MaxResends int
+}
+
+// Validate проверяет границы окна и числа повторов и способ роста интервала.
+func (p Policy) Validate() error {
+	if p.Interval <= 0 {
+		return fmt.Errorf("%w: interval must be positive", ErrInvalidPolicy)
+	}
+	if p.Window < 0 || p.Window > MaxWindow {
+		return fmt.Errorf("%w: window must be between 0 and %s", ErrInvalidPolicy, MaxWindow)
+	}
+	if p.Window > 0 && p.Window < p.Interval {
+		return fmt.Errorf("%w: window is shorter than the repeat interval", ErrInvalidPolicy)
+	}
+	if p.MaxResends < 0 || p.MaxResends > MaxResendsLimit {
+		return fmt.Errorf("%w: max resends must be between 0 and %d", ErrInvalidPolicy, MaxResendsLimit)
+	}
+	if _, err := ParseBackoff(string(p.Backoff)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// EffectiveWindow возвращает окно подтверждения с учётом значения по умолчанию.
+func (p Policy) EffectiveWindow() time.Duration {
+	if p.Window <= 0 {
+		return DefaultWindow
+	}
+	return p.Window
+}
+
+// Delay возвращает задержку перед следующим повтором, когда уже отправлено
+// resends повторов. Экспоненциальный интервал не превышает окна.
+func (p Policy) Delay(resends int) time.Duration {
+	if p.Backoff != BackoffExponential || resends <= 0 {
+		return p.Interval
+	}
+	window := p.EffectiveWindow()
+	d := p.Interval
+	for range resends {
+		if d >= window {
+			return window
+		}
+		d *= 2
+	}
+	return min(d, window)
+}
+
+// Exhausted сообщает, что повторы закончились и осталось только ждать конца окна.
+func (p Policy) Exhausted(resends int) bool {
+	return p.MaxResends > 0 && resends >= p.MaxResends
+}
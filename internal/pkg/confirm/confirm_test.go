@@ -0,0 +1,72 @@
+package confirm
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestDelay(t *testing.T) {
+	fixed := Policy{Interval: 15 * time.Minute, Backoff: BackoffFixed}
+	exp := Policy{Interval: 15 * time.Minute, Window: 2 * time.Hour, Backoff: BackoffExponential}
+
+	tests := []struct {
+		name    string
+		p       Policy
+		resends int
+		want    time.Duration
+	}{
+		{"fixed first", fixed, 0, 15 * time.Minute},
+		{"fixed later", fixed, 7, 15 * time.Minute},
+		{"exponential first", exp, 0, 15 * time.Minute},
+		{"exponential second", exp, 1, 30 * time.Minute},
+		{"exponential third", exp, 2, time.Hour},
+		{"exponential capped by window", exp, 5, 2 * time.Hour},
+		{"exponential many resends", exp, 1000, 2 * time.Hour},
+	}
+	for _, tt := range tests {
+		if got := tt.p.Delay(tt.resends); got != tt.want {
+			t.Errorf("%s: Delay(%d) = %s, want %s", tt.name, tt.resends, got, tt.want)
+		}
+	}
+}
+
+func TestExhausted(t *testing.T) {
+	p := Policy{Interval: time.Minute, MaxResends: 3}
+	if p.Exhausted(2) || !p.Exhausted(3) {
+		t.Errorf("Exhausted with MaxResends=3: got %v at 2, %v at 3", p.Exhausted(2), p.Exhausted(3))
+	}
+	if (Policy{Interval: time.Minute}).Exhausted(1000) {
+		t.Error("Exhausted without MaxResends = true, want false")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	if err := (Policy{Interval: 10 * time.Minute}).Validate(); err != nil {
+		t.Errorf("default policy: %v", err)
+	}
+	for name, p := range map[string]Policy{
+		"no interval":       {},
+		"negative window":   {Interval: time.Minute, Window: -time.Hour},
+		"window too long":   {Interval: time.Minute, Window: MaxWindow + time.Hour},
+		"window < interval": {Interval: time.Hour, Window: 30 * time.Minute},
+		"negative resends":  {Interval: time.Minute, MaxResends: -1},
+		"too many resends":  {Interval: time.Minute, MaxResends: MaxResendsLimit + 1},
+		"unknown backoff":   {Interval: time.Minute, Backoff: "linear"},
+	} {
+		if err := p.Validate(); !errors.Is(err, ErrInvalidPolicy) {
+			t.Errorf("%s: error = %v, want ErrInvalidPolicy", name, err)
+		}
+	}
+}
+
+func TestParseBackoff(t *testing.T) {
+	for in, want := range map[string]Backoff{"": BackoffFixed, "fixed": BackoffFixed, "exponential": BackoffExponential} {
+		if got, err := ParseBackoff(in); err != nil || got != want {
+			t.Errorf("ParseBackoff(%q) = %q, %v; want %q", in, got, err, want)
+		}
+	}
+	if _, err := ParseBackoff("linear"); !errors.Is(err, ErrInvalidPolicy) {
+		t.Errorf("ParseBackoff(linear) error = %v, want ErrInvalidPolicy", err)
+	}
+}
@@ -0,0 +1,51 @@
+// Package cursor кодирует курсоры keyset-пагинации.
+//
+// Курсор указывает на последнюю показанную строку: поле и направление
+// сортировки, значение ключа сортировки в текстовом виде Postgres и ID строки
+// (ключ равных значений). Для клиента курсор непрозрачен: это base64url от
+// JSON, который он передаёт обратно без изменений.
+package cursor
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ErrInvalid — курсор повреждён или выдан для другого запроса.
+var ErrInvalid = errors.New("invalid cursor")
+
+// Cursor — позиция в выборке, после которой начинается следующая порция.
+type Cursor struct {
+	// Sort — поле сортировки выборки.
+	Sort string `json:"s"`
+	// Order — направление сортировки: asc или desc.
+	Order string `json:"o"`
+	// Key — значение ключа сортировки последней строки.
+	Key string `json:"k"`
+	// ID — ID последней строки.
+	ID string `json:"i"`
+}
+
+// Encode возвращает непрозрачную строку курсора.
+func (c Cursor) Encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// Decode разбирает строку, полученную от Encode.
+func Decode(s string) (Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return Cursor{}, fmt.Errorf("%w: %w", ErrInvalid, err)
+	}
+	var c Cursor
+	if err := json.Unmarshal(data, &c); err != nil {
+		return Cursor{}, fmt.Errorf("%w: %w", ErrInvalid, err)
+	}
+	if c.Sort == "" || c.ID == "" || (c.Order != "asc" && c.Order != "desc") {
+		return Cursor{}, ErrInvalid
+	}
+	return c, nil
+}
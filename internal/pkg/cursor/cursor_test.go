@@ -0,0 +1,36 @@
+package cursor
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestRoundTrip(t *testing.T) {
+	want := Cursor{
+		Sort:  "remind_at",
+		Order: "desc",
+		Key:   "2026-03-16 09:00:00.123456+03",
+		ID:    "0f8fad5b-d9cb-469f-a165-70867728950e",
+	}
+	got, err := Decode(want.Encode())
+	if err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+	if got != want {
+		t.Errorf("Decode = %+v, want %+v", got, want)
+	}
+}
+
+func TestDecodeInvalid(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"не base64",
+		"bm90IGpzb24", // "not json"
+		Cursor{Sort: "title", Order: "up", ID: "x"}.Encode(), // неверное направление
+		Cursor{Sort: "title", Order: "asc"}.Encode(),         // без ID
+	} {
+		if _, err := Decode(s); !errors.Is(err, ErrInvalid) {
+			t.Errorf("Decode(%q) error = %v, want ErrInvalid", s, err)
+		}
+	}
+}
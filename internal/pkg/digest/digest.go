@@ -0,0 +1,137 @@
+// Package digest собирает утреннюю сводку напоминаний пользователя: что
+// сработает сегодня и что со вчерашнего дня осталось без подтверждения.
+//
+// Сводка приходит ежедневно в выбранное пользователем время по его таймзоне.
+// Расписание Temporal для неё строит Spec, текст — Digest.Text.
+package digest
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.temporal.io/sdk/client"
+
+	"github.com/vovanwin/template/internal/model"
+)
+
+// DefaultTime — время сводки, пока пользователь не выбрал своё.
+const DefaultTime = "08:00"
+
+// MaxItems ограничивает число напоминаний в каждом разделе сводки.
+const MaxItems = 20
+
+// ErrInvalidTime — время сводки не удалось разобрать.
+var ErrInvalidTime = errors.New("digest: invalid time")
+
+// Clock — местное время отправки сводки.
+type Clock struct {
+	Hour   int
+	Minute int
+}
+
+// ParseClock разбирает время «ЧЧ:ММ» от 00:00 до 23:59; пустая строка — DefaultTime.
+func ParseClock(s string) (Clock, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		s = DefaultTime
+	}
+	hh, mm, ok := strings.Cut(s, ":")
+	if !ok || len(mm) != 2 {
+		return Clock{}, fmt.Errorf("%w: %q, expected HH:MM", ErrInvalidTime, s)
+	}
+	h, err := strconv.Atoi(hh)
+	if err != nil || h < 0 || h > 23 {
+		return Clock{}, fmt.Errorf("%w: %q, hour out of range", ErrInvalidTime, s)
+	}
+	m, err := strconv.Atoi(mm)
+	if err != nil || m < 0 || m > 59 {
+		return Clock{}, fmt.Errorf("%w: %q, minute out of range", ErrInvalidTime, s)
+	}
+	return Clock{Hour: h, Minute: m}, nil
+}
+
+// String возвращает время в виде «ЧЧ:ММ» для хранения в БД.
+func (c Clock) String() string {
+	return fmt.Sprintf("%02d:%02d", c.Hour, c.Minute)
+}
+
+// Spec строит спецификацию Temporal Schedule: ежедневно в c по таймзоне loc.
+// Календарная спецификация сохраняет местное время при переходе на летнее время.
+func Spec(c Clock, loc *time.Location) client.ScheduleSpec {
+	return client.ScheduleSpec{
+		Calendars: []client.ScheduleCalendarSpec{{
+			Hour:    []client.ScheduleRange{{Start: c.Hour}},
+			Minute:  []client.ScheduleRange{{Start: c.Minute}},
+			Comment: "daily digest at " + c.String(),
+		}},
+		TimeZoneName: loc.String(),
+	}
+}
+
+// Day возвращает границы местных суток, в которые попадает t: [start, end).
+func Day(t time.Time, loc *time.Location) (start, end time.Time) {
+	t = t.In(loc)
+	start = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+	return start, start.AddDate(0, 0, 1)
+}
+
+// Item — напоминание в сводке.
+type Item struct {
+	Title    string
+	At       time.Time
+	Priority model.ReminderPriority
+}
+
+// Digest — сводка на день.
+type Digest struct {
+	// Day — начало местных суток сводки.
+	Day time.Time
+	// Today — что сработает сегодня, по времени.
+	Today []Item
+	// Unacknowledged — вчерашние напоминания без подтверждения.
+	Unacknowledged []Item
+}
+
+// Empty сообщает, что в сводке нечего показать: её не отправляют.
+func (d Digest) Empty() bool {
+	return len(d.Today) == 0 && len(d.Unacknowledged) == 0
+}
+
+// Title возвращает заголовок сводки.
+func (d Digest) Title() string {
+	return "Сводка на " + d.Day.Format("02.01")
+}
+
+// Text возвращает текст сводки; время — в таймзоне Day.
+func (d Digest) Text() string {
+	var b strings.Builder
+	if len(d.Today) > 0 {
+		fmt.Fprintf(&b, "Сегодня (%d):\n", len(d.Today))
+		writeItems(&b, d.Today, d.Day.Location())
+	} else {
+		b.WriteString("Сегодня напоминаний нет.\n")
+	}
+	if len(d.Unacknowledged) > 0 {
+		fmt.Fprintf(&b, "\nВчера без подтверждения (%d):\n", len(d.Unacknowledged))
+		writeItems(&b, d.Unacknowledged, d.Day.Location())
+	}
+	return strings.TrimRight(b.String(), "\n")
+}
+
+// writeItems пишет не больше MaxItems строк и число оставшихся.
+func writeItems(b *strings.Builder, items []Item, loc *time.Location) {
+	for i, it := range items {
+		if i == MaxItems {
+			fmt.Fprintf(b, "…и ещё %d\n", len(items)-MaxItems)
+			return
+		}
+		mark := "•"
+		if it.Priority == model.ReminderPriorityHigh {
+			mark = "❗"
+		}
+		fmt.Fprintf(b, "%s %s %s\n", mark, it.At.In(loc).Format("15:04"), it.Title)
+	}
+}
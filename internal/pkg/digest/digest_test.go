@@ -0,0 +1,63 @@
+package digest
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/vovanwin/template/internal/model"
+)
+
+func TestParseClock(t *testing.T) {
+	for raw, want := range map[string]string{
+		"":       DefaultTime,
+		"7:05":   "07:05",
+		" 23:59": "23:59",
+		"00:00":  "00:00",
+	} {
+		c, err := ParseClock(raw)
+		if err != nil || c.String() != want {
+			t.Errorf("ParseClock(%q) = %q, %v; want %q", raw, c, err, want)
+		}
+	}
+	for _, raw := range []string{"24:00", "12:60", "8", "8:5", "aa:bb", "-1:00"} {
+		if _, err := ParseClock(raw); !errors.Is(err, ErrInvalidTime) {
+			t.Errorf("ParseClock(%q) error = %v, want ErrInvalidTime", raw, err)
+		}
+	}
+}
+
+func TestDay(t *testing.T) {
+	loc := time.FixedZone("UTC+3", 3*60*60)
+	// 22:30 UTC — уже следующие сутки по UTC+3
+	start, end := Day(time.Date(2026, 3, 1, 22, 30, 0, 0, time.UTC), loc)
+	if want := time.Date(2026, 3, 2, 0, 0, 0, 0, loc); !start.Equal(want) {
+		t.Errorf("start = %s, want %s", start, want)
+	}
+	if got := end.Sub(start); got != 24*time.Hour {
+		t.Errorf("day length = %s, want 24h", got)
+	}
+}
+
+func TestText(t *testing.T) {
+	loc := time.FixedZone("UTC+3", 3*60*60)
+	day := time.Date(2026, 3, 2, 0, 0, 0, 0, loc)
+	d := Digest{
+		Day: day,
+		Today: []Item{
+			{Title: "Созвон", At: day.Add(10 * time.Hour)},
+			{Title: "Оплатить счёт", At: day.Add(18*time.Hour + 30*time.Minute), Priority: model.ReminderPriorityHigh},
+		},
+		Unacknowledged: []Item{{Title: "Лекарство", At: day.Add(-3 * time.Hour)}},
+	}
+	want := "Сегодня (2):\n• 10:00 Созвон\n❗ 18:30 Оплатить счёт\n\nВчера без подтверждения (1):\n• 21:00 Лекарство"
+	if got := d.Text(); got != want {
+		t.Errorf("Text() =\n%s\nwant\n%s", got, want)
+	}
+	if d.Title() != "Сводка на 02.03" {
+		t.Errorf("Title() = %q", d.Title())
+	}
+	if !(Digest{Day: day}).Empty() {
+		t.Error("Empty() = false for digest without items")
+	}
+}
@@ -0,0 +1,253 @@
+// Package dpop реализует проверку DPoP proof (RFC 9449) для привязки токенов к ключу клиента.
+package dpop
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// HeaderName — имя HTTP-заголовка (и ключа gRPC metadata в нижнем регистре) с DPoP proof.
+const HeaderName = "DPoP"
+
+// proofType — обязательное значение заголовка typ у DPoP proof.
+const proofType = "dpop+jwt"
+
+// clockSkew — допустимое опережение часов клиента.
+const clockSkew = 5 * time.Second
+
+var (
+	ErrMissingProof    = errors.New("dpop proof is required")
+	ErrInvalidProof    = errors.New("invalid dpop proof")
+	ErrReplayedProof   = errors.New("dpop proof has already been used")
+	ErrKeyMismatch     = errors.New("dpop key does not match token binding")
+	ErrUnsupportedJWK  = errors.New("unsupported dpop jwk")
+	ErrAccessTokenHash = errors.New("dpop proof ath does not match access token")
+)
+
+// supportedAlgs — асимметричные алгоритмы, допустимые для подписи proof.
+var supportedAlgs = []string{"ES256", "ES384", "RS256", "PS256", "EdDSA"}
+
+// Proof — проверенный DPoP proof.
+type Proof struct {
+	// JKT — SHA-256 thumbprint JWK клиента (RFC 7638), base64url без паддинга
+	JKT      string
+	JTI      string
+	HTM      string
+	HTU      string
+	IssuedAt time.Time
+}
+
+type proofClaims struct {
+	HTM string `json:"htm"`
+	HTU string `json:"htu"`
+	ATH string `json:"ath,omitempty"`
+	jwt.RegisteredClaims
+}
+
+// Verifier проверяет DPoP proof и защищает от их повторного использования.
+type Verifier struct {
+	maxAge time.Duration
+	replay *ReplayCache
+}
+
+// NewVerifier создаёт Verifier; maxAge — максимальный возраст proof по iat.
+func NewVerifier(maxAge time.Duration) *Verifier {
+	return &Verifier{
+		maxAge: maxAge,
+		replay: NewReplayCache(),
+	}
+}
+
+// Verify проверяет proof для запроса method+target.
+// Если accessToken не пуст, дополнительно проверяется claim ath.
+func (v *Verifier) Verify(proof, method, target, accessToken string) (*Proof, error) {
+	if proof == "" {
+		return nil, ErrMissingProof
+	}
+
+	var jkt string
+	claims := &proofClaims{}
+	token, err := jwt.ParseWithClaims(proof, claims, func(t *jwt.Token) (interface{}, error) {
+		if typ, _ := t.Header["typ"].(string); typ != proofType {
+			return nil, fmt.Errorf("unexpected typ %q", t.Header["typ"])
+		}
+		raw, ok := t.Header["jwk"].(map[string]interface{})
+		if !ok {
+			return nil, errors.New("missing jwk header")
+		}
+		key, thumbprint, err := parseJWK(raw)
+		if err != nil {
+			return nil, err
+		}
+		jkt = thumbprint
+		return key, nil
+	}, jwt.WithValidMethods(supportedAlgs))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidProof, err)
+	}
+	if !token.Valid {
+		return nil, ErrInvalidProof
+	}
+
+	if claims.ID == "" {
+		return nil, fmt.Errorf("%w: missing jti", ErrInvalidProof)
+	}
+	if claims.HTM != method {
+		return nil, fmt.Errorf("%w: htm %q does not match %q", ErrInvalidProof, claims.HTM, method)
+	}
+	if !sameTarget(claims.HTU, target) {
+		return nil, fmt.Errorf("%w: htu %q does not match %q", ErrInvalidProof, claims.HTU, target)
+	}
+	if claims.IssuedAt == nil {
+		return nil, fmt.Errorf("%w: missing iat", ErrInvalidProof)
+	}
+	now := time.Now()
+	iat := claims.IssuedAt.Time
+	if iat.After(now.Add(clockSkew)) || now.Sub(iat) > v.maxAge {
+		return nil, fmt.Errorf("%w: iat is outside of the acceptable window", ErrInvalidProof)
+	}
+	if accessToken != "" && claims.ATH != AccessTokenHash(accessToken) {
+		return nil, ErrAccessTokenHash
+	}
+
+	// jti уникален в рамках ключа, поэтому ключ кэша включает thumbprint
+	if !v.replay.Add(jkt+":"+claims.ID, iat.Add(v.maxAge+clockSkew)) {
+		return nil, ErrReplayedProof
+	}
+
+	return &Proof{
+		JKT:      jkt,
+		JTI:      claims.ID,
+		HTM:      claims.HTM,
+		HTU:      claims.HTU,
+		IssuedAt: iat,
+	}, nil
+}
+
+// AccessTokenHash возвращает значение claim ath для access токена.
+func AccessTokenHash(accessToken string) string {
+	h := sha256.Sum256([]byte(accessToken))
+	return base64.RawURLEncoding.EncodeToString(h[:])
+}
+
+// sameTarget сравнивает htu с URL запроса без учёта query и fragment (RFC 9449, 4.3).
+func sameTarget(htu, target string) bool {
+	a, err := url.Parse(htu)
+	if err != nil {
+		return false
+	}
+	b, err := url.Parse(target)
+	if err != nil {
+		return false
+	}
+	return strings.EqualFold(a.Scheme, b.Scheme) &&
+		strings.EqualFold(a.Host, b.Host) &&
+		a.EscapedPath() == b.EscapedPath()
+}
+
+// parseJWK разбирает публичный JWK и вычисляет его thumbprint.
+func parseJWK(raw map[string]interface{}) (crypto.PublicKey, string, error) {
+	str := func(name string) string {
+		s, _ := raw[name].(string)
+		return s
+	}
+	if str("d") != "" {
+		return nil, "", fmt.Errorf("%w: private key material in jwk", ErrUnsupportedJWK)
+	}
+
+	var (
+		key     crypto.PublicKey
+		members map[string]string
+	)
+	switch kty := str("kty"); kty {
+	case "EC":
+		var curve elliptic.Curve
+		switch str("crv") {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		default:
+			return nil, "", fmt.Errorf("%w: curve %q", ErrUnsupportedJWK, str("crv"))
+		}
+		x, err := decodeInt(str("x"))
+		if err != nil {
+			return nil, "", err
+		}
+		y, err := decodeInt(str("y"))
+		if err != nil {
+			return nil, "", err
+		}
+		pub := &ecdsa.PublicKey{Curve: curve, X: x, Y: y}
+		if !curve.IsOnCurve(x, y) {
+			return nil, "", fmt.Errorf("%w: point is not on curve", ErrUnsupportedJWK)
+		}
+		key = pub
+		members = map[string]string{"crv": str("crv"), "kty": kty, "x": str("x"), "y": str("y")}
+	case "RSA":
+		n, err := decodeInt(str("n"))
+		if err != nil {
+			return nil, "", err
+		}
+		e, err := decodeInt(str("e"))
+		if err != nil {
+			return nil, "", err
+		}
+		if n.BitLen() < 2048 || !e.IsInt64() {
+			return nil, "", fmt.Errorf("%w: weak rsa key", ErrUnsupportedJWK)
+		}
+		key = &rsa.PublicKey{N: n, E: int(e.Int64())}
+		members = map[string]string{"e": str("e"), "kty": kty, "n": str("n")}
+	case "OKP":
+		if str("crv") != "Ed25519" {
+			return nil, "", fmt.Errorf("%w: curve %q", ErrUnsupportedJWK, str("crv"))
+		}
+		x, err := base64.RawURLEncoding.DecodeString(str("x"))
+		if err != nil || len(x) != ed25519.PublicKeySize {
+			return nil, "", fmt.Errorf("%w: invalid ed25519 key", ErrUnsupportedJWK)
+		}
+		key = ed25519.PublicKey(x)
+		members = map[string]string{"crv": str("crv"), "kty": kty, "x": str("x")}
+	default:
+		return nil, "", fmt.Errorf("%w: kty %q", ErrUnsupportedJWK, kty)
+	}
+
+	thumbprint, err := thumbprint(members)
+	if err != nil {
+		return nil, "", err
+	}
+	return key, thumbprint, nil
+}
+
+// thumbprint вычисляет JWK thumbprint по RFC 7638.
+// encoding/json сериализует ключи map в лексикографическом порядке, как того требует RFC.
+func thumbprint(members map[string]string) (string, error) {
+	b, err := json.Marshal(members)
+	if err != nil {
+		return "", fmt.Errorf("marshal jwk: %w", err)
+	}
+	h := sha256.Sum256(b)
+	return base64.RawURLEncoding.EncodeToString(h[:]), nil
+}
+
+func decodeInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil || len(b) == 0 {
+		return nil, fmt.Errorf("%w: invalid key parameter", ErrUnsupportedJWK)
+	}
+	return new(big.Int).SetBytes(b), nil
+}
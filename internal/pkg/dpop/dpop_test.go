@@ -0,0 +1,104 @@
+package dpop
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func newProof(t *testing.T, key *ecdsa.PrivateKey, jti, htm, htu, ath string, iat time.Time) string {
+	t.Helper()
+
+	claims := jwt.MapClaims{
+		"jti": jti,
+		"htm": htm,
+		"htu": htu,
+		"iat": iat.Unix(),
+	}
+	if ath != "" {
+		claims["ath"] = ath
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, claims)
+	token.Header["typ"] = "dpop+jwt"
+	token.Header["jwk"] = map[string]interface{}{
+		"kty": "EC",
+		"crv": "P-256",
+		"x":   base64.RawURLEncoding.EncodeToString(key.X.FillBytes(make([]byte, 32))),
+		"y":   base64.RawURLEncoding.EncodeToString(key.Y.FillBytes(make([]byte, 32))),
+	}
+
+	proof, err := token.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return proof
+}
+
+func TestVerify(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	v := NewVerifier(time.Minute)
+	htu := "https://api.example.com/api/v1/auth/refresh"
+
+	proof := newProof(t, key, "jti-1", "POST", htu, "", time.Now())
+	p, err := v.Verify(proof, "POST", htu+"?x=1", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.JKT == "" {
+		t.Error("expected non-empty thumbprint")
+	}
+
+	if _, err := v.Verify(proof, "POST", htu, ""); !errors.Is(err, ErrReplayedProof) {
+		t.Errorf("expected replay error, got %v", err)
+	}
+
+	other := newProof(t, key, "jti-2", "POST", htu, "", time.Now())
+	p2, err := v.Verify(other, "POST", htu, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p2.JKT != p.JKT {
+		t.Error("expected the same thumbprint for the same key")
+	}
+}
+
+func TestVerifyRejects(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	v := NewVerifier(time.Minute)
+	htu := "https://api.example.com/api/v1/profile"
+
+	tests := []struct {
+		name   string
+		proof  string
+		method string
+		token  string
+	}{
+		{"wrong method", newProof(t, key, "a", "GET", htu, "", time.Now()), "POST", ""},
+		{"expired", newProof(t, key, "b", "POST", htu, "", time.Now().Add(-time.Hour)), "POST", ""},
+		{"missing ath", newProof(t, key, "c", "POST", htu, "", time.Now()), "POST", "access"},
+		{"wrong ath", newProof(t, key, "d", "POST", htu, AccessTokenHash("other"), time.Now()), "POST", "access"},
+		{"empty", "", "POST", ""},
+	}
+	for _, tt := range tests {
+		if _, err := v.Verify(tt.proof, tt.method, htu, tt.token); err == nil {
+			t.Errorf("%s: expected error", tt.name)
+		}
+	}
+
+	proof := newProof(t, key, "e", "POST", htu, AccessTokenHash("access"), time.Now())
+	if _, err := v.Verify(proof, "POST", htu, "access"); err != nil {
+		t.Errorf("expected valid proof with ath, got %v", err)
+	}
+}
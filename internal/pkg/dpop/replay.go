@@ -0,0 +1,46 @@
+package dpop
+
+import (
+	"sync"
+	"time"
+)
+
+// purgeInterval — как часто ReplayCache удаляет истёкшие записи.
+const purgeInterval = time.Minute
+
+// ReplayCache хранит использованные jti до истечения срока годности proof.
+type ReplayCache struct {
+	mu        sync.Mutex
+	seen      map[string]time.Time
+	lastPurge time.Time
+}
+
+// NewReplayCache создаёт пустой кэш.
+func NewReplayCache() *ReplayCache {
+	return &ReplayCache{
+		seen:      make(map[string]time.Time),
+		lastPurge: time.Now(),
+	}
+}
+
+// Add запоминает jti до expiresAt. Возвращает false, если jti уже встречался.
+func (c *ReplayCache) Add(jti string, expiresAt time.Time) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(c.lastPurge) > purgeInterval {
+		for k, exp := range c.seen {
+			if now.After(exp) {
+				delete(c.seen, k)
+			}
+		}
+		c.lastPurge = now
+	}
+
+	if exp, ok := c.seen[jti]; ok && now.Before(exp) {
+		return false
+	}
+	c.seen[jti] = expiresAt
+	return true
+}
@@ -0,0 +1,123 @@
+// Package escalation описывает цепочки эскалации неподтверждённых напоминаний.
+//
+// Политика записывается строкой из шагов через «;». Шаг — порог повторов и
+// контакт: «<повторов> <канал>:<адрес>», например
+// «3 telegram:123456789; 6 email:lead@example.com». Шаг срабатывает,
+// когда напоминание отправлено повторно столько раз без подтверждения.
+package escalation
+
+import (
+	"errors"
+	"fmt"
+	"net/mail"
+	"strconv"
+	"strings"
+
+	"github.com/vovanwin/template/internal/pkg/notify"
+)
+
+// MaxSteps ограничивает длину цепочки.
+const MaxSteps = 5
+
+// ErrInvalidPolicy — политику не удалось разобрать.
+var ErrInvalidPolicy = errors.New("escalation: invalid policy")
+
+// Step — шаг эскалации: кого уведомить после AfterRepeats повторов.
+type Step struct {
+	AfterRepeats int
+	Channel      notify.Channel
+	// Address — Chat ID для telegram, email или URL webhook. Сервис разрешает
+	// только адреса создателя и участников списка напоминания.
+	Address string
+}
+
+// Policy — шаги эскалации в порядке срабатывания.
+type Policy []Step
+
+// Parse разбирает политику; пустая строка — без эскалации.
+func Parse(raw string) (Policy, error) {
+	var policy Policy
+	for _, part := range strings.Split(raw, ";") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		step, err := parseStep(part)
+		if err != nil {
+			return nil, err
+		}
+		if n := len(policy); n > 0 && step.AfterRepeats <= policy[n-1].AfterRepeats {
+			return nil, fmt.Errorf("%w: thresholds must increase: %q", ErrInvalidPolicy, part)
+		}
+		policy = append(policy, step)
+	}
+	if len(policy) > MaxSteps {
+		return nil, fmt.Errorf("%w: at most %d steps", ErrInvalidPolicy, MaxSteps)
+	}
+	return policy, nil
+}
+
+func parseStep(s string) (Step, error) {
+	count, contact, ok := strings.Cut(s, " ")
+	if !ok {
+		return Step{}, fmt.Errorf("%w: expected \"<repeats> <channel>:<address>\": %q", ErrInvalidPolicy, s)
+	}
+	repeats, err := strconv.Atoi(count)
+	if err != nil || repeats < 1 {
+		return Step{}, fmt.Errorf("%w: repeats must be a positive number: %q", ErrInvalidPolicy, s)
+	}
+	name, address, ok := strings.Cut(strings.TrimSpace(contact), ":")
+	if !ok || address == "" {
+		return Step{}, fmt.Errorf("%w: expected <channel>:<address>: %q", ErrInvalidPolicy, s)
+	}
+	channel, err := notify.Parse(name)
+	if err != nil {
+		return Step{}, fmt.Errorf("%w: %w", ErrInvalidPolicy, err)
+	}
+
+	step := Step{AfterRepeats: repeats, Channel: channel, Address: strings.TrimSpace(address)}
+	if _, err := step.Recipient(); err != nil {
+		return Step{}, fmt.Errorf("%w: %q: %w", ErrInvalidPolicy, s, err)
+	}
+	return step, nil
+}
+
+// Recipient возвращает адрес контакта в канале шага.
+func (s Step) Recipient() (notify.Recipient, error) {
+	switch s.Channel {
+	case notify.ChannelTelegram:
+		chatID, err := strconv.ParseInt(s.Address, 10, 64)
+		if err != nil || chatID == 0 {
+			return notify.Recipient{}, errors.New("telegram address must be a chat ID")
+		}
+		return notify.Recipient{ChatID: chatID}, nil
+	case notify.ChannelEmail:
+		addr, err := mail.ParseAddress(s.Address)
+		if err != nil {
+			return notify.Recipient{}, errors.New("invalid email address")
+		}
+		return notify.Recipient{Email: addr.Address}, nil
+	case notify.ChannelWebhook:
+		if err := notify.ValidateWebhookURL(s.Address); err != nil {
+			return notify.Recipient{}, err
+		}
+		return notify.Recipient{WebhookURL: s.Address}, nil
+	default:
+		// In-app уведомление видит только сам владелец напоминания.
+		return notify.Recipient{}, fmt.Errorf("channel %s cannot be used for escalation", s.Channel)
+	}
+}
+
+// String возвращает шаг в формате политики.
+func (s Step) String() string {
+	return fmt.Sprintf("%d %s:%s", s.AfterRepeats, s.Channel, s.Address)
+}
+
+// String возвращает политику в каноническом виде для хранения в БД.
+func (p Policy) String() string {
+	parts := make([]string, len(p))
+	for i, s := range p {
+		parts[i] = s.String()
+	}
+	return strings.Join(parts, "; ")
+}
@@ -0,0 +1,54 @@
+package escalation
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/vovanwin/template/internal/pkg/notify"
+)
+
+func TestParse(t *testing.T) {
+	p, err := Parse(" 3 telegram:-1001234567890;6 EMAIL:lead@example.com ; ")
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	want := Policy{
+		{AfterRepeats: 3, Channel: notify.ChannelTelegram, Address: "-1001234567890"},
+		{AfterRepeats: 6, Channel: notify.ChannelEmail, Address: "lead@example.com"},
+	}
+	if len(p) != len(want) {
+		t.Fatalf("got %d steps, want %d", len(p), len(want))
+	}
+	for i := range want {
+		if p[i] != want[i] {
+			t.Errorf("step %d = %+v, want %+v", i, p[i], want[i])
+		}
+	}
+	if got := p.String(); got != "3 telegram:-1001234567890; 6 email:lead@example.com" {
+		t.Errorf("String() = %q", got)
+	}
+
+	if p, err := Parse(""); err != nil || len(p) != 0 {
+		t.Errorf("Parse(\"\") = %v, %v; want empty policy", p, err)
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	for _, raw := range []string{
+		"telegram:123",
+		"0 telegram:123",
+		"3 telegram:@lead",
+		"3 inapp:user",
+		"3 sms:+7900",
+		"3 email:not-an-email",
+		"3 webhook:ftp://example.com",
+		"3 webhook:http://169.254.169.254/latest/meta-data",
+		"3 webhook:http://10.0.0.1/hook",
+		"3 telegram:1; 3 telegram:2",
+		"1 telegram:1; 2 telegram:2; 3 telegram:3; 4 telegram:4; 5 telegram:5; 6 telegram:6",
+	} {
+		if _, err := Parse(raw); !errors.Is(err, ErrInvalidPolicy) {
+			t.Errorf("Parse(%q) error = %v, want ErrInvalidPolicy", raw, err)
+		}
+	}
+}
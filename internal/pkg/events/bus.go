@@ -2,34 +2,72 @@ package events
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
+	"strconv"
 
 	"github.com/vovanwin/template/internal/pkg/centrifugo"
+	"github.com/vovanwin/template/internal/pkg/outbox"
+	"github.com/vovanwin/template/internal/repository"
 )
 
+// OutboxKind — вид сообщений outbox с событиями для Centrifugo.
+const OutboxKind = "event.publish"
+
 type Event struct {
 	UserID  string `json:"-"`
 	Message string `json:"message"`
 	Type    string `json:"type"`
 }
 
+// envelope — событие в outbox вместе с получателем, который в JSON события не попадает.
+type envelope struct {
+	UserID string `json:"user_id"`
+	Event  Event  `json:"event"`
+}
+
+// Bus публикует события пользователям через outbox: событие сохраняется
+// в таблице outbox, а в Centrifugo его отправляет Deliver из outbox.Relay.
 type Bus struct {
+	outbox *repository.OutboxRepo
 	client *centrifugo.Client
 	log    *slog.Logger
 }
 
-func NewBus(client *centrifugo.Client, log *slog.Logger) *Bus {
+func NewBus(outboxRepo *repository.OutboxRepo, client *centrifugo.Client, log *slog.Logger) *Bus {
 	return &Bus{
+		outbox: outboxRepo,
 		client: client,
 		log:    log,
 	}
 }
 
-func (b *Bus) Publish(e Event) error {
-	channel := centrifugo.PersonalChannel(e.UserID)
-	if err := b.client.Publish(context.Background(), channel, e); err != nil {
+// Publish ставит событие в outbox. Внутри RunInTx событие сохраняется в той
+// же транзакции, что и изменение, и не уйдёт, если транзакция откатится.
+func (b *Bus) Publish(ctx context.Context, e Event) error {
+	msg, err := outbox.New(OutboxKind, "", envelope{UserID: e.UserID, Event: e})
+	if err != nil {
+		return err
+	}
+	if err := b.outbox.Add(ctx, msg); err != nil {
 		b.log.Warn("failed to publish event", slog.String("userID", e.UserID), slog.Any("err", err))
 		return err
 	}
 	return nil
 }
+
+// Deliver отправляет событие из outbox в персональный канал пользователя.
+// ID сообщения — ключ идемпотентности Centrifugo, поэтому повтор после
+// сбоя не покажет уведомление дважды.
+func (b *Bus) Deliver(ctx context.Context, msg outbox.Message) error {
+	var env envelope
+	if err := msg.Decode(&env); err != nil {
+		return err
+	}
+	channel := centrifugo.PersonalChannel(env.UserID)
+	key := "outbox-" + strconv.FormatInt(msg.ID, 10)
+	if err := b.client.PublishIdempotent(ctx, channel, env.Event, key); err != nil {
+		return fmt.Errorf("publish event: %w", err)
+	}
+	return nil
+}
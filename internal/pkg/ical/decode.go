@@ -0,0 +1,326 @@
+package ical
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidCalendar — данные не похожи на календарь iCalendar.
+var ErrInvalidCalendar = errors.New("ical: invalid calendar")
+
+const dateOnly = "20060102"
+
+// maxContentLine — предел длины развёрнутой строки, защищает от мусорных файлов.
+const maxContentLine = 1 << 20
+
+// Decode разбирает события VEVENT и задачи VTODO календаря. Для задачи
+// началом считается срок DUE, если он указан. Из первого VALARM с
+// DISPLAY или AUDIO берётся смещение срабатывания.
+//
+// Время без таймзоны и даты без времени относятся к loc; неизвестный TZID
+// (например, имена таймзон Windows из Outlook) тоже заменяется на loc.
+// Значения, которые не удалось разобрать, остаются нулевыми: проверять их —
+// задача вызывающего.
+func Decode(r io.Reader, loc *time.Location) ([]Event, error) {
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	lines, err := unfold(r)
+	if err != nil {
+		return nil, err
+	}
+	if len(lines) == 0 || !strings.EqualFold(lines[0], "BEGIN:VCALENDAR") {
+		return nil, fmt.Errorf("%w: no BEGIN:VCALENDAR", ErrInvalidCalendar)
+	}
+
+	var (
+		events []Event
+		cur    *component
+		alarm  *component
+	)
+	for _, line := range lines {
+		name, params, value, ok := parseLine(line)
+		if !ok {
+			continue
+		}
+		switch {
+		case name == "BEGIN" && (strings.EqualFold(value, "VEVENT") || strings.EqualFold(value, "VTODO")):
+			cur = &component{todo: strings.EqualFold(value, "VTODO"), props: map[string]property{}}
+		case cur == nil:
+			continue
+		case name == "BEGIN" && strings.EqualFold(value, "VALARM"):
+			alarm = &component{props: map[string]property{}}
+		case name == "END" && strings.EqualFold(value, "VALARM"):
+			if cur.alarm == nil && alarm != nil && isVisibleAlarm(alarm) {
+				cur.alarm = alarm
+			}
+			alarm = nil
+		case name == "END" && (strings.EqualFold(value, "VEVENT") || strings.EqualFold(value, "VTODO")):
+			events = append(events, cur.event(loc))
+			cur, alarm = nil, nil
+		case alarm != nil:
+			alarm.add(name, params, value)
+		default:
+			cur.add(name, params, value)
+		}
+	}
+	return events, nil
+}
+
+// property — значение свойства с параметрами.
+type property struct {
+	params map[string]string
+	value  string
+}
+
+// component — накопленные свойства VEVENT/VTODO или VALARM.
+type component struct {
+	todo       bool
+	props      map[string]property
+	categories []string
+	alarm      *component
+}
+
+func (c *component) add(name string, params map[string]string, value string) {
+	if name == "CATEGORIES" {
+		c.categories = append(c.categories, splitText(value)...)
+		return
+	}
+	// Повторное свойство игнорируется: берётся первое значение
+	if _, ok := c.props[name]; !ok {
+		c.props[name] = property{params: params, value: value}
+	}
+}
+
+func (c *component) event(loc *time.Location) Event {
+	e := Event{
+		UID:         c.props["UID"].value,
+		Summary:     unescape(c.props["SUMMARY"].value),
+		Description: unescape(c.props["DESCRIPTION"].value),
+		RRule:       strings.TrimSpace(c.props["RRULE"].value),
+		Categories:  c.categories,
+		Status:      strings.ToUpper(strings.TrimSpace(c.props["STATUS"].value)),
+	}
+	start := c.props["DTSTART"]
+	if due, ok := c.props["DUE"]; c.todo && ok {
+		start = due
+	}
+	e.Start, e.AllDay = parseTime(start, loc)
+	if p, err := strconv.Atoi(strings.TrimSpace(c.props["PRIORITY"].value)); err == nil {
+		e.Priority = p
+	}
+	if d, err := parseDuration(c.props["DURATION"].value); err == nil {
+		e.Duration = d
+	}
+	if t, _ := parseTime(c.props["LAST-MODIFIED"], loc); !t.IsZero() {
+		e.Modified = t
+	}
+
+	if c.alarm != nil {
+		trig := c.alarm.props["TRIGGER"]
+		if strings.EqualFold(trig.params["VALUE"], "DATE-TIME") {
+			if at, _ := parseTime(trig, loc); !at.IsZero() && !e.Start.IsZero() {
+				e.Alarm, e.AlarmOffset = true, at.Sub(e.Start)
+			}
+		} else if d, err := parseDuration(trig.value); err == nil {
+			if strings.EqualFold(trig.params["RELATED"], "END") {
+				d += e.Duration
+			}
+			e.Alarm, e.AlarmOffset = true, d
+		}
+	}
+	return e
+}
+
+// isVisibleAlarm отбрасывает напоминания по email и служебные: импортируются
+// только те, что показываются пользователю.
+func isVisibleAlarm(c *component) bool {
+	action := strings.ToUpper(c.props["ACTION"].value)
+	return action == "DISPLAY" || action == "AUDIO" || action == ""
+}
+
+// unfold читает строки и склеивает перенесённые продолжения (RFC 5545, 3.1).
+func unfold(r io.Reader) ([]string, error) {
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 64*1024), maxContentLine)
+
+	var lines []string
+	for sc.Scan() {
+		line := strings.TrimSuffix(sc.Text(), "\r")
+		if len(lines) == 0 {
+			line = strings.TrimPrefix(line, "\ufeff")
+		}
+		if line == "" {
+			continue
+		}
+		if (line[0] == ' ' || line[0] == '\t') && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidCalendar, err)
+	}
+	return lines, nil
+}
+
+// parseLine разбирает «NAME;PARAM=value;PARAM="a:b":VALUE».
+func parseLine(line string) (name string, params map[string]string, value string, ok bool) {
+	quoted := false
+	colon := -1
+	for i := 0; i < len(line) && colon < 0; i++ {
+		switch line[i] {
+		case '"':
+			quoted = !quoted
+		case ':':
+			if !quoted {
+				colon = i
+			}
+		}
+	}
+	if colon < 0 {
+		return "", nil, "", false
+	}
+
+	head, value := line[:colon], line[colon+1:]
+	parts := strings.Split(head, ";")
+	name = strings.ToUpper(strings.TrimSpace(parts[0]))
+	params = make(map[string]string, len(parts)-1)
+	for _, p := range parts[1:] {
+		if k, v, found := strings.Cut(p, "="); found {
+			params[strings.ToUpper(k)] = strings.Trim(v, `"`)
+		}
+	}
+	return name, params, value, name != ""
+}
+
+// parseTime разбирает DATE-TIME или DATE; второе значение — дата без времени.
+func parseTime(p property, loc *time.Location) (time.Time, bool) {
+	v := strings.TrimSpace(p.value)
+	if v == "" {
+		return time.Time{}, false
+	}
+	if strings.EqualFold(p.params["VALUE"], "DATE") || len(v) == len(dateOnly) {
+		t, err := time.ParseInLocation(dateOnly, v, loc)
+		if err != nil {
+			return time.Time{}, false
+		}
+		return t, true
+	}
+	if strings.HasSuffix(v, "Z") {
+		t, err := time.Parse(dateTimeUTC, v)
+		if err != nil {
+			return time.Time{}, false
+		}
+		return t, false
+	}
+
+	tzLoc := loc
+	if tzid := p.params["TZID"]; tzid != "" {
+		if l, err := time.LoadLocation(strings.TrimPrefix(tzid, "/")); err == nil {
+			tzLoc = l
+		}
+	}
+	t, err := time.ParseInLocation(dateTimeLocal, v, tzLoc)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, false
+}
+
+// parseDuration разбирает DURATION вида «-P1DT2H30M» или «PT15M» (RFC 5545, 3.3.6).
+func parseDuration(s string) (time.Duration, error) {
+	s = strings.ToUpper(strings.TrimSpace(s))
+	if s == "" {
+		return 0, errors.New("empty duration")
+	}
+	sign := time.Duration(1)
+	switch s[0] {
+	case '-':
+		sign, s = -1, s[1:]
+	case '+':
+		s = s[1:]
+	}
+	if !strings.HasPrefix(s, "P") || len(s) < 3 {
+		return 0, fmt.Errorf("invalid duration %q", s)
+	}
+
+	var (
+		d      time.Duration
+		num    int
+		digits bool
+		inTime bool
+	)
+	for _, r := range s[1:] {
+		switch {
+		case r >= '0' && r <= '9':
+			num = num*10 + int(r-'0')
+			digits = true
+			continue
+		case r == 'T':
+			inTime = true
+			continue
+		}
+		if !digits {
+			return 0, fmt.Errorf("invalid duration %q", s)
+		}
+		var unit time.Duration
+		switch {
+		case r == 'W' && !inTime:
+			unit = 7 * 24 * time.Hour
+		case r == 'D' && !inTime:
+			unit = 24 * time.Hour
+		case r == 'H' && inTime:
+			unit = time.Hour
+		case r == 'M' && inTime:
+			unit = time.Minute
+		case r == 'S' && inTime:
+			unit = time.Second
+		default:
+			return 0, fmt.Errorf("invalid duration %q", s)
+		}
+		d += time.Duration(num) * unit
+		num, digits = 0, false
+	}
+	if digits {
+		return 0, fmt.Errorf("invalid duration %q", s)
+	}
+	return sign * d, nil
+}
+
+// splitText делит список TEXT по неэкранированным запятым.
+func splitText(s string) []string {
+	var (
+		out []string
+		b   strings.Builder
+	)
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] == '\\' && i+1 < len(s):
+			b.WriteByte(s[i])
+			b.WriteByte(s[i+1])
+			i++
+		case s[i] == ',':
+			out = append(out, unescape(b.String()))
+			b.Reset()
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+	return append(out, unescape(b.String()))
+}
+
+// unescape снимает экранирование значения TEXT.
+func unescape(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+	return strings.NewReplacer(`\\`, `\`, `\;`, ";", `\,`, ",", `\n`, "\n", `\N`, "\n").Replace(s)
+}
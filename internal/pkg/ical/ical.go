@@ -0,0 +1,283 @@
+// Package ical формирует календарь в формате iCalendar (RFC 5545) для подписки
+// в календарных приложениях: события VEVENT с напоминаниями VALARM, — и разбирает
+// календари других приложений для импорта (см. Decode).
+//
+// Время событий записывается в таймзоне календаря (DTSTART;TZID=...), чтобы
+// повторяющиеся события не сдвигались при переходе на летнее время; описание
+// таймзоны VTIMEZONE строится по базе таймзон Go.
+package ical
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// maxLineOctets — максимальная длина строки без переноса (RFC 5545, 3.1).
+const maxLineOctets = 75
+
+const (
+	dateTimeLocal = "20060102T150405"
+	dateTimeUTC   = "20060102T150405Z"
+)
+
+// Calendar — календарь с событиями в одной таймзоне.
+type Calendar struct {
+	// ProdID — идентификатор приложения, сформировавшего календарь.
+	ProdID string
+	// Name — название календаря в приложении (X-WR-CALNAME).
+	Name string
+	// Location — таймзона событий; nil — UTC.
+	Location *time.Location
+	Events   []Event
+}
+
+// Event — событие календаря.
+type Event struct {
+	// UID — постоянный идентификатор: по нему приложение обновляет событие.
+	UID         string
+	Summary     string
+	Description string
+	Start       time.Time
+	Duration    time.Duration
+	// RRule — правило повторения без префикса «RRULE:»; пусто — разовое событие.
+	RRule string
+	// Categories — категории (теги) события.
+	Categories []string
+	// Priority — 1 (высший)..9 (низший); 0 — не указан.
+	Priority int
+	// Alarm — добавить VALARM; срабатывает через AlarmOffset от начала события
+	// (отрицательное смещение — раньше начала).
+	Alarm       bool
+	AlarmOffset time.Duration
+	// AllDay — событие на весь день (DTSTART;VALUE=DATE); заполняется при разборе.
+	AllDay bool
+	// Status — STATUS события или задачи (CONFIRMED, COMPLETED, CANCELLED...);
+	// заполняется при разборе.
+	Status   string
+	Modified time.Time
+}
+
+// Encode записывает календарь в w.
+func Encode(w io.Writer, cal Calendar) error {
+	loc := cal.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	lw := &lineWriter{w: bufio.NewWriter(w)}
+	lw.prop("BEGIN", "VCALENDAR")
+	lw.prop("VERSION", "2.0")
+	lw.prop("PRODID", cal.ProdID)
+	lw.prop("CALSCALE", "GREGORIAN")
+	lw.prop("METHOD", "PUBLISH")
+	if cal.Name != "" {
+		lw.prop("X-WR-CALNAME", escape(cal.Name))
+	}
+	if loc != time.UTC {
+		lw.prop("X-WR-TIMEZONE", loc.String())
+		from, to := timezoneRange(cal.Events)
+		writeTimezone(lw, loc, from, to)
+	}
+
+	for _, e := range cal.Events {
+		writeEvent(lw, e, loc)
+	}
+	lw.prop("END", "VCALENDAR")
+
+	if lw.err != nil {
+		return lw.err
+	}
+	return lw.w.Flush()
+}
+
+func writeEvent(lw *lineWriter, e Event, loc *time.Location) {
+	lw.prop("BEGIN", "VEVENT")
+	lw.prop("UID", e.UID)
+	stamp := e.Modified
+	if stamp.IsZero() {
+		stamp = time.Now()
+	}
+	lw.prop("DTSTAMP", stamp.UTC().Format(dateTimeUTC))
+	if loc == time.UTC {
+		lw.prop("DTSTART", e.Start.UTC().Format(dateTimeUTC))
+	} else {
+		lw.prop("DTSTART;TZID="+loc.String(), e.Start.In(loc).Format(dateTimeLocal))
+	}
+	if e.Duration > 0 {
+		lw.prop("DURATION", duration(e.Duration))
+	}
+	if e.RRule != "" {
+		lw.prop("RRULE", e.RRule)
+	}
+	lw.prop("SUMMARY", escape(e.Summary))
+	if e.Description != "" {
+		lw.prop("DESCRIPTION", escape(e.Description))
+	}
+	if len(e.Categories) > 0 {
+		escaped := make([]string, len(e.Categories))
+		for i, c := range e.Categories {
+			escaped[i] = escape(c)
+		}
+		lw.prop("CATEGORIES", strings.Join(escaped, ","))
+	}
+	if e.Priority > 0 {
+		lw.prop("PRIORITY", fmt.Sprint(e.Priority))
+	}
+	if e.Alarm {
+		lw.prop("BEGIN", "VALARM")
+		lw.prop("ACTION", "DISPLAY")
+		lw.prop("DESCRIPTION", escape(e.Summary))
+		lw.prop("TRIGGER", trigger(e.AlarmOffset))
+		lw.prop("END", "VALARM")
+	}
+	lw.prop("END", "VEVENT")
+}
+
+// timezoneRange возвращает период, который должно покрывать описание таймзоны:
+// от начала года самого раннего события до конца следующего за текущим года.
+func timezoneRange(events []Event) (from, to time.Time) {
+	now := time.Now().UTC()
+	from = time.Date(now.Year(), 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, e := range events {
+		if e.Start.Before(from) {
+			from = time.Date(e.Start.Year(), 1, 1, 0, 0, 0, 0, time.UTC)
+		}
+	}
+	return from, time.Date(now.Year()+2, 1, 1, 0, 0, 0, 0, time.UTC)
+}
+
+// writeTimezone описывает таймзону переходами между смещениями в периоде [from, to).
+func writeTimezone(lw *lineWriter, loc *time.Location, from, to time.Time) {
+	lw.prop("BEGIN", "VTIMEZONE")
+	lw.prop("TZID", loc.String())
+
+	prev := from.In(loc)
+	writeObservance(lw, prev, prev)
+	for t := from.Add(24 * time.Hour); t.Before(to); t = t.Add(24 * time.Hour) {
+		cur := t.In(loc)
+		_, prevOffset := prev.Zone()
+		if _, offset := cur.Zone(); offset != prevOffset {
+			at := transition(prev, cur)
+			writeObservance(lw, at.Add(-time.Second), at)
+		}
+		prev = cur
+	}
+
+	lw.prop("END", "VTIMEZONE")
+}
+
+// transition находит момент смены смещения между before и after с точностью до секунды.
+func transition(before, after time.Time) time.Time {
+	_, offset := before.Zone()
+	for after.Sub(before) > time.Second {
+		mid := before.Add(after.Sub(before) / 2)
+		if _, o := mid.Zone(); o == offset {
+			before = mid
+		} else {
+			after = mid
+		}
+	}
+	return after
+}
+
+// writeObservance записывает STANDARD или DAYLIGHT, действующий с момента at;
+// before — последний момент до перехода.
+func writeObservance(lw *lineWriter, before, at time.Time) {
+	_, offsetFrom := before.Zone()
+	name, offsetTo := at.Zone()
+
+	kind := "STANDARD"
+	if at.IsDST() {
+		kind = "DAYLIGHT"
+	}
+	lw.prop("BEGIN", kind)
+	// Начало указывается по местному времени до перехода
+	lw.prop("DTSTART", at.In(time.FixedZone("", offsetFrom)).Format(dateTimeLocal))
+	lw.prop("TZOFFSETFROM", utcOffset(offsetFrom))
+	lw.prop("TZOFFSETTO", utcOffset(offsetTo))
+	if name != "" {
+		lw.prop("TZNAME", escape(name))
+	}
+	lw.prop("END", kind)
+}
+
+func utcOffset(seconds int) string {
+	sign := '+'
+	if seconds < 0 {
+		sign, seconds = '-', -seconds
+	}
+	return fmt.Sprintf("%c%02d%02d", sign, seconds/3600, seconds%3600/60)
+}
+
+// duration форматирует длительность как DURATION (RFC 5545, 3.3.6).
+func duration(d time.Duration) string {
+	d = d.Round(time.Second)
+	var b strings.Builder
+	b.WriteString("PT")
+	if h := d / time.Hour; h > 0 {
+		fmt.Fprintf(&b, "%dH", h)
+	}
+	if m := d % time.Hour / time.Minute; m > 0 {
+		fmt.Fprintf(&b, "%dM", m)
+	}
+	if s := d % time.Minute / time.Second; s > 0 || b.Len() == 2 {
+		fmt.Fprintf(&b, "%dS", s)
+	}
+	return b.String()
+}
+
+// trigger форматирует смещение VALARM относительно начала события.
+func trigger(d time.Duration) string {
+	if d < 0 {
+		return "-" + duration(-d)
+	}
+	return duration(d)
+}
+
+// escape экранирует значение типа TEXT.
+func escape(s string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+		"\r", "",
+	).Replace(s)
+}
+
+// lineWriter пишет строки свойств с CRLF и переносом длинных строк.
+type lineWriter struct {
+	w   *bufio.Writer
+	err error
+}
+
+func (lw *lineWriter) prop(name, value string) {
+	lw.line(name + ":" + value)
+}
+
+// line переносит строку длиннее 75 октетов, не разрывая символы UTF-8:
+// продолжение начинается с пробела.
+func (lw *lineWriter) line(s string) {
+	if lw.err != nil {
+		return
+	}
+	limit := maxLineOctets
+	for len(s) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(s[cut]) {
+			cut--
+		}
+		if _, lw.err = lw.w.WriteString(s[:cut] + "\r\n "); lw.err != nil {
+			return
+		}
+		s = s[cut:]
+		// Пробел в начале продолжения тоже занимает октет
+		limit = maxLineOctets - 1
+	}
+	_, lw.err = lw.w.WriteString(s + "\r\n")
+}
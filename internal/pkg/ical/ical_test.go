@@ -0,0 +1,153 @@
+package ical
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+	_ "time/tzdata"
+)
+
+func encode(t *testing.T, cal Calendar) string {
+	t.Helper()
+	var b strings.Builder
+	if err := Encode(&b, cal); err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+	return b.String()
+}
+
+func TestEncodeEvent(t *testing.T) {
+	msk := time.FixedZone("MSK", 3*60*60)
+	out := encode(t, Calendar{
+		ProdID:   "-//test//RU",
+		Location: msk,
+		Events: []Event{{
+			UID:        "1@test",
+			Summary:    "Купить молоко, хлеб; сыр",
+			Start:      time.Date(2026, 10, 20, 9, 0, 0, 0, msk),
+			Duration:   15 * time.Minute,
+			RRule:      "FREQ=WEEKLY;BYDAY=MO",
+			Categories: []string{"дом", "покупки"},
+			Alarm:      true,
+		}},
+	})
+
+	for _, want := range []string{
+		"DTSTART;TZID=MSK:20261020T090000\r\n",
+		"DURATION:PT15M\r\n",
+		"RRULE:FREQ=WEEKLY;BYDAY=MO\r\n",
+		`SUMMARY:Купить молоко\, хлеб\; сыр` + "\r\n",
+		"CATEGORIES:дом,покупки\r\n",
+		"BEGIN:VALARM\r\n",
+		"TZOFFSETTO:+0300\r\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output has no %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestEncodeFoldsLongLines(t *testing.T) {
+	out := encode(t, Calendar{
+		ProdID: "-//test//RU",
+		Events: []Event{{UID: "1@test", Summary: strings.Repeat("напоминание ", 20), Start: time.Now()}},
+	})
+	for _, line := range strings.Split(strings.TrimSuffix(out, "\r\n"), "\r\n") {
+		if len(line) > maxLineOctets {
+			t.Errorf("line longer than %d octets: %q", maxLineOctets, line)
+		}
+	}
+	unfolded := strings.ReplaceAll(out, "\r\n ", "")
+	if !strings.Contains(unfolded, "SUMMARY:"+strings.Repeat("напоминание ", 20)) {
+		t.Errorf("folded summary does not unfold back:\n%s", out)
+	}
+}
+
+func TestTimezoneTransitions(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := encode(t, Calendar{
+		ProdID:   "-//test//RU",
+		Location: berlin,
+		Events:   []Event{{UID: "1@test", Summary: "x", Start: time.Date(2026, 1, 5, 9, 0, 0, 0, berlin)}},
+	})
+	// Переход на летнее время 29.03.2026 в 02:00 по местному времени
+	if !strings.Contains(out, "BEGIN:DAYLIGHT\r\nDTSTART:20260329T020000\r\nTZOFFSETFROM:+0100\r\nTZOFFSETTO:+0200\r\n") {
+		t.Errorf("no DST transition in:\n%s", out)
+	}
+	if !strings.Contains(out, "BEGIN:STANDARD\r\nDTSTART:20261025T030000\r\nTZOFFSETFROM:+0200\r\nTZOFFSETTO:+0100\r\n") {
+		t.Errorf("no standard time transition in:\n%s", out)
+	}
+}
+
+func TestDecode(t *testing.T) {
+	msk := time.FixedZone("MSK", 3*60*60)
+	in := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"BEGIN:VEVENT",
+		"UID:1@test",
+		"SUMMARY:Купить молоко\\, хлеб",
+		"DTSTART;TZID=Europe/Berlin:20261020T090000",
+		"RRULE:FREQ=WEEKLY;BYDAY=TU",
+		"CATEGORIES:дом,покупки",
+		"PRIORITY:1",
+		"BEGIN:VALARM",
+		"ACTION:EMAIL",
+		"TRIGGER:-PT1H",
+		"END:VALARM",
+		"BEGIN:VALARM",
+		"ACTION:DISPLAY",
+		"TRIGGER:-PT15M",
+		"END:VALARM",
+		"END:VEVENT",
+		"BEGIN:VTODO",
+		"SUMMARY:Отчёт за кв",
+		" артал",
+		"DTSTART:20261020T070000Z",
+		"DUE;VALUE=DATE:20261023",
+		"STATUS:NEEDS-ACTION",
+		"END:VTODO",
+		"END:VCALENDAR",
+	}, "\r\n")
+
+	events, err := Decode(strings.NewReader(in), msk)
+	if err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+	if len(events) != 2 {
+		t.Fatalf("got %d events, want 2", len(events))
+	}
+
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	e := events[0]
+	if e.Summary != "Купить молоко, хлеб" || e.RRule != "FREQ=WEEKLY;BYDAY=TU" || e.Priority != 1 {
+		t.Errorf("event = %+v", e)
+	}
+	if !e.Start.Equal(time.Date(2026, 10, 20, 9, 0, 0, 0, berlin)) {
+		t.Errorf("Start = %v", e.Start)
+	}
+	if !e.Alarm || e.AlarmOffset != -15*time.Minute {
+		t.Errorf("alarm = %v %v, want display alarm 15m before", e.Alarm, e.AlarmOffset)
+	}
+	if strings.Join(e.Categories, ",") != "дом,покупки" {
+		t.Errorf("Categories = %v", e.Categories)
+	}
+
+	todo := events[1]
+	if todo.Summary != "Отчёт за квартал" || !todo.AllDay || todo.Alarm {
+		t.Errorf("todo = %+v", todo)
+	}
+	if !todo.Start.Equal(time.Date(2026, 10, 23, 0, 0, 0, 0, msk)) {
+		t.Errorf("todo Start = %v, want due date", todo.Start)
+	}
+}
+
+func TestDecodeRejectsNonCalendar(t *testing.T) {
+	if _, err := Decode(strings.NewReader("title,remind_at\n"), time.UTC); !errors.Is(err, ErrInvalidCalendar) {
+		t.Errorf("err = %v, want ErrInvalidCalendar", err)
+	}
+}
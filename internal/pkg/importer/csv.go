@@ -0,0 +1,216 @@
+package importer
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/vovanwin/template/internal/model"
+	"github.com/vovanwin/template/internal/pkg/nldate"
+	"github.com/vovanwin/template/internal/pkg/tags"
+)
+
+// column — поле напоминания, которому соответствует колонка CSV.
+type column int
+
+const (
+	colTitle column = iota + 1
+	colDescription
+	colRemindAt
+	colRecurrence
+	colTags
+	colPriority
+)
+
+// columnNames — допустимые заголовки колонок; неизвестные колонки пропускаются.
+var columnNames = map[string]column{
+	"title":           colTitle,
+	"name":            colTitle,
+	"название":        colTitle,
+	"description":     colDescription,
+	"notes":           colDescription,
+	"описание":        colDescription,
+	"remind_at":       colRemindAt,
+	"when":            colRemindAt,
+	"date":            colRemindAt,
+	"когда":           colRemindAt,
+	"время":           colRemindAt,
+	"дата":            colRemindAt,
+	"recurrence":      colRecurrence,
+	"recurrence_rule": colRecurrence,
+	"rrule":           colRecurrence,
+	"повтор":          colRecurrence,
+	"tags":            colTags,
+	"теги":            colTags,
+	"priority":        colPriority,
+	"приоритет":       colPriority,
+}
+
+// timeLayouts — форматы времени в колонке remind_at, от точных к общим.
+// Всё остальное разбирается как текст пакетом nldate («завтра в 9»).
+var timeLayouts = []struct {
+	layout   string
+	dateOnly bool
+}{
+	{time.RFC3339, false},
+	{"2006-01-02 15:04:05", false},
+	{"2006-01-02 15:04", false},
+	{"2006-01-02T15:04", false},
+	{"02.01.2006 15:04", false},
+	{"2006-01-02", true},
+	{"02.01.2006", true},
+}
+
+// priorityNames — значения колонки priority кроме low, normal и high.
+var priorityNames = map[string]model.ReminderPriority{
+	"низкий":  model.ReminderPriorityLow,
+	"обычный": model.ReminderPriorityNormal,
+	"высокий": model.ReminderPriorityHigh,
+}
+
+// parseCSV разбирает таблицу с заголовком. Разделитель — запятая, точка с
+// запятой (так сохраняет Excel с русской локалью) или табуляция.
+func parseCSV(data []byte, now time.Time, loc *time.Location) ([]Row, error) {
+	data = bytes.TrimPrefix(data, []byte("\ufeff"))
+
+	r := csv.NewReader(bytes.NewReader(data))
+	r.Comma = detectDelimiter(data)
+	r.FieldsPerRecord = -1
+	r.LazyQuotes = true
+	r.TrimLeadingSpace = true
+
+	header, err := r.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("%w: empty file", ErrInvalidFile)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidFile, err)
+	}
+	columns, err := mapColumns(header)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []Row
+	for {
+		record, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidFile, err)
+		}
+		if len(rows) == MaxRows {
+			return nil, fmt.Errorf("%w: more than %d reminders", ErrTooLarge, MaxRows)
+		}
+		line, _ := r.FieldPos(0)
+		rows = append(rows, csvRow(line, columns, record, now, loc))
+	}
+	return rows, nil
+}
+
+// detectDelimiter выбирает самый частый разделитель в строке заголовка.
+func detectDelimiter(data []byte) rune {
+	header, _, _ := bytes.Cut(data, []byte("\n"))
+	best, count := ',', bytes.Count(header, []byte(","))
+	for _, d := range []rune{';', '\t'} {
+		if n := bytes.Count(header, []byte(string(d))); n > count {
+			best, count = d, n
+		}
+	}
+	return best
+}
+
+// mapColumns сопоставляет заголовки с полями; название и время обязательны.
+func mapColumns(header []string) (map[column]int, error) {
+	columns := make(map[column]int)
+	for i, name := range header {
+		col, ok := columnNames[strings.ToLower(strings.TrimSpace(name))]
+		if !ok {
+			continue
+		}
+		if _, dup := columns[col]; !dup {
+			columns[col] = i
+		}
+	}
+	if _, ok := columns[colTitle]; !ok {
+		return nil, fmt.Errorf("%w: no title column", ErrInvalidFile)
+	}
+	if _, ok := columns[colRemindAt]; !ok {
+		return nil, fmt.Errorf("%w: no remind_at column", ErrInvalidFile)
+	}
+	return columns, nil
+}
+
+func csvRow(line int, columns map[column]int, record []string, now time.Time, loc *time.Location) Row {
+	field := func(c column) string {
+		i, ok := columns[c]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	row := Row{
+		Line:           line,
+		Title:          field(colTitle),
+		Description:    field(colDescription),
+		RecurrenceRule: field(colRecurrence),
+	}
+
+	if raw := field(colRemindAt); raw == "" {
+		row.fail("не указано время")
+	} else if at, rule, err := parseRemindAt(raw, now, loc); err != nil {
+		row.fail("не удалось разобрать время %q", raw)
+	} else {
+		row.RemindAt = at
+		if row.RecurrenceRule == "" {
+			row.RecurrenceRule = rule
+		}
+	}
+
+	if t, err := tags.Parse(field(colTags)); err != nil {
+		row.fail("теги: %v", err)
+	} else {
+		row.Tags = t
+	}
+
+	if raw := strings.ToLower(field(colPriority)); raw != "" {
+		if p, ok := priorityNames[raw]; ok {
+			row.Priority = p
+		} else if p, err := model.ReminderPriorityString(raw); err == nil {
+			row.Priority = p
+		} else {
+			row.fail("неизвестный приоритет %q", raw)
+		}
+	}
+	return row
+}
+
+// parseRemindAt разбирает время в одном из timeLayouts или текстом через nldate;
+// для текста вроде «каждый понедельник в 10» возвращает и правило повторения.
+func parseRemindAt(raw string, now time.Time, loc *time.Location) (time.Time, string, error) {
+	for _, l := range timeLayouts {
+		t, err := time.ParseInLocation(l.layout, raw, loc)
+		if err != nil {
+			continue
+		}
+		if l.dateOnly {
+			t = time.Date(t.Year(), t.Month(), t.Day(), defaultHour, 0, 0, 0, loc)
+		}
+		return t, "", nil
+	}
+
+	res, err := nldate.Parse(raw, now, loc)
+	if err != nil {
+		return time.Time{}, "", err
+	}
+	if res.Rest != "" {
+		return time.Time{}, "", fmt.Errorf("unexpected text %q", res.Rest)
+	}
+	return res.At, res.Rule, nil
+}
@@ -0,0 +1,93 @@
+package importer
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/vovanwin/template/internal/model"
+	"github.com/vovanwin/template/internal/pkg/ical"
+	"github.com/vovanwin/template/internal/pkg/tags"
+)
+
+// parseICS превращает события и задачи календаря в строки импорта.
+// Напоминание срабатывает в момент VALARM, а без него — в начало события
+// (у задачи — в срок); для события на весь день без VALARM — в 09:00.
+func parseICS(data []byte, loc *time.Location) ([]Row, error) {
+	events, err := ical.Decode(bytes.NewReader(data), loc)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidFile, err)
+	}
+
+	rows := make([]Row, 0, len(events))
+	for i, e := range events {
+		row := Row{
+			Line:           i + 1,
+			Title:          e.Summary,
+			Description:    strings.TrimSpace(e.Description),
+			RecurrenceRule: e.RRule,
+			Priority:       icsPriority(e.Priority),
+		}
+
+		switch e.Status {
+		case "COMPLETED":
+			row.fail("задача уже выполнена")
+		case "CANCELLED":
+			row.fail("событие отменено")
+		}
+
+		if t, err := tags.Normalize(categoryTags(e.Categories)); err != nil {
+			row.fail("теги: %v", err)
+		} else {
+			row.Tags = t
+		}
+
+		switch {
+		case e.Start.IsZero():
+			row.fail("не указано время начала")
+		case e.Alarm:
+			row.RemindAt = e.Start.Add(e.AlarmOffset)
+			// Правило повторения задаёт дни по началу события: сдвиг
+			// напоминания на другой день сломал бы расписание.
+			if e.RRule != "" && !sameDay(row.RemindAt, e.Start, loc) {
+				row.fail("у повторяющегося события напоминание должно быть в день события")
+			}
+		case e.AllDay:
+			y, m, d := e.Start.Date()
+			row.RemindAt = time.Date(y, m, d, defaultHour, 0, 0, 0, e.Start.Location())
+		default:
+			row.RemindAt = e.Start
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// categoryTags превращает категории в теги: пробелы внутри категории
+// заменяются на «-», потому что тег — одно слово.
+func categoryTags(categories []string) []string {
+	out := make([]string, 0, len(categories))
+	for _, c := range categories {
+		out = append(out, strings.Join(strings.Fields(c), "-"))
+	}
+	return out
+}
+
+// icsPriority переводит PRIORITY (1 — высший, 9 — низший, 0 — не указан) в приоритет напоминания.
+func icsPriority(p int) model.ReminderPriority {
+	switch {
+	case p >= 1 && p <= 4:
+		return model.ReminderPriorityHigh
+	case p >= 6 && p <= 9:
+		return model.ReminderPriorityLow
+	default:
+		return model.ReminderPriorityNormal
+	}
+}
+
+func sameDay(a, b time.Time, loc *time.Location) bool {
+	ay, am, ad := a.In(loc).Date()
+	by, bm, bd := b.In(loc).Date()
+	return ay == by && am == bm && ad == bd
+}
@@ -0,0 +1,151 @@
+// Package importer разбирает файлы других приложений — календари iCalendar
+// и таблицы CSV — в строки импорта напоминаний и проверяет каждую строку.
+//
+// Строка с ошибками не импортируется, но остаётся в результате, чтобы
+// пользователь увидел причину в предпросмотре.
+package importer
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/vovanwin/template/internal/model"
+	"github.com/vovanwin/template/internal/pkg/recurrence"
+)
+
+const (
+	// MaxFileSize — максимальный размер файла импорта.
+	MaxFileSize = 1 << 20
+	// MaxRows — сколько напоминаний можно импортировать за раз.
+	MaxRows = 500
+	// MaxTitleLen — длина названия, которую вмещает reminders.title.
+	MaxTitleLen = 255
+	// defaultHour — время напоминания для даты без времени, как в nldate.
+	defaultHour = 9
+)
+
+var (
+	// ErrUnknownFormat — файл не похож ни на iCalendar, ни на CSV.
+	ErrUnknownFormat = errors.New("import: unknown file format")
+	// ErrInvalidFile — файл нужного формата, но его не удалось разобрать.
+	ErrInvalidFile = errors.New("import: invalid file")
+	// ErrTooLarge — файл больше MaxFileSize или в нём больше MaxRows записей.
+	ErrTooLarge = errors.New("import: file too large")
+)
+
+// Format — формат файла импорта.
+type Format string
+
+const (
+	FormatICS Format = "ics"
+	FormatCSV Format = "csv"
+)
+
+// Row — напоминание из одной строки CSV или одной записи календаря.
+type Row struct {
+	// Line — номер строки CSV или порядковый номер записи календаря.
+	Line        int
+	Title       string
+	Description string
+	RemindAt    time.Time
+	// RecurrenceRule — нормализованное правило повторения; пусто — разовое напоминание.
+	RecurrenceRule string
+	Tags           []string
+	Priority       model.ReminderPriority
+	// Errors — причины, по которым строка не будет импортирована.
+	Errors []string
+}
+
+// Valid сообщает, можно ли импортировать строку.
+func (r *Row) Valid() bool {
+	return len(r.Errors) == 0
+}
+
+func (r *Row) fail(format string, args ...any) {
+	r.Errors = append(r.Errors, fmt.Sprintf(format, args...))
+}
+
+// DetectFormat определяет формат по содержимому, а для CSV — и по расширению.
+func DetectFormat(filename string, data []byte) (Format, error) {
+	head := bytes.TrimLeft(bytes.TrimPrefix(data, []byte("\ufeff")), " \t\r\n")
+	if len(head) >= len("BEGIN:VCALENDAR") && strings.EqualFold(string(head[:len("BEGIN:VCALENDAR")]), "BEGIN:VCALENDAR") {
+		return FormatICS, nil
+	}
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".csv", ".txt", "":
+		return FormatCSV, nil
+	case ".ics", ".ical", ".ifb":
+		return "", fmt.Errorf("%w: no BEGIN:VCALENDAR", ErrInvalidFile)
+	}
+	return "", ErrUnknownFormat
+}
+
+// Parse разбирает файл и проверяет строки. Время без таймзоны относится
+// к loc; разовые напоминания в прошлом относительно now отклоняются.
+func Parse(filename string, data []byte, now time.Time, loc *time.Location) ([]Row, error) {
+	if len(data) > MaxFileSize {
+		return nil, fmt.Errorf("%w: more than %d bytes", ErrTooLarge, MaxFileSize)
+	}
+	format, err := DetectFormat(filename, data)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []Row
+	switch format {
+	case FormatICS:
+		rows, err = parseICS(data, loc)
+	default:
+		rows, err = parseCSV(data, now, loc)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) > MaxRows {
+		return nil, fmt.Errorf("%w: more than %d reminders", ErrTooLarge, MaxRows)
+	}
+
+	for i := range rows {
+		validate(&rows[i], now, loc)
+	}
+	return rows, nil
+}
+
+// validate проверяет общие для форматов правила.
+func validate(row *Row, now time.Time, loc *time.Location) {
+	row.Title = strings.TrimSpace(row.Title)
+	switch {
+	case row.Title == "":
+		row.fail("нет названия")
+	case len([]rune(row.Title)) > MaxTitleLen:
+		row.fail("название длиннее %d символов", MaxTitleLen)
+	}
+	if row.RemindAt.IsZero() {
+		return
+	}
+
+	if row.RecurrenceRule == "" {
+		if !row.RemindAt.After(now) {
+			row.fail("время %s уже прошло", row.RemindAt.In(loc).Format("02.01.2006 15:04"))
+		}
+		return
+	}
+
+	rule, err := recurrence.Parse(row.RecurrenceRule)
+	if err == nil {
+		_, err = rule.ScheduleSpec(row.RemindAt, loc)
+	}
+	if err != nil {
+		row.fail("неподдерживаемое правило повторения %q", row.RecurrenceRule)
+		return
+	}
+	if !rule.Until.IsZero() && rule.Until.Before(now) {
+		row.fail("повторения закончились %s", rule.Until.In(loc).Format("02.01.2006"))
+		return
+	}
+	row.RecurrenceRule = rule.String()
+}
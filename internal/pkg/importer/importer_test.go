@@ -0,0 +1,128 @@
+package importer
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/vovanwin/template/internal/model"
+)
+
+var (
+	msk = time.FixedZone("MSK", 3*60*60)
+	// now — понедельник, 19.10.2026 10:00 MSK.
+	now = time.Date(2026, 10, 19, 10, 0, 0, 0, msk)
+)
+
+func TestParseCSV(t *testing.T) {
+	data := "\ufeffНазвание;Когда;Повтор;Теги;Приоритет;Заметка\n" +
+		"Купить молоко;20.10.2026 09:00;;дом, покупки;высокий;x\n" +
+		"Планёрка;каждый понедельник в 10;;работа;;\n" +
+		"Отчёт;2026-10-23;FREQ=MONTHLY;;low;\n" +
+		";завтра;;;;\n" +
+		"Старое;01.01.2020 10:00;;;;\n" +
+		"Что-то;когда-нибудь;;;срочно;\n"
+
+	rows, err := Parse("reminders.csv", []byte(data), now, msk)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if len(rows) != 6 {
+		t.Fatalf("got %d rows, want 6", len(rows))
+	}
+
+	want := []struct {
+		line     int
+		at       string
+		rule     string
+		priority model.ReminderPriority
+		errors   int
+	}{
+		{2, "20.10.2026 09:00", "", model.ReminderPriorityHigh, 0},
+		{3, "26.10.2026 10:00", "FREQ=WEEKLY;BYDAY=MO", model.ReminderPriorityNormal, 0},
+		{4, "23.10.2026 09:00", "FREQ=MONTHLY", model.ReminderPriorityLow, 0},
+		{5, "20.10.2026 09:00", "", model.ReminderPriorityNormal, 1},
+		{6, "01.01.2020 10:00", "", model.ReminderPriorityNormal, 1},
+		{7, "", "", model.ReminderPriorityNormal, 2},
+	}
+	for i, w := range want {
+		r := rows[i]
+		at := ""
+		if !r.RemindAt.IsZero() {
+			at = r.RemindAt.In(msk).Format("02.01.2006 15:04")
+		}
+		if r.Line != w.line || at != w.at || r.RecurrenceRule != w.rule || r.Priority != w.priority || len(r.Errors) != w.errors {
+			t.Errorf("row %d = line %d, %q, %q, %s, errors %q", i, r.Line, at, r.RecurrenceRule, r.Priority, r.Errors)
+		}
+	}
+	if strings.Join(rows[0].Tags, ",") != "дом,покупки" {
+		t.Errorf("tags = %v", rows[0].Tags)
+	}
+}
+
+func TestParseICS(t *testing.T) {
+	data := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"BEGIN:VEVENT",
+		"SUMMARY:Встреча",
+		"DTSTART:20261021T120000Z",
+		"CATEGORIES:Рабочие встречи",
+		"PRIORITY:1",
+		"BEGIN:VALARM",
+		"ACTION:DISPLAY",
+		"TRIGGER:-PT30M",
+		"END:VALARM",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"SUMMARY:День рождения",
+		"DTSTART;VALUE=DATE:20261101",
+		"RRULE:FREQ=YEARLY",
+		"END:VEVENT",
+		"BEGIN:VTODO",
+		"SUMMARY:Сдать отчёт",
+		"DUE;VALUE=DATE:20261030",
+		"STATUS:COMPLETED",
+		"END:VTODO",
+		"END:VCALENDAR",
+	}, "\r\n")
+
+	rows, err := Parse("calendar.ics", []byte(data), now, msk)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if len(rows) != 3 {
+		t.Fatalf("got %d rows, want 3", len(rows))
+	}
+
+	meeting := rows[0]
+	if !meeting.Valid() || !meeting.RemindAt.Equal(time.Date(2026, 10, 21, 14, 30, 0, 0, msk)) {
+		t.Errorf("meeting = %+v", meeting)
+	}
+	if meeting.Priority != model.ReminderPriorityHigh || strings.Join(meeting.Tags, ",") != "рабочие-встречи" {
+		t.Errorf("meeting priority/tags = %s %v", meeting.Priority, meeting.Tags)
+	}
+	if rows[1].Valid() {
+		t.Errorf("yearly event accepted: %+v", rows[1])
+	}
+	if rows[2].Valid() {
+		t.Errorf("completed task accepted: %+v", rows[2])
+	}
+}
+
+func TestParseRejectsFile(t *testing.T) {
+	cases := []struct {
+		name, data string
+		want       error
+	}{
+		{"data.xlsx", "PK...", ErrUnknownFormat},
+		{"cal.ics", "title,remind_at\n", ErrInvalidFile},
+		{"data.csv", "title,notes\nx,y\n", ErrInvalidFile},
+		{"data.csv", "title,remind_at\n" + strings.Repeat("x,завтра\n", MaxRows+1), ErrTooLarge},
+	}
+	for _, c := range cases {
+		if _, err := Parse(c.name, []byte(c.data), now, msk); !errors.Is(err, c.want) {
+			t.Errorf("Parse(%s) err = %v, want %v", c.name, err, c.want)
+		}
+	}
+}
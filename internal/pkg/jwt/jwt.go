@@ -13,9 +13,25 @@ type JWTClaims struct {
 	UserID    string `json:"user_id"`
 	UserEmail string `json:"user_email"`
 	TokenType string `json:"token_type"` // "access" или "refresh"
+	// Cnf — привязка токена к ключу клиента (DPoP), nil для bearer токенов
+	Cnf *Confirmation `json:"cnf,omitempty"`
 	jwt.RegisteredClaims
 }
 
+// Confirmation представляет claim cnf (RFC 7800)
+type Confirmation struct {
+	// JKT — thumbprint публичного ключа клиента (RFC 9449)
+	JKT string `json:"jkt"`
+}
+
+// BoundJKT возвращает thumbprint ключа, к которому привязан токен, или пустую строку
+func (c *JWTClaims) BoundJKT() string {
+	if c.Cnf == nil {
+		return ""
+	}
+	return c.Cnf.JKT
+}
+
 // TokenPair представляет пару токенов
 type TokenPair struct {
 	AccessToken  string
@@ -25,6 +41,7 @@ type TokenPair struct {
 // JWTService интерфейс для работы с JWT токенами
 type JWTService interface {
 	GenerateTokenPair(userID, userEmail string) (*TokenPair, error)
+	GenerateBoundTokenPair(userID, userEmail, jkt string) (*TokenPair, error)
 	GenerateToken(userID, userEmail string) (string, error) // оставляем для обратной совместимости
 	ValidateToken(tokenString string) (*JWTClaims, error)
 	RefreshTokens(refreshToken string) (*TokenPair, error)
@@ -48,14 +65,20 @@ func NewJWTService(secretKey string, tokenTTL, refreshTTL time.Duration) JWTServ
 
 // GenerateTokenPair создает пару access и refresh токенов
 func (j *DefaultJWTService) GenerateTokenPair(userID, userEmail string) (*TokenPair, error) {
+	return j.GenerateBoundTokenPair(userID, userEmail, "")
+}
+
+// GenerateBoundTokenPair создает пару токенов, привязанных к ключу клиента (DPoP).
+// При пустом jkt токены выпускаются как обычные bearer токены.
+func (j *DefaultJWTService) GenerateBoundTokenPair(userID, userEmail, jkt string) (*TokenPair, error) {
 	// Генерируем access токен
-	accessToken, err := j.generateTokenWithType(userID, userEmail, "access", j.tokenTTL)
+	accessToken, err := j.generateTokenWithType(userID, userEmail, "access", jkt, j.tokenTTL)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate access token: %w", err)
 	}
 
 	// Генерируем refresh токен
-	refreshToken, err := j.generateTokenWithType(userID, userEmail, "refresh", j.refreshTTL)
+	refreshToken, err := j.generateTokenWithType(userID, userEmail, "refresh", jkt, j.refreshTTL)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate refresh token: %w", err)
 	}
@@ -68,11 +91,11 @@ func (j *DefaultJWTService) GenerateTokenPair(userID, userEmail string) (*TokenP
 
 // GenerateToken создает новый JWT токен (для обратной совместимости)
 func (j *DefaultJWTService) GenerateToken(userID, userEmail string) (string, error) {
-	return j.generateTokenWithType(userID, userEmail, "access", j.tokenTTL)
+	return j.generateTokenWithType(userID, userEmail, "access", "", j.tokenTTL)
 }
 
 // generateTokenWithType создает токен определённого типа
-func (j *DefaultJWTService) generateTokenWithType(userID, userEmail, tokenType string, ttl time.Duration) (string, error) {
+func (j *DefaultJWTService) generateTokenWithType(userID, userEmail, tokenType, jkt string, ttl time.Duration) (string, error) {
 	claims := JWTClaims{
 		UserID:    userID,
 		UserEmail: userEmail,
@@ -83,6 +106,9 @@ func (j *DefaultJWTService) generateTokenWithType(userID, userEmail, tokenType s
 			NotBefore: jwt.NewNumericDate(time.Now()),
 		},
 	}
+	if jkt != "" {
+		claims.Cnf = &Confirmation{JKT: jkt}
+	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 	tokenString, err := token.SignedString(j.secretKey)
@@ -126,8 +152,8 @@ func (j *DefaultJWTService) RefreshTokens(refreshTokenString string) (*TokenPair
 		return nil, fmt.Errorf("token is not a refresh token")
 	}
 
-	// Генерируем новую пару токенов
-	return j.GenerateTokenPair(claims.UserID, claims.UserEmail)
+	// Генерируем новую пару токенов, сохраняя привязку к ключу
+	return j.GenerateBoundTokenPair(claims.UserID, claims.UserEmail, claims.BoundJKT())
 }
 
 // GetUserIDFromContext извлекает ID пользователя из контекста
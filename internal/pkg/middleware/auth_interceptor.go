@@ -4,6 +4,7 @@ import (
 	"context"
 	"strings"
 
+	"github.com/vovanwin/template/internal/pkg/dpop"
 	"github.com/vovanwin/template/internal/pkg/jwt"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -23,7 +24,9 @@ var publicMethods = map[string]bool{
 }
 
 // AuthInterceptor создаёт unary gRPC interceptor для JWT авторизации.
-func AuthInterceptor(jwtService jwt.JWTService, authBypass bool) grpc.UnaryServerInterceptor {
+// Если передан dpopVerifier, для access токенов с привязкой к ключу (cnf.jkt)
+// дополнительно требуется DPoP proof этим ключом.
+func AuthInterceptor(jwtService jwt.JWTService, dpopVerifier *dpop.Verifier, authBypass bool) grpc.UnaryServerInterceptor {
 	return func(
 		ctx context.Context,
 		req interface{},
@@ -51,8 +54,8 @@ func AuthInterceptor(jwtService jwt.JWTService, authBypass bool) grpc.UnaryServe
 			return nil, status.Error(codes.Unauthenticated, "missing authorization header")
 		}
 
-		token := strings.TrimPrefix(authHeader[0], "Bearer ")
-		if token == authHeader[0] {
+		scheme, token, found := strings.Cut(authHeader[0], " ")
+		if !found || (scheme != "Bearer" && scheme != "DPoP") {
 			return nil, status.Error(codes.Unauthenticated, "invalid authorization format")
 		}
 
@@ -65,6 +68,19 @@ func AuthInterceptor(jwtService jwt.JWTService, authBypass bool) grpc.UnaryServe
 			return nil, status.Error(codes.Unauthenticated, "not an access token")
 		}
 
+		if jkt := claims.BoundJKT(); jkt != "" && dpopVerifier != nil {
+			proofJKT, err := VerifyDPoP(ctx, dpopVerifier, token)
+			if err != nil {
+				return nil, status.Errorf(codes.Unauthenticated, "invalid dpop proof: %v", err)
+			}
+			if proofJKT == "" {
+				return nil, status.Error(codes.Unauthenticated, "dpop proof is required")
+			}
+			if proofJKT != jkt {
+				return nil, status.Error(codes.Unauthenticated, "dpop key does not match token")
+			}
+		}
+
 		ctx = context.WithValue(ctx, "user_id", claims.UserID)
 		ctx = context.WithValue(ctx, "user_email", claims.UserEmail)
 
@@ -0,0 +1,93 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/vovanwin/template/internal/pkg/dpop"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// dpopMetadataKey — ключ gRPC metadata с DPoP proof нативного gRPC-клиента.
+const dpopMetadataKey = "dpop"
+
+// dpopRequestKey — ключ контекста с DPoP proof и параметрами HTTP-запроса,
+// который кладёт DPoPMiddleware. Клиент не может подделать значение
+// контекста, в отличие от metadata, которую он присылает сам.
+type dpopRequestKey struct{}
+
+// dpopHTTPRequest — proof и параметры исходного HTTP-запроса (htm, htu).
+type dpopHTTPRequest struct {
+	proof, htm, htu string
+}
+
+// DPoPMiddleware кладёт заголовок DPoP, метод и URL запроса в контекст,
+// чтобы proof можно было проверить за grpc-gateway (htm/htu из исходного HTTP-запроса).
+// Gateway вызывает сервисы в том же процессе, поэтому контекст доходит до обработчика.
+func DPoPMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Не доверяем значениям, пришедшим от клиента напрямую
+		r.Header.Del("Grpc-Metadata-Dpop")
+		r.Header.Del("Grpc-Metadata-Dpop-Htm")
+		r.Header.Del("Grpc-Metadata-Dpop-Htu")
+
+		if proof := r.Header.Get(dpop.HeaderName); proof != "" {
+			scheme := "http"
+			if r.TLS != nil {
+				scheme = "https"
+			}
+			if proto := r.Header.Get("X-Forwarded-Proto"); proto != "" {
+				scheme = proto
+			}
+
+			ctx := context.WithValue(r.Context(), dpopRequestKey{}, dpopHTTPRequest{
+				proof: proof,
+				htm:   r.Method,
+				htu:   scheme + "://" + r.Host + r.URL.Path,
+			})
+			r = r.WithContext(ctx)
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// DPoPRequest возвращает DPoP proof и параметры запроса (htm, htu), которым он должен соответствовать.
+// За grpc-gateway они берутся из контекста DPoPMiddleware. Для нативных gRPC-клиентов
+// htm — "POST", htu — полное имя вызванного метода: их определяет сервер, а не metadata
+// клиента, иначе proof для одного метода можно было бы предъявить другому.
+func DPoPRequest(ctx context.Context) (proof, htm, htu string) {
+	if req, ok := ctx.Value(dpopRequestKey{}).(dpopHTTPRequest); ok {
+		return req.proof, req.htm, req.htu
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", "", ""
+	}
+	if v := md.Get(dpopMetadataKey); len(v) > 0 {
+		proof = v[0]
+	}
+	if proof == "" {
+		return "", "", ""
+	}
+
+	// Без имени метода htu пустой, и проверка proof не пройдёт.
+	method, _ := grpc.Method(ctx)
+	return proof, http.MethodPost, method
+}
+
+// VerifyDPoP проверяет DPoP proof текущего запроса.
+// Возвращает пустой thumbprint без ошибки, если клиент не прислал proof.
+func VerifyDPoP(ctx context.Context, verifier *dpop.Verifier, accessToken string) (string, error) {
+	proof, htm, htu := DPoPRequest(ctx)
+	if proof == "" {
+		return "", nil
+	}
+	p, err := verifier.Verify(proof, htm, htu, accessToken)
+	if err != nil {
+		return "", err
+	}
+	return p.JKT, nil
+}
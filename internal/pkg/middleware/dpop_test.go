@@ -0,0 +1,65 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/vovanwin/template/internal/pkg/dpop"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// methodStream сообщает grpc.Method имя вызванного метода, как сервер gRPC.
+type methodStream struct {
+	grpc.ServerTransportStream
+	method string
+}
+
+func (s methodStream) Method() string { return s.method }
+
+func TestDPoPRequestNativeGRPC(t *testing.T) {
+	const method = "/auth.v1.AuthService/RefreshToken"
+	ctx := grpc.NewContextWithServerTransportStream(context.Background(), methodStream{method: method})
+	// Клиент пытается выдать proof другого запроса за proof этого вызова.
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(
+		"dpop", "proof",
+		"dpop-htm", http.MethodGet,
+		"dpop-htu", "https://api.example.com/api/v1/profile",
+	))
+
+	proof, htm, htu := DPoPRequest(ctx)
+	if proof != "proof" || htm != http.MethodPost || htu != method {
+		t.Errorf("DPoPRequest = %q, %q, %q; want %q, %q, %q", proof, htm, htu, "proof", http.MethodPost, method)
+	}
+
+	if proof, _, _ := DPoPRequest(metadata.NewIncomingContext(context.Background(), metadata.Pairs("dpop-htu", "x"))); proof != "" {
+		t.Errorf("DPoPRequest without proof = %q, want empty", proof)
+	}
+}
+
+func TestDPoPMiddleware(t *testing.T) {
+	var proof, htm, htu string
+	var forged []string
+	handler := DPoPMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Gateway переносит заголовки Grpc-Metadata-* в metadata: подделку нужно убрать.
+		forged = r.Header.Values("Grpc-Metadata-Dpop-Htu")
+		ctx := metadata.NewIncomingContext(r.Context(), metadata.Pairs("dpop-htu", "https://evil.example.com/"))
+		proof, htm, htu = DPoPRequest(ctx)
+	}))
+
+	r := httptest.NewRequest(http.MethodPost, "http://api.example.com/api/v1/auth/refresh?x=1", nil)
+	r.Header.Set(dpop.HeaderName, "proof")
+	r.Header.Set("X-Forwarded-Proto", "https")
+	r.Header.Set("Grpc-Metadata-Dpop-Htu", "https://evil.example.com/")
+	handler.ServeHTTP(httptest.NewRecorder(), r)
+
+	if len(forged) != 0 {
+		t.Errorf("forged header passed through: %q", forged)
+	}
+	want := "https://api.example.com/api/v1/auth/refresh"
+	if proof != "proof" || htm != http.MethodPost || htu != want {
+		t.Errorf("DPoPRequest = %q, %q, %q; want %q, %q, %q", proof, htm, htu, "proof", http.MethodPost, want)
+	}
+}
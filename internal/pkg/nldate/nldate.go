@@ -0,0 +1,670 @@
+// Package nldate распознаёт время напоминания во фразе на русском или английском:
+// «завтра в 9», «через 2 часа», «в пятницу 18:30», «every Monday at 10».
+//
+// Выражение времени может стоять в любом месте фразы, остальные слова
+// возвращаются как название напоминания. Время считается в таймзоне
+// пользователя; «каждый понедельник» превращается в RRULE для пакета recurrence.
+package nldate
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrNoDate — во фразе нет выражения времени.
+	ErrNoDate = errors.New("nldate: no date found")
+	// ErrAmbiguous — выражения времени противоречат друг другу («завтра в пятницу»).
+	ErrAmbiguous = errors.New("nldate: conflicting date expressions")
+	// ErrInvalidDate — дата не существует («31.02»).
+	ErrInvalidDate = errors.New("nldate: invalid date")
+)
+
+// defaultHour — час срабатывания, если указан только день.
+const defaultHour = 9
+
+// Result — распознанное время напоминания.
+type Result struct {
+	// At — первое срабатывание в таймзоне пользователя.
+	At time.Time
+	// Rule — правило повторения (RRULE); пусто для разового напоминания.
+	Rule string
+	// Rest — фраза без выражения времени.
+	Rest string
+}
+
+// Parse ищет выражение времени в text относительно now в таймзоне loc.
+// День без времени означает 09:00, время без дня — ближайшее будущее.
+func Parse(text string, now time.Time, loc *time.Location) (Result, error) {
+	words := strings.Fields(text)
+	p := &parser{tokens: make([]string, len(words))}
+	for i, w := range words {
+		p.tokens[i] = normalize(w)
+	}
+
+	used := make([]bool, len(words))
+	for i := 0; i < len(words); {
+		n := p.match(i, "")
+		if n == 0 {
+			i++
+			continue
+		}
+		for k := i; k < i+n; k++ {
+			used[k] = true
+		}
+		i += n
+	}
+	if !p.found {
+		return Result{}, ErrNoDate
+	}
+
+	at, err := p.resolve(now.In(loc))
+	if err != nil {
+		return Result{}, err
+	}
+
+	rest := make([]string, 0, len(words))
+	for i, w := range words {
+		if !used[i] {
+			rest = append(rest, w)
+		}
+	}
+	return Result{
+		At:   at,
+		Rule: p.rule,
+		Rest: strings.Trim(strings.Join(rest, " "), " ,:-—–"),
+	}, nil
+}
+
+// Время суток, уточняющее час: «в 7 вечера», «9pm».
+const (
+	partNone = iota
+	partAM
+	partAfternoon
+	partPM
+	partNight
+)
+
+// parser накапливает найденные части выражения.
+type parser struct {
+	tokens []string
+	found  bool
+	// specs — сколько раз задан день; больше одного — противоречие.
+	specs    int
+	conflict bool
+
+	offset    time.Duration
+	hasOffset bool
+
+	days      int
+	hasDays   bool
+	keepClock bool // «через 3 дня» без времени — то же время суток, что сейчас
+
+	weekday    time.Weekday
+	hasWeekday bool
+	nextWeek   bool // «следующий понедельник» — не сегодня
+	workdays   bool
+
+	year, month, day int // year 0 — ближайшая такая дата
+	hasDate          bool
+
+	hour, minute int
+	hasTime      bool
+	part         int
+	partHour     int // час для «утром», «вечером» без точного времени
+
+	rule string
+}
+
+var (
+	prepositions = map[string]bool{"в": true, "во": true, "на": true, "по": true, "к": true, "at": true, "on": true, "by": true}
+	everyWords   = map[string]bool{"каждый": true, "каждую": true, "каждое": true, "каждого": true, "every": true, "each": true}
+	nextWords    = map[string]bool{"следующий": true, "следующую": true, "следующее": true, "следующая": true, "next": true}
+	hourWords    = map[string]bool{"час": true, "часа": true, "часов": true, "o'clock": true}
+	relDays      = map[string]int{"сегодня": 0, "today": 0, "завтра": 1, "tomorrow": 1, "послезавтра": 2}
+
+	partWords = map[string]int{"утра": partAM, "am": partAM, "дня": partAfternoon, "вечера": partPM, "pm": partPM, "ночи": partNight}
+	// dayParts — «утром», «вечером» без точного времени: час по умолчанию и уточнение.
+	dayParts = map[string][2]int{
+		"утром":     {9, partAM},
+		"morning":   {9, partAM},
+		"днем":      {13, partAfternoon},
+		"afternoon": {13, partAfternoon},
+		"вечером":   {19, partPM},
+		"evening":   {19, partPM},
+		"ночью":     {23, partNight},
+	}
+
+	weekdays = map[string]time.Weekday{
+		"понедельник": time.Monday, "пн": time.Monday, "monday": time.Monday, "mon": time.Monday,
+		"вторник": time.Tuesday, "вт": time.Tuesday, "tuesday": time.Tuesday, "tue": time.Tuesday,
+		"среда": time.Wednesday, "среду": time.Wednesday, "ср": time.Wednesday, "wednesday": time.Wednesday, "wed": time.Wednesday,
+		"четверг": time.Thursday, "чт": time.Thursday, "thursday": time.Thursday, "thu": time.Thursday,
+		"пятница": time.Friday, "пятницу": time.Friday, "пт": time.Friday, "friday": time.Friday, "fri": time.Friday,
+		"суббота": time.Saturday, "субботу": time.Saturday, "сб": time.Saturday, "saturday": time.Saturday,
+		"воскресенье": time.Sunday, "вс": time.Sunday, "sunday": time.Sunday,
+	}
+	// weekdaysPlural — «по понедельникам», «on Mondays»: еженедельный повтор.
+	weekdaysPlural = map[string]time.Weekday{
+		"понедельникам": time.Monday, "mondays": time.Monday,
+		"вторникам": time.Tuesday, "tuesdays": time.Tuesday,
+		"средам": time.Wednesday, "wednesdays": time.Wednesday,
+		"четвергам": time.Thursday, "thursdays": time.Thursday,
+		"пятницам": time.Friday, "fridays": time.Friday,
+		"субботам": time.Saturday, "saturdays": time.Saturday,
+		"воскресеньям": time.Sunday, "sundays": time.Sunday,
+	}
+	weekdayCodes = [...]string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+	months = map[string]int{
+		"января": 1, "january": 1, "jan": 1,
+		"февраля": 2, "february": 2, "feb": 2,
+		"марта": 3, "march": 3, "mar": 3,
+		"апреля": 4, "april": 4, "apr": 4,
+		"мая": 5, "may": 5,
+		"июня": 6, "june": 6, "jun": 6,
+		"июля": 7, "july": 7, "jul": 7,
+		"августа": 8, "august": 8, "aug": 8,
+		"сентября": 9, "september": 9, "sep": 9, "sept": 9,
+		"октября": 10, "october": 10, "oct": 10,
+		"ноября": 11, "november": 11, "nov": 11,
+		"декабря": 12, "december": 12, "dec": 12,
+	}
+
+	numbers = map[string]int{
+		"один": 1, "одну": 1, "одна": 1, "a": 1, "an": 1, "one": 1,
+		"два": 2, "две": 2, "пару": 2, "two": 2, "couple": 2,
+		"три": 3, "three": 3, "четыре": 4, "four": 4, "пять": 5, "five": 5,
+		"шесть": 6, "six": 6, "семь": 7, "seven": 7, "восемь": 8, "eight": 8,
+		"девять": 9, "nine": 9, "десять": 10, "ten": 10,
+		"пятнадцать": 15, "fifteen": 15, "двадцать": 20, "twenty": 20,
+		"тридцать": 30, "thirty": 30, "сорок": 40, "forty": 40,
+	}
+
+	unitMinute = time.Minute
+	unitHour   = time.Hour
+	unitDay    = 24 * time.Hour
+	unitWeek   = 7 * 24 * time.Hour
+	units      = map[string]time.Duration{
+		"минуту": unitMinute, "минуты": unitMinute, "минут": unitMinute, "мин": unitMinute,
+		"minute": unitMinute, "minutes": unitMinute, "min": unitMinute, "mins": unitMinute, "m": unitMinute,
+		"час": unitHour, "часа": unitHour, "часов": unitHour, "ч": unitHour,
+		"hour": unitHour, "hours": unitHour, "hr": unitHour, "hrs": unitHour, "h": unitHour,
+		"день": unitDay, "дня": unitDay, "дней": unitDay, "day": unitDay, "days": unitDay,
+		"неделю": unitWeek, "недели": unitWeek, "недель": unitWeek, "week": unitWeek, "weeks": unitWeek,
+	}
+)
+
+const (
+	ruleDaily    = "FREQ=DAILY"
+	ruleWeekly   = "FREQ=WEEKLY"
+	ruleWorkdays = "FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR"
+)
+
+func normalize(word string) string {
+	word = strings.ToLower(strings.Trim(word, ",.!?;:«»\"()"))
+	return strings.ReplaceAll(word, "ё", "е")
+}
+
+func (p *parser) at(i int) string {
+	if i < len(p.tokens) {
+		return p.tokens[i]
+	}
+	return ""
+}
+
+// match пытается распознать выражение с позиции i и возвращает число занятых слов.
+// prep — предлог перед выражением: после «в» и «at» голое число считается часом.
+func (p *parser) match(i int, prep string) int {
+	t := p.tokens[i]
+	if prep == "" && prepositions[t] && i+1 < len(p.tokens) {
+		if n := p.match(i+1, t); n > 0 {
+			return n + 1
+		}
+		return 0
+	}
+
+	if (prep == "в" || prep == "at") && strings.Count(t, ".") == 1 {
+		// «в 18.30» — время, а не дата
+		if n := p.matchClock(i, prep); n > 0 {
+			p.found = true
+			return n
+		}
+	}
+	for _, m := range []func(int) int{p.matchRecurrence, p.matchRelative, p.matchDay, p.matchWeekday, p.matchDate} {
+		if n := m(i); n > 0 {
+			p.found = true
+			return n
+		}
+	}
+	if n := p.matchClock(i, prep); n > 0 {
+		p.found = true
+		return n
+	}
+	if dp, ok := dayParts[t]; ok {
+		p.found = true
+		p.partHour, p.part = dp[0], dp[1]
+		return 1
+	}
+	return 0
+}
+
+// matchRecurrence — «каждый день», «every Monday», «по будням», «по пятницам».
+func (p *parser) matchRecurrence(i int) int {
+	t := p.tokens[i]
+	switch {
+	case t == "ежедневно" || t == "daily":
+		p.setRule(ruleDaily)
+		return 1
+	case t == "будням" || t == "weekdays":
+		p.setRule(ruleWorkdays)
+		p.workdays = true
+		return 1
+	}
+	if d, ok := weekdaysPlural[t]; ok {
+		p.setRule(ruleWeekly + ";BYDAY=" + weekdayCodes[d])
+		p.setWeekday(d)
+		return 1
+	}
+	if !everyWords[t] {
+		return 0
+	}
+
+	next := p.at(i + 1)
+	if d, ok := weekdays[next]; ok {
+		p.setRule(ruleWeekly + ";BYDAY=" + weekdayCodes[d])
+		p.setWeekday(d)
+		return 2
+	}
+	switch next {
+	case "день", "day":
+		p.setRule(ruleDaily)
+		return 2
+	case "утро", "morning":
+		p.setRule(ruleDaily)
+		p.partHour, p.part = 9, partAM
+		return 2
+	case "вечер", "evening":
+		p.setRule(ruleDaily)
+		p.partHour, p.part = 19, partPM
+		return 2
+	case "неделю", "week":
+		p.setRule(ruleWeekly)
+		return 2
+	case "weekday":
+		p.setRule(ruleWorkdays)
+		p.workdays = true
+		return 2
+	case "будний":
+		if p.at(i+2) == "день" {
+			p.setRule(ruleWorkdays)
+			p.workdays = true
+			return 3
+		}
+	}
+	return 0
+}
+
+// matchRelative — «через 2 часа», «через полчаса», «in 15 minutes», «in 2h».
+func (p *parser) matchRelative(i int) int {
+	t := p.tokens[i]
+	if t != "через" && t != "in" {
+		return 0
+	}
+	if p.at(i+1) == "полчаса" {
+		p.setOffset(30 * time.Minute)
+		return 2
+	}
+
+	count, n := 1, 1
+	if v, ok := parseNumber(p.at(i + 1)); ok {
+		count, n = v, 2
+	} else if v, unit, ok := splitCompact(p.at(i + 1)); ok {
+		// «2ч», «30m» — число и единица одним словом
+		p.applyRelative(v, unit)
+		return 2
+	}
+	unit, ok := units[p.at(i+n)]
+	if !ok || count <= 0 {
+		return 0
+	}
+	p.applyRelative(count, unit)
+	return n + 1
+}
+
+func (p *parser) applyRelative(count int, unit time.Duration) {
+	if unit >= unitDay {
+		// Дни считаются по календарю, чтобы «через 2 дня в 9» попадало на 9:00
+		p.setDays(count * int(unit/unitDay))
+		p.keepClock = true
+		return
+	}
+	p.setOffset(time.Duration(count) * unit)
+}
+
+// matchDay — «сегодня», «завтра», «послезавтра».
+func (p *parser) matchDay(i int) int {
+	d, ok := relDays[p.tokens[i]]
+	if !ok {
+		return 0
+	}
+	p.setDays(d)
+	return 1
+}
+
+// matchWeekday — «пятницу», «следующий понедельник», «next Friday».
+func (p *parser) matchWeekday(i int) int {
+	n := 0
+	next := nextWords[p.tokens[i]]
+	if next {
+		n = 1
+	}
+	d, ok := weekdays[p.at(i+n)]
+	if !ok {
+		return 0
+	}
+	p.setWeekday(d)
+	p.nextWeek = next
+	return n + 1
+}
+
+// matchDate — «20.10», «20.10.2026», «2026-10-20», «20 октября», «October 20th».
+func (p *parser) matchDate(i int) int {
+	t := p.tokens[i]
+	if y, m, d, ok := parseNumericDate(t); ok {
+		p.setDate(y, m, d)
+		return 1
+	}
+
+	if day, ok := parseDayOfMonth(t); ok {
+		if m, ok := months[p.at(i+1)]; ok {
+			y, n := p.yearAt(i + 2)
+			p.setDate(y, m, day)
+			return 2 + n
+		}
+		return 0
+	}
+	if m, ok := months[t]; ok {
+		if day, ok := parseDayOfMonth(p.at(i + 1)); ok {
+			y, n := p.yearAt(i + 2)
+			p.setDate(y, m, day)
+			return 2 + n
+		}
+	}
+	return 0
+}
+
+// yearAt возвращает год на позиции i, если он там указан.
+func (p *parser) yearAt(i int) (year, n int) {
+	t := strings.TrimSuffix(p.at(i), "г")
+	if len(t) == 4 && isDigits(t) {
+		year, _ = strconv.Atoi(t)
+		n = 1
+		if p.at(i+1) == "года" || p.at(i+1) == "г" {
+			n++
+		}
+		return year, n
+	}
+	return 0, 0
+}
+
+// matchClock — «18:30», «9am», «9 вечера», «9 часов утра»; голое число — только после «в» или «at».
+func (p *parser) matchClock(i int, prep string) int {
+	t := p.tokens[i]
+	if (prep == "в" || prep == "at") && strings.Count(t, ".") == 1 {
+		// «в 18.30» — время, а не дата
+		t = strings.Replace(t, ".", ":", 1)
+	}
+	hour, minute, part, ok := parseClock(t)
+	if !ok {
+		return 0
+	}
+
+	n := 1
+	if hourWords[p.at(i+n)] {
+		n++
+	}
+	if part == partNone {
+		if pw, ok := partWords[p.at(i+n)]; ok {
+			part = pw
+			n++
+		}
+	}
+	bare := !strings.Contains(t, ":") && part == partNone
+	if bare && n == 1 && prep != "в" && prep != "at" {
+		return 0
+	}
+	if (part == partAM || part == partPM) && hour > 12 {
+		return 0
+	}
+
+	if p.hasTime {
+		p.conflict = true
+	}
+	p.hour, p.minute, p.hasTime = hour, minute, true
+	if part != partNone {
+		p.part = part
+	}
+	return n
+}
+
+func (p *parser) setOffset(d time.Duration) {
+	p.specs++
+	p.offset, p.hasOffset = d, true
+}
+
+func (p *parser) setDays(d int) {
+	p.specs++
+	p.days, p.hasDays = d, true
+}
+
+func (p *parser) setWeekday(d time.Weekday) {
+	p.specs++
+	p.weekday, p.hasWeekday = d, true
+}
+
+func (p *parser) setDate(y, m, d int) {
+	p.specs++
+	p.year, p.month, p.day, p.hasDate = y, m, d, true
+}
+
+func (p *parser) setRule(rule string) {
+	if p.rule != "" && p.rule != rule {
+		p.conflict = true
+	}
+	p.rule = rule
+}
+
+// clock возвращает час и минуту с учётом времени суток.
+func (p *parser) clock() (int, int) {
+	h := p.hour
+	switch p.part {
+	case partAM, partNight:
+		if h == 12 {
+			h = 0
+		}
+	case partAfternoon:
+		// «в 2 дня» — 14:00, «в 12 дня» — полдень
+		if h >= 1 && h <= 6 {
+			h += 12
+		}
+	case partPM:
+		if h < 12 {
+			h += 12
+		}
+	}
+	return h, p.minute
+}
+
+// resolve превращает найденные части в момент времени.
+func (p *parser) resolve(now time.Time) (time.Time, error) {
+	if p.conflict || p.specs > 1 || (p.hasOffset && (p.hasTime || p.rule != "")) {
+		return time.Time{}, ErrAmbiguous
+	}
+	if p.hasOffset {
+		return now.Add(p.offset).Truncate(time.Minute), nil
+	}
+
+	hour, minute := defaultHour, 0
+	switch {
+	case p.hasTime:
+		hour, minute = p.clock()
+	case p.partHour > 0:
+		hour = p.partHour
+	case p.keepClock:
+		hour, minute = now.Hour(), now.Minute()
+	}
+
+	loc := now.Location()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+	at := func(day time.Time) time.Time {
+		return time.Date(day.Year(), day.Month(), day.Day(), hour, minute, 0, 0, loc)
+	}
+
+	switch {
+	case p.hasDate:
+		year := p.year
+		if year == 0 {
+			year = now.Year()
+		}
+		t := time.Date(year, time.Month(p.month), p.day, hour, minute, 0, 0, loc)
+		if t.Day() != p.day || t.Month() != time.Month(p.month) {
+			return time.Time{}, ErrInvalidDate
+		}
+		if p.year == 0 && !t.After(now) {
+			t = t.AddDate(1, 0, 0)
+		}
+		return t, nil
+	case p.hasDays:
+		return at(today.AddDate(0, 0, p.days)), nil
+	}
+
+	// День недели, будни или только время — ближайший подходящий день в будущем
+	start := today
+	if p.nextWeek {
+		start = today.AddDate(0, 0, 1)
+	}
+	for k := 0; k < 8; k++ {
+		day := start.AddDate(0, 0, k)
+		wd := day.Weekday()
+		if p.hasWeekday && wd != p.weekday {
+			continue
+		}
+		if p.workdays && (wd == time.Saturday || wd == time.Sunday) {
+			continue
+		}
+		if t := at(day); t.After(now) {
+			return t, nil
+		}
+	}
+	return at(start), nil
+}
+
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func parseNumber(s string) (int, bool) {
+	if isDigits(s) && len(s) <= 3 {
+		v, _ := strconv.Atoi(s)
+		return v, true
+	}
+	v, ok := numbers[s]
+	return v, ok
+}
+
+// splitCompact разбирает «2ч», «30мин», «2h».
+func splitCompact(s string) (int, time.Duration, bool) {
+	i := 0
+	for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+		i++
+	}
+	if i == 0 || i == len(s) || i > 3 {
+		return 0, 0, false
+	}
+	unit, ok := units[s[i:]]
+	if !ok {
+		return 0, 0, false
+	}
+	v, _ := strconv.Atoi(s[:i])
+	return v, unit, v > 0
+}
+
+// parseClock разбирает «18:30», «9», «9am», «9:30pm».
+func parseClock(s string) (hour, minute, part int, ok bool) {
+	switch {
+	case strings.HasSuffix(s, "am"):
+		part, s = partAM, strings.TrimSuffix(s, "am")
+	case strings.HasSuffix(s, "pm"):
+		part, s = partPM, strings.TrimSuffix(s, "pm")
+	}
+	hs, ms, hasMinutes := strings.Cut(s, ":")
+	if !isDigits(hs) || len(hs) > 2 {
+		return 0, 0, 0, false
+	}
+	hour, _ = strconv.Atoi(hs)
+	if hasMinutes {
+		if !isDigits(ms) || len(ms) != 2 {
+			return 0, 0, 0, false
+		}
+		minute, _ = strconv.Atoi(ms)
+	}
+	return hour, minute, part, hour <= 23 && minute <= 59
+}
+
+// parseDayOfMonth разбирает число месяца: «20», «20th».
+func parseDayOfMonth(s string) (int, bool) {
+	for _, suffix := range []string{"st", "nd", "rd", "th", "-го", "го"} {
+		s = strings.TrimSuffix(s, suffix)
+	}
+	if !isDigits(s) || len(s) > 2 {
+		return 0, false
+	}
+	d, _ := strconv.Atoi(s)
+	return d, d >= 1 && d <= 31
+}
+
+// parseNumericDate разбирает «20.10», «20.10.2026» и «2026-10-20».
+func parseNumericDate(s string) (year, month, day int, ok bool) {
+	if parts := strings.Split(s, "-"); len(parts) == 3 && len(parts[0]) == 4 {
+		if !isDigits(parts[0]) || !isDigits(parts[1]) || !isDigits(parts[2]) {
+			return 0, 0, 0, false
+		}
+		year, _ = strconv.Atoi(parts[0])
+		month, _ = strconv.Atoi(parts[1])
+		day, _ = strconv.Atoi(parts[2])
+		return year, month, day, month >= 1 && month <= 12 && day >= 1 && day <= 31
+	}
+
+	parts := strings.Split(s, ".")
+	if len(parts) < 2 || len(parts) > 3 {
+		return 0, 0, 0, false
+	}
+	for _, part := range parts {
+		if !isDigits(part) {
+			return 0, 0, 0, false
+		}
+	}
+	if len(parts[0]) > 2 || len(parts[1]) > 2 {
+		return 0, 0, 0, false
+	}
+	day, _ = strconv.Atoi(parts[0])
+	month, _ = strconv.Atoi(parts[1])
+	if len(parts) == 3 {
+		if len(parts[2]) != 4 {
+			return 0, 0, 0, false
+		}
+		year, _ = strconv.Atoi(parts[2])
+	}
+	return year, month, day, month >= 1 && month <= 12 && day >= 1 && day <= 31
+}
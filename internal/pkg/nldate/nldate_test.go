@@ -0,0 +1,78 @@
+package nldate
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+var (
+	msk = time.FixedZone("MSK", 3*60*60)
+	// now — понедельник, 19.10.2026 10:00 MSK.
+	now = time.Date(2026, 10, 19, 10, 0, 0, 0, msk)
+)
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		in   string
+		at   string
+		rule string
+		rest string
+	}{
+		{"завтра в 9 купить молоко", "20.10.2026 09:00", "", "купить молоко"},
+		{"позвонить маме через 2 часа", "19.10.2026 12:00", "", "позвонить маме"},
+		{"через полчаса", "19.10.2026 10:30", "", ""},
+		{"в пятницу 18:30 — отчёт", "23.10.2026 18:30", "", "отчёт"},
+		{"в 7 вечера", "19.10.2026 19:00", "", ""},
+		{"в 9", "20.10.2026 09:00", "", ""},
+		{"в 18.30 созвон", "19.10.2026 18:30", "", "созвон"},
+		{"20.10 в 9", "20.10.2026 09:00", "", ""},
+		{"1 января", "01.01.2027 09:00", "", ""},
+		{"через 3 дня в 8", "22.10.2026 08:00", "", ""},
+		{"через 3 дня", "22.10.2026 10:00", "", ""},
+		{"сегодня вечером", "19.10.2026 19:00", "", ""},
+		{"в понедельник", "26.10.2026 09:00", "", ""},
+		{"в понедельник в 11", "19.10.2026 11:00", "", ""},
+		{"каждый понедельник в 10 планёрка", "26.10.2026 10:00", "FREQ=WEEKLY;BYDAY=MO", "планёрка"},
+		{"по будням в 8:15", "20.10.2026 08:15", "FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR", ""},
+		{"every Monday", "26.10.2026 09:00", "FREQ=WEEKLY;BYDAY=MO", ""},
+		{"call Bob tomorrow at 5pm", "20.10.2026 17:00", "", "call Bob"},
+		{"in 15 minutes", "19.10.2026 10:15", "", ""},
+		{"next friday", "23.10.2026 09:00", "", ""},
+		{"October 20th at 9:30am", "20.10.2026 09:30", "", ""},
+		{"купить 2 хлеба завтра", "20.10.2026 09:00", "", "купить 2 хлеба"},
+	}
+	for _, c := range cases {
+		res, err := Parse(c.in, now, msk)
+		if err != nil {
+			t.Errorf("Parse(%q): %v", c.in, err)
+			continue
+		}
+		if got := res.At.Format("02.01.2006 15:04"); got != c.at {
+			t.Errorf("Parse(%q).At = %s, want %s", c.in, got, c.at)
+		}
+		if res.Rule != c.rule {
+			t.Errorf("Parse(%q).Rule = %q, want %q", c.in, res.Rule, c.rule)
+		}
+		if res.Rest != c.rest {
+			t.Errorf("Parse(%q).Rest = %q, want %q", c.in, res.Rest, c.rest)
+		}
+	}
+}
+
+func TestParseRejects(t *testing.T) {
+	cases := map[string]error{
+		"купить молоко":         ErrNoDate,
+		"встреча at home":       ErrNoDate,
+		"завтра в пятницу":      ErrAmbiguous,
+		"через 2 часа в 9":      ErrAmbiguous,
+		"в 9 в 10":              ErrAmbiguous,
+		"31.02":                 ErrInvalidDate,
+		"каждый день через час": ErrAmbiguous,
+	}
+	for in, want := range cases {
+		if _, err := Parse(in, now, msk); !errors.Is(err, want) {
+			t.Errorf("Parse(%q) err = %v, want %v", in, err, want)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vovanwin/template/internal/pkg/centrifugo"
+)
+
+// InAppNotifier публикует уведомление в персональный канал Centrifugo.
+type InAppNotifier struct {
+	client *centrifugo.Client
+}
+
+func NewInAppNotifier(client *centrifugo.Client) *InAppNotifier {
+	return &InAppNotifier{client: client}
+}
+
+// inAppEvent — сообщение в канале; поля type и message совместимы с events.Event.
+type inAppEvent struct {
+	Type                string `json:"type"`
+	Message             string `json:"message"`
+	ReminderID          string `json:"reminder_id"`
+	RequireConfirmation bool   `json:"require_confirmation"`
+}
+
+func (n *InAppNotifier) Send(ctx context.Context, to Recipient, msg Message) error {
+	if to.UserID == "" {
+		return fmt.Errorf("%w: %s", ErrNoAddress, ChannelInApp)
+	}
+	return n.client.Publish(ctx, centrifugo.PersonalChannel(to.UserID), inAppEvent{
+		Type:                "reminder",
+		Message:             "🔔 " + msg.Text(),
+		ReminderID:          msg.ReminderID,
+		RequireConfirmation: msg.RequireConfirmation,
+	})
+}
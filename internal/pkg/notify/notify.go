@@ -0,0 +1,125 @@
+// Package notify описывает каналы доставки напоминаний и их отправщики.
+//
+// Каждый канал (Telegram, in-app через Centrifugo, email, исходящий webhook)
+// реализует Notifier. Порядок каналов задаётся пользователем: workflow
+// напоминания пробует их по очереди, пока один не доставит уведомление.
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/vovanwin/template/internal/model"
+)
+
+// Channel — канал доставки уведомления.
+type Channel string
+
+const (
+	ChannelTelegram Channel = "telegram"
+	ChannelInApp    Channel = "inapp"
+	ChannelEmail    Channel = "email"
+	ChannelWebhook  Channel = "webhook"
+)
+
+// All — все поддерживаемые каналы в порядке отображения.
+var All = []Channel{ChannelTelegram, ChannelInApp, ChannelEmail, ChannelWebhook}
+
+// DefaultChannels — порядок каналов для пользователя без настроек.
+var DefaultChannels = []Channel{ChannelTelegram, ChannelInApp}
+
+var (
+	// ErrUnknownChannel — канал не поддерживается.
+	ErrUnknownChannel = errors.New("notify: unknown channel")
+	// ErrNoAddress — у получателя нет адреса в этом канале.
+	ErrNoAddress = errors.New("notify: recipient has no address for channel")
+	// ErrNotConfigured — канал не настроен на сервере.
+	ErrNotConfigured = errors.New("notify: channel is not configured")
+)
+
+// Label возвращает название канала для UI.
+func (c Channel) Label() string {
+	switch c {
+	case ChannelTelegram:
+		return "Telegram"
+	case ChannelInApp:
+		return "В приложении"
+	case ChannelEmail:
+		return "Email"
+	case ChannelWebhook:
+		return "Webhook"
+	default:
+		return string(c)
+	}
+}
+
+// Parse разбирает имя канала.
+func Parse(s string) (Channel, error) {
+	c := Channel(strings.ToLower(strings.TrimSpace(s)))
+	if !slices.Contains(All, c) {
+		return "", fmt.Errorf("%w: %q", ErrUnknownChannel, s)
+	}
+	return c, nil
+}
+
+// ParseList разбирает упорядоченный список каналов, отбрасывая повторы.
+func ParseList(values []string) ([]Channel, error) {
+	channels := make([]Channel, 0, len(values))
+	for _, v := range values {
+		c, err := Parse(v)
+		if err != nil {
+			return nil, err
+		}
+		if !slices.Contains(channels, c) {
+			channels = append(channels, c)
+		}
+	}
+	return channels, nil
+}
+
+// Strings превращает список каналов в строки для БД и proto.
+func Strings(channels []Channel) []string {
+	out := make([]string, len(channels))
+	for i, c := range channels {
+		out[i] = string(c)
+	}
+	return out
+}
+
+// Recipient — адреса получателя в каналах.
+type Recipient struct {
+	UserID     string
+	ChatID     int64
+	Email      string
+	WebhookURL string
+}
+
+// Message — уведомление о напоминании.
+type Message struct {
+	ReminderID          string
+	Title               string
+	Description         string
+	RequireConfirmation bool
+	Priority            model.ReminderPriority
+}
+
+// Text возвращает текст уведомления без разметки.
+// Напоминание с высоким приоритетом помечается «❗».
+func (m Message) Text() string {
+	title := m.Title
+	if m.Priority == model.ReminderPriorityHigh {
+		title = "❗ " + title
+	}
+	if m.Description == "" {
+		return title
+	}
+	return title + "\n\n" + m.Description
+}
+
+// Notifier отправляет уведомление в свой канал.
+type Notifier interface {
+	Send(ctx context.Context, to Recipient, msg Message) error
+}
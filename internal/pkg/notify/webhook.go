@@ -0,0 +1,158 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// SignatureHeader — заголовок с HMAC-SHA256 подписью тела запроса.
+const SignatureHeader = "X-Reminder-Signature"
+
+// ErrForbiddenAddress — webhook указывает во внутреннюю сеть сервера.
+var ErrForbiddenAddress = errors.New("notify: webhook address is not allowed")
+
+// sharedAddressSpace — адреса провайдерского NAT (RFC 6598), тоже не публичные.
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// WebhookNotifier отправляет уведомление POST-запросом на URL пользователя.
+type WebhookNotifier struct {
+	secret []byte
+	http   *http.Client
+}
+
+// NewWebhookNotifier создаёт отправщик; пустой secret отключает подпись.
+// Соединения открываются только с публичными адресами: проверка при
+// подключении, после разрешения имени, защищает и от DNS rebinding, и от
+// перенаправлений во внутреннюю сеть.
+func NewWebhookNotifier(secret string, timeout time.Duration) *WebhookNotifier {
+	dialer := &net.Dialer{Timeout: timeout, Control: controlPublicAddress}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// Через прокси проверка адреса назначения невозможна.
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &WebhookNotifier{
+		secret: []byte(secret),
+		http:   &http.Client{Timeout: timeout, Transport: transport},
+	}
+}
+
+// ValidateWebhookURL проверяет URL webhook до сохранения: схема http(s),
+// хост без учётных данных и не адрес внутренней сети. Имена хостов
+// проверяются окончательно при подключении.
+func ValidateWebhookURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Hostname() == "" {
+		return errors.New("invalid webhook URL")
+	}
+	if u.User != nil {
+		return fmt.Errorf("%w: webhook URL must not contain credentials", ErrForbiddenAddress)
+	}
+	host := strings.ToLower(strings.TrimSuffix(u.Hostname(), "."))
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return fmt.Errorf("%w: %s", ErrForbiddenAddress, host)
+	}
+	if ip, err := netip.ParseAddr(host); err == nil && !publicAddress(ip) {
+		return fmt.Errorf("%w: %s", ErrForbiddenAddress, host)
+	}
+	return nil
+}
+
+// publicAddress сообщает, что ip — публичный unicast-адрес: не loopback,
+// не частная сеть (RFC 1918, RFC 4193), не link-local (в том числе
+// 169.254.169.254 облачных метаданных), не multicast и не 0.0.0.0.
+func publicAddress(ip netip.Addr) bool {
+	ip = ip.Unmap()
+	return ip.IsValid() &&
+		ip.IsGlobalUnicast() &&
+		!ip.IsPrivate() &&
+		!ip.IsLoopback() &&
+		!ip.IsLinkLocalUnicast() &&
+		!sharedAddressSpace.Contains(ip)
+}
+
+// controlPublicAddress — net.Dialer.Control: отказывает в соединении
+// с непубличным адресом уже после разрешения имени.
+func controlPublicAddress(network, address string, _ syscall.RawConn) error {
+	addr, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrForbiddenAddress, address)
+	}
+	if !publicAddress(addr.Addr()) {
+		return ErrForbiddenAddress
+	}
+	return nil
+}
+
+// webhookPayload — тело запроса webhook.
+type webhookPayload struct {
+	Event               string    `json:"event"`
+	ReminderID          string    `json:"reminder_id"`
+	Title               string    `json:"title"`
+	Description         string    `json:"description,omitempty"`
+	RequireConfirmation bool      `json:"require_confirmation"`
+	Priority            string    `json:"priority"`
+	SentAt              time.Time `json:"sent_at"`
+}
+
+func (n *WebhookNotifier) Send(ctx context.Context, to Recipient, msg Message) error {
+	if to.WebhookURL == "" {
+		return fmt.Errorf("%w: %s", ErrNoAddress, ChannelWebhook)
+	}
+
+	body, err := json.Marshal(webhookPayload{
+		Event:               "reminder.fired",
+		ReminderID:          msg.ReminderID,
+		Title:               msg.Title,
+		Description:         msg.Description,
+		RequireConfirmation: msg.RequireConfirmation,
+		Priority:            msg.Priority.String(),
+		SentAt:              time.Now().UTC(),
+	})
+	if err != nil {
+		return fmt.Errorf("marshal webhook payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, to.WebhookURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("create webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if len(n.secret) > 0 {
+		req.Header.Set(SignatureHeader, "sha256="+Sign(n.secret, body))
+	}
+
+	resp, err := n.http.Do(req)
+	if errors.Is(err, ErrForbiddenAddress) {
+		// Адрес внутренней сети в ошибку не попадает: она видна пользователю в истории доставки.
+		return fmt.Errorf("send webhook: %w", ErrForbiddenAddress)
+	}
+	if err != nil {
+		return fmt.Errorf("send webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// Sign возвращает hex HMAC-SHA256 тела запроса.
+func Sign(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,94 @@
+// Package outbox доставляет побочные эффекты изменений в БД — запуск workflow,
+// сигналы Temporal, события Centrifugo — по схеме transactional outbox.
+//
+// Сообщение записывается в таблицу outbox в той же транзакции, что и само
+// изменение, а Relay в фоне передаёт его обработчику своего вида и повторяет
+// с растущей паузой, пока обработчик не вернёт nil. Доставка «хотя бы один
+// раз», поэтому обработчики должны быть идемпотентными.
+//
+// Сообщения с одним ключом (Key) доставляются строго по порядку: следующее
+// не берётся в работу, пока не доставлено предыдущее. Сообщения без ключа
+// независимы.
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	// minBackoff и maxBackoff ограничивают паузу между попытками доставки.
+	minBackoff = time.Second
+	maxBackoff = 5 * time.Minute
+)
+
+// Message — сообщение outbox.
+type Message struct {
+	ID int64
+	// Kind — вид сообщения, по нему выбирается обработчик.
+	Kind string
+	// Key — агрегат, внутри которого сохраняется порядок; пусто — без порядка.
+	Key     string
+	Payload json.RawMessage
+	// Attempts — номер текущей попытки доставки, начиная с 1.
+	Attempts int
+}
+
+// New собирает сообщение с телом payload в JSON.
+func New(kind, key string, payload any) (Message, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return Message{}, fmt.Errorf("outbox: marshal %s: %w", kind, err)
+	}
+	return Message{Kind: kind, Key: key, Payload: data}, nil
+}
+
+// Decode разбирает тело сообщения; ошибка разбора — постоянная.
+func (m Message) Decode(v any) error {
+	if err := json.Unmarshal(m.Payload, v); err != nil {
+		return Permanent(fmt.Errorf("outbox: decode %s: %w", m.Kind, err))
+	}
+	return nil
+}
+
+// Handler доставляет сообщение. Ошибка — повторить позже, ошибка,
+// обёрнутая Permanent, — больше не пытаться.
+type Handler func(ctx context.Context, msg Message) error
+
+// permanentError — ошибка, после которой повтор бессмыслен.
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+func (e *permanentError) Unwrap() error { return e.err }
+
+// Permanent помечает ошибку обработчика как постоянную: сообщение
+// остаётся в таблице с отметкой об ошибке и больше не доставляется.
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &permanentError{err: err}
+}
+
+// IsPermanent сообщает, помечена ли ошибка через Permanent.
+func IsPermanent(err error) bool {
+	var p *permanentError
+	return errors.As(err, &p)
+}
+
+// Backoff возвращает паузу перед повтором после неудачной попытки attempt:
+// 1s, 2s, 4s… но не больше 5 минут.
+func Backoff(attempt int) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+	if attempt > 10 {
+		return maxBackoff
+	}
+	return min(minBackoff<<(attempt-1), maxBackoff)
+}
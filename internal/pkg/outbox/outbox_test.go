@@ -0,0 +1,62 @@
+package outbox
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestBackoff(t *testing.T) {
+	cases := []struct {
+		attempt int
+		want    time.Duration
+	}{
+		{0, time.Second},
+		{1, time.Second},
+		{2, 2 * time.Second},
+		{5, 16 * time.Second},
+		{9, 256 * time.Second},
+		{10, 5 * time.Minute},
+		{100, 5 * time.Minute},
+	}
+	for _, c := range cases {
+		if got := Backoff(c.attempt); got != c.want {
+			t.Errorf("Backoff(%d) = %s, want %s", c.attempt, got, c.want)
+		}
+	}
+}
+
+func TestPermanent(t *testing.T) {
+	base := errors.New("boom")
+	wrapped := fmt.Errorf("handler: %w", Permanent(base))
+
+	if !IsPermanent(wrapped) {
+		t.Error("wrapped permanent error not detected")
+	}
+	if !errors.Is(wrapped, base) {
+		t.Error("permanent error does not unwrap to its cause")
+	}
+	if IsPermanent(base) {
+		t.Error("plain error reported as permanent")
+	}
+	if Permanent(nil) != nil {
+		t.Error("Permanent(nil) != nil")
+	}
+}
+
+func TestDecode(t *testing.T) {
+	msg, err := New("test", "k", map[string]int{"n": 1})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	var v struct{ N int }
+	if err := msg.Decode(&v); err != nil || v.N != 1 {
+		t.Errorf("Decode = %+v, %v", v, err)
+	}
+
+	msg.Payload = []byte("{")
+	if err := msg.Decode(&v); !IsPermanent(err) {
+		t.Errorf("Decode of broken payload = %v, want permanent error", err)
+	}
+}
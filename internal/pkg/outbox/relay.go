@@ -0,0 +1,164 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+// Store — хранилище сообщений outbox.
+type Store interface {
+	// Claim забирает до limit готовых к доставке сообщений в порядке ID и
+	// откладывает их на lease: если экземпляр упадёт посреди доставки,
+	// сообщения снова станут доступны. Attempts увеличивается на 1.
+	Claim(ctx context.Context, limit int, lease time.Duration) ([]Message, error)
+	// Complete удаляет доставленное сообщение.
+	Complete(ctx context.Context, id int64) error
+	// Retry откладывает сообщение до at и запоминает ошибку.
+	Retry(ctx context.Context, id int64, at time.Time, reason string) error
+	// Fail помечает сообщение недоставляемым.
+	Fail(ctx context.Context, id int64, reason string) error
+}
+
+// Config — параметры Relay.
+type Config struct {
+	// PollInterval — как часто проверять таблицу outbox.
+	PollInterval time.Duration
+	// BatchSize — сколько сообщений забирать за раз.
+	BatchSize int
+	// Lease — на сколько сообщение скрывается от других экземпляров во время доставки.
+	Lease time.Duration
+}
+
+// Relay в фоне доставляет сообщения outbox зарегистрированным обработчикам.
+// Несколько экземпляров приложения могут работать одновременно: Claim
+// раздаёт им разные сообщения.
+type Relay struct {
+	store    Store
+	handlers map[string]Handler
+	cfg      Config
+	log      *slog.Logger
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func NewRelay(store Store, cfg Config, log *slog.Logger) *Relay {
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = time.Second
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = 50
+	}
+	if cfg.Lease <= 0 {
+		cfg.Lease = time.Minute
+	}
+	return &Relay{
+		store:    store,
+		handlers: make(map[string]Handler),
+		cfg:      cfg,
+		log:      log,
+	}
+}
+
+// Handle регистрирует обработчик сообщений вида kind. Вызывается до Start.
+func (r *Relay) Handle(kind string, h Handler) {
+	r.handlers[kind] = h
+}
+
+// Start запускает доставку в фоне до Stop.
+func (r *Relay) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+	r.done = make(chan struct{})
+	go r.run(ctx)
+}
+
+// Stop останавливает доставку и ждёт текущую пачку или ctx. Недоставленные
+// сообщения заберёт следующий запуск после истечения Lease.
+func (r *Relay) Stop(ctx context.Context) {
+	if r.cancel == nil {
+		return
+	}
+	r.cancel()
+	select {
+	case <-r.done:
+	case <-ctx.Done():
+	}
+}
+
+func (r *Relay) run(ctx context.Context) {
+	defer close(r.done)
+
+	ticker := time.NewTicker(r.cfg.PollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		// Полная пачка — в таблице, скорее всего, есть ещё: забираем сразу.
+		for ctx.Err() == nil {
+			n, err := r.RunOnce(ctx)
+			if err != nil {
+				if ctx.Err() == nil {
+					r.log.Error("outbox relay failed", slog.Any("err", err))
+				}
+				break
+			}
+			if n < r.cfg.BatchSize {
+				break
+			}
+		}
+	}
+}
+
+// RunOnce забирает одну пачку сообщений и доставляет их по порядку.
+// Возвращает, сколько сообщений было забрано.
+func (r *Relay) RunOnce(ctx context.Context) (int, error) {
+	msgs, err := r.store.Claim(ctx, r.cfg.BatchSize, r.cfg.Lease)
+	if err != nil {
+		return 0, fmt.Errorf("claim outbox messages: %w", err)
+	}
+	for _, msg := range msgs {
+		if err := ctx.Err(); err != nil {
+			return len(msgs), err
+		}
+		if err := r.deliver(ctx, msg); err != nil {
+			return len(msgs), err
+		}
+	}
+	return len(msgs), nil
+}
+
+// deliver передаёт сообщение обработчику и записывает результат.
+// Ошибка возвращается, только если результат не удалось сохранить.
+func (r *Relay) deliver(ctx context.Context, msg Message) error {
+	log := r.log.With(
+		slog.Int64("id", msg.ID),
+		slog.String("kind", msg.Kind),
+		slog.Int("attempt", msg.Attempts),
+	)
+
+	h, ok := r.handlers[msg.Kind]
+	if !ok {
+		log.Error("no outbox handler")
+		return r.store.Fail(ctx, msg.ID, "no handler for "+msg.Kind)
+	}
+
+	err := h(ctx, msg)
+	switch {
+	case err == nil:
+		return r.store.Complete(ctx, msg.ID)
+	case IsPermanent(err):
+		log.Error("outbox message dropped", slog.Any("err", err))
+		return r.store.Fail(ctx, msg.ID, err.Error())
+	default:
+		retryIn := Backoff(msg.Attempts)
+		log.Warn("outbox delivery failed, will retry", slog.Any("err", err), slog.Duration("retry_in", retryIn))
+		return r.store.Retry(ctx, msg.ID, time.Now().Add(retryIn), err.Error())
+	}
+}
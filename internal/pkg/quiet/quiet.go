@@ -0,0 +1,288 @@
+// Package quiet описывает тихие часы и режим «не беспокоить» пользователя.
+//
+// Расписание тихих часов записывается строкой из окон через «;». Окно —
+// дни недели и интервал времени: «<дни> <ЧЧ:ММ>-<ЧЧ:ММ>», например
+// «mon-fri 22:00-07:30; sat,sun 23:00-10:00». Дни — mon…sun, диапазоны через
+// «-» и списки через «,»; без дней окно действует ежедневно. Окно, у которого
+// конец раньше начала, переходит через полночь и относится к дню начала.
+package quiet
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MaxWindows ограничивает число окон в расписании.
+const MaxWindows = 7
+
+// ErrInvalidSchedule — расписание не удалось разобрать.
+var ErrInvalidSchedule = errors.New("quiet: invalid schedule")
+
+// dayNames — имена дней в порядке time.Weekday.
+var dayNames = [7]string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+
+// Days — набор дней недели, бит на каждый time.Weekday.
+type Days uint8
+
+// AllDays — окно действует ежедневно.
+const AllDays Days = 1<<7 - 1
+
+// Has сообщает, входит ли день в набор.
+func (d Days) Has(day time.Weekday) bool {
+	return d&(1<<day) != 0
+}
+
+// String возвращает дни в каноническом виде: последовательные дни
+// с понедельника схлопываются в диапазоны.
+func (d Days) String() string {
+	if d == AllDays {
+		return ""
+	}
+	var parts []string
+	for i := 0; i < 7; {
+		if !d.Has(mondayFirst(i)) {
+			i++
+			continue
+		}
+		j := i
+		for j+1 < 7 && d.Has(mondayFirst(j+1)) {
+			j++
+		}
+		switch {
+		case j == i:
+			parts = append(parts, dayNames[mondayFirst(i)])
+		case j == i+1:
+			parts = append(parts, dayNames[mondayFirst(i)], dayNames[mondayFirst(j)])
+		default:
+			parts = append(parts, dayNames[mondayFirst(i)]+"-"+dayNames[mondayFirst(j)])
+		}
+		i = j + 1
+	}
+	return strings.Join(parts, ",")
+}
+
+// mondayFirst переводит номер дня недели, начиная с понедельника, в time.Weekday.
+func mondayFirst(i int) time.Weekday {
+	return time.Weekday((i + 1) % 7)
+}
+
+// Window — окно тихих часов. Start и End — смещения от полуночи;
+// End == 24h означает конец суток.
+type Window struct {
+	Days  Days
+	Start time.Duration
+	End   time.Duration
+}
+
+// overnight сообщает, переходит ли окно через полночь.
+func (w Window) overnight() bool {
+	return w.End <= w.Start
+}
+
+// String возвращает окно в формате расписания.
+func (w Window) String() string {
+	span := formatClock(w.Start) + "-" + formatClock(w.End)
+	if days := w.Days.String(); days != "" {
+		return days + " " + span
+	}
+	return span
+}
+
+// Schedule — окна тихих часов.
+type Schedule []Window
+
+// Parse разбирает расписание; пустая строка — без тихих часов.
+func Parse(raw string) (Schedule, error) {
+	var schedule Schedule
+	for _, part := range strings.Split(raw, ";") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		w, err := parseWindow(part)
+		if err != nil {
+			return nil, err
+		}
+		schedule = append(schedule, w)
+	}
+	if len(schedule) > MaxWindows {
+		return nil, fmt.Errorf("%w: at most %d windows", ErrInvalidSchedule, MaxWindows)
+	}
+	return schedule, nil
+}
+
+func parseWindow(s string) (Window, error) {
+	w := Window{Days: AllDays}
+	span := s
+	if days, rest, ok := strings.Cut(s, " "); ok {
+		parsed, err := parseDays(days)
+		if err != nil {
+			return Window{}, fmt.Errorf("%w: %q: %w", ErrInvalidSchedule, s, err)
+		}
+		w.Days = parsed
+		span = strings.TrimSpace(rest)
+	}
+
+	from, to, ok := strings.Cut(span, "-")
+	if !ok {
+		return Window{}, fmt.Errorf("%w: expected \"[days] HH:MM-HH:MM\": %q", ErrInvalidSchedule, s)
+	}
+	var err error
+	if w.Start, err = parseClock(from); err != nil || w.Start == 24*time.Hour {
+		return Window{}, fmt.Errorf("%w: invalid start time: %q", ErrInvalidSchedule, s)
+	}
+	if w.End, err = parseClock(to); err != nil {
+		return Window{}, fmt.Errorf("%w: invalid end time: %q", ErrInvalidSchedule, s)
+	}
+	if w.Start == w.End {
+		return Window{}, fmt.Errorf("%w: window is empty: %q", ErrInvalidSchedule, s)
+	}
+	return w, nil
+}
+
+// parseDays разбирает «mon-fri,sun»; «daily» — все дни.
+func parseDays(raw string) (Days, error) {
+	raw = strings.ToLower(strings.TrimSpace(raw))
+	if raw == "daily" {
+		return AllDays, nil
+	}
+	var days Days
+	for _, part := range strings.Split(raw, ",") {
+		from, to, isRange := strings.Cut(strings.TrimSpace(part), "-")
+		first, err := parseDay(from)
+		if err != nil {
+			return 0, err
+		}
+		last := first
+		if isRange {
+			if last, err = parseDay(to); err != nil {
+				return 0, err
+			}
+		}
+		// Диапазон может переходить через воскресенье: «fri-mon».
+		for d := first; ; d = (d + 1) % 7 {
+			days |= 1 << d
+			if d == last {
+				break
+			}
+		}
+	}
+	return days, nil
+}
+
+func parseDay(s string) (time.Weekday, error) {
+	s = strings.TrimSpace(s)
+	for i, name := range dayNames {
+		if s == name {
+			return time.Weekday(i), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown day %q", s)
+}
+
+// parseClock разбирает «ЧЧ:ММ»; допускается «24:00» — конец суток.
+func parseClock(s string) (time.Duration, error) {
+	hh, mm, ok := strings.Cut(strings.TrimSpace(s), ":")
+	if !ok {
+		return 0, errors.New("expected HH:MM")
+	}
+	h, err := strconv.Atoi(hh)
+	if err != nil {
+		return 0, err
+	}
+	m, err := strconv.Atoi(mm)
+	if err != nil || len(mm) != 2 {
+		return 0, errors.New("expected HH:MM")
+	}
+	if h < 0 || m < 0 || m > 59 || h > 24 || (h == 24 && m != 0) {
+		return 0, errors.New("time out of range")
+	}
+	return time.Duration(h)*time.Hour + time.Duration(m)*time.Minute, nil
+}
+
+func formatClock(d time.Duration) string {
+	return fmt.Sprintf("%02d:%02d", int(d/time.Hour), int(d%time.Hour/time.Minute))
+}
+
+// String возвращает расписание в каноническом виде для хранения в БД.
+func (s Schedule) String() string {
+	parts := make([]string, len(s))
+	for i, w := range s {
+		parts[i] = w.String()
+	}
+	return strings.Join(parts, "; ")
+}
+
+// end возвращает конец окна расписания, в которое попадает t, или нулевое время.
+// Проверяются окна, начавшиеся в день t и накануне (переход через полночь).
+func (s Schedule) end(t time.Time) time.Time {
+	var until time.Time
+	y, m, d := t.Date()
+	for _, offset := range []int{-1, 0} {
+		day := time.Date(y, m, d+offset, 0, 0, 0, 0, t.Location())
+		for _, w := range s {
+			if !w.Days.Has(day.Weekday()) {
+				continue
+			}
+			start := atClock(day, w.Start)
+			stop := atClock(day, w.End)
+			if w.overnight() {
+				stop = atClock(day.AddDate(0, 0, 1), w.End)
+			}
+			if !t.Before(start) && t.Before(stop) && stop.After(until) {
+				until = stop
+			}
+		}
+	}
+	return until
+}
+
+// atClock возвращает момент в день day со смещением от полуночи по часам;
+// time.Date сам учитывает переходы на летнее время.
+func atClock(day time.Time, d time.Duration) time.Time {
+	return time.Date(day.Year(), day.Month(), day.Day(), int(d/time.Hour), int(d%time.Hour/time.Minute), 0, 0, day.Location())
+}
+
+// Settings — тихие часы и режим «не беспокоить» пользователя.
+type Settings struct {
+	Schedule Schedule
+	// Location — таймзона, в которой заданы окна расписания.
+	Location *time.Location
+	// DNDUntil — до какого момента включён режим «не беспокоить»; нулевое — выключен.
+	DNDUntil time.Time
+}
+
+// maxChain ограничивает число смежных окон, через которые продлевается тишина:
+// расписание «круглые сутки» иначе не закончилось бы никогда.
+const maxChain = 16
+
+// Until возвращает, до какого момента после t уведомления отправлять нельзя,
+// или нулевое время, если отправлять можно сразу. Смежные окна и режим
+// «не беспокоить» продлевают друг друга.
+func (s Settings) Until(t time.Time) time.Time {
+	loc := s.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+	at := t
+	for range maxChain {
+		next := at
+		if s.DNDUntil.After(next) {
+			next = s.DNDUntil
+		}
+		if end := s.Schedule.end(next.In(loc)); !end.IsZero() {
+			next = end
+		}
+		if !next.After(at) {
+			break
+		}
+		at = next
+	}
+	if !at.After(t) {
+		return time.Time{}
+	}
+	return at
+}
@@ -0,0 +1,109 @@
+package quiet
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	s, err := Parse(" MON-FRI 22:00-07:30;sat,sun 23:00-10:00 ; 13:00-14:00 ")
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	want := "mon-fri 22:00-07:30; sat,sun 23:00-10:00; 13:00-14:00"
+	if got := s.String(); got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+
+	for raw, want := range map[string]string{
+		"daily 00:00-24:00":    "00:00-24:00",
+		"fri-mon 01:00-02:00":  "mon,fri-sun 01:00-02:00",
+		"mon,wed,thu 1:05-2:0": "",
+	} {
+		s, err := Parse(raw)
+		if want == "" {
+			if err == nil {
+				t.Errorf("Parse(%q) = %q, want error", raw, s)
+			}
+			continue
+		}
+		if err != nil || s.String() != want {
+			t.Errorf("Parse(%q) = %q, %v; want %q", raw, s, err, want)
+		}
+	}
+
+	if s, err := Parse(""); err != nil || len(s) != 0 {
+		t.Errorf("Parse(\"\") = %v, %v; want empty schedule", s, err)
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	for _, raw := range []string{
+		"22:00",
+		"22:00-22:00",
+		"25:00-07:00",
+		"24:00-07:00",
+		"22:60-07:00",
+		"mon-funday 22:00-07:00",
+		"weekdays 22:00-07:00",
+		"1:00-2:00; 1:00-2:00; 1:00-2:00; 1:00-2:00; 1:00-2:00; 1:00-2:00; 1:00-2:00; 1:00-2:00",
+	} {
+		if _, err := Parse(raw); !errors.Is(err, ErrInvalidSchedule) {
+			t.Errorf("Parse(%q) error = %v, want ErrInvalidSchedule", raw, err)
+		}
+	}
+}
+
+func TestUntil(t *testing.T) {
+	loc := time.FixedZone("MSK", 3*60*60)
+	at := func(day, hh, mm int) time.Time {
+		// 2026-03-02 — понедельник.
+		return time.Date(2026, 3, 1+day, hh, mm, 0, 0, loc)
+	}
+	schedule, err := Parse("mon-fri 22:00-07:00; sat,sun 23:00-10:00; sun 10:00-12:00")
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	settings := Settings{Schedule: schedule, Location: loc}
+
+	cases := []struct {
+		name string
+		t    time.Time
+		want time.Time
+	}{
+		{"daytime", at(1, 12, 0), time.Time{}},
+		{"evening before window", at(1, 21, 59), time.Time{}},
+		{"window start", at(1, 22, 0), at(2, 7, 0)},
+		{"after midnight", at(2, 3, 0), at(2, 7, 0)},
+		{"window end", at(2, 7, 0), time.Time{}},
+		{"friday night runs to saturday morning", at(5, 23, 0), at(6, 7, 0)},
+		{"saturday morning after friday window", at(6, 9, 0), time.Time{}},
+		{"sunday morning chains saturday window into sunday one", at(7, 9, 0), at(7, 12, 0)},
+		{"monday early belongs to sunday window", at(8, 5, 0), at(8, 10, 0)},
+	}
+	for _, c := range cases {
+		if got := settings.Until(c.t); !got.Equal(c.want) {
+			t.Errorf("%s: Until(%s) = %s, want %s", c.name, c.t, got, c.want)
+		}
+	}
+
+	// Режим «не беспокоить» продлевается тихими часами, в которые он заканчивается.
+	settings.DNDUntil = at(1, 23, 0)
+	if got, want := settings.Until(at(1, 12, 0)), at(2, 7, 0); !got.Equal(want) {
+		t.Errorf("DND into quiet hours: Until = %s, want %s", got, want)
+	}
+	settings.DNDUntil = at(1, 13, 0)
+	if got, want := settings.Until(at(1, 12, 0)), at(1, 13, 0); !got.Equal(want) {
+		t.Errorf("DND: Until = %s, want %s", got, want)
+	}
+	if got := settings.Until(at(1, 14, 0)); !got.IsZero() {
+		t.Errorf("expired DND: Until = %s, want zero", got)
+	}
+
+	// Круглосуточное расписание не зацикливает расчёт.
+	always, _ := Parse("00:00-24:00")
+	if got := (Settings{Schedule: always, Location: loc}).Until(at(1, 12, 0)); got.IsZero() {
+		t.Error("always quiet: Until is zero")
+	}
+}
@@ -0,0 +1,330 @@
+// Package recurrence разбирает правила повторения напоминаний.
+//
+// Поддерживается подмножество RFC 5545 RRULE (FREQ=DAILY|WEEKLY|MONTHLY,
+// INTERVAL, BYDAY, BYMONTHDAY, BYHOUR, BYMINUTE, UNTIL, COUNT) и классические
+// 5-польные cron-выражения. Правило хранится в БД строкой (Rule.String) и
+// превращается в спецификацию Temporal Schedule (Rule.ScheduleSpec).
+package recurrence
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron"
+)
+
+// Frequency — частота повторения RRULE.
+type Frequency string
+
+const (
+	FreqDaily   Frequency = "DAILY"
+	FreqWeekly  Frequency = "WEEKLY"
+	FreqMonthly Frequency = "MONTHLY"
+)
+
+const cronPrefix = "CRON:"
+
+var (
+	// ErrEmptyRule — правило не задано.
+	ErrEmptyRule = errors.New("recurrence: empty rule")
+	// ErrInvalidRule — правило не удалось разобрать.
+	ErrInvalidRule = errors.New("recurrence: invalid rule")
+	// ErrUnsupported — правило корректно, но не выражается через Temporal Schedule.
+	ErrUnsupported = errors.New("recurrence: unsupported rule")
+)
+
+// Rule — разобранное правило повторения.
+// Либо заполнен Cron, либо поля RRULE.
+type Rule struct {
+	Freq       Frequency
+	Interval   int
+	ByDay      []time.Weekday
+	ByMonthDay []int
+	// Hour и Minute равны -1, если время берётся из первого срабатывания.
+	Hour   int
+	Minute int
+	Until  time.Time
+	Count  int
+
+	Cron     string
+	schedule cron.Schedule
+}
+
+var weekdayCodes = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+var weekdayNames = [...]string{"вс", "пн", "вт", "ср", "чт", "пт", "сб"}
+
+// Parse разбирает строку правила: "RRULE:FREQ=...", "FREQ=...",
+// "CRON:<expr>" или голое cron-выражение ("0 9 * * 1-5", "@daily").
+func Parse(s string) (*Rule, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, ErrEmptyRule
+	}
+
+	upper := strings.ToUpper(s)
+	switch {
+	case strings.HasPrefix(upper, cronPrefix):
+		return parseCron(strings.TrimSpace(s[len(cronPrefix):]))
+	case strings.HasPrefix(upper, "RRULE:"):
+		return parseRRule(s[len("RRULE:"):])
+	case strings.Contains(upper, "FREQ="):
+		return parseRRule(s)
+	default:
+		return parseCron(s)
+	}
+}
+
+func parseCron(expr string) (*Rule, error) {
+	if expr == "" {
+		return nil, ErrEmptyRule
+	}
+	if !strings.HasPrefix(expr, "@") && len(strings.Fields(expr)) != 5 {
+		return nil, fmt.Errorf("%w: cron expression must have 5 fields", ErrInvalidRule)
+	}
+	if strings.HasPrefix(expr, "@every") {
+		return nil, fmt.Errorf("%w: @every is not supported", ErrInvalidRule)
+	}
+	sched, err := cron.ParseStandard(expr)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidRule, err)
+	}
+	return &Rule{Cron: strings.Join(strings.Fields(expr), " "), schedule: sched, Hour: -1, Minute: -1}, nil
+}
+
+func parseRRule(s string) (*Rule, error) {
+	r := &Rule{Interval: 1, Hour: -1, Minute: -1}
+
+	for part := range strings.SplitSeq(strings.TrimSpace(s), ";") {
+		if part == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidRule, part)
+		}
+		key = strings.ToUpper(strings.TrimSpace(key))
+		value = strings.ToUpper(strings.TrimSpace(value))
+
+		var err error
+		switch key {
+		case "FREQ":
+			r.Freq = Frequency(value)
+			if r.Freq != FreqDaily && r.Freq != FreqWeekly && r.Freq != FreqMonthly {
+				return nil, fmt.Errorf("%w: FREQ=%s is not supported", ErrInvalidRule, value)
+			}
+		case "INTERVAL":
+			r.Interval, err = parseRange(value, 1, 366)
+		case "COUNT":
+			r.Count, err = parseRange(value, 1, 10000)
+		case "BYHOUR":
+			r.Hour, err = parseRange(value, 0, 23)
+		case "BYMINUTE":
+			r.Minute, err = parseRange(value, 0, 59)
+		case "BYDAY":
+			for code := range strings.SplitSeq(value, ",") {
+				day, ok := weekdayCodes[code]
+				if !ok {
+					return nil, fmt.Errorf("%w: BYDAY=%s", ErrInvalidRule, code)
+				}
+				if !slices.Contains(r.ByDay, day) {
+					r.ByDay = append(r.ByDay, day)
+				}
+			}
+			slices.Sort(r.ByDay)
+		case "BYMONTHDAY":
+			for v := range strings.SplitSeq(value, ",") {
+				day, err := parseRange(v, 1, 31)
+				if err != nil {
+					return nil, fmt.Errorf("%w: BYMONTHDAY=%s", ErrInvalidRule, v)
+				}
+				if !slices.Contains(r.ByMonthDay, day) {
+					r.ByMonthDay = append(r.ByMonthDay, day)
+				}
+			}
+			slices.Sort(r.ByMonthDay)
+		case "UNTIL":
+			r.Until, err = parseUntil(value)
+		default:
+			return nil, fmt.Errorf("%w: %s is not supported", ErrInvalidRule, key)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s=%s", ErrInvalidRule, key, value)
+		}
+	}
+
+	switch {
+	case r.Freq == "":
+		return nil, fmt.Errorf("%w: FREQ is required", ErrInvalidRule)
+	case r.Count > 0 && !r.Until.IsZero():
+		return nil, fmt.Errorf("%w: COUNT and UNTIL are mutually exclusive", ErrInvalidRule)
+	case len(r.ByDay) > 0 && r.Freq != FreqWeekly:
+		return nil, fmt.Errorf("%w: BYDAY requires FREQ=WEEKLY", ErrInvalidRule)
+	case len(r.ByMonthDay) > 0 && r.Freq != FreqMonthly:
+		return nil, fmt.Errorf("%w: BYMONTHDAY requires FREQ=MONTHLY", ErrInvalidRule)
+	case r.Freq == FreqMonthly && 12%r.Interval != 0:
+		return nil, fmt.Errorf("%w: monthly INTERVAL must divide 12", ErrUnsupported)
+	}
+
+	return r, nil
+}
+
+func parseRange(value string, minValue, maxValue int) (int, error) {
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, err
+	}
+	if n < minValue || n > maxValue {
+		return 0, fmt.Errorf("value %d out of range %d..%d", n, minValue, maxValue)
+	}
+	return n, nil
+}
+
+func parseUntil(value string) (time.Time, error) {
+	if t, err := time.Parse("20060102T150405Z", value); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse("20060102", value)
+	if err != nil {
+		return time.Time{}, err
+	}
+	// Дата без времени включает весь день.
+	return t.Add(24*time.Hour - time.Second), nil
+}
+
+// IsCron сообщает, задано ли правило cron-выражением.
+func (r *Rule) IsCron() bool {
+	return r.Cron != ""
+}
+
+// String возвращает каноническую запись правила для хранения в БД.
+func (r *Rule) String() string {
+	if r.IsCron() {
+		return cronPrefix + r.Cron
+	}
+
+	parts := []string{"FREQ=" + string(r.Freq)}
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+	if len(r.ByDay) > 0 {
+		codes := make([]string, 0, len(r.ByDay))
+		for _, d := range r.ByDay {
+			codes = append(codes, strings.ToUpper(d.String()[:2]))
+		}
+		parts = append(parts, "BYDAY="+strings.Join(codes, ","))
+	}
+	if len(r.ByMonthDay) > 0 {
+		parts = append(parts, "BYMONTHDAY="+joinInts(r.ByMonthDay))
+	}
+	if r.Hour >= 0 {
+		parts = append(parts, "BYHOUR="+strconv.Itoa(r.Hour))
+	}
+	if r.Minute >= 0 {
+		parts = append(parts, "BYMINUTE="+strconv.Itoa(r.Minute))
+	}
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+	if !r.Until.IsZero() {
+		parts = append(parts, "UNTIL="+r.Until.UTC().Format("20060102T150405Z"))
+	}
+	return strings.Join(parts, ";")
+}
+
+// Describe возвращает человекочитаемое описание правила для UI и бота.
+func (r *Rule) Describe() string {
+	if r.IsCron() {
+		return "cron: " + r.Cron
+	}
+
+	var b strings.Builder
+	switch r.Freq {
+	case FreqDaily:
+		b.WriteString(every(r.Interval, "каждый день", "дня", "дней"))
+	case FreqWeekly:
+		b.WriteString(every(r.Interval, "каждую неделю", "недели", "недель"))
+		if len(r.ByDay) > 0 {
+			names := make([]string, 0, len(r.ByDay))
+			for _, d := range r.ByDay {
+				names = append(names, weekdayNames[d])
+			}
+			b.WriteString(" по " + strings.Join(names, ", "))
+		}
+	case FreqMonthly:
+		b.WriteString(every(r.Interval, "каждый месяц", "месяца", "месяцев"))
+		if len(r.ByMonthDay) > 0 {
+			b.WriteString(" " + strings.ReplaceAll(joinInts(r.ByMonthDay), ",", ", ") + " числа")
+		}
+	}
+	switch {
+	case r.Hour >= 0 && r.Minute >= 0:
+		fmt.Fprintf(&b, " в %02d:%02d", r.Hour, r.Minute)
+	case r.Hour >= 0:
+		fmt.Fprintf(&b, " в %d ч", r.Hour)
+	}
+	if r.Count > 0 {
+		fmt.Fprintf(&b, ", %d раз", r.Count)
+	}
+	if !r.Until.IsZero() {
+		b.WriteString(", до " + r.Until.UTC().Format("02.01.2006"))
+	}
+	return b.String()
+}
+
+func every(n int, one, few, many string) string {
+	if n <= 1 {
+		return one
+	}
+	unit := many
+	if n%10 >= 2 && n%10 <= 4 && (n%100 < 10 || n%100 >= 20) {
+		unit = few
+	}
+	return fmt.Sprintf("каждые %d %s", n, unit)
+}
+
+func joinInts(values []int) string {
+	s := make([]string, 0, len(values))
+	for _, v := range values {
+		s = append(s, strconv.Itoa(v))
+	}
+	return strings.Join(s, ",")
+}
+
+// Preset — готовый вариант повторения для форм UI и Telegram.
+type Preset struct {
+	Key   string
+	Label string
+	Rule  string
+}
+
+// Presets — варианты повторения, предлагаемые пользователю.
+// Время и день берутся из первого срабатывания напоминания.
+var Presets = []Preset{
+	{Key: "daily", Label: "Каждый день", Rule: "FREQ=DAILY"},
+	{Key: "weekdays", Label: "По будням", Rule: "FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR"},
+	{Key: "weekly", Label: "Каждую неделю", Rule: "FREQ=WEEKLY"},
+	{Key: "monthly", Label: "Каждый месяц", Rule: "FREQ=MONTHLY"},
+}
+
+// PresetRule возвращает правило готового варианта по ключу.
+func PresetRule(key string) (string, bool) {
+	for _, p := range Presets {
+		if p.Key == key {
+			return p.Rule, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,113 @@
+package recurrence
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseString(t *testing.T) {
+	cases := map[string]string{
+		"FREQ=DAILY": "FREQ=DAILY",
+		"RRULE:freq=weekly;byday=fr,mo;interval=2": "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,FR",
+		"FREQ=MONTHLY;BYMONTHDAY=15,1;COUNT=3":     "FREQ=MONTHLY;BYMONTHDAY=1,15;COUNT=3",
+		"FREQ=DAILY;UNTIL=20261231":                "FREQ=DAILY;UNTIL=20261231T235959Z",
+		"0  9 * * 1-5":                             "CRON:0 9 * * 1-5",
+		"cron:@daily":                              "CRON:@daily",
+	}
+	for in, want := range cases {
+		r, err := Parse(in)
+		if err != nil {
+			t.Errorf("Parse(%q): %v", in, err)
+			continue
+		}
+		if got := r.String(); got != want {
+			t.Errorf("Parse(%q).String() = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestParseRejects(t *testing.T) {
+	cases := map[string]error{
+		"":                                  ErrEmptyRule,
+		"FREQ=YEARLY":                       ErrInvalidRule,
+		"FREQ=DAILY;BYDAY=MO":               ErrInvalidRule,
+		"FREQ=DAILY;COUNT=2;UNTIL=20260101": ErrInvalidRule,
+		"FREQ=MONTHLY;INTERVAL=5":           ErrUnsupported,
+		"0 9 * *":                           ErrInvalidRule,
+		"@every 1h":                         ErrInvalidRule,
+	}
+	for in, want := range cases {
+		if _, err := Parse(in); !errors.Is(err, want) {
+			t.Errorf("Parse(%q) error = %v, want %v", in, err, want)
+		}
+	}
+}
+
+func TestNext(t *testing.T) {
+	loc := time.FixedZone("MSK", 3*60*60)
+	// Среда, 7 октября 2026, 09:30.
+	start := time.Date(2026, 10, 7, 9, 30, 0, 0, loc)
+
+	cases := []struct {
+		rule  string
+		after time.Time
+		want  time.Time
+	}{
+		{"FREQ=DAILY", start, time.Date(2026, 10, 8, 9, 30, 0, 0, loc)},
+		{"FREQ=DAILY;INTERVAL=3", start, time.Date(2026, 10, 10, 9, 30, 0, 0, loc)},
+		{"FREQ=WEEKLY;BYDAY=MO,FR", start, time.Date(2026, 10, 9, 9, 30, 0, 0, loc)},
+		{"FREQ=WEEKLY;INTERVAL=2;BYDAY=MO", start, time.Date(2026, 10, 19, 9, 30, 0, 0, loc)},
+		{"FREQ=MONTHLY;BYMONTHDAY=31", start, time.Date(2026, 10, 31, 9, 30, 0, 0, loc)},
+		{"FREQ=DAILY;BYHOUR=8;BYMINUTE=0", start, time.Date(2026, 10, 8, 8, 0, 0, 0, loc)},
+		{"FREQ=DAILY;COUNT=2", start.AddDate(0, 0, 1), time.Time{}},
+		{"0 18 * * *", start, time.Date(2026, 10, 7, 18, 0, 0, 0, loc)},
+		{"FREQ=DAILY", start.Add(-time.Hour), start},
+	}
+	for _, c := range cases {
+		r, err := Parse(c.rule)
+		if err != nil {
+			t.Fatalf("Parse(%q): %v", c.rule, err)
+		}
+		if got := r.Next(start, c.after, loc); !got.Equal(c.want) {
+			t.Errorf("%q: Next(%v) = %v, want %v", c.rule, c.after, got, c.want)
+		}
+	}
+}
+
+func TestScheduleSpec(t *testing.T) {
+	loc := time.FixedZone("MSK", 3*60*60)
+	start := time.Date(2026, 10, 7, 9, 30, 0, 0, loc)
+
+	r, _ := Parse("FREQ=WEEKLY;BYDAY=MO,WE")
+	spec, err := r.ScheduleSpec(start, loc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(spec.Calendars) != 1 || len(spec.Calendars[0].DayOfWeek) != 2 || spec.Calendars[0].Hour[0].Start != 9 {
+		t.Errorf("unexpected calendar spec: %+v", spec.Calendars)
+	}
+
+	r, _ = Parse("FREQ=WEEKLY;INTERVAL=2;BYDAY=MO")
+	spec, err = r.ScheduleSpec(start, loc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(spec.Intervals) != 1 || spec.Intervals[0].Every != 14*24*time.Hour {
+		t.Fatalf("unexpected interval spec: %+v", spec.Intervals)
+	}
+	want := r.Next(start, start, loc)
+	every := int64(spec.Intervals[0].Every / time.Second)
+	if got := want.Unix() % every; got != int64(spec.Intervals[0].Offset/time.Second) {
+		t.Errorf("interval offset does not match next occurrence %v", want)
+	}
+
+	r, _ = Parse("FREQ=MONTHLY;INTERVAL=3")
+	spec, err = r.ScheduleSpec(start, loc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m := spec.Calendars[0].Month; len(m) != 1 || m[0].Start != 1 || m[0].Step != 3 {
+		t.Errorf("unexpected month range: %+v", m)
+	}
+}
@@ -0,0 +1,203 @@
+package recurrence
+
+import (
+	"fmt"
+	"time"
+
+	"go.temporal.io/sdk/client"
+)
+
+// maxScanDays ограничивает перебор дней при поиске следующего срабатывания.
+const maxScanDays = 366 * 10
+
+// Next возвращает первое срабатывание строго после after.
+// start — первое срабатывание напоминания (remind_at): от него отсчитываются
+// INTERVAL и COUNT, из него берутся время, день недели и число месяца.
+// Нулевое время означает, что срабатываний больше не будет.
+func (r *Rule) Next(start, after time.Time, loc *time.Location) time.Time {
+	start = start.In(loc)
+	if after.Before(start) {
+		after = start.Add(-time.Second)
+	}
+
+	if r.IsCron() {
+		next := r.schedule.Next(after.In(loc))
+		if !r.Until.IsZero() && next.After(r.Until) {
+			return time.Time{}
+		}
+		return next
+	}
+
+	hour, minute := r.clock(start)
+	first := civil(start)
+	count := 0
+	for i := range maxScanDays {
+		day := first.AddDate(0, 0, i)
+		if !r.matches(first, day, start) {
+			continue
+		}
+		at := time.Date(day.Year(), day.Month(), day.Day(), hour, minute, 0, 0, loc)
+		if at.Before(start) {
+			continue
+		}
+		count++
+		if r.Count > 0 && count > r.Count {
+			return time.Time{}
+		}
+		if !r.Until.IsZero() && at.After(r.Until) {
+			return time.Time{}
+		}
+		if at.After(after) {
+			return at
+		}
+	}
+	return time.Time{}
+}
+
+// ScheduleSpec строит спецификацию Temporal Schedule.
+// Для INTERVAL=1 используются календарные спецификации в зоне loc;
+// дневные и недельные правила с INTERVAL>1 выражаются через IntervalSpec,
+// поэтому при переходе на летнее время срабатывание сдвигается на час.
+func (r *Rule) ScheduleSpec(start time.Time, loc *time.Location) (client.ScheduleSpec, error) {
+	start = start.In(loc)
+	spec := client.ScheduleSpec{
+		StartAt:      start,
+		EndAt:        r.Until,
+		TimeZoneName: loc.String(),
+	}
+
+	if r.IsCron() {
+		spec.CronExpressions = []string{r.Cron}
+		return spec, nil
+	}
+
+	hour, minute := r.clock(start)
+	cal := client.ScheduleCalendarSpec{
+		Hour:    []client.ScheduleRange{{Start: hour}},
+		Minute:  []client.ScheduleRange{{Start: minute}},
+		Comment: r.String(),
+	}
+
+	switch r.Freq {
+	case FreqDaily:
+		if r.Interval > 1 {
+			spec.Intervals = []client.ScheduleIntervalSpec{r.interval(start, loc, start.Weekday(), 1)}
+			return spec, nil
+		}
+	case FreqWeekly:
+		days := r.weekdays(start)
+		if r.Interval > 1 {
+			for _, d := range days {
+				spec.Intervals = append(spec.Intervals, r.interval(start, loc, d, 7))
+			}
+			return spec, nil
+		}
+		for _, d := range days {
+			cal.DayOfWeek = append(cal.DayOfWeek, client.ScheduleRange{Start: int(d)})
+		}
+	case FreqMonthly:
+		for _, d := range r.monthDays(start) {
+			cal.DayOfMonth = append(cal.DayOfMonth, client.ScheduleRange{Start: d})
+		}
+		if r.Interval > 1 {
+			if 12%r.Interval != 0 {
+				return client.ScheduleSpec{}, fmt.Errorf("%w: monthly INTERVAL must divide 12", ErrUnsupported)
+			}
+			first := (int(start.Month())-1)%r.Interval + 1
+			cal.Month = []client.ScheduleRange{{Start: first, End: 12, Step: r.Interval}}
+		}
+	default:
+		return client.ScheduleSpec{}, fmt.Errorf("%w: FREQ=%s", ErrUnsupported, r.Freq)
+	}
+
+	spec.Calendars = []client.ScheduleCalendarSpec{cal}
+	return spec, nil
+}
+
+// interval строит IntervalSpec с периодом Interval*unitDays дней,
+// выровненный на первое срабатывание в день недели weekday.
+func (r *Rule) interval(start time.Time, loc *time.Location, weekday time.Weekday, unitDays int) client.ScheduleIntervalSpec {
+	every := time.Duration(r.Interval*unitDays) * 24 * time.Hour
+	hour, minute := r.clock(start)
+
+	day := civil(start)
+	if unitDays == 7 {
+		// Недели отсчитываются от понедельника недели первого срабатывания, как в Next.
+		day = monday(day).AddDate(0, 0, (int(weekday)+6)%7)
+	}
+	first := time.Date(day.Year(), day.Month(), day.Day(), hour, minute, 0, 0, loc)
+	if first.Before(start) {
+		first = first.AddDate(0, 0, r.Interval*unitDays)
+	}
+
+	return client.ScheduleIntervalSpec{
+		Every:  every,
+		Offset: time.Duration(first.Unix()%int64(every/time.Second)) * time.Second,
+	}
+}
+
+func (r *Rule) matches(first, day, start time.Time) bool {
+	switch r.Freq {
+	case FreqDaily:
+		return daysBetween(first, day)%r.Interval == 0
+	case FreqWeekly:
+		weeks := daysBetween(monday(first), monday(day)) / 7
+		if weeks%r.Interval != 0 {
+			return false
+		}
+		for _, d := range r.weekdays(start) {
+			if d == day.Weekday() {
+				return true
+			}
+		}
+	case FreqMonthly:
+		months := (day.Year()-first.Year())*12 + int(day.Month()) - int(first.Month())
+		if months%r.Interval != 0 {
+			return false
+		}
+		for _, d := range r.monthDays(start) {
+			if d == day.Day() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (r *Rule) clock(start time.Time) (int, int) {
+	hour, minute := start.Hour(), start.Minute()
+	if r.Hour >= 0 {
+		hour = r.Hour
+	}
+	if r.Minute >= 0 {
+		minute = r.Minute
+	}
+	return hour, minute
+}
+
+func (r *Rule) weekdays(start time.Time) []time.Weekday {
+	if len(r.ByDay) > 0 {
+		return r.ByDay
+	}
+	return []time.Weekday{start.Weekday()}
+}
+
+func (r *Rule) monthDays(start time.Time) []int {
+	if len(r.ByMonthDay) > 0 {
+		return r.ByMonthDay
+	}
+	return []int{start.Day()}
+}
+
+// civil отбрасывает время и зону, оставляя календарную дату в UTC.
+func civil(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func monday(day time.Time) time.Time {
+	return day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+}
+
+func daysBetween(from, to time.Time) int {
+	return int(to.Sub(from).Hours() / 24)
+}
@@ -0,0 +1,92 @@
+// Package search — полнотекстовый поиск напоминаний: нормализация запроса
+// и разбор подсветки совпадений, которую возвращает Postgres (ts_headline).
+//
+// Совпадения в подсветке обрамляются управляющими символами StartSel и
+// StopSel, которых нет в пользовательском тексте: так текст можно безопасно
+// экранировать, а разметку добавить уже при выводе.
+package search
+
+import (
+	"html"
+	"strings"
+	"unicode/utf8"
+)
+
+// MaxQueryLen — максимальная длина поискового запроса в символах.
+const MaxQueryLen = 200
+
+// Границы совпадения в подсветке.
+const (
+	StartSel = "\x02"
+	StopSel  = "\x03"
+)
+
+// Параметры ts_headline: название подсвечивается целиком, из описания
+// берутся до двух фрагментов вокруг совпадений.
+const (
+	TitleOptions   = "HighlightAll=true, StartSel=" + StartSel + ", StopSel=" + StopSel
+	SnippetOptions = `MaxWords=20, MinWords=8, MaxFragments=2, FragmentDelimiter=" … ", ` +
+		"StartSel=" + StartSel + ", StopSel=" + StopSel
+)
+
+// Highlight — подсветка совпадений в напоминании.
+type Highlight struct {
+	// Title — название с отмеченными совпадениями.
+	Title string
+	// Snippet — фрагменты описания с совпадениями; пусто, если совпадений
+	// в описании нет.
+	Snippet string
+}
+
+// Part — кусок подсвеченного текста.
+type Part struct {
+	Text  string
+	Match bool
+}
+
+// Normalize убирает лишние пробелы и обрезает запрос до MaxQueryLen символов.
+func Normalize(q string) string {
+	q = strings.Join(strings.Fields(q), " ")
+	if utf8.RuneCountInString(q) <= MaxQueryLen {
+		return q
+	}
+	return string([]rune(q)[:MaxQueryLen])
+}
+
+// HasMatch сообщает, есть ли в подсвеченном тексте совпадения.
+func HasMatch(s string) bool {
+	return strings.Contains(s, StartSel)
+}
+
+// Parts разбивает подсвеченный текст на куски с совпадениями и без.
+func Parts(s string) []Part {
+	var parts []Part
+	for s != "" {
+		before, rest, found := strings.Cut(s, StartSel)
+		if before != "" {
+			parts = append(parts, Part{Text: before})
+		}
+		if !found {
+			break
+		}
+		match, after, _ := strings.Cut(rest, StopSel)
+		if match != "" {
+			parts = append(parts, Part{Text: match, Match: true})
+		}
+		s = after
+	}
+	return parts
+}
+
+// HTML экранирует текст и обрамляет совпадения тегом <mark>.
+func HTML(s string) string {
+	var b strings.Builder
+	for _, p := range Parts(s) {
+		if p.Match {
+			b.WriteString("<mark>" + html.EscapeString(p.Text) + "</mark>")
+			continue
+		}
+		b.WriteString(html.EscapeString(p.Text))
+	}
+	return b.String()
+}
@@ -0,0 +1,49 @@
+package search
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	if got := Normalize("  купить \t молоко\n "); got != "купить молоко" {
+		t.Errorf("Normalize = %q", got)
+	}
+	long := strings.Repeat("я", MaxQueryLen+10)
+	if got := Normalize(long); got != strings.Repeat("я", MaxQueryLen) {
+		t.Errorf("Normalize(long) has %d runes, want %d", len([]rune(got)), MaxQueryLen)
+	}
+}
+
+func TestParts(t *testing.T) {
+	tests := []struct {
+		in   string
+		want []Part
+	}{
+		{"", nil},
+		{"без совпадений", []Part{{Text: "без совпадений"}}},
+		{
+			"Купить " + StartSel + "молоко" + StopSel + " и " + StartSel + "хлеб" + StopSel,
+			[]Part{{Text: "Купить "}, {Text: "молоко", Match: true}, {Text: " и "}, {Text: "хлеб", Match: true}},
+		},
+		// Незакрытое совпадение идёт до конца строки.
+		{StartSel + "milk", []Part{{Text: "milk", Match: true}}},
+	}
+	for _, tt := range tests {
+		if got := Parts(tt.in); !slices.Equal(got, tt.want) {
+			t.Errorf("Parts(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestHTML(t *testing.T) {
+	in := "<b>" + StartSel + "Tom & Jerry" + StopSel + "</b>"
+	want := "&lt;b&gt;<mark>Tom &amp; Jerry</mark>&lt;/b&gt;"
+	if got := HTML(in); got != want {
+		t.Errorf("HTML = %q, want %q", got, want)
+	}
+	if !HasMatch(in) || HasMatch("Tom") {
+		t.Error("HasMatch is wrong")
+	}
+}
@@ -0,0 +1,46 @@
+// Package snooze описывает варианты откладывания напоминаний,
+// общие для кнопок в Telegram и действий в веб-интерфейсе.
+package snooze
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrUnknownOption — неизвестный вариант откладывания.
+var ErrUnknownOption = errors.New("snooze: unknown option")
+
+// TomorrowHour — час, на который переносится напоминание вариантом «завтра».
+const TomorrowHour = 9
+
+// Option — вариант откладывания.
+type Option struct {
+	Key   string
+	Label string
+	// For — фиксированная задержка; ноль означает «завтра в TomorrowHour:00».
+	For time.Duration
+}
+
+// Options — варианты, предлагаемые пользователю.
+var Options = []Option{
+	{Key: "10m", Label: "+10 мин", For: 10 * time.Minute},
+	{Key: "1h", Label: "+1 ч", For: time.Hour},
+	{Key: "tomorrow", Label: "Завтра 9:00"},
+}
+
+// Duration возвращает задержку для варианта key относительно now.
+// Вариант «завтра» считается в зоне loc.
+func Duration(key string, now time.Time, loc *time.Location) (time.Duration, error) {
+	for _, o := range Options {
+		if o.Key != key {
+			continue
+		}
+		if o.For > 0 {
+			return o.For, nil
+		}
+		local := now.In(loc)
+		tomorrow := time.Date(local.Year(), local.Month(), local.Day()+1, TomorrowHour, 0, 0, 0, loc)
+		return tomorrow.Sub(now), nil
+	}
+	return 0, ErrUnknownOption
+}
@@ -0,0 +1,37 @@
+package snooze
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestDuration(t *testing.T) {
+	loc := time.FixedZone("MSK", 3*60*60)
+	now := time.Date(2026, 10, 7, 23, 30, 0, 0, loc)
+
+	cases := map[string]time.Duration{
+		"10m":      10 * time.Minute,
+		"1h":       time.Hour,
+		"tomorrow": 9*time.Hour + 30*time.Minute,
+	}
+	for key, want := range cases {
+		got, err := Duration(key, now, loc)
+		if err != nil {
+			t.Fatalf("Duration(%q): %v", key, err)
+		}
+		if got != want {
+			t.Errorf("Duration(%q) = %v, want %v", key, got, want)
+		}
+	}
+
+	// 23:30 UTC — уже следующие сутки по Москве.
+	got, _ := Duration("tomorrow", time.Date(2026, 10, 7, 23, 30, 0, 0, time.UTC), loc)
+	if want := 30*time.Hour + 30*time.Minute; got != want {
+		t.Errorf("Duration(tomorrow) across zones = %v, want %v", got, want)
+	}
+
+	if _, err := Duration("week", now, loc); !errors.Is(err, ErrUnknownOption) {
+		t.Errorf("Duration(week) error = %v, want %v", err, ErrUnknownOption)
+	}
+}
@@ -139,12 +139,7 @@ func New(opts Options, log *slog.Logger) (*Postgres, error) {
 		return nil, fmt.Errorf("pgdb - New - pgxpool.ConnectConfig: %w", err)
 	}
 
-	conn, err := pg.Pool.Acquire(context.Background())
-	if err != nil {
-		return nil, fmt.Errorf("pgdb - New - pgxpool.Acquire: %w", err)
-	}
-	pg.TxManager = NewTxManager(conn.Conn())
-	conn.Release()
+	pg.TxManager = NewTxManager(pg.Pool)
 
 	return pg, nil
 }
@@ -6,16 +6,23 @@ import (
 	"log"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 )
 
 // TxManager управляет транзакциями.
 type TxManager struct {
-	conn *pgx.Conn
+	db txBeginner
 }
 
-// NewTxManager создает новый TxManager.
-func NewTxManager(conn *pgx.Conn) *TxManager {
-	return &TxManager{conn: conn}
+// txBeginner открывает транзакцию — пул соединений или отдельное соединение.
+type txBeginner interface {
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
+// NewTxManager создает новый TxManager. Каждая транзакция берёт своё
+// соединение из db, поэтому RunInTx можно вызывать конкурентно.
+func NewTxManager(db txBeginner) *TxManager {
+	return &TxManager{db: db}
 }
 
 // RunInTx выполняет функцию f внутри транзакции.
@@ -24,7 +31,7 @@ func (tm *TxManager) RunInTx(ctx context.Context, f func(context.Context) error)
 	tx, ok := ctx.Value(txKey{}).(pgx.Tx)
 	if !ok {
 		// Создаем новую транзакцию, если она еще не существует
-		tx, err = tm.conn.Begin(ctx)
+		tx, err = tm.db.Begin(ctx)
 		if err != nil {
 			return fmt.Errorf("создание транзакции: %v", err)
 		}
@@ -45,7 +52,7 @@ func (tm *TxManager) RunInTx(ctx context.Context, f func(context.Context) error)
 			} else {
 				// Коммитим транзакцию, если все прошло успешно
 				if commitErr := tx.Commit(ctx); commitErr != nil {
-					log.Printf("Ошибка коммита транзакции: %v", commitErr)
+					err = fmt.Errorf("коммит транзакции: %w", commitErr)
 				}
 			}
 		}()
@@ -70,3 +77,20 @@ func TxFromContext(ctx context.Context) pgx.Tx {
 	tx, _ := ctx.Value(txKey{}).(pgx.Tx)
 	return tx
 }
+
+// Querier — запросы, общие для пула и транзакции.
+type Querier interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+	SendBatch(ctx context.Context, b *pgx.Batch) pgx.BatchResults
+}
+
+// DB возвращает транзакцию из контекста, если RunInTx её открыл, иначе пул:
+// репозитории через DB участвуют во внешней транзакции без отдельного API.
+func (p *Postgres) DB(ctx context.Context) Querier {
+	if tx := TxFromContext(ctx); tx != nil {
+		return tx
+	}
+	return p.Pool
+}
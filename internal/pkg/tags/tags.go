@@ -0,0 +1,64 @@
+// Package tags нормализует пользовательские теги напоминаний.
+//
+// Тег — одно слово из букв, цифр, «-» и «_» в нижнем регистре; ведущий «#»
+// отбрасывается. В строке теги разделяются запятыми или пробелами.
+package tags
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+const (
+	// MaxTags — сколько тегов можно задать одному напоминанию.
+	MaxTags = 10
+	// MaxLen — максимальная длина тега в символах.
+	MaxLen = 32
+)
+
+// ErrInvalidTag — тег с недопустимыми символами, слишком длинный или их слишком много.
+var ErrInvalidTag = errors.New("invalid tag")
+
+// Parse разбирает строку вида «#работа, дом срочно».
+func Parse(raw string) ([]string, error) {
+	return Normalize(strings.FieldsFunc(raw, func(r rune) bool {
+		return r == ',' || unicode.IsSpace(r)
+	}))
+}
+
+// Normalize приводит теги к нижнему регистру, убирает «#», пустые значения
+// и повторы с сохранением порядка.
+func Normalize(values []string) ([]string, error) {
+	out := make([]string, 0, len(values))
+	seen := make(map[string]bool, len(values))
+	for _, v := range values {
+		tag := strings.ToLower(strings.TrimPrefix(strings.TrimSpace(v), "#"))
+		if tag == "" || seen[tag] {
+			continue
+		}
+		if len([]rune(tag)) > MaxLen {
+			return nil, fmt.Errorf("%w: %q longer than %d characters", ErrInvalidTag, tag, MaxLen)
+		}
+		for _, r := range tag {
+			if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '-' && r != '_' {
+				return nil, fmt.Errorf("%w: %q contains %q", ErrInvalidTag, tag, r)
+			}
+		}
+		seen[tag] = true
+		out = append(out, tag)
+	}
+	if len(out) > MaxTags {
+		return nil, fmt.Errorf("%w: at most %d tags", ErrInvalidTag, MaxTags)
+	}
+	return out, nil
+}
+
+// String соединяет теги для отображения: «#работа #дом».
+func String(tags []string) string {
+	if len(tags) == 0 {
+		return ""
+	}
+	return "#" + strings.Join(tags, " #")
+}
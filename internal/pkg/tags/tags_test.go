@@ -0,0 +1,38 @@
+package tags
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	got, err := Parse(" #Работа, дом  срочно,#работа ,, ")
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	want := []string{"работа", "дом", "срочно"}
+	if !slices.Equal(got, want) {
+		t.Errorf("Parse = %v, want %v", got, want)
+	}
+	if s := String(got); s != "#работа #дом #срочно" {
+		t.Errorf("String = %q", s)
+	}
+
+	if got, err := Parse(""); err != nil || len(got) != 0 {
+		t.Errorf("Parse(\"\") = %v, %v; want empty", got, err)
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	for _, raw := range []string{
+		"re:work",
+		"#" + strings.Repeat("x", MaxLen+1),
+		"a b c d e f g h i j k",
+	} {
+		if _, err := Parse(raw); !errors.Is(err, ErrInvalidTag) {
+			t.Errorf("Parse(%q) error = %v, want ErrInvalidTag", raw, err)
+		}
+	}
+}
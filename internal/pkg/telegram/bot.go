@@ -3,6 +3,7 @@ package telegram
 import (
 	"context"
 	"fmt"
+	"io"
 	"log/slog"
 	"net/http"
 	"time"
@@ -94,6 +95,10 @@ func (b *Bot) setCommands(ctx context.Context) {
 		{Command: "start", Description: "Начать работу"},
 		{Command: "help", Description: "Список команд"},
 		{Command: "remind", Description: "Создать напоминание"},
+		{Command: "edit", Description: "Изменить напоминание"},
+		{Command: "dnd", Description: "Режим «не беспокоить»"},
+		{Command: "timezone", Description: "Часовой пояс"},
+		{Command: "digest", Description: "Утренняя сводка"},
 		{Command: "cancel", Description: "Отменить текущее действие"},
 		{Command: "app", Description: "Открыть Mini App"},
 	}
@@ -130,35 +135,60 @@ func (b *Bot) Stop() {
 	}
 }
 
-// SendMessage отправляет текстовое сообщение в указанный чат.
-func (b *Bot) SendMessage(ctx context.Context, chatID int64, text string) error {
+// SendMessage отправляет текстовое сообщение в указанный чат и возвращает
+// ID сообщения; 0 — бот не настроен и сообщение пропущено.
+func (b *Bot) SendMessage(ctx context.Context, chatID int64, text string) (int, error) {
+	return b.SendMessageWithMarkup(ctx, chatID, text, nil)
+}
+
+// SendMessageWithMarkup отправляет сообщение с inline-клавиатурой и возвращает его ID.
+func (b *Bot) SendMessageWithMarkup(ctx context.Context, chatID int64, text string, markup models.ReplyMarkup) (int, error) {
 	if b.bot == nil {
 		b.log.Warn("telegram bot is not configured, skipping message", slog.Int64("chat_id", chatID))
+		return 0, nil
+	}
+	sent, err := b.bot.SendMessage(ctx, &bot.SendMessageParams{
+		ChatID:      chatID,
+		Text:        text,
+		ReplyMarkup: markup,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("send telegram message: %w", err)
+	}
+	return sent.ID, nil
+}
+
+// SendPhoto отправляет изображение в чат (sendPhoto): Telegram показывает его
+// превью и принимает файлы до 10 МБ.
+func (b *Bot) SendPhoto(ctx context.Context, chatID int64, filename string, data io.Reader, caption string) error {
+	if b.bot == nil {
+		b.log.Warn("telegram bot is not configured, skipping photo", slog.Int64("chat_id", chatID))
 		return nil
 	}
-	_, err := b.bot.SendMessage(ctx, &bot.SendMessageParams{
-		ChatID: chatID,
-		Text:   text,
+	_, err := b.bot.SendPhoto(ctx, &bot.SendPhotoParams{
+		ChatID:  chatID,
+		Photo:   &models.InputFileUpload{Filename: filename, Data: data},
+		Caption: caption,
 	})
 	if err != nil {
-		return fmt.Errorf("send telegram message: %w", err)
+		return fmt.Errorf("send telegram photo: %w", err)
 	}
 	return nil
 }
 
-// SendMessageWithMarkup отправляет сообщение с inline-клавиатурой.
-func (b *Bot) SendMessageWithMarkup(ctx context.Context, chatID int64, text string, markup models.ReplyMarkup) error {
+// SendDocument отправляет файл в чат документом (sendDocument).
+func (b *Bot) SendDocument(ctx context.Context, chatID int64, filename string, data io.Reader, caption string) error {
 	if b.bot == nil {
-		b.log.Warn("telegram bot is not configured, skipping message", slog.Int64("chat_id", chatID))
+		b.log.Warn("telegram bot is not configured, skipping document", slog.Int64("chat_id", chatID))
 		return nil
 	}
-	_, err := b.bot.SendMessage(ctx, &bot.SendMessageParams{
-		ChatID:      chatID,
-		Text:        text,
-		ReplyMarkup: markup,
+	_, err := b.bot.SendDocument(ctx, &bot.SendDocumentParams{
+		ChatID:   chatID,
+		Document: &models.InputFileUpload{Filename: filename, Data: data},
+		Caption:  caption,
 	})
 	if err != nil {
-		return fmt.Errorf("send telegram message: %w", err)
+		return fmt.Errorf("send telegram document: %w", err)
 	}
 	return nil
 }
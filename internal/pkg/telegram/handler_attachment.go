@@ -0,0 +1,152 @@
+package telegram
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"slices"
+
+	"github.com/go-telegram/bot"
+	"github.com/go-telegram/bot/models"
+	"github.com/go-telegram/fsm"
+	"github.com/vovanwin/template/internal/service"
+)
+
+// createStates — шаги диалога /remind, на которых можно прислать фото или файл.
+var createStates = []fsm.StateID{
+	stateWaitTitle,
+	stateWaitDescription,
+	stateWaitPriority,
+	stateWaitTags,
+	stateWaitDate,
+	stateWaitTime,
+	stateWaitConfirmation,
+	stateWaitRecurrence,
+}
+
+// telegramFile — файл, присланный в диалоге /remind; скачивается из Telegram
+// при создании напоминания.
+type telegramFile struct {
+	FileID      string
+	Filename    string
+	ContentType string
+	Size        int64
+}
+
+// messageFile возвращает фото (в наибольшем размере) или документ сообщения.
+func messageFile(msg *models.Message) (telegramFile, bool) {
+	if msg.Document != nil {
+		return telegramFile{
+			FileID:      msg.Document.FileID,
+			Filename:    msg.Document.FileName,
+			ContentType: msg.Document.MimeType,
+			Size:        msg.Document.FileSize,
+		}, true
+	}
+	if n := len(msg.Photo); n > 0 {
+		// Telegram присылает фото в нескольких размерах по возрастанию.
+		photo := msg.Photo[n-1]
+		return telegramFile{
+			FileID:      photo.FileID,
+			Filename:    photo.FileUniqueID + ".jpg",
+			ContentType: "image/jpeg",
+			Size:        int64(photo.FileSize),
+		}, true
+	}
+	return telegramFile{}, false
+}
+
+// handleAttachment запоминает фото или файл, присланный в диалоге /remind.
+// Возвращает true, если сообщение обработано; подпись к файлу на шагах
+// названия и описания обрабатывается дальше как обычный текст.
+func (h *ReminderHandler) handleAttachment(ctx context.Context, b *bot.Bot, chatID, userID int64, msg *models.Message) bool {
+	file, ok := messageFile(msg)
+	if !ok {
+		return false
+	}
+	state := h.fsm.Current(userID)
+	if !slices.Contains(createStates, state) {
+		h.sendText(ctx, b, chatID, "Чтобы приложить файл к напоминанию, начните /remind и пришлите его во время диалога.")
+		return true
+	}
+
+	filesVal, _ := h.fsm.Get(userID, "attachments")
+	files, _ := filesVal.([]telegramFile)
+	switch {
+	case len(files) >= service.MaxAttachments:
+		h.sendError(ctx, b, chatID, fmt.Sprintf("Можно приложить не больше %d файлов.", service.MaxAttachments))
+	case file.Size > service.MaxAttachmentSize:
+		h.sendError(ctx, b, chatID, fmt.Sprintf("Файл больше %d МБ, его не получится приложить.", service.MaxAttachmentSize>>20))
+	default:
+		files = append(files, file)
+		h.fsm.Set(userID, "attachments", files)
+		h.sendText(ctx, b, chatID, fmt.Sprintf("📎 Файл приложен (%d из %d). Он придёт вместе с напоминанием.", len(files), service.MaxAttachments))
+	}
+
+	return msg.Caption == "" || (state != stateWaitTitle && state != stateWaitDescription)
+}
+
+// downloadAttachments скачивает из Telegram файлы диалога для сохранения в хранилище.
+func downloadAttachments(ctx context.Context, b *bot.Bot, files []telegramFile) ([]service.AttachmentUpload, error) {
+	uploads := make([]service.AttachmentUpload, 0, len(files))
+	for _, f := range files {
+		data, err := downloadFile(ctx, b, f.FileID)
+		if err != nil {
+			return nil, err
+		}
+		uploads = append(uploads, service.AttachmentUpload{
+			Filename:    f.Filename,
+			ContentType: f.ContentType,
+			Size:        int64(len(data)),
+			Data:        bytes.NewReader(data),
+		})
+	}
+	return uploads, nil
+}
+
+// downloadFile скачивает файл по file_id не больше MaxAttachmentSize байт.
+func downloadFile(ctx context.Context, b *bot.Bot, fileID string) ([]byte, error) {
+	file, err := b.GetFile(ctx, &bot.GetFileParams{FileID: fileID})
+	if err != nil {
+		return nil, fmt.Errorf("get telegram file: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, b.FileDownloadLink(file), nil)
+	if err != nil {
+		return nil, fmt.Errorf("build download request: %w", err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		// Ссылка содержит токен бота — в ошибку её не пишем.
+		return nil, fmt.Errorf("download telegram file %s: request failed", fileID)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("download telegram file %s: status %d", fileID, resp.StatusCode)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, service.MaxAttachmentSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("read telegram file %s: %w", fileID, err)
+	}
+	if len(data) > service.MaxAttachmentSize {
+		return nil, fmt.Errorf("telegram file %s is larger than %d bytes", fileID, service.MaxAttachmentSize)
+	}
+	return data, nil
+}
+
+// attachmentsFor скачивает файлы, запомненные в диалоге; при ошибке
+// сообщает о ней в чат и возвращает false.
+func (h *ReminderHandler) attachmentsFor(ctx context.Context, b *bot.Bot, chatID int64, files []telegramFile) ([]service.AttachmentUpload, bool) {
+	if len(files) == 0 {
+		return nil, true
+	}
+	uploads, err := downloadAttachments(ctx, b, files)
+	if err != nil {
+		h.log.Error("failed to download attachments", slog.Any("err", err))
+		h.sendError(ctx, b, chatID, "Не удалось получить приложенные файлы. Попробуйте /remind заново.")
+		return nil, false
+	}
+	return uploads, true
+}
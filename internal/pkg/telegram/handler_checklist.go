@@ -0,0 +1,144 @@
+package telegram
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/go-telegram/bot"
+	"github.com/go-telegram/bot/models"
+	"github.com/google/uuid"
+	"github.com/vovanwin/template/internal/repository"
+	"github.com/vovanwin/template/internal/service"
+)
+
+// checklistCallbackPrefix — кнопка пункта чек-листа: check_item:<item_id>.
+// ID напоминания в данные не входит: вместе они не помещаются в 64 байта.
+const checklistCallbackPrefix = "check_item:"
+
+// checklistButtonLen — сколько символов пункта помещается на кнопку.
+const checklistButtonLen = 40
+
+// ChecklistRows возвращает кнопки пунктов чек-листа для уведомления,
+// по пункту в строке; нажатие отмечает пункт или снимает отметку.
+func ChecklistRows(items []repository.ChecklistItem) [][]models.InlineKeyboardButton {
+	rows := make([][]models.InlineKeyboardButton, 0, len(items))
+	for _, item := range items {
+		mark := "⬜️ "
+		if item.Done {
+			mark = "✅ "
+		}
+		text := item.Text
+		if utf8.RuneCountInString(text) > checklistButtonLen {
+			text = string([]rune(text)[:checklistButtonLen-1]) + "…"
+		}
+		rows = append(rows, []models.InlineKeyboardButton{{
+			Text:         mark + text,
+			CallbackData: checklistCallbackPrefix + item.ID.String(),
+		}})
+	}
+	return rows
+}
+
+// withChecklist заменяет кнопки чек-листа в клавиатуре уведомления, сохраняя
+// остальные на своих местах. Подтверждённому напоминанию кнопка
+// «Подтвердить» больше не нужна.
+func withChecklist(markup *models.InlineKeyboardMarkup, items []repository.ChecklistItem, acknowledged bool) *models.InlineKeyboardMarkup {
+	result := &models.InlineKeyboardMarkup{InlineKeyboard: [][]models.InlineKeyboardButton{}}
+	inserted := false
+	for _, row := range markup.InlineKeyboard {
+		if len(row) == 0 {
+			continue
+		}
+		data := row[0].CallbackData
+		switch {
+		case strings.HasPrefix(data, checklistCallbackPrefix):
+			if !inserted {
+				result.InlineKeyboard = append(result.InlineKeyboard, ChecklistRows(items)...)
+				inserted = true
+			}
+		case acknowledged && strings.HasPrefix(data, "ack_reminder:"):
+		default:
+			result.InlineKeyboard = append(result.InlineKeyboard, row)
+		}
+	}
+	return result
+}
+
+// handleChecklistCallback отмечает пункт чек-листа под уведомлением и
+// перерисовывает кнопки сообщения.
+func (h *ReminderHandler) handleChecklistCallback(ctx context.Context, b *bot.Bot, update *models.Update) {
+	if update.CallbackQuery == nil {
+		return
+	}
+
+	answer := func(text string) {
+		b.AnswerCallbackQuery(ctx, &bot.AnswerCallbackQueryParams{
+			CallbackQueryID: update.CallbackQuery.ID,
+			Text:            text,
+		})
+	}
+
+	msg := update.CallbackQuery.Message.Message
+	if msg == nil {
+		answer("Сообщение устарело")
+		return
+	}
+
+	itemID, err := uuid.Parse(strings.TrimPrefix(update.CallbackQuery.Data, checklistCallbackPrefix))
+	if err != nil {
+		answer("Ошибка: неверный ID")
+		return
+	}
+
+	user, err := h.userRepo.GetByChatID(ctx, msg.Chat.ID)
+	if err != nil || user == nil {
+		answer("Ошибка: пользователь не найден")
+		return
+	}
+
+	res, err := h.reminderService.ToggleChecklistItem(ctx, user.ID, itemID)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrReminderNotFound), errors.Is(err, service.ErrReminderForbidden):
+			answer("Пункт удалён")
+		case errors.Is(err, service.ErrActionNotAllowed):
+			answer("Отмечать пункты может только исполнитель")
+		default:
+			h.log.Error("failed to toggle checklist item", slog.Any("err", err))
+			answer("Не удалось отметить пункт")
+		}
+		return
+	}
+
+	if msg.ReplyMarkup != nil {
+		_, err = b.EditMessageReplyMarkup(ctx, &bot.EditMessageReplyMarkupParams{
+			ChatID:      msg.Chat.ID,
+			MessageID:   msg.ID,
+			ReplyMarkup: withChecklist(msg.ReplyMarkup, res.Items, res.Acknowledged),
+		})
+		if err != nil {
+			h.log.Warn("failed to update checklist buttons", slog.Any("err", err))
+		}
+	}
+
+	if res.Acknowledged {
+		answer("✅ Все пункты выполнены — напоминание подтверждено")
+		return
+	}
+	answer(checklistProgress(res.Items))
+}
+
+// checklistProgress — сколько пунктов чек-листа отмечено.
+func checklistProgress(items []repository.ChecklistItem) string {
+	done := 0
+	for _, item := range items {
+		if item.Done {
+			done++
+		}
+	}
+	return fmt.Sprintf("Выполнено %d из %d", done, len(items))
+}
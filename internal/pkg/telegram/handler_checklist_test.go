@@ -0,0 +1,68 @@
+package telegram
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/go-telegram/bot/models"
+	"github.com/google/uuid"
+	"github.com/vovanwin/template/internal/repository"
+)
+
+// callbackRows — данные кнопок клавиатуры построчно.
+func callbackRows(markup *models.InlineKeyboardMarkup) [][]string {
+	rows := make([][]string, 0, len(markup.InlineKeyboard))
+	for _, row := range markup.InlineKeyboard {
+		data := make([]string, 0, len(row))
+		for _, button := range row {
+			data = append(data, button.CallbackData)
+		}
+		rows = append(rows, data)
+	}
+	return rows
+}
+
+func TestWithChecklist(t *testing.T) {
+	first := repository.ChecklistItem{ID: uuid.New(), Text: "купить молоко"}
+	second := repository.ChecklistItem{ID: uuid.New(), Text: "позвонить маме"}
+	ack := []models.InlineKeyboardButton{{Text: "✅ Подтвердить", CallbackData: "ack_reminder:1"}}
+	snooze := []models.InlineKeyboardButton{
+		{Text: "15 мин", CallbackData: "snooze_reminder:1:15m"},
+		{Text: "1 час", CallbackData: "snooze_reminder:1:1h"},
+	}
+	markup := &models.InlineKeyboardMarkup{
+		InlineKeyboard: append(append([][]models.InlineKeyboardButton{ack}, ChecklistRows([]repository.ChecklistItem{first, second})...), snooze),
+	}
+
+	first.Done = true
+	items := []repository.ChecklistItem{first, second}
+
+	got := withChecklist(markup, items, false)
+	want := [][]string{
+		{"ack_reminder:1"},
+		{checklistCallbackPrefix + first.ID.String()},
+		{checklistCallbackPrefix + second.ID.String()},
+		{"snooze_reminder:1:15m", "snooze_reminder:1:1h"},
+	}
+	assertRows(t, "not acknowledged", callbackRows(got), want)
+	if text := got.InlineKeyboard[1][0].Text; text != "✅ купить молоко" {
+		t.Errorf("done item button = %q, want %q", text, "✅ купить молоко")
+	}
+	if text := got.InlineKeyboard[2][0].Text; text != "⬜️ позвонить маме" {
+		t.Errorf("open item button = %q, want %q", text, "⬜️ позвонить маме")
+	}
+	if text := markup.InlineKeyboard[1][0].Text; text != "⬜️ купить молоко" {
+		t.Errorf("source markup changed: %q", text)
+	}
+
+	second.Done = true
+	got = withChecklist(markup, []repository.ChecklistItem{first, second}, true)
+	assertRows(t, "acknowledged", callbackRows(got), want[1:])
+}
+
+func assertRows(t *testing.T, name string, got, want [][]string) {
+	t.Helper()
+	if !slices.EqualFunc(got, want, slices.Equal[[]string]) {
+		t.Errorf("%s: rows = %q, want %q", name, got, want)
+	}
+}
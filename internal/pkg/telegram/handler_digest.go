@@ -0,0 +1,68 @@
+package telegram
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/go-telegram/bot"
+	"github.com/go-telegram/bot/models"
+	"github.com/vovanwin/template/internal/pkg/digest"
+	"github.com/vovanwin/template/internal/repository"
+	"github.com/vovanwin/template/internal/service"
+)
+
+// handleDigest показывает настройки утренней сводки. «/digest 07:30» включает
+// сводку в указанное время, «/digest on» — в прежнее, «/digest off» выключает.
+func (h *ReminderHandler) handleDigest(ctx context.Context, b *bot.Bot, update *models.Update) {
+	if update.Message == nil {
+		return
+	}
+	chatID := update.Message.Chat.ID
+
+	user, settings, ok := h.timezoneSettings(ctx, b, chatID)
+	if !ok {
+		return
+	}
+	_, arg, _ := strings.Cut(update.Message.Text, " ")
+	arg = strings.ToLower(strings.TrimSpace(arg))
+	if arg == "" {
+		h.sendText(ctx, b, chatID, digestText(settings)+
+			"\n\nНапишите «/digest 07:30», чтобы получать сводку в это время, или «/digest off», чтобы выключить.")
+		return
+	}
+
+	enabled, at := true, arg
+	switch arg {
+	case "on":
+		at = settings.DigestTime
+	case "off":
+		enabled, at = false, settings.DigestTime
+	}
+	if err := h.reminderService.UpdateDigest(ctx, user.ID, enabled, at); err != nil {
+		if errors.Is(err, service.ErrInvalidReminder) {
+			h.sendError(ctx, b, chatID, fmt.Sprintf("Не понял время «%s». Пример: /digest 07:30", arg))
+			return
+		}
+		h.log.Error("failed to update digest", slog.Any("err", err))
+		h.sendError(ctx, b, chatID, "Не удалось сохранить настройку.")
+		return
+	}
+
+	settings.DigestEnabled = enabled
+	if clock, err := digest.ParseClock(at); err == nil {
+		settings.DigestTime = clock.String()
+	}
+	h.sendText(ctx, b, chatID, digestText(settings))
+}
+
+// digestText описывает настройки утренней сводки.
+func digestText(settings *repository.NotificationSettings) string {
+	if !settings.DigestEnabled {
+		return "☀️ Утренняя сводка выключена."
+	}
+	return fmt.Sprintf("☀️ Утренняя сводка приходит в %s по вашему часовому поясу: "+
+		"напоминания на сегодня и вчерашние без подтверждения.", settings.DigestTime)
+}
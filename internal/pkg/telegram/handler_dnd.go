@@ -0,0 +1,105 @@
+package telegram
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-telegram/bot"
+	"github.com/go-telegram/bot/models"
+	"github.com/vovanwin/template/internal/pkg/timezone"
+)
+
+// dndChoices — варианты режима «не беспокоить» в /dnd, в минутах; 0 — выключить.
+var dndChoices = []struct {
+	Label   string
+	Minutes int
+}{
+	{"1 час", 60},
+	{"3 часа", 180},
+	{"8 часов", 480},
+	{"Сутки", 1440},
+	{"Выключить", 0},
+}
+
+// handleDND показывает состояние режима «не беспокоить» и кнопки переключения.
+func (h *ReminderHandler) handleDND(ctx context.Context, b *bot.Bot, update *models.Update) {
+	if update.Message == nil {
+		return
+	}
+	chatID := update.Message.Chat.ID
+
+	user, err := h.userRepo.GetByChatID(ctx, chatID)
+	if err != nil || user == nil {
+		h.sendError(ctx, b, chatID, "Ваш аккаунт не привязан. Укажите Chat ID в настройках профиля.")
+		return
+	}
+	settings, err := h.reminderService.GetNotificationSettings(ctx, user.ID)
+	if err != nil {
+		h.log.Error("failed to get notification settings", slog.Any("err", err))
+		h.sendError(ctx, b, chatID, "Ошибка при загрузке настроек.")
+		return
+	}
+
+	var row []models.InlineKeyboardButton
+	for _, c := range dndChoices {
+		row = append(row, models.InlineKeyboardButton{
+			Text:         c.Label,
+			CallbackData: fmt.Sprintf("dnd:%d", c.Minutes),
+		})
+	}
+	if _, err := b.SendMessage(ctx, &bot.SendMessageParams{
+		ChatID:      chatID,
+		Text:        dndText(ctx, settings.DNDUntil) + "\n\nНа сколько включить?",
+		ReplyMarkup: &models.InlineKeyboardMarkup{InlineKeyboard: [][]models.InlineKeyboardButton{row}},
+	}); err != nil {
+		h.log.Error("failed to send dnd choices", slog.Any("err", err))
+	}
+}
+
+// handleDNDCallback включает или выключает режим «не беспокоить».
+func (h *ReminderHandler) handleDNDCallback(ctx context.Context, b *bot.Bot, update *models.Update) {
+	if update.CallbackQuery == nil {
+		return
+	}
+	chatID := update.CallbackQuery.Message.Message.Chat.ID
+
+	b.AnswerCallbackQuery(ctx, &bot.AnswerCallbackQueryParams{
+		CallbackQueryID: update.CallbackQuery.ID,
+	})
+
+	minutes, err := strconv.Atoi(strings.TrimPrefix(update.CallbackQuery.Data, "dnd:"))
+	if err != nil || minutes < 0 {
+		return
+	}
+	user, err := h.userRepo.GetByChatID(ctx, chatID)
+	if err != nil || user == nil {
+		h.sendError(ctx, b, chatID, "Ваш аккаунт не привязан. Укажите Chat ID в настройках профиля.")
+		return
+	}
+
+	until, err := h.reminderService.SetDND(ctx, user.ID, time.Duration(minutes)*time.Minute)
+	if err != nil {
+		h.log.Error("failed to set dnd", slog.Any("err", err))
+		h.sendError(ctx, b, chatID, "Не удалось сохранить настройку.")
+		return
+	}
+	if _, err := b.SendMessage(ctx, &bot.SendMessageParams{
+		ChatID: chatID,
+		Text:   dndText(ctx, until),
+	}); err != nil {
+		h.log.Error("failed to send dnd status", slog.Any("err", err))
+	}
+}
+
+// dndText описывает состояние режима «не беспокоить».
+func dndText(ctx context.Context, until *time.Time) string {
+	if until == nil || !until.After(time.Now()) {
+		return "🔔 Режим «не беспокоить» выключен."
+	}
+	return "🔕 Не беспокоить до " + timezone.FormatUser(ctx, *until, "02.01 15:04") +
+		". Уведомления придут после, высокий приоритет — сразу."
+}
@@ -27,7 +27,14 @@ const helpText = `Доступные команды:
 
 /start — приветствие и ваш Chat ID
 /help — список команд
-/remind — создать напоминание
+/remind — создать напоминание (разовое или повторяющееся)
+/remind завтра в 9 купить молоко — создать одной строкой
+Фото и файлы, присланные во время /remind, придут вместе с напоминанием
+Пункты чек-листа под уведомлением отмечаются нажатием; когда отмечены все, напоминание подтверждается
+/edit — изменить название, описание или время напоминания
+/dnd — режим «не беспокоить»
+/timezone — часовой пояс; можно прислать геопозицию
+/digest — утренняя сводка: /digest 07:30 или /digest off
 /cancel — отменить текущее действие
 /app — открыть Mini App`
 
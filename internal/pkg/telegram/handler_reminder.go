@@ -2,8 +2,10 @@ package telegram
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"strings"
 	"sync"
 	"time"
 
@@ -12,6 +14,11 @@ import (
 	"github.com/go-telegram/fsm"
 	"github.com/go-telegram/ui/datepicker"
 	"github.com/google/uuid"
+	"github.com/vovanwin/template/internal/model"
+	"github.com/vovanwin/template/internal/pkg/nldate"
+	"github.com/vovanwin/template/internal/pkg/recurrence"
+	"github.com/vovanwin/template/internal/pkg/snooze"
+	"github.com/vovanwin/template/internal/pkg/tags"
 	"github.com/vovanwin/template/internal/pkg/timezone"
 	"github.com/vovanwin/template/internal/repository"
 	"github.com/vovanwin/template/internal/service"
@@ -22,9 +29,22 @@ const (
 	stateDefault          fsm.StateID = "default"
 	stateWaitTitle        fsm.StateID = "waitTitle"
 	stateWaitDescription  fsm.StateID = "waitDescription"
+	stateWaitPriority     fsm.StateID = "waitPriority"
+	stateWaitTags         fsm.StateID = "waitTags"
 	stateWaitDate         fsm.StateID = "waitDate"
 	stateWaitTime         fsm.StateID = "waitTime"
 	stateWaitConfirmation fsm.StateID = "waitConfirmation"
+	stateWaitRecurrence   fsm.StateID = "waitRecurrence"
+	stateConfirmQuick     fsm.StateID = "confirmQuick"
+)
+
+// FSM states для изменения напоминания (/edit).
+const (
+	stateEditField       fsm.StateID = "editField"
+	stateEditTitle       fsm.StateID = "editTitle"
+	stateEditDescription fsm.StateID = "editDescription"
+	stateEditDate        fsm.StateID = "editDate"
+	stateEditTime        fsm.StateID = "editTime"
 )
 
 // ReminderHandler обрабатывает команду /remind с FSM для многошагового диалога.
@@ -57,10 +77,25 @@ func NewReminderHandler(
 
 func (h *ReminderHandler) Options() []bot.Option {
 	return []bot.Option{
-		bot.WithMessageTextHandler("/remind", bot.MatchTypeExact, h.handleRemind),
+		bot.WithMiddlewares(h.withLocation),
+		bot.WithMessageTextHandler("remind", bot.MatchTypeCommandStartOnly, h.handleRemind),
 		bot.WithMessageTextHandler("/cancel", bot.MatchTypeExact, h.handleCancel),
+		bot.WithMessageTextHandler("/edit", bot.MatchTypeExact, h.handleEdit),
+		bot.WithMessageTextHandler("/dnd", bot.MatchTypeExact, h.handleDND),
+		bot.WithMessageTextHandler("timezone", bot.MatchTypeCommandStartOnly, h.handleTimezone),
+		bot.WithMessageTextHandler("digest", bot.MatchTypeCommandStartOnly, h.handleDigest),
+		bot.WithCallbackQueryDataHandler("edit_reminder:", bot.MatchTypePrefix, h.handleEditReminderCallback),
+		bot.WithCallbackQueryDataHandler("edit_field:", bot.MatchTypePrefix, h.handleEditFieldCallback),
 		bot.WithCallbackQueryDataHandler("ack_reminder:", bot.MatchTypePrefix, h.handleAckCallback),
+		bot.WithCallbackQueryDataHandler(checklistCallbackPrefix, bot.MatchTypePrefix, h.handleChecklistCallback),
+		bot.WithCallbackQueryDataHandler("snooze_reminder:", bot.MatchTypePrefix, h.handleSnoozeCallback),
 		bot.WithCallbackQueryDataHandler("confirm_interval:", bot.MatchTypePrefix, h.handleConfirmIntervalCallback),
+		bot.WithCallbackQueryDataHandler("recurrence:", bot.MatchTypePrefix, h.handleRecurrenceCallback),
+		bot.WithCallbackQueryDataHandler("priority:", bot.MatchTypePrefix, h.handlePriorityCallback),
+		bot.WithCallbackQueryDataHandler("quick:", bot.MatchTypePrefix, h.handleQuickCallback),
+		bot.WithCallbackQueryDataHandler("dnd:", bot.MatchTypePrefix, h.handleDNDCallback),
+		bot.WithCallbackQueryDataHandler("tz:", bot.MatchTypePrefix, h.handleTimezoneCallback),
+		bot.WithCallbackQueryDataHandler("tz_follow:", bot.MatchTypePrefix, h.handleTimezoneCallback),
 		bot.WithDefaultHandler(h.handleDefault),
 	}
 }
@@ -72,7 +107,9 @@ func (h *ReminderHandler) initWidgets(b *bot.Bot) {
 		h.dp = datepicker.New(b, h.onDateSelected,
 			datepicker.Language("ru"),
 			datepicker.WithPrefix("reminder_dp"),
-			datepicker.From(time.Now().In(timezone.UserLocation)),
+			// Календарь общий для всех пользователей: начинаем со вчерашнего дня
+			// по UTC, чтобы «сегодня» было доступно в любой таймзоне.
+			datepicker.From(time.Now().UTC().AddDate(0, 0, -1)),
 		)
 		h.tp = NewTimePicker(b, "reminder_tp", h.onTimeSelected)
 	})
@@ -84,7 +121,11 @@ func (h *ReminderHandler) onDateSelected(ctx context.Context, b *bot.Bot, mes mo
 	userID := chatID // для личных чатов совпадают
 
 	h.fsm.Set(userID, "date", date)
-	h.fsm.Transition(userID, stateWaitTime)
+	if h.fsm.Current(userID) == stateEditDate {
+		h.fsm.Transition(userID, stateEditTime)
+	} else {
+		h.fsm.Transition(userID, stateWaitTime)
+	}
 
 	h.initWidgets(b)
 	if _, err := b.SendMessage(ctx, &bot.SendMessageParams{
@@ -110,11 +151,21 @@ func (h *ReminderHandler) onTimeSelected(ctx context.Context, b *bot.Bot, mes mo
 	date, _ := dateVal.(time.Time)
 
 	// Собираем дату+время в таймзоне пользователя, затем конвертируем в UTC для хранения.
-	localTime := time.Date(date.Year(), date.Month(), date.Day(), hour, minute, 0, 0, timezone.UserLocation)
+	localTime := time.Date(date.Year(), date.Month(), date.Day(), hour, minute, 0, 0, timezone.Location(ctx))
 	remindAtUTC := localTime.UTC()
 
+	if h.fsm.Current(userID) == stateEditTime {
+		h.applyEdit(ctx, b, chatID, userID, func(in *service.UpdateReminderInput) { in.RemindAt = &remindAtUTC })
+		return
+	}
+
+	h.askConfirmation(ctx, b, chatID, userID, remindAtUTC, "")
+}
+
+// askConfirmation сохраняет время напоминания и предлагает выбрать интервал подтверждения.
+// prefix выводится перед вопросом, например распознанное из текста время.
+func (h *ReminderHandler) askConfirmation(ctx context.Context, b *bot.Bot, chatID, userID int64, remindAtUTC time.Time, prefix string) {
 	h.fsm.Set(userID, "remind_at_utc", remindAtUTC)
-	h.fsm.Set(userID, "local_time", localTime)
 	h.fsm.Transition(userID, stateWaitConfirmation)
 
 	// Показываем выбор подтверждения
@@ -137,7 +188,7 @@ func (h *ReminderHandler) onTimeSelected(ctx context.Context, b *bot.Bot, mes mo
 
 	if _, err := b.SendMessage(ctx, &bot.SendMessageParams{
 		ChatID:      chatID,
-		Text:        "Требуется подтверждение получения? Выберите интервал повтора:",
+		Text:        prefix + "Требуется подтверждение получения? Выберите интервал повтора:",
 		ReplyMarkup: keyboard,
 	}); err != nil {
 		h.log.Error("failed to send confirmation prompt", slog.Any("err", err))
@@ -145,6 +196,7 @@ func (h *ReminderHandler) onTimeSelected(ctx context.Context, b *bot.Bot, mes mo
 }
 
 // handleRemind запускает FSM-диалог создания напоминания.
+// С текстом после команды («/remind завтра в 9 купить молоко») создаёт напоминание из одной строки.
 func (h *ReminderHandler) handleRemind(ctx context.Context, b *bot.Bot, update *models.Update) {
 	if update.Message == nil {
 		return
@@ -152,12 +204,17 @@ func (h *ReminderHandler) handleRemind(ctx context.Context, b *bot.Bot, update *
 	userID := update.Message.From.ID
 	chatID := update.Message.Chat.ID
 
+	if _, args, _ := strings.Cut(update.Message.Text, " "); strings.TrimSpace(args) != "" {
+		h.handleQuickRemind(ctx, b, chatID, userID, args)
+		return
+	}
+
 	h.initWidgets(b)
 	h.fsm.Transition(userID, stateWaitTitle)
 
 	if _, err := b.SendMessage(ctx, &bot.SendMessageParams{
 		ChatID: chatID,
-		Text:   "Введите название напоминания:",
+		Text:   "Введите название напоминания:\n\nВ любой момент диалога можно прислать фото или файл — они придут вместе с напоминанием.",
 	}); err != nil {
 		h.log.Error("failed to send remind prompt", slog.Any("err", err))
 	}
@@ -193,6 +250,17 @@ func (h *ReminderHandler) handleDefault(ctx context.Context, b *bot.Bot, update
 	chatID := update.Message.Chat.ID
 	text := update.Message.Text
 
+	if update.Message.Location != nil {
+		h.handleLocation(ctx, b, chatID, update.Message.Location)
+		return
+	}
+	if h.handleAttachment(ctx, b, chatID, userID, update.Message) {
+		return
+	}
+	if text == "" {
+		text = update.Message.Caption
+	}
+
 	state := h.fsm.Current(userID)
 
 	switch state {
@@ -212,6 +280,39 @@ func (h *ReminderHandler) handleDefault(ctx context.Context, b *bot.Bot, update
 			desc = ""
 		}
 		h.fsm.Set(userID, "description", desc)
+		h.fsm.Transition(userID, stateWaitPriority)
+
+		if _, err := b.SendMessage(ctx, &bot.SendMessageParams{
+			ChatID: chatID,
+			Text:   "Выберите приоритет (высокий всегда требует подтверждения):",
+			ReplyMarkup: &models.InlineKeyboardMarkup{InlineKeyboard: [][]models.InlineKeyboardButton{{
+				{Text: "Низкий", CallbackData: "priority:" + model.ReminderPriorityLow.String()},
+				{Text: "Обычный", CallbackData: "priority:" + model.ReminderPriorityNormal.String()},
+				{Text: "❗ Высокий", CallbackData: "priority:" + model.ReminderPriorityHigh.String()},
+			}}},
+		}); err != nil {
+			h.log.Error("failed to send priority prompt", slog.Any("err", err))
+		}
+
+	case stateWaitPriority:
+		// Ожидаем нажатие на inline-кнопку приоритета
+		if _, err := b.SendMessage(ctx, &bot.SendMessageParams{
+			ChatID: chatID,
+			Text:   "Пожалуйста, выберите приоритет из кнопок выше.",
+		}); err != nil {
+			h.log.Error("failed to send priority hint", slog.Any("err", err))
+		}
+
+	case stateWaitTags:
+		var reminderTags []string
+		if text != "-" {
+			var err error
+			if reminderTags, err = tags.Parse(text); err != nil {
+				h.sendError(ctx, b, chatID, "Теги — слова из букв, цифр, «-» и «_», не больше 10. Попробуйте ещё раз:")
+				return
+			}
+		}
+		h.fsm.Set(userID, "tags", reminderTags)
 		h.fsm.Transition(userID, stateWaitDate)
 
 		h.initWidgets(b)
@@ -224,13 +325,18 @@ func (h *ReminderHandler) handleDefault(ctx context.Context, b *bot.Bot, update
 		}
 
 	case stateWaitDate:
-		// Ожидаем нажатие на datepicker, текст игнорируем
-		if _, err := b.SendMessage(ctx, &bot.SendMessageParams{
-			ChatID: chatID,
-			Text:   "Пожалуйста, выберите дату из календаря выше.",
-		}); err != nil {
-			h.log.Error("failed to send date hint", slog.Any("err", err))
+		// Дату можно выбрать в календаре или написать текстом: «завтра в 9»
+		res, err := nldate.Parse(text, time.Now(), timezone.Location(ctx))
+		if err != nil || res.Rule != "" || !res.At.After(time.Now()) {
+			if _, err := b.SendMessage(ctx, &bot.SendMessageParams{
+				ChatID: chatID,
+				Text:   "Выберите дату из календаря выше или напишите, например, «завтра в 9» или «в пятницу 18:30». Повтор настраивается последним шагом.",
+			}); err != nil {
+				h.log.Error("failed to send date hint", slog.Any("err", err))
+			}
+			return
 		}
+		h.askConfirmation(ctx, b, chatID, userID, res.At.UTC(), "Время: "+timezone.FormatUser(ctx, res.At, "02.01.2006 15:04")+"\n\n")
 
 	case stateWaitTime:
 		// Ожидаем нажатие на timepicker, текст игнорируем
@@ -250,11 +356,84 @@ func (h *ReminderHandler) handleDefault(ctx context.Context, b *bot.Bot, update
 			h.log.Error("failed to send confirmation hint", slog.Any("err", err))
 		}
 
+	case stateConfirmQuick:
+		// Ожидаем подтверждение распознанного времени
+		if _, err := b.SendMessage(ctx, &bot.SendMessageParams{
+			ChatID: chatID,
+			Text:   "Нажмите «Создать» или «Отмена» выше.",
+		}); err != nil {
+			h.log.Error("failed to send quick remind hint", slog.Any("err", err))
+		}
+
+	case stateEditTitle:
+		h.applyEdit(ctx, b, chatID, userID, func(in *service.UpdateReminderInput) { in.Title = text })
+
+	case stateEditDescription:
+		desc := text
+		if desc == "-" {
+			desc = ""
+		}
+		h.applyEdit(ctx, b, chatID, userID, func(in *service.UpdateReminderInput) { in.Description = desc })
+
+	case stateEditField, stateEditDate, stateEditTime:
+		// Ожидаем нажатие на inline-кнопки, текст игнорируем
+		if _, err := b.SendMessage(ctx, &bot.SendMessageParams{
+			ChatID: chatID,
+			Text:   "Пожалуйста, воспользуйтесь кнопками выше или отправьте /cancel.",
+		}); err != nil {
+			h.log.Error("failed to send edit hint", slog.Any("err", err))
+		}
+
+	case stateWaitRecurrence:
+		// Свой вариант повторения: RRULE или cron-выражение текстом
+		rule, err := recurrence.Parse(text)
+		if err != nil {
+			h.sendError(ctx, b, chatID, "Не удалось разобрать правило. Пример: FREQ=WEEKLY;BYDAY=MO,WE или 0 9 * * 1-5")
+			return
+		}
+		h.completeReminder(ctx, b, chatID, userID, rule.String())
+
 	default:
 		h.log.Info("unhandled message", slog.Int64("chat_id", chatID), slog.String("text", text))
 	}
 }
 
+// handlePriorityCallback обрабатывает выбор приоритета и просит теги.
+func (h *ReminderHandler) handlePriorityCallback(ctx context.Context, b *bot.Bot, update *models.Update) {
+	if update.CallbackQuery == nil {
+		return
+	}
+
+	chatID := update.CallbackQuery.Message.Message.Chat.ID
+	userID := chatID
+
+	b.AnswerCallbackQuery(ctx, &bot.AnswerCallbackQueryParams{
+		CallbackQueryID: update.CallbackQuery.ID,
+	})
+
+	if h.fsm.Current(userID) != stateWaitPriority {
+		return
+	}
+
+	// Формат: priority:<low|normal|high>
+	priority, err := model.ReminderPriorityString(strings.TrimPrefix(update.CallbackQuery.Data, "priority:"))
+	if err != nil {
+		h.sendError(ctx, b, chatID, "Ошибка при обработке выбора.")
+		h.fsm.Reset(userID)
+		return
+	}
+
+	h.fsm.Set(userID, "priority", priority)
+	h.fsm.Transition(userID, stateWaitTags)
+
+	if _, err := b.SendMessage(ctx, &bot.SendMessageParams{
+		ChatID: chatID,
+		Text:   "Введите теги через запятую, например «работа, отчёт» (или отправьте «-» чтобы пропустить):",
+	}); err != nil {
+		h.log.Error("failed to send tags prompt", slog.Any("err", err))
+	}
+}
+
 // handleConfirmIntervalCallback обрабатывает выбор интервала подтверждения.
 func (h *ReminderHandler) handleConfirmIntervalCallback(ctx context.Context, b *bot.Bot, update *models.Update) {
 	if update.CallbackQuery == nil {
@@ -281,20 +460,94 @@ func (h *ReminderHandler) handleConfirmIntervalCallback(ctx context.Context, b *
 		return
 	}
 
+	h.fsm.Set(userID, "confirm_interval", intervalMinutes)
+	h.fsm.Transition(userID, stateWaitRecurrence)
+
+	rows := [][]models.InlineKeyboardButton{
+		{{Text: "Не повторять", CallbackData: "recurrence:none"}},
+	}
+	for _, p := range recurrence.Presets {
+		rows = append(rows, []models.InlineKeyboardButton{
+			{Text: p.Label, CallbackData: "recurrence:" + p.Key},
+		})
+	}
+
+	if _, err := b.SendMessage(ctx, &bot.SendMessageParams{
+		ChatID:      chatID,
+		Text:        "Повторять напоминание?\nМожно отправить своё правило текстом: RRULE (FREQ=WEEKLY;INTERVAL=2;BYDAY=MO) или cron (0 9 * * 1-5).",
+		ReplyMarkup: &models.InlineKeyboardMarkup{InlineKeyboard: rows},
+	}); err != nil {
+		h.log.Error("failed to send recurrence prompt", slog.Any("err", err))
+	}
+}
+
+// handleRecurrenceCallback обрабатывает выбор готового варианта повторения.
+func (h *ReminderHandler) handleRecurrenceCallback(ctx context.Context, b *bot.Bot, update *models.Update) {
+	if update.CallbackQuery == nil {
+		return
+	}
+
+	chatID := update.CallbackQuery.Message.Message.Chat.ID
+	userID := chatID
+
+	b.AnswerCallbackQuery(ctx, &bot.AnswerCallbackQueryParams{
+		CallbackQueryID: update.CallbackQuery.ID,
+	})
+
+	if h.fsm.Current(userID) != stateWaitRecurrence {
+		return
+	}
+
+	// Формат: recurrence:<preset>
+	key := strings.TrimPrefix(update.CallbackQuery.Data, "recurrence:")
+	var rule string
+	if key != "none" {
+		var ok bool
+		if rule, ok = recurrence.PresetRule(key); !ok {
+			h.sendError(ctx, b, chatID, "Ошибка при обработке выбора.")
+			h.fsm.Reset(userID)
+			return
+		}
+	}
+
+	h.completeReminder(ctx, b, chatID, userID, rule)
+}
+
+// completeReminder собирает данные диалога из FSM и создаёт напоминание.
+func (h *ReminderHandler) completeReminder(ctx context.Context, b *bot.Bot, chatID, userID int64, rule string) {
 	titleVal, _ := h.fsm.Get(userID, "title")
 	descVal, _ := h.fsm.Get(userID, "description")
 	remindAtVal, _ := h.fsm.Get(userID, "remind_at_utc")
-	localTimeVal, _ := h.fsm.Get(userID, "local_time")
+	intervalVal, _ := h.fsm.Get(userID, "confirm_interval")
+	priorityVal, _ := h.fsm.Get(userID, "priority")
+	tagsVal, _ := h.fsm.Get(userID, "tags")
+	filesVal, _ := h.fsm.Get(userID, "attachments")
 	h.fsm.Reset(userID)
 
 	title, _ := titleVal.(string)
 	desc, _ := descVal.(string)
 	remindAtUTC, _ := remindAtVal.(time.Time)
-	localTime, _ := localTimeVal.(time.Time)
+	intervalMinutes, _ := intervalVal.(int)
+	priority, _ := priorityVal.(model.ReminderPriority)
+	reminderTags, _ := tagsVal.([]string)
+	files, _ := filesVal.([]telegramFile)
 
-	requireConfirmation := intervalMinutes > 0
+	attachments, ok := h.attachmentsFor(ctx, b, chatID, files)
+	if !ok {
+		return
+	}
 
-	h.finishReminder(ctx, b, chatID, title, desc, remindAtUTC, localTime, requireConfirmation, intervalMinutes)
+	h.finishReminder(ctx, b, chatID, service.CreateReminderInput{
+		Title:                 title,
+		Description:           desc,
+		RemindAt:              remindAtUTC,
+		RequireConfirmation:   intervalMinutes > 0,
+		RepeatIntervalMinutes: intervalMinutes,
+		RecurrenceRule:        rule,
+		Priority:              priority,
+		Tags:                  reminderTags,
+		Attachments:           attachments,
+	})
 }
 
 // handleAckCallback обрабатывает нажатие кнопки "Подтвердить" на уведомлении.
@@ -354,8 +607,58 @@ func (h *ReminderHandler) handleAckCallback(ctx context.Context, b *bot.Bot, upd
 	})
 }
 
-// finishReminder завершает создание напоминания.
-func (h *ReminderHandler) finishReminder(ctx context.Context, b *bot.Bot, chatID int64, title, desc string, remindAtUTC, localTime time.Time, requireConfirmation bool, repeatIntervalMinutes int) {
+// handleSnoozeCallback обрабатывает кнопки откладывания на уведомлении.
+func (h *ReminderHandler) handleSnoozeCallback(ctx context.Context, b *bot.Bot, update *models.Update) {
+	if update.CallbackQuery == nil {
+		return
+	}
+
+	answer := func(text string) {
+		b.AnswerCallbackQuery(ctx, &bot.AnswerCallbackQueryParams{
+			CallbackQueryID: update.CallbackQuery.ID,
+			Text:            text,
+		})
+	}
+
+	chatID := update.CallbackQuery.Message.Message.Chat.ID
+
+	// Формат: snooze_reminder:<reminder_id>:<вариант>
+	reminderIDStr, key, ok := strings.Cut(strings.TrimPrefix(update.CallbackQuery.Data, "snooze_reminder:"), ":")
+	if !ok {
+		answer("Ошибка: неверные данные")
+		return
+	}
+
+	reminderID, err := uuid.Parse(reminderIDStr)
+	if err != nil {
+		answer("Ошибка: неверный ID")
+		return
+	}
+
+	d, err := snooze.Duration(key, time.Now(), timezone.Location(ctx))
+	if err != nil {
+		answer("Ошибка: неизвестный вариант")
+		return
+	}
+
+	user, err := h.userRepo.GetByChatID(ctx, chatID)
+	if err != nil || user == nil {
+		answer("Ошибка: пользователь не найден")
+		return
+	}
+
+	if err := h.reminderService.SnoozeReminder(ctx, user.ID, reminderID, d); err != nil {
+		h.log.Error("failed to snooze reminder", slog.Any("err", err))
+		answer("Не удалось отложить напоминание")
+		return
+	}
+
+	until := timezone.ToUser(ctx, time.Now().Add(d))
+	answer(fmt.Sprintf("⏰ Отложено до %s", until.Format("02.01 15:04")))
+}
+
+// finishReminder завершает создание напоминания: in — данные диалога без пользователя и чата.
+func (h *ReminderHandler) finishReminder(ctx context.Context, b *bot.Bot, chatID int64, in service.CreateReminderInput) {
 	user, err := h.userRepo.GetByChatID(ctx, chatID)
 	if err != nil {
 		h.log.Error("failed to find user by chat_id", slog.Any("err", err), slog.Int64("chat_id", chatID))
@@ -367,21 +670,39 @@ func (h *ReminderHandler) finishReminder(ctx context.Context, b *bot.Bot, chatID
 		return
 	}
 
-	rem, err := h.reminderService.CreateReminder(ctx, user.ID, title, desc, remindAtUTC, chatID, requireConfirmation, repeatIntervalMinutes)
+	in.UserID = user.ID
+	in.TelegramChatID = chatID
+	rem, err := h.reminderService.CreateReminder(ctx, in)
 	if err != nil {
+		if errors.Is(err, recurrence.ErrInvalidRule) || errors.Is(err, recurrence.ErrUnsupported) {
+			h.sendError(ctx, b, chatID, "Это правило повторения не поддерживается. Попробуйте /remind заново.")
+			return
+		}
 		h.log.Error("failed to create reminder", slog.Any("err", err))
 		h.sendError(ctx, b, chatID, "Ошибка при создании напоминания.")
 		return
 	}
 
 	// Показываем пользователю время в его таймзоне
-	displayTime := timezone.FormatUser(rem.RemindAt, "02.01.2006 15:04")
+	displayTime := timezone.FormatUser(ctx, rem.RemindAt, "02.01.2006 15:04")
 	msg := fmt.Sprintf("Напоминание создано!\n\nНазвание: %s\nВремя: %s", rem.Title, displayTime)
-	if desc != "" {
-		msg = fmt.Sprintf("Напоминание создано!\n\nНазвание: %s\nОписание: %s\nВремя: %s", rem.Title, desc, displayTime)
+	if rem.Description != "" {
+		msg = fmt.Sprintf("Напоминание создано!\n\nНазвание: %s\nОписание: %s\nВремя: %s", rem.Title, rem.Description, displayTime)
+	}
+	if rem.Priority == model.ReminderPriorityHigh {
+		msg += "\nПриоритет: высокий"
+	}
+	if len(rem.Tags) > 0 {
+		msg += "\nТеги: " + tags.String(rem.Tags)
+	}
+	if len(in.Attachments) > 0 {
+		msg += fmt.Sprintf("\nВложений: %d", len(in.Attachments))
+	}
+	if rem.RequireConfirmation {
+		msg += fmt.Sprintf("\nПодтверждение: каждые %d мин", rem.RepeatIntervalMinutes)
 	}
-	if requireConfirmation {
-		msg += fmt.Sprintf("\nПодтверждение: каждые %d мин", repeatIntervalMinutes)
+	if r, err := recurrence.Parse(rem.RecurrenceRule); err == nil {
+		msg += "\nПовтор: " + r.Describe()
 	}
 
 	if _, err := b.SendMessage(ctx, &bot.SendMessageParams{
@@ -0,0 +1,221 @@
+package telegram
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+
+	"github.com/go-telegram/bot"
+	"github.com/go-telegram/bot/models"
+	"github.com/google/uuid"
+	"github.com/vovanwin/template/internal/model"
+	"github.com/vovanwin/template/internal/pkg/timezone"
+	"github.com/vovanwin/template/internal/repository"
+	"github.com/vovanwin/template/internal/service"
+)
+
+// maxEditChoices ограничивает число напоминаний в списке /edit.
+const maxEditChoices = 10
+
+// handleEdit показывает список напоминаний, которые можно изменить.
+func (h *ReminderHandler) handleEdit(ctx context.Context, b *bot.Bot, update *models.Update) {
+	if update.Message == nil {
+		return
+	}
+	chatID := update.Message.Chat.ID
+	userID := update.Message.From.ID
+	h.fsm.Reset(userID)
+
+	user, err := h.userRepo.GetByChatID(ctx, chatID)
+	if err != nil || user == nil {
+		h.sendError(ctx, b, chatID, "Ваш аккаунт не привязан. Укажите Chat ID в настройках профиля.")
+		return
+	}
+
+	reminders, err := h.reminderService.ListReminders(ctx, user.ID)
+	if err != nil {
+		h.log.Error("failed to list reminders", slog.Any("err", err))
+		h.sendError(ctx, b, chatID, "Ошибка при загрузке напоминаний.")
+		return
+	}
+
+	var rows [][]models.InlineKeyboardButton
+	for _, rem := range reminders {
+		if !isEditable(rem) {
+			continue
+		}
+		rows = append(rows, []models.InlineKeyboardButton{{
+			Text:         timezone.FormatUser(ctx, rem.RemindAt, "02.01 15:04") + " · " + rem.Title,
+			CallbackData: "edit_reminder:" + rem.ID.String(),
+		}})
+		if len(rows) == maxEditChoices {
+			break
+		}
+	}
+	if len(rows) == 0 {
+		h.sendError(ctx, b, chatID, "Нет напоминаний, которые можно изменить.")
+		return
+	}
+
+	if _, err := b.SendMessage(ctx, &bot.SendMessageParams{
+		ChatID:      chatID,
+		Text:        "Какое напоминание изменить?",
+		ReplyMarkup: &models.InlineKeyboardMarkup{InlineKeyboard: rows},
+	}); err != nil {
+		h.log.Error("failed to send edit list", slog.Any("err", err))
+	}
+}
+
+// handleEditReminderCallback запоминает выбранное напоминание и предлагает выбрать поле.
+func (h *ReminderHandler) handleEditReminderCallback(ctx context.Context, b *bot.Bot, update *models.Update) {
+	if update.CallbackQuery == nil {
+		return
+	}
+
+	chatID := update.CallbackQuery.Message.Message.Chat.ID
+	userID := chatID
+
+	b.AnswerCallbackQuery(ctx, &bot.AnswerCallbackQueryParams{
+		CallbackQueryID: update.CallbackQuery.ID,
+	})
+
+	// Формат: edit_reminder:<reminder_id>
+	reminderID, err := uuid.Parse(strings.TrimPrefix(update.CallbackQuery.Data, "edit_reminder:"))
+	if err != nil {
+		h.sendError(ctx, b, chatID, "Ошибка: неверный ID.")
+		return
+	}
+
+	user, err := h.userRepo.GetByChatID(ctx, chatID)
+	if err != nil || user == nil {
+		h.sendError(ctx, b, chatID, "Ошибка: пользователь не найден.")
+		return
+	}
+
+	rem, err := h.reminderService.GetReminder(ctx, user.ID, reminderID)
+	if err != nil {
+		h.log.Error("failed to get reminder", slog.Any("err", err))
+		h.sendError(ctx, b, chatID, "Напоминание не найдено.")
+		return
+	}
+
+	h.fsm.Set(userID, "edit_reminder", *rem)
+	h.fsm.Transition(userID, stateEditField)
+
+	rows := [][]models.InlineKeyboardButton{
+		{{Text: "Название", CallbackData: "edit_field:title"}},
+		{{Text: "Описание", CallbackData: "edit_field:description"}},
+	}
+	if isEditable(*rem) {
+		rows = append(rows, []models.InlineKeyboardButton{{Text: "Дата и время", CallbackData: "edit_field:time"}})
+	}
+
+	if _, err := b.SendMessage(ctx, &bot.SendMessageParams{
+		ChatID:      chatID,
+		Text:        "«" + rem.Title + "» — что изменить?",
+		ReplyMarkup: &models.InlineKeyboardMarkup{InlineKeyboard: rows},
+	}); err != nil {
+		h.log.Error("failed to send edit fields", slog.Any("err", err))
+	}
+}
+
+// handleEditFieldCallback переводит диалог к вводу выбранного поля.
+func (h *ReminderHandler) handleEditFieldCallback(ctx context.Context, b *bot.Bot, update *models.Update) {
+	if update.CallbackQuery == nil {
+		return
+	}
+
+	chatID := update.CallbackQuery.Message.Message.Chat.ID
+	userID := chatID
+
+	b.AnswerCallbackQuery(ctx, &bot.AnswerCallbackQueryParams{
+		CallbackQueryID: update.CallbackQuery.ID,
+	})
+
+	if h.fsm.Current(userID) != stateEditField {
+		return
+	}
+
+	// Формат: edit_field:<поле>
+	params := &bot.SendMessageParams{ChatID: chatID}
+	switch strings.TrimPrefix(update.CallbackQuery.Data, "edit_field:") {
+	case "title":
+		h.fsm.Transition(userID, stateEditTitle)
+		params.Text = "Введите новое название:"
+	case "description":
+		h.fsm.Transition(userID, stateEditDescription)
+		params.Text = "Введите новое описание (или отправьте «-» чтобы очистить):"
+	case "time":
+		h.initWidgets(b)
+		h.fsm.Transition(userID, stateEditDate)
+		params.Text = "Выберите новую дату:"
+		params.ReplyMarkup = h.dp
+	default:
+		h.sendError(ctx, b, chatID, "Ошибка при обработке выбора.")
+		h.fsm.Reset(userID)
+		return
+	}
+
+	if _, err := b.SendMessage(ctx, params); err != nil {
+		h.log.Error("failed to send edit prompt", slog.Any("err", err))
+	}
+}
+
+// applyEdit применяет изменение к напоминанию, выбранному в диалоге /edit.
+func (h *ReminderHandler) applyEdit(ctx context.Context, b *bot.Bot, chatID, userID int64, change func(*service.UpdateReminderInput)) {
+	remVal, _ := h.fsm.Get(userID, "edit_reminder")
+	h.fsm.Reset(userID)
+
+	rem, ok := remVal.(repository.Reminder)
+	if !ok {
+		h.sendError(ctx, b, chatID, "Ошибка: напоминание не выбрано. Попробуйте /edit заново.")
+		return
+	}
+
+	user, err := h.userRepo.GetByChatID(ctx, chatID)
+	if err != nil || user == nil {
+		h.sendError(ctx, b, chatID, "Ошибка: пользователь не найден.")
+		return
+	}
+
+	in := service.UpdateReminderInput{Title: rem.Title, Description: rem.Description}
+	change(&in)
+
+	updated, err := h.reminderService.UpdateReminder(ctx, user.ID, rem.ID, in)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidReminder) {
+			h.sendError(ctx, b, chatID, "Изменение отклонено: "+err.Error())
+			return
+		}
+		if errors.Is(err, service.ErrActionNotAllowed) {
+			h.sendError(ctx, b, chatID, "Изменять напоминание может только создатель или редактор списка.")
+			return
+		}
+		h.log.Error("failed to update reminder", slog.Any("err", err))
+		h.sendError(ctx, b, chatID, "Ошибка при изменении напоминания.")
+		return
+	}
+
+	msg := "Напоминание изменено!\n\nНазвание: " + updated.Title
+	if updated.Description != "" {
+		msg += "\nОписание: " + updated.Description
+	}
+	msg += "\nВремя: " + timezone.FormatUser(ctx, updated.RemindAt, "02.01.2006 15:04")
+
+	if _, err := b.SendMessage(ctx, &bot.SendMessageParams{
+		ChatID: chatID,
+		Text:   msg,
+	}); err != nil {
+		h.log.Error("failed to send reminder updated message", slog.Any("err", err))
+	}
+}
+
+// isEditable сообщает, можно ли перенести время напоминания:
+// разовое ещё ждёт отправки, повторяющееся не отменено.
+func isEditable(rem repository.Reminder) bool {
+	if rem.IsRecurring() {
+		return rem.Status != model.ReminderStatusCancelled.String()
+	}
+	return rem.WorkflowID != "" && (rem.Status == model.ReminderStatusPending.String() || rem.Status == model.ReminderStatusSnoozed.String())
+}
@@ -0,0 +1,100 @@
+package telegram
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/go-telegram/bot"
+	"github.com/go-telegram/bot/models"
+	"github.com/vovanwin/template/internal/pkg/nldate"
+	"github.com/vovanwin/template/internal/pkg/recurrence"
+	"github.com/vovanwin/template/internal/pkg/timezone"
+	"github.com/vovanwin/template/internal/service"
+)
+
+const quickRemindExamples = "Примеры:\n/remind завтра в 9 купить молоко\n/remind через 2 часа позвонить маме\n/remind в пятницу 18:30 отчёт\n/remind каждый понедельник в 10 планёрка"
+
+// handleQuickRemind разбирает однострочную команду и просит подтвердить распознанное время.
+func (h *ReminderHandler) handleQuickRemind(ctx context.Context, b *bot.Bot, chatID, userID int64, text string) {
+	h.fsm.Reset(userID)
+
+	res, err := nldate.Parse(text, time.Now(), timezone.Location(ctx))
+	switch {
+	case errors.Is(err, nldate.ErrAmbiguous):
+		h.sendError(ctx, b, chatID, "Во фразе несколько разных дат. Укажите одну.\n\n"+quickRemindExamples)
+		return
+	case err != nil:
+		h.sendError(ctx, b, chatID, "Не удалось распознать время. Отправьте /remind без текста, чтобы выбрать дату в календаре.\n\n"+quickRemindExamples)
+		return
+	case !res.At.After(time.Now()):
+		h.sendError(ctx, b, chatID, fmt.Sprintf("Время %s уже прошло.", timezone.FormatUser(ctx, res.At, "02.01.2006 15:04")))
+		return
+	case res.Rest == "":
+		h.sendError(ctx, b, chatID, "Добавьте название напоминания.\n\n"+quickRemindExamples)
+		return
+	}
+
+	h.fsm.Set(userID, "title", res.Rest)
+	h.fsm.Set(userID, "remind_at_utc", res.At.UTC())
+	h.fsm.Set(userID, "rule", res.Rule)
+	h.fsm.Transition(userID, stateConfirmQuick)
+
+	msg := fmt.Sprintf("Создать напоминание?\n\nНазвание: %s\nВремя: %s", res.Rest, timezone.FormatUser(ctx, res.At, "02.01.2006 15:04"))
+	if res.Rule != "" {
+		if rule, err := recurrence.Parse(res.Rule); err == nil {
+			msg += "\nПовтор: " + rule.Describe()
+		}
+	}
+
+	if _, err := b.SendMessage(ctx, &bot.SendMessageParams{
+		ChatID: chatID,
+		Text:   msg,
+		ReplyMarkup: &models.InlineKeyboardMarkup{InlineKeyboard: [][]models.InlineKeyboardButton{{
+			{Text: "✅ Создать", CallbackData: "quick:create"},
+			{Text: "✖️ Отмена", CallbackData: "quick:cancel"},
+		}}},
+	}); err != nil {
+		h.log.Error("failed to send quick remind confirmation", slog.Any("err", err))
+	}
+}
+
+// handleQuickCallback создаёт напоминание из однострочной команды после подтверждения.
+func (h *ReminderHandler) handleQuickCallback(ctx context.Context, b *bot.Bot, update *models.Update) {
+	if update.CallbackQuery == nil {
+		return
+	}
+
+	chatID := update.CallbackQuery.Message.Message.Chat.ID
+	userID := chatID
+
+	b.AnswerCallbackQuery(ctx, &bot.AnswerCallbackQueryParams{
+		CallbackQueryID: update.CallbackQuery.ID,
+	})
+
+	if h.fsm.Current(userID) != stateConfirmQuick {
+		return
+	}
+
+	titleVal, _ := h.fsm.Get(userID, "title")
+	remindAtVal, _ := h.fsm.Get(userID, "remind_at_utc")
+	ruleVal, _ := h.fsm.Get(userID, "rule")
+	h.fsm.Reset(userID)
+
+	if update.CallbackQuery.Data != "quick:create" {
+		h.sendError(ctx, b, chatID, "Напоминание не создано.")
+		return
+	}
+
+	title, _ := titleVal.(string)
+	remindAtUTC, _ := remindAtVal.(time.Time)
+	rule, _ := ruleVal.(string)
+
+	h.finishReminder(ctx, b, chatID, service.CreateReminderInput{
+		Title:          title,
+		RemindAt:       remindAtUTC,
+		RecurrenceRule: rule,
+	})
+}
@@ -0,0 +1,190 @@
+package telegram
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/go-telegram/bot"
+	"github.com/go-telegram/bot/models"
+	"github.com/google/uuid"
+	"github.com/vovanwin/template/internal/pkg/timezone"
+	"github.com/vovanwin/template/internal/repository"
+	"github.com/vovanwin/template/internal/service"
+)
+
+// timezoneColumns — число кнопок таймзон в строке клавиатуры /timezone.
+const timezoneColumns = 3
+
+// withLocation кладёт в контекст таймзону пользователя, привязанного к чату:
+// хендлеры показывают и разбирают время в ней.
+func (h *ReminderHandler) withLocation(next bot.HandlerFunc) bot.HandlerFunc {
+	return func(ctx context.Context, b *bot.Bot, update *models.Update) {
+		if chatID := updateChatID(update); chatID != 0 {
+			if user, err := h.userRepo.GetByChatID(ctx, chatID); err == nil && user != nil {
+				if loc, err := h.reminderService.Location(ctx, user.ID); err == nil {
+					ctx = timezone.WithLocation(ctx, loc)
+				}
+			}
+		}
+		next(ctx, b, update)
+	}
+}
+
+// updateChatID возвращает чат сообщения или нажатой кнопки; 0 — другое обновление.
+func updateChatID(update *models.Update) int64 {
+	switch {
+	case update.Message != nil:
+		return update.Message.Chat.ID
+	case update.CallbackQuery != nil && update.CallbackQuery.Message.Message != nil:
+		return update.CallbackQuery.Message.Message.Chat.ID
+	}
+	return 0
+}
+
+// handleTimezone показывает таймзону пользователя и кнопки выбора.
+// «/timezone Asia/Tokyo» сразу сохраняет указанную таймзону.
+func (h *ReminderHandler) handleTimezone(ctx context.Context, b *bot.Bot, update *models.Update) {
+	if update.Message == nil {
+		return
+	}
+	chatID := update.Message.Chat.ID
+
+	user, settings, ok := h.timezoneSettings(ctx, b, chatID)
+	if !ok {
+		return
+	}
+	if _, arg, _ := strings.Cut(update.Message.Text, " "); strings.TrimSpace(arg) != "" {
+		h.setTimezone(ctx, b, chatID, user.ID, strings.TrimSpace(arg), settings.TimezoneFollow)
+		return
+	}
+
+	var rows [][]models.InlineKeyboardButton
+	for i, name := range timezone.Common {
+		if i%timezoneColumns == 0 {
+			rows = append(rows, nil)
+		}
+		rows[len(rows)-1] = append(rows[len(rows)-1], models.InlineKeyboardButton{
+			Text:         name,
+			CallbackData: "tz:" + name,
+		})
+	}
+	rows = append(rows, []models.InlineKeyboardButton{followButton(settings.TimezoneFollow)})
+
+	if _, err := b.SendMessage(ctx, &bot.SendMessageParams{
+		ChatID: chatID,
+		Text: timezoneText(ctx, settings) + "\n\nВыберите часовой пояс, напишите «/timezone Asia/Tokyo» " +
+			"или отправьте геопозицию — пояс определится по ней.",
+		ReplyMarkup: &models.InlineKeyboardMarkup{InlineKeyboard: rows},
+	}); err != nil {
+		h.log.Error("failed to send timezone choices", slog.Any("err", err))
+	}
+}
+
+// handleTimezoneCallback сохраняет таймзону («tz:<имя>») или переключает,
+// следуют ли напоминания за пользователем («tz_follow:<0|1>»).
+func (h *ReminderHandler) handleTimezoneCallback(ctx context.Context, b *bot.Bot, update *models.Update) {
+	if update.CallbackQuery == nil {
+		return
+	}
+	chatID := update.CallbackQuery.Message.Message.Chat.ID
+
+	b.AnswerCallbackQuery(ctx, &bot.AnswerCallbackQueryParams{
+		CallbackQueryID: update.CallbackQuery.ID,
+	})
+
+	user, settings, ok := h.timezoneSettings(ctx, b, chatID)
+	if !ok {
+		return
+	}
+	data := update.CallbackQuery.Data
+	if follow, ok := strings.CutPrefix(data, "tz_follow:"); ok {
+		settings.TimezoneFollow = follow == "1"
+		if err := h.reminderService.SetTimezoneFollow(ctx, user.ID, settings.TimezoneFollow); err != nil {
+			h.log.Error("failed to update timezone follow", slog.Any("err", err))
+			h.sendError(ctx, b, chatID, "Не удалось сохранить настройку.")
+			return
+		}
+		h.sendText(ctx, b, chatID, timezoneText(ctx, settings))
+		return
+	}
+	h.setTimezone(ctx, b, chatID, user.ID, strings.TrimPrefix(data, "tz:"), settings.TimezoneFollow)
+}
+
+// handleLocation определяет таймзону по присланной геопозиции.
+func (h *ReminderHandler) handleLocation(ctx context.Context, b *bot.Bot, chatID int64, loc *models.Location) {
+	user, settings, ok := h.timezoneSettings(ctx, b, chatID)
+	if !ok {
+		return
+	}
+	h.setTimezone(ctx, b, chatID, user.ID, timezone.FromLongitude(loc.Longitude, time.Now()), settings.TimezoneFollow)
+}
+
+// setTimezone сохраняет таймзону и сообщает, сколько напоминаний перенесено.
+func (h *ReminderHandler) setTimezone(ctx context.Context, b *bot.Bot, chatID int64, userID uuid.UUID, name string, follow bool) {
+	moved, err := h.reminderService.UpdateTimezone(ctx, userID, name, follow)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidReminder) {
+			h.sendError(ctx, b, chatID, fmt.Sprintf("Неизвестный часовой пояс «%s». Пример: Europe/Moscow, Asia/Tokyo.", name))
+			return
+		}
+		h.log.Error("failed to update timezone", slog.Any("err", err))
+		h.sendError(ctx, b, chatID, "Не удалось сохранить часовой пояс.")
+		return
+	}
+
+	ctx = timezone.WithLocation(ctx, timezone.Load(name))
+	msg := fmt.Sprintf("🌍 Часовой пояс: %s, сейчас %s.", name, timezone.FormatUser(ctx, time.Now(), "15:04"))
+	if moved > 0 {
+		msg += fmt.Sprintf("\nНапоминаний перенесено на то же местное время: %d.", moved)
+	}
+	h.sendText(ctx, b, chatID, msg)
+}
+
+// timezoneSettings находит пользователя чата и его настройки; при ошибке
+// сообщает о ней в чат и возвращает false.
+func (h *ReminderHandler) timezoneSettings(ctx context.Context, b *bot.Bot, chatID int64) (*repository.User, *repository.NotificationSettings, bool) {
+	user, err := h.userRepo.GetByChatID(ctx, chatID)
+	if err != nil || user == nil {
+		h.sendError(ctx, b, chatID, "Ваш аккаунт не привязан. Укажите Chat ID в настройках профиля.")
+		return nil, nil, false
+	}
+	settings, err := h.reminderService.GetNotificationSettings(ctx, user.ID)
+	if err != nil {
+		h.log.Error("failed to get notification settings", slog.Any("err", err))
+		h.sendError(ctx, b, chatID, "Ошибка при загрузке настроек.")
+		return nil, nil, false
+	}
+	return user, settings, true
+}
+
+// sendText отправляет в чат сообщение без клавиатуры.
+func (h *ReminderHandler) sendText(ctx context.Context, b *bot.Bot, chatID int64, text string) {
+	if _, err := b.SendMessage(ctx, &bot.SendMessageParams{ChatID: chatID, Text: text}); err != nil {
+		h.log.Error("failed to send message", slog.Any("err", err))
+	}
+}
+
+// followButton переключает, следуют ли напоминания за сменой часового пояса.
+func followButton(follow bool) models.InlineKeyboardButton {
+	if follow {
+		return models.InlineKeyboardButton{Text: "Напоминания остаются в прежнем поясе", CallbackData: "tz_follow:0"}
+	}
+	return models.InlineKeyboardButton{Text: "Напоминания следуют за мной", CallbackData: "tz_follow:1"}
+}
+
+// timezoneText описывает таймзону пользователя и поведение напоминаний при её смене.
+func timezoneText(ctx context.Context, settings *repository.NotificationSettings) string {
+	name := settings.Timezone
+	if name == "" {
+		name = timezone.DefaultName + " (по умолчанию)"
+	}
+	msg := fmt.Sprintf("🌍 Часовой пояс: %s, сейчас %s.", name, timezone.FormatUser(ctx, time.Now(), "15:04"))
+	if settings.TimezoneFollow {
+		return msg + "\nПри смене пояса напоминания сохраняют местное время: 9:00 останется 9:00."
+	}
+	return msg + "\nПри смене пояса напоминания срабатывают в прежний момент по прежнему поясу."
+}
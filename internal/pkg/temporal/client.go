@@ -66,18 +66,24 @@ func (c *Client) GetWorkflow(ctx context.Context, workflowID, runID string) clie
 	return c.temporalClient.GetWorkflow(ctx, workflowID, runID)
 }
 
-// ScheduleWorkflow планирует выполнение воркфлоу
+// ScheduleWorkflow планирует выполнение воркфлоу.
+// Поля opts (политика перекрытия, лимит запусков, пауза и т.д.) сохраняются,
+// ID, Spec и Action берутся из аргументов.
 func (c *Client) ScheduleWorkflow(ctx context.Context, scheduleID string, schedule client.ScheduleSpec, action *client.ScheduleWorkflowAction, opts client.ScheduleOptions) (client.ScheduleHandle, error) {
-	return c.temporalClient.ScheduleClient().Create(ctx, client.ScheduleOptions{
-		ID:   scheduleID,
-		Spec: schedule,
-		Action: &client.ScheduleWorkflowAction{
-			ID:                       action.ID,
-			Workflow:                 action.Workflow,
-			Args:                     action.Args,
-			TaskQueue:                action.TaskQueue,
-			WorkflowExecutionTimeout: action.WorkflowExecutionTimeout,
-			WorkflowTaskTimeout:      action.WorkflowTaskTimeout,
-		},
-	})
+	opts.ID = scheduleID
+	opts.Spec = schedule
+	opts.Action = &client.ScheduleWorkflowAction{
+		ID:                       action.ID,
+		Workflow:                 action.Workflow,
+		Args:                     action.Args,
+		TaskQueue:                action.TaskQueue,
+		WorkflowExecutionTimeout: action.WorkflowExecutionTimeout,
+		WorkflowTaskTimeout:      action.WorkflowTaskTimeout,
+	}
+	return c.temporalClient.ScheduleClient().Create(ctx, opts)
+}
+
+// GetSchedule возвращает хэндл существующего расписания
+func (c *Client) GetSchedule(ctx context.Context, scheduleID string) client.ScheduleHandle {
+	return c.temporalClient.ScheduleClient().GetHandle(ctx, scheduleID)
 }
@@ -1,28 +1,129 @@
+// Package timezone переводит время между UTC и таймзоной пользователя.
+//
+// Таймзона пользователя хранится в профиле как имя IANA («Europe/Moscow»).
+// Обработчики UI и бота кладут её в контекст запроса через WithLocation,
+// а шаблоны и хендлеры форматируют время функциями с контекстом.
 package timezone
 
-import "time"
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
 
-// UserLocation возвращает таймзону пользователя.
-// TODO: в будущем можно брать из профиля пользователя.
-var UserLocation = func() *time.Location {
-	loc, err := time.LoadLocation("Europe/Moscow")
+// DefaultName — таймзона пользователя, который её не выбрал.
+const DefaultName = "Europe/Moscow"
+
+// Default — таймзона по умолчанию.
+var Default = func() *time.Location {
+	loc, err := time.LoadLocation(DefaultName)
 	if err != nil {
 		return time.FixedZone("MSK", 3*60*60)
 	}
 	return loc
 }()
 
+// Common — таймзоны, которые предлагаются в списке выбора.
+var Common = []string{
+	"Europe/Kaliningrad",
+	"Europe/Moscow",
+	"Europe/Samara",
+	"Asia/Yekaterinburg",
+	"Asia/Omsk",
+	"Asia/Novosibirsk",
+	"Asia/Krasnoyarsk",
+	"Asia/Irkutsk",
+	"Asia/Yakutsk",
+	"Asia/Vladivostok",
+	"Asia/Magadan",
+	"Asia/Kamchatka",
+	"Europe/Minsk",
+	"Europe/Kyiv",
+	"Asia/Almaty",
+	"Asia/Tbilisi",
+	"Asia/Yerevan",
+	"Asia/Tashkent",
+	"Europe/Istanbul",
+	"Europe/Berlin",
+	"Europe/London",
+	"Asia/Dubai",
+	"Asia/Bangkok",
+	"America/New_York",
+	"UTC",
+}
+
+// Valid сообщает, является ли name именем таймзоны IANA.
+// «Local» не принимается: он зависит от сервера, а не от пользователя.
+func Valid(name string) bool {
+	if name == "" || strings.EqualFold(name, "Local") {
+		return false
+	}
+	_, err := time.LoadLocation(name)
+	return err == nil
+}
+
+// Load возвращает таймзону по имени IANA; пустое или неизвестное имя — Default.
+func Load(name string) *time.Location {
+	if !Valid(name) {
+		return Default
+	}
+	loc, _ := time.LoadLocation(name)
+	return loc
+}
+
+type ctxKey struct{}
+
+// WithLocation возвращает контекст с таймзоной пользователя.
+func WithLocation(ctx context.Context, loc *time.Location) context.Context {
+	return context.WithValue(ctx, ctxKey{}, loc)
+}
+
+// Location возвращает таймзону пользователя из контекста; без неё — Default.
+func Location(ctx context.Context) *time.Location {
+	if loc, ok := ctx.Value(ctxKey{}).(*time.Location); ok && loc != nil {
+		return loc
+	}
+	return Default
+}
+
 // ToUser конвертирует UTC-время в локальное время пользователя.
-func ToUser(t time.Time) time.Time {
-	return t.In(UserLocation)
+func ToUser(ctx context.Context, t time.Time) time.Time {
+	return t.In(Location(ctx))
 }
 
 // FromUser парсит строку datetime-local (без зоны) как локальное время пользователя.
-func FromUser(layout, value string) (time.Time, error) {
-	return time.ParseInLocation(layout, value, UserLocation)
+func FromUser(ctx context.Context, layout, value string) (time.Time, error) {
+	return time.ParseInLocation(layout, value, Location(ctx))
 }
 
 // FormatUser форматирует время в локальной зоне пользователя.
-func FormatUser(t time.Time, layout string) string {
-	return t.In(UserLocation).Format(layout)
+func FormatUser(ctx context.Context, t time.Time, layout string) string {
+	return t.In(Location(ctx)).Format(layout)
+}
+
+// SameClock возвращает момент с тем же календарным временем, что t, но в loc:
+// 09:00 по Москве становится 09:00 по loc.
+func SameClock(t time.Time, loc *time.Location) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc)
+}
+
+// FromLongitude оценивает таймзону по долготе, когда точная неизвестна
+// (например, по геопозиции из Telegram): смещение — долгота / 15° с округлением.
+// Предпочитается таймзона из Common с тем же смещением в момент now,
+// иначе — фиксированная «Etc/GMT±N».
+func FromLongitude(lon float64, now time.Time) string {
+	hours := int(math.Round(lon / 15))
+	hours = max(-12, min(14, hours))
+	for _, name := range Common {
+		if _, offset := now.In(Load(name)).Zone(); offset == hours*60*60 {
+			return name
+		}
+	}
+	if hours == 0 {
+		return "UTC"
+	}
+	// В именах Etc/GMT знак обратный: Etc/GMT-3 — это UTC+3.
+	return fmt.Sprintf("Etc/GMT%+d", -hours)
 }
@@ -0,0 +1,65 @@
+package timezone
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestLoad(t *testing.T) {
+	cases := map[string]string{
+		"Asia/Tokyo": "Asia/Tokyo",
+		"UTC":        "UTC",
+		"":           DefaultName,
+		"Local":      DefaultName,
+		"Mars/Base":  DefaultName,
+	}
+	for name, want := range cases {
+		if got := Load(name).String(); got != want {
+			t.Errorf("Load(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestContext(t *testing.T) {
+	ctx := context.Background()
+	if got := Location(ctx); got != Default {
+		t.Errorf("Location without value = %v, want Default", got)
+	}
+
+	tokyo := Load("Asia/Tokyo")
+	ctx = WithLocation(ctx, tokyo)
+	at := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	if got, want := FormatUser(ctx, at, "02.01 15:04"), "01.03 09:00"; got != want {
+		t.Errorf("FormatUser = %q, want %q", got, want)
+	}
+	parsed, err := FromUser(ctx, "2006-01-02T15:04", "2026-03-01T09:00")
+	if err != nil || !parsed.Equal(at) {
+		t.Errorf("FromUser = %v, %v; want %v", parsed, err, at)
+	}
+}
+
+func TestSameClock(t *testing.T) {
+	moscow := time.FixedZone("MSK", 3*60*60)
+	at := time.Date(2026, 3, 2, 9, 0, 0, 0, moscow)
+	got := SameClock(at, time.UTC)
+	if want := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("SameClock = %v, want %v", got, want)
+	}
+}
+
+func TestFromLongitude(t *testing.T) {
+	now := time.Date(2026, 1, 15, 12, 0, 0, 0, time.UTC)
+	cases := map[float64]string{
+		37.6:   "Europe/Moscow",
+		139.7:  "Asia/Yakutsk",
+		-74.0:  "America/New_York",
+		-150.0: "Etc/GMT+10",
+		0.1:    "Europe/London",
+	}
+	for lon, want := range cases {
+		if got := FromLongitude(lon, now); got != want {
+			t.Errorf("FromLongitude(%v) = %q, want %q", lon, got, want)
+		}
+	}
+}
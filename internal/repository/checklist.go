@@ -0,0 +1,156 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// ChecklistItem — пункт чек-листа напоминания.
+type ChecklistItem struct {
+	ID         uuid.UUID
+	ReminderID uuid.UUID
+	Position   int
+	Text       string
+	Done       bool
+	// DoneBy и DoneAt — кто и когда отметил пункт; nil, пока не отмечен.
+	DoneBy    *uuid.UUID
+	DoneAt    *time.Time
+	CreatedAt time.Time
+}
+
+var checklistColumns = []string{"id", "reminder_id", "position", "text", "done", "done_by", "done_at", "created_at"}
+
+func checklistFields(item *ChecklistItem) []any {
+	return []any{&item.ID, &item.ReminderID, &item.Position, &item.Text, &item.Done, &item.DoneBy, &item.DoneAt, &item.CreatedAt}
+}
+
+// AddChecklistItems добавляет пункты в конец чек-листа напоминания.
+func (r *ReminderRepo) AddChecklistItems(ctx context.Context, reminderID uuid.UUID, texts []string) error {
+	if len(texts) == 0 {
+		return nil
+	}
+	builder := r.pg.Builder.
+		Insert("reminder_checklist_items").
+		Columns("reminder_id", "position", "text")
+	for i, text := range texts {
+		builder = builder.Values(reminderID,
+			squirrel.Expr("(SELECT COALESCE(MAX(position), 0) FROM reminder_checklist_items WHERE reminder_id = ?) + ?", reminderID, i+1),
+			text)
+	}
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	if _, err := r.pg.DB(ctx).Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("add checklist items: %w", err)
+	}
+	return nil
+}
+
+// ListChecklist возвращает чек-лист напоминания по порядку пунктов.
+func (r *ReminderRepo) ListChecklist(ctx context.Context, reminderID uuid.UUID) ([]ChecklistItem, error) {
+	query, args, err := r.pg.Builder.
+		Select(checklistColumns...).
+		From("reminder_checklist_items").
+		Where(squirrel.Eq{"reminder_id": reminderID}).
+		OrderBy("position", "id").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query: %w", err)
+	}
+
+	rows, err := r.pg.DB(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("list checklist: %w", err)
+	}
+	defer rows.Close()
+
+	var items []ChecklistItem
+	for rows.Next() {
+		var item ChecklistItem
+		if err := rows.Scan(checklistFields(&item)...); err != nil {
+			return nil, fmt.Errorf("scan checklist item: %w", err)
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("list checklist: %w", err)
+	}
+	return items, nil
+}
+
+// GetChecklistItem возвращает пункт чек-листа; nil — не найден.
+func (r *ReminderRepo) GetChecklistItem(ctx context.Context, id uuid.UUID) (*ChecklistItem, error) {
+	query, args, err := r.pg.Builder.
+		Select(checklistColumns...).
+		From("reminder_checklist_items").
+		Where(squirrel.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query: %w", err)
+	}
+
+	var item ChecklistItem
+	err = r.pg.DB(ctx).QueryRow(ctx, query, args...).Scan(checklistFields(&item)...)
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get checklist item: %w", err)
+	}
+	return &item, nil
+}
+
+// SetChecklistItemDone отмечает пункт выполненным пользователем userID или
+// снимает отметку. Возвращает true, если отметка изменилась: повторное
+// нажатие той же кнопки ничего не меняет.
+func (r *ReminderRepo) SetChecklistItemDone(ctx context.Context, id uuid.UUID, done bool, userID uuid.UUID) (bool, error) {
+	builder := r.pg.Builder.
+		Update("reminder_checklist_items").
+		Set("done", done).
+		Where(squirrel.Eq{"id": id, "done": !done})
+	if done {
+		builder = builder.Set("done_by", userID).Set("done_at", squirrel.Expr("NOW()"))
+	} else {
+		builder = builder.Set("done_by", nil).Set("done_at", nil)
+	}
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return false, fmt.Errorf("build query: %w", err)
+	}
+
+	tag, err := r.pg.DB(ctx).Exec(ctx, query, args...)
+	if err != nil {
+		return false, fmt.Errorf("update checklist item: %w", err)
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// DeleteChecklistItem удаляет пункт чек-листа напоминания и возвращает его; nil — не найден.
+func (r *ReminderRepo) DeleteChecklistItem(ctx context.Context, reminderID, id uuid.UUID) (*ChecklistItem, error) {
+	query, args, err := r.pg.Builder.
+		Delete("reminder_checklist_items").
+		Where(squirrel.Eq{"id": id, "reminder_id": reminderID}).
+		Suffix("RETURNING " + strings.Join(checklistColumns, ", ")).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query: %w", err)
+	}
+
+	var item ChecklistItem
+	err = r.pg.DB(ctx).QueryRow(ctx, query, args...).Scan(checklistFields(&item)...)
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("delete checklist item: %w", err)
+	}
+	return &item, nil
+}
@@ -27,6 +27,9 @@ func ApplyFilters(b squirrel.SelectBuilder, filters []model.ActiveFilter, wl Fil
 			escaped = strings.ReplaceAll(escaped, "_", "\\_")
 			b = b.Where(squirrel.ILike{col: "%" + escaped + "%"})
 
+		case model.FilterSearch:
+			b = b.Where(col+" @@ "+searchQuery, f.Value, f.Value)
+
 		case model.FilterDateRange:
 			if f.Value != "" {
 				b = b.Where(squirrel.GtOrEq{col: f.Value})
@@ -0,0 +1,135 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/vovanwin/template/internal/model"
+	"github.com/vovanwin/template/internal/pkg/cursor"
+)
+
+// sortKey — выражение сортировки. Строки с равным ключом упорядочиваются
+// по id, поэтому пара (ключ, id) однозначно задаёт позицию строки.
+type sortKey struct {
+	expr string
+	args []any
+	// typ — тип выражения: курсор хранит ключ текстом и приводится к нему.
+	typ string
+}
+
+// order возвращает ORDER BY по ключу и id в направлении dir.
+func (k sortKey) order(dir string) squirrel.Sqlizer {
+	return squirrel.Expr(k.expr+" "+dir+", id "+dir, k.args...)
+}
+
+// after возвращает условие «строка после курсора c».
+func (k sortKey) after(c cursor.Cursor) squirrel.Sqlizer {
+	op := "<"
+	if c.Order == "asc" {
+		op = ">"
+	}
+	args := append(slices.Clone(k.args), c.Key, c.ID)
+	return squirrel.Expr("("+k.expr+", id) "+op+" (CAST(? AS "+k.typ+"), CAST(? AS uuid))", args...)
+}
+
+// text возвращает ключ строки в текстовом виде для курсора.
+func (k sortKey) text() squirrel.Sqlizer {
+	return squirrel.Expr("("+k.expr+")::text", k.args...)
+}
+
+// reminderSortKey возвращает поле сортировки, которое действительно
+// применится, и его ключ: неизвестное поле и релевантность без поиска —
+// сортировка по дате создания.
+func reminderSortKey(sortField string, filters []model.ActiveFilter) (string, sortKey) {
+	if q := searchValue(filters); sortField == model.SortRank && q != "" {
+		// Совпадения в названии весят больше, чем в описании.
+		return sortField, sortKey{expr: "ts_rank_cd(search, " + searchQuery + ")", args: []any{q, q}, typ: "real"}
+	}
+	if key, ok := reminderSortKeys[sortField]; ok {
+		return sortField, key
+	}
+	return "created_at", reminderSortKeys["created_at"]
+}
+
+// ListByUserIDKeyset возвращает до limit напоминаний, доступных пользователю,
+// после курсора after (keyset-пагинация): без OFFSET и подсчёта всех строк,
+// а вставки и удаления не сдвигают следующие порции. Пустой after — первая
+// порция; дальше сортировка берётся из курсора, а фильтры должны совпадать
+// с первым запросом. NextCursor пуст, когда строк больше нет.
+func (r *ReminderRepo) ListByUserIDKeyset(ctx context.Context, userID uuid.UUID, after string, limit int, sortField, sortOrder string, filters []model.ActiveFilter) (*PagedReminders, error) {
+	var cur *cursor.Cursor
+	if after != "" {
+		c, err := cursor.Decode(after)
+		if err != nil {
+			return nil, err
+		}
+		cur = &c
+		sortField, sortOrder = c.Sort, c.Order
+	}
+	if sortOrder != "asc" && sortOrder != "desc" {
+		sortOrder = "desc"
+	}
+	field, key := reminderSortKey(sortField, filters)
+	// Курсор релевантности без поискового запроса или подделанное поле.
+	if cur != nil && cur.Sort != field {
+		return nil, fmt.Errorf("%w: sort %q is not available", cursor.ErrInvalid, cur.Sort)
+	}
+
+	builder := r.pg.Builder.
+		Select(reminderColumns...).
+		Column(key.text()).
+		From("reminders").
+		Where(visibleTo(userID)).
+		OrderByClause(key.order(sortOrder)).
+		// Лишняя строка показывает, есть ли следующая порция.
+		Limit(uint64(limit + 1))
+	if cur != nil {
+		builder = builder.Where(key.after(*cur))
+	}
+	builder = ApplyFilters(builder, filters, reminderFilterWhitelist)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query: %w", err)
+	}
+
+	rows, err := r.pg.DB(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query reminders: %w", err)
+	}
+	defer rows.Close()
+
+	var (
+		result []Reminder
+		keys   []string
+	)
+	for rows.Next() {
+		var (
+			rem Reminder
+			k   string
+		)
+		if err := rows.Scan(append(reminderFields(&rem), &k)...); err != nil {
+			return nil, fmt.Errorf("scan reminder: %w", err)
+		}
+		result = append(result, rem)
+		keys = append(keys, k)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("query reminders: %w", err)
+	}
+
+	page := &PagedReminders{Items: result}
+	if len(result) > limit {
+		page.Items = result[:limit]
+		page.NextCursor = cursor.Cursor{
+			Sort:  field,
+			Order: sortOrder,
+			Key:   keys[limit-1],
+			ID:    result[limit-1].ID.String(),
+		}.Encode()
+	}
+	return page, nil
+}
@@ -0,0 +1,215 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/vovanwin/template/internal/model"
+	"github.com/vovanwin/template/internal/pkg/storage/postgres"
+)
+
+// ReminderList — общий список напоминаний.
+type ReminderList struct {
+	ID        uuid.UUID
+	Name      string
+	OwnerID   uuid.UUID
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	// Role — роль текущего пользователя; заполняется в ListByMember.
+	Role model.ListRole
+}
+
+// ListMember — участник списка.
+type ListMember struct {
+	ListID    uuid.UUID
+	UserID    uuid.UUID
+	Email     string
+	Name      string
+	Role      model.ListRole
+	CreatedAt time.Time
+}
+
+type ListRepo struct {
+	pg *postgres.Postgres
+}
+
+func NewListRepo(pg *postgres.Postgres) *ListRepo {
+	return &ListRepo{pg: pg}
+}
+
+// createListQuery создаёт список и добавляет владельца участником с ролью owner.
+const createListQuery = `
+WITH l AS (
+	INSERT INTO reminder_lists (name, owner_id)
+	VALUES ($1, $2)
+	RETURNING id, name, owner_id, created_at, updated_at
+), m AS (
+	INSERT INTO reminder_list_members (list_id, user_id, role)
+	SELECT id, owner_id, 'owner' FROM l
+)
+SELECT id, name, owner_id, created_at, updated_at FROM l`
+
+func (r *ListRepo) Create(ctx context.Context, ownerID uuid.UUID, name string) (*ReminderList, error) {
+	l := ReminderList{Role: model.ListRoleOwner}
+	err := r.pg.Pool.QueryRow(ctx, createListQuery, name, ownerID).Scan(
+		&l.ID, &l.Name, &l.OwnerID, &l.CreatedAt, &l.UpdatedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("insert list: %w", err)
+	}
+	return &l, nil
+}
+
+func (r *ListRepo) GetByID(ctx context.Context, id uuid.UUID) (*ReminderList, error) {
+	query, args, err := r.pg.Builder.
+		Select("id", "name", "owner_id", "created_at", "updated_at").
+		From("reminder_lists").
+		Where(squirrel.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query: %w", err)
+	}
+
+	var l ReminderList
+	err = r.pg.Pool.QueryRow(ctx, query, args...).Scan(&l.ID, &l.Name, &l.OwnerID, &l.CreatedAt, &l.UpdatedAt)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get list: %w", err)
+	}
+	return &l, nil
+}
+
+// ListByMember возвращает списки, в которых состоит пользователь, с его ролью.
+func (r *ListRepo) ListByMember(ctx context.Context, userID uuid.UUID) ([]ReminderList, error) {
+	query, args, err := r.pg.Builder.
+		Select("l.id", "l.name", "l.owner_id", "l.created_at", "l.updated_at", "m.role").
+		From("reminder_lists l").
+		Join("reminder_list_members m ON m.list_id = l.id").
+		Where(squirrel.Eq{"m.user_id": userID}).
+		OrderBy("l.name ASC").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query: %w", err)
+	}
+
+	rows, err := r.pg.Pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query lists: %w", err)
+	}
+	defer rows.Close()
+
+	var lists []ReminderList
+	for rows.Next() {
+		var l ReminderList
+		if err := rows.Scan(&l.ID, &l.Name, &l.OwnerID, &l.CreatedAt, &l.UpdatedAt, &l.Role); err != nil {
+			return nil, fmt.Errorf("scan list: %w", err)
+		}
+		lists = append(lists, l)
+	}
+	return lists, nil
+}
+
+// MemberRole возвращает роль пользователя в списке; ok=false — не участник.
+func (r *ListRepo) MemberRole(ctx context.Context, listID, userID uuid.UUID) (role model.ListRole, ok bool, err error) {
+	query, args, err := r.pg.Builder.
+		Select("role").
+		From("reminder_list_members").
+		Where(squirrel.Eq{"list_id": listID, "user_id": userID}).
+		ToSql()
+	if err != nil {
+		return 0, false, fmt.Errorf("build query: %w", err)
+	}
+
+	err = r.pg.Pool.QueryRow(ctx, query, args...).Scan(&role)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return 0, false, nil
+		}
+		return 0, false, fmt.Errorf("get member role: %w", err)
+	}
+	return role, true, nil
+}
+
+func (r *ListRepo) ListMembers(ctx context.Context, listID uuid.UUID) ([]ListMember, error) {
+	query, args, err := r.pg.Builder.
+		Select("m.list_id", "m.user_id", "u.email", "COALESCE(u.name, '')", "m.role", "m.created_at").
+		From("reminder_list_members m").
+		Join("users u ON u.id = m.user_id").
+		Where(squirrel.Eq{"m.list_id": listID}).
+		OrderBy("m.created_at ASC").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query: %w", err)
+	}
+
+	rows, err := r.pg.Pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query members: %w", err)
+	}
+	defer rows.Close()
+
+	var members []ListMember
+	for rows.Next() {
+		var m ListMember
+		if err := rows.Scan(&m.ListID, &m.UserID, &m.Email, &m.Name, &m.Role, &m.CreatedAt); err != nil {
+			return nil, fmt.Errorf("scan member: %w", err)
+		}
+		members = append(members, m)
+	}
+	return members, nil
+}
+
+// UpsertMember добавляет участника или меняет его роль.
+func (r *ListRepo) UpsertMember(ctx context.Context, listID, userID uuid.UUID, role model.ListRole) error {
+	query, args, err := r.pg.Builder.
+		Insert("reminder_list_members").
+		Columns("list_id", "user_id", "role").
+		Values(listID, userID, role.String()).
+		Suffix("ON CONFLICT (list_id, user_id) DO UPDATE SET role = EXCLUDED.role").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	if _, err := r.pg.Pool.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("upsert member: %w", err)
+	}
+	return nil
+}
+
+// RemoveMember удаляет участника из списка.
+func (r *ListRepo) RemoveMember(ctx context.Context, listID, userID uuid.UUID) error {
+	query, args, err := r.pg.Builder.
+		Delete("reminder_list_members").
+		Where(squirrel.Eq{"list_id": listID, "user_id": userID}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	if _, err := r.pg.Pool.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("remove member: %w", err)
+	}
+	return nil
+}
+
+func (r *ListRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	query, args, err := r.pg.Builder.
+		Delete("reminder_lists").
+		Where(squirrel.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	if _, err := r.pg.Pool.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("delete list: %w", err)
+	}
+	return nil
+}
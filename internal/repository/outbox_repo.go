@@ -0,0 +1,137 @@
+package repository
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/vovanwin/template/internal/pkg/outbox"
+	"github.com/vovanwin/template/internal/pkg/storage/postgres"
+)
+
+// OutboxRepo хранит сообщения outbox и реализует outbox.Store.
+type OutboxRepo struct {
+	pg *postgres.Postgres
+}
+
+func NewOutboxRepo(pg *postgres.Postgres) *OutboxRepo {
+	return &OutboxRepo{pg: pg}
+}
+
+// Add сохраняет сообщения; внутри RunInTx — в той же транзакции.
+func (r *OutboxRepo) Add(ctx context.Context, msgs ...outbox.Message) error {
+	if len(msgs) == 0 {
+		return nil
+	}
+	builder := r.pg.Builder.
+		Insert("outbox").
+		Columns("kind", "aggregate_key", "payload")
+	for _, m := range msgs {
+		builder = builder.Values(m.Kind, squirrel.Expr("NULLIF(?, '')", m.Key), []byte(m.Payload))
+	}
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	if _, err := r.pg.DB(ctx).Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("insert outbox messages: %w", err)
+	}
+	return nil
+}
+
+// claimOutboxQuery забирает готовые сообщения, у которых нет более раннего
+// недоставленного сообщения с тем же ключом, и скрывает их на время lease.
+// SKIP LOCKED не даёт двум экземплярам забрать одно сообщение.
+const claimOutboxQuery = `
+UPDATE outbox
+SET attempts = attempts + 1,
+	next_attempt_at = NOW() + make_interval(secs => $2)
+WHERE id IN (
+	SELECT o.id FROM outbox o
+	WHERE o.failed_at IS NULL
+		AND o.next_attempt_at <= NOW()
+		AND NOT EXISTS (
+			SELECT 1 FROM outbox p
+			WHERE p.aggregate_key = o.aggregate_key AND p.id < o.id AND p.failed_at IS NULL
+		)
+	ORDER BY o.id
+	LIMIT $1
+	FOR UPDATE SKIP LOCKED
+)
+RETURNING id, kind, COALESCE(aggregate_key, ''), payload, attempts`
+
+func (r *OutboxRepo) Claim(ctx context.Context, limit int, lease time.Duration) ([]outbox.Message, error) {
+	rows, err := r.pg.DB(ctx).Query(ctx, claimOutboxQuery, limit, lease.Seconds())
+	if err != nil {
+		return nil, fmt.Errorf("claim outbox messages: %w", err)
+	}
+	defer rows.Close()
+
+	var result []outbox.Message
+	for rows.Next() {
+		var m outbox.Message
+		if err := rows.Scan(&m.ID, &m.Kind, &m.Key, &m.Payload, &m.Attempts); err != nil {
+			return nil, fmt.Errorf("scan outbox message: %w", err)
+		}
+		result = append(result, m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("claim outbox messages: %w", err)
+	}
+	// RETURNING не гарантирует порядок.
+	slices.SortFunc(result, func(a, b outbox.Message) int { return cmp.Compare(a.ID, b.ID) })
+	return result, nil
+}
+
+func (r *OutboxRepo) Complete(ctx context.Context, id int64) error {
+	query, args, err := r.pg.Builder.
+		Delete("outbox").
+		Where(squirrel.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	if _, err := r.pg.DB(ctx).Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("delete outbox message: %w", err)
+	}
+	return nil
+}
+
+func (r *OutboxRepo) Retry(ctx context.Context, id int64, at time.Time, reason string) error {
+	query, args, err := r.pg.Builder.
+		Update("outbox").
+		Set("next_attempt_at", at).
+		Set("last_error", reason).
+		Where(squirrel.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	if _, err := r.pg.DB(ctx).Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("retry outbox message: %w", err)
+	}
+	return nil
+}
+
+func (r *OutboxRepo) Fail(ctx context.Context, id int64, reason string) error {
+	query, args, err := r.pg.Builder.
+		Update("outbox").
+		Set("failed_at", squirrel.Expr("NOW()")).
+		Set("last_error", reason).
+		Where(squirrel.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	if _, err := r.pg.DB(ctx).Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("fail outbox message: %w", err)
+	}
+	return nil
+}
@@ -3,12 +3,14 @@ package repository
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/Masterminds/squirrel"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/vovanwin/template/internal/model"
+	"github.com/vovanwin/template/internal/pkg/search"
 	"github.com/vovanwin/template/internal/pkg/storage/postgres"
 )
 
@@ -22,8 +24,117 @@ type Reminder struct {
 	Status                string
 	RequireConfirmation   bool
 	RepeatIntervalMinutes int
-	CreatedAt             time.Time
-	UpdatedAt             time.Time
+	RecurrenceRule        string
+	ScheduleID            string
+	OccurrenceCount       int
+	LastOccurrenceAt      *time.Time
+	SnoozeCount           int
+	SnoozedUntil          *time.Time
+	// Channels — каналы доставки напоминания; пусто — настройки пользователя.
+	Channels []string
+	// EscalationPolicy — цепочка эскалации (escalation.Policy.String()).
+	EscalationPolicy string
+	// EscalationLevel — сколько шагов эскалации сработало в текущем срабатывании.
+	EscalationLevel int
+	// Priority — приоритет; высокий всегда требует подтверждения.
+	Priority model.ReminderPriority
+	// Tags — пользовательские теги (см. пакет tags).
+	Tags []string
+	// ListID — общий список; nil — личное напоминание создателя.
+	ListID *uuid.UUID
+	// AssigneeID — кому доставляется напоминание и кто его подтверждает;
+	// nil — самому создателю.
+	AssigneeID *uuid.UUID
+	// OverrideQuietHours — доставлять, не дожидаясь конца тихих часов получателя.
+	OverrideQuietHours bool
+	// Timezone — таймзона IANA, в которой задано время напоминания и его
+	// повторения; пусто — таймзона по умолчанию.
+	Timezone string
+	// ConfirmWindowMinutes, ResendBackoff и MaxResends — политика ожидания
+	// подтверждения (см. пакет confirm); 0 — окно по умолчанию и без ограничения повторов.
+	ConfirmWindowMinutes int
+	ResendBackoff        string
+	MaxResends           int
+	// ListName и AssigneeEmail — для отображения, только чтение.
+	ListName      string
+	AssigneeEmail string
+	// ChecklistTotal и ChecklistDone — пункты чек-листа: всего и отмеченных;
+	// только чтение.
+	ChecklistTotal int
+	ChecklistDone  int
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// RecipientID возвращает пользователя, которому доставляется напоминание.
+func (r *Reminder) RecipientID() uuid.UUID {
+	if r.AssigneeID != nil {
+		return *r.AssigneeID
+	}
+	return r.UserID
+}
+
+// IsRecurring сообщает, повторяется ли напоминание по расписанию.
+func (r *Reminder) IsRecurring() bool {
+	return r.RecurrenceRule != ""
+}
+
+// reminderColumns — колонки, которые читаются в Reminder через scanReminder.
+var reminderColumns = []string{
+	"id", "user_id", "title", "description", "remind_at", "COALESCE(workflow_id, '')", "status",
+	"require_confirmation", "repeat_interval_minutes",
+	"recurrence_rule", "schedule_id", "occurrence_count", "last_occurrence_at",
+	"snooze_count", "snoozed_until", "channels",
+	"escalation_policy", "escalation_level", "priority", "tags", "list_id", "assignee_id",
+	"override_quiet_hours", "timezone",
+	"confirm_window_minutes", "resend_backoff", "max_resends",
+	"COALESCE((SELECT name FROM reminder_lists WHERE id = reminders.list_id), '')",
+	"COALESCE((SELECT email FROM users WHERE id = reminders.assignee_id), '')",
+	"(SELECT count(*) FROM reminder_checklist_items WHERE reminder_id = reminders.id)",
+	"(SELECT count(*) FILTER (WHERE done) FROM reminder_checklist_items WHERE reminder_id = reminders.id)",
+	"created_at", "updated_at",
+}
+
+func scanReminder(row pgx.Row, rem *Reminder) error {
+	return row.Scan(reminderFields(rem)...)
+}
+
+// reminderFields — поля Reminder в порядке reminderColumns.
+func reminderFields(rem *Reminder) []any {
+	return []any{
+		&rem.ID, &rem.UserID, &rem.Title, &rem.Description, &rem.RemindAt,
+		&rem.WorkflowID, &rem.Status, &rem.RequireConfirmation, &rem.RepeatIntervalMinutes,
+		&rem.RecurrenceRule, &rem.ScheduleID, &rem.OccurrenceCount, &rem.LastOccurrenceAt,
+		&rem.SnoozeCount, &rem.SnoozedUntil, &rem.Channels,
+		&rem.EscalationPolicy, &rem.EscalationLevel, &rem.Priority, &rem.Tags, &rem.ListID, &rem.AssigneeID,
+		&rem.OverrideQuietHours, &rem.Timezone,
+		&rem.ConfirmWindowMinutes, &rem.ResendBackoff, &rem.MaxResends,
+		&rem.ListName, &rem.AssigneeEmail,
+		&rem.ChecklistTotal, &rem.ChecklistDone,
+		&rem.CreatedAt, &rem.UpdatedAt,
+	}
+}
+
+// CreateReminderParams — данные для создания напоминания.
+type CreateReminderParams struct {
+	UserID                uuid.UUID
+	Title                 string
+	Description           string
+	RemindAt              time.Time
+	RequireConfirmation   bool
+	RepeatIntervalMinutes int
+	RecurrenceRule        string
+	Channels              []string
+	EscalationPolicy      string
+	Priority              model.ReminderPriority
+	Tags                  []string
+	ListID                *uuid.UUID
+	AssigneeID            *uuid.UUID
+	OverrideQuietHours    bool
+	Timezone              string
+	ConfirmWindowMinutes  int
+	ResendBackoff         string
+	MaxResends            int
 }
 
 type ReminderRepo struct {
@@ -34,41 +145,175 @@ func NewReminderRepo(pg *postgres.Postgres) *ReminderRepo {
 	return &ReminderRepo{pg: pg}
 }
 
-func (r *ReminderRepo) Create(ctx context.Context, userID uuid.UUID, title, description string, remindAt time.Time, requireConfirmation bool, repeatIntervalMinutes int) (*Reminder, error) {
-	query, args, err := r.pg.Builder.
-		Insert("reminders").
-		Columns("user_id", "title", "description", "remind_at", "require_confirmation", "repeat_interval_minutes").
-		Values(userID, title, description, remindAt, requireConfirmation, repeatIntervalMinutes).
-		Suffix("RETURNING id, user_id, title, description, remind_at, COALESCE(workflow_id, ''), status, require_confirmation, repeat_interval_minutes, created_at, updated_at").
-		ToSql()
+func (r *ReminderRepo) Create(ctx context.Context, p CreateReminderParams) (*Reminder, error) {
+	query, args, err := r.insertQuery(p)
 	if err != nil {
-		return nil, fmt.Errorf("build query: %w", err)
+		return nil, err
 	}
 
 	var rem Reminder
-	err = r.pg.Pool.QueryRow(ctx, query, args...).Scan(
-		&rem.ID, &rem.UserID, &rem.Title, &rem.Description, &rem.RemindAt,
-		&rem.WorkflowID, &rem.Status, &rem.RequireConfirmation, &rem.RepeatIntervalMinutes,
-		&rem.CreatedAt, &rem.UpdatedAt,
-	)
+	err = scanReminder(r.pg.DB(ctx).QueryRow(ctx, query, args...), &rem)
 	if err != nil {
 		return nil, fmt.Errorf("insert reminder: %w", err)
 	}
 	return &rem, nil
 }
 
+// CreateBatch создаёт напоминания одним пакетом: пакет выполняется в неявной
+// транзакции, поэтому при ошибке не создаётся ни одно. Порядок результата
+// совпадает с порядком params.
+func (r *ReminderRepo) CreateBatch(ctx context.Context, params []CreateReminderParams) ([]Reminder, error) {
+	batch := &pgx.Batch{}
+	for _, p := range params {
+		query, args, err := r.insertQuery(p)
+		if err != nil {
+			return nil, err
+		}
+		batch.Queue(query, args...)
+	}
+
+	results := r.pg.DB(ctx).SendBatch(ctx, batch)
+	defer results.Close()
+
+	reminders := make([]Reminder, len(params))
+	for i := range reminders {
+		if err := scanReminder(results.QueryRow(), &reminders[i]); err != nil {
+			return nil, fmt.Errorf("insert reminder %d: %w", i, err)
+		}
+	}
+	if err := results.Close(); err != nil {
+		return nil, fmt.Errorf("insert reminders: %w", err)
+	}
+	return reminders, nil
+}
+
+func (r *ReminderRepo) insertQuery(p CreateReminderParams) (string, []any, error) {
+	query, args, err := r.pg.Builder.
+		Insert("reminders").
+		Columns("user_id", "title", "description", "remind_at", "require_confirmation", "repeat_interval_minutes", "recurrence_rule", "channels", "escalation_policy", "priority", "tags", "list_id", "assignee_id", "override_quiet_hours", "timezone", "confirm_window_minutes", "resend_backoff", "max_resends").
+		Values(p.UserID, p.Title, p.Description, p.RemindAt, p.RequireConfirmation, p.RepeatIntervalMinutes, p.RecurrenceRule, arrayOrEmpty(p.Channels), p.EscalationPolicy, p.Priority.String(), arrayOrEmpty(p.Tags), p.ListID, p.AssigneeID, p.OverrideQuietHours, p.Timezone, p.ConfirmWindowMinutes, p.ResendBackoff, p.MaxResends).
+		Suffix("RETURNING " + strings.Join(reminderColumns, ", ")).
+		ToSql()
+	if err != nil {
+		return "", nil, fmt.Errorf("build query: %w", err)
+	}
+	return query, args, nil
+}
+
+// visibleTo отбирает напоминания, доступные пользователю: созданные им,
+// назначенные ему и из общих списков, где он участник.
+func visibleTo(userID uuid.UUID) squirrel.Sqlizer {
+	return squirrel.Or{
+		squirrel.Eq{"user_id": userID},
+		squirrel.Eq{"assignee_id": userID},
+		squirrel.Expr("list_id IN (SELECT list_id FROM reminder_list_members WHERE user_id = ?)", userID),
+	}
+}
+
+// ListByUserID возвращает напоминания, доступные пользователю (см. visibleTo).
 func (r *ReminderRepo) ListByUserID(ctx context.Context, userID uuid.UUID) ([]Reminder, error) {
 	query, args, err := r.pg.Builder.
-		Select("id", "user_id", "title", "description", "remind_at", "COALESCE(workflow_id, '')", "status", "require_confirmation", "repeat_interval_minutes", "created_at", "updated_at").
+		Select(reminderColumns...).
+		From("reminders").
+		Where(visibleTo(userID)).
+		OrderBy("remind_at ASC").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query: %w", err)
+	}
+
+	rows, err := r.pg.DB(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query reminders: %w", err)
+	}
+	defer rows.Close()
+
+	var result []Reminder
+	for rows.Next() {
+		var rem Reminder
+		if err := scanReminder(rows, &rem); err != nil {
+			return nil, fmt.Errorf("scan reminder: %w", err)
+		}
+		result = append(result, rem)
+	}
+	return result, nil
+}
+
+// ListForCalendar возвращает напоминания, которые получает пользователь и которые ещё сработают:
+// ожидающие и отложенные разовые и повторяющиеся, кроме приостановленных и отменённых.
+func (r *ReminderRepo) ListForCalendar(ctx context.Context, userID uuid.UUID) ([]Reminder, error) {
+	query, args, err := r.pg.Builder.
+		Select(reminderColumns...).
+		From("reminders").
+		Where(squirrel.Or{
+			squirrel.Eq{"assignee_id": userID},
+			squirrel.And{squirrel.Eq{"user_id": userID}, squirrel.Eq{"assignee_id": nil}},
+		}).
+		Where(squirrel.Or{
+			squirrel.And{
+				squirrel.NotEq{"recurrence_rule": ""},
+				squirrel.NotEq{"status": []string{model.ReminderStatusPaused.String(), model.ReminderStatusCancelled.String()}},
+			},
+			squirrel.And{
+				squirrel.Eq{"recurrence_rule": ""},
+				squirrel.Eq{"status": []string{
+					model.ReminderStatusPending.String(),
+					model.ReminderStatusProcessing.String(),
+					model.ReminderStatusSnoozed.String(),
+				}},
+			},
+		}).
+		OrderBy("remind_at ASC").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query: %w", err)
+	}
+
+	rows, err := r.pg.DB(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query reminders: %w", err)
+	}
+	defer rows.Close()
+
+	var result []Reminder
+	for rows.Next() {
+		var rem Reminder
+		if err := scanReminder(rows, &rem); err != nil {
+			return nil, fmt.Errorf("scan reminder: %w", err)
+		}
+		result = append(result, rem)
+	}
+	return result, nil
+}
+
+// ListPersonalActive возвращает личные напоминания пользователя, которые ещё
+// сработают: созданные им без исполнителя, разовые — ожидающие и отложенные,
+// повторяющиеся — кроме отменённых.
+func (r *ReminderRepo) ListPersonalActive(ctx context.Context, userID uuid.UUID) ([]Reminder, error) {
+	query, args, err := r.pg.Builder.
+		Select(reminderColumns...).
 		From("reminders").
-		Where(squirrel.Eq{"user_id": userID}).
+		Where(squirrel.Eq{"user_id": userID, "assignee_id": nil}).
+		Where(squirrel.Or{
+			squirrel.And{
+				squirrel.NotEq{"recurrence_rule": ""},
+				squirrel.NotEq{"status": model.ReminderStatusCancelled.String()},
+			},
+			squirrel.And{
+				squirrel.Eq{"recurrence_rule": ""},
+				squirrel.Eq{"status": []string{
+					model.ReminderStatusPending.String(),
+					model.ReminderStatusSnoozed.String(),
+				}},
+			},
+		}).
 		OrderBy("remind_at ASC").
 		ToSql()
 	if err != nil {
 		return nil, fmt.Errorf("build query: %w", err)
 	}
 
-	rows, err := r.pg.Pool.Query(ctx, query, args...)
+	rows, err := r.pg.DB(ctx).Query(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("query reminders: %w", err)
 	}
@@ -77,11 +322,52 @@ func (r *ReminderRepo) ListByUserID(ctx context.Context, userID uuid.UUID) ([]Re
 	var result []Reminder
 	for rows.Next() {
 		var rem Reminder
-		if err := rows.Scan(
-			&rem.ID, &rem.UserID, &rem.Title, &rem.Description, &rem.RemindAt,
-			&rem.WorkflowID, &rem.Status, &rem.RequireConfirmation, &rem.RepeatIntervalMinutes,
-			&rem.CreatedAt, &rem.UpdatedAt,
-		); err != nil {
+		if err := scanReminder(rows, &rem); err != nil {
+			return nil, fmt.Errorf("scan reminder: %w", err)
+		}
+		result = append(result, rem)
+	}
+	return result, rows.Err()
+}
+
+// ListUnacknowledged возвращает разовые напоминания, которые пользователь
+// получил в [from, to) и так и не подтвердил: ещё ожидающие подтверждения,
+// отправленные и просроченные.
+func (r *ReminderRepo) ListUnacknowledged(ctx context.Context, userID uuid.UUID, from, to time.Time) ([]Reminder, error) {
+	query, args, err := r.pg.Builder.
+		Select(reminderColumns...).
+		From("reminders").
+		Where(squirrel.Or{
+			squirrel.Eq{"assignee_id": userID},
+			squirrel.And{squirrel.Eq{"user_id": userID}, squirrel.Eq{"assignee_id": nil}},
+		}).
+		Where(squirrel.Eq{
+			"recurrence_rule":      "",
+			"require_confirmation": true,
+			"status": []string{
+				model.ReminderStatusProcessing.String(),
+				model.ReminderStatusSent.String(),
+				model.ReminderStatusExpired.String(),
+			},
+		}).
+		Where(squirrel.GtOrEq{"remind_at": from}).
+		Where(squirrel.Lt{"remind_at": to}).
+		OrderBy("remind_at ASC").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query: %w", err)
+	}
+
+	rows, err := r.pg.DB(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query reminders: %w", err)
+	}
+	defer rows.Close()
+
+	var result []Reminder
+	for rows.Next() {
+		var rem Reminder
+		if err := scanReminder(rows, &rem); err != nil {
 			return nil, fmt.Errorf("scan reminder: %w", err)
 		}
 		result = append(result, rem)
@@ -89,18 +375,94 @@ func (r *ReminderRepo) ListByUserID(ctx context.Context, userID uuid.UUID) ([]Re
 	return result, nil
 }
 
+// ReminderOutboxKey — ключ сообщений outbox напоминания: запуск и остановка
+// его workflow доставляются по порядку.
+func ReminderOutboxKey(id uuid.UUID) string {
+	return "reminder/" + id.String()
+}
+
+// ListForReconcile возвращает активные напоминания, не менявшиеся с updatedBefore,
+// с id больше afterID — страницу для сверки с Temporal. Разовые — ожидающие,
+// отправляемые и отложенные; повторяющиеся — активные и приостановленные.
+// Напоминания с недоставленными сообщениями outbox пропускаются: их запуск
+// ещё впереди.
+func (r *ReminderRepo) ListForReconcile(ctx context.Context, updatedBefore time.Time, afterID uuid.UUID, limit int) ([]Reminder, error) {
+	query, args, err := r.pg.Builder.
+		Select(reminderColumns...).
+		From("reminders").
+		Where(squirrel.Eq{"status": []string{
+			model.ReminderStatusPending.String(),
+			model.ReminderStatusProcessing.String(),
+			model.ReminderStatusSnoozed.String(),
+			model.ReminderStatusPaused.String(),
+		}}).
+		Where(squirrel.Lt{"updated_at": updatedBefore}).
+		Where(squirrel.Gt{"id": afterID}).
+		Where("NOT EXISTS (SELECT 1 FROM outbox WHERE outbox.aggregate_key = 'reminder/' || reminders.id::text AND outbox.failed_at IS NULL)").
+		OrderBy("id ASC").
+		Limit(uint64(limit)).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query: %w", err)
+	}
+
+	rows, err := r.pg.DB(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query reminders: %w", err)
+	}
+	defer rows.Close()
+
+	var result []Reminder
+	for rows.Next() {
+		var rem Reminder
+		if err := scanReminder(rows, &rem); err != nil {
+			return nil, fmt.Errorf("scan reminder: %w", err)
+		}
+		result = append(result, rem)
+	}
+	return result, rows.Err()
+}
+
+// WithAdvisoryLock выполняет fn, удерживая транзакционную advisory-блокировку key.
+// Если блокировку держит другой экземпляр приложения, fn не вызывается и
+// возвращается false. Блокировка снимается по завершении fn.
+func (r *ReminderRepo) WithAdvisoryLock(ctx context.Context, key int64, fn func(ctx context.Context) error) (bool, error) {
+	tx, err := r.pg.Pool.Begin(ctx)
+	if err != nil {
+		return false, fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	var locked bool
+	if err := tx.QueryRow(ctx, "SELECT pg_try_advisory_xact_lock($1)", key).Scan(&locked); err != nil {
+		return false, fmt.Errorf("try advisory lock: %w", err)
+	}
+	if !locked {
+		return false, nil
+	}
+	return true, fn(ctx)
+}
+
 type PagedReminders struct {
-	Items      []Reminder
+	Items []Reminder
+	// TotalPages и TotalItems считаются только в режиме страниц.
 	TotalPages int
 	TotalItems int
+	// NextCursor — курсор следующей порции в режиме keyset; пусто — порций больше нет.
+	NextCursor string
+	// Highlights — подсветка совпадений по ID напоминания; только при поиске.
+	Highlights map[uuid.UUID]search.Highlight
 }
 
-// allowedSortFields — whitelist полей для сортировки.
-var allowedSortFields = map[string]string{
-	"remind_at":  "remind_at",
-	"created_at": "created_at",
-	"title":      "title",
-	"status":     "status",
+// reminderSortKeys — whitelist полей для сортировки; сортировка по
+// релевантности (model.SortRank) строится из поискового запроса.
+var reminderSortKeys = map[string]sortKey{
+	"remind_at":  {expr: "remind_at", typ: "timestamptz"},
+	"created_at": {expr: "created_at", typ: "timestamptz"},
+	"title":      {expr: "title", typ: "text"},
+	"status":     {expr: "status", typ: "text"},
+	// Приоритет сортируется по важности, а не по алфавиту.
+	"priority": {expr: "CASE priority WHEN 'high' THEN 2 WHEN 'normal' THEN 1 ELSE 0 END", typ: "int"},
 }
 
 // reminderFilterWhitelist — whitelist колонок для фильтрации.
@@ -109,24 +471,26 @@ var reminderFilterWhitelist = FilterWhitelist{
 	"title":      "title",
 	"remind_at":  "remind_at",
 	"created_at": "created_at",
+	"priority":   "priority",
+	// Строковый фильтр ищет подстроку в тегах, соединённых пробелом.
+	"tags": "array_to_string(tags, ' ')",
+	// Полнотекстовый поиск по названию и описанию.
+	"q": "search",
 }
 
 func (r *ReminderRepo) ListByUserIDPaged(ctx context.Context, userID uuid.UUID, page, pageSize int, sortField, sortOrder string, filters []model.ActiveFilter) (*PagedReminders, error) {
 	// Валидация сортировки
-	dbColumn, ok := allowedSortFields[sortField]
-	if !ok {
-		dbColumn = "created_at"
-	}
 	if sortOrder != "asc" && sortOrder != "desc" {
 		sortOrder = "desc"
 	}
-	orderClause := dbColumn + " " + sortOrder
+	_, key := reminderSortKey(sortField, filters)
+	order := key.order(sortOrder)
 
 	// Count total
 	countBuilder := r.pg.Builder.
 		Select("COUNT(*)").
 		From("reminders").
-		Where(squirrel.Eq{"user_id": userID})
+		Where(visibleTo(userID))
 	countBuilder = ApplyFilters(countBuilder, filters, reminderFilterWhitelist)
 
 	countQuery, countArgs, err := countBuilder.ToSql()
@@ -135,7 +499,7 @@ func (r *ReminderRepo) ListByUserIDPaged(ctx context.Context, userID uuid.UUID,
 	}
 
 	var total int
-	if err := r.pg.Pool.QueryRow(ctx, countQuery, countArgs...).Scan(&total); err != nil {
+	if err := r.pg.DB(ctx).QueryRow(ctx, countQuery, countArgs...).Scan(&total); err != nil {
 		return nil, fmt.Errorf("count reminders: %w", err)
 	}
 
@@ -147,10 +511,10 @@ func (r *ReminderRepo) ListByUserIDPaged(ctx context.Context, userID uuid.UUID,
 	offset := (page - 1) * pageSize
 
 	dataBuilder := r.pg.Builder.
-		Select("id", "user_id", "title", "description", "remind_at", "COALESCE(workflow_id, '')", "status", "require_confirmation", "repeat_interval_minutes", "created_at", "updated_at").
+		Select(reminderColumns...).
 		From("reminders").
-		Where(squirrel.Eq{"user_id": userID}).
-		OrderBy(orderClause).
+		Where(visibleTo(userID)).
+		OrderByClause(order).
 		Limit(uint64(pageSize)).
 		Offset(uint64(offset))
 	dataBuilder = ApplyFilters(dataBuilder, filters, reminderFilterWhitelist)
@@ -160,7 +524,7 @@ func (r *ReminderRepo) ListByUserIDPaged(ctx context.Context, userID uuid.UUID,
 		return nil, fmt.Errorf("build query: %w", err)
 	}
 
-	rows, err := r.pg.Pool.Query(ctx, query, args...)
+	rows, err := r.pg.DB(ctx).Query(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("query reminders: %w", err)
 	}
@@ -169,11 +533,7 @@ func (r *ReminderRepo) ListByUserIDPaged(ctx context.Context, userID uuid.UUID,
 	var result []Reminder
 	for rows.Next() {
 		var rem Reminder
-		if err := rows.Scan(
-			&rem.ID, &rem.UserID, &rem.Title, &rem.Description, &rem.RemindAt,
-			&rem.WorkflowID, &rem.Status, &rem.RequireConfirmation, &rem.RepeatIntervalMinutes,
-			&rem.CreatedAt, &rem.UpdatedAt,
-		); err != nil {
+		if err := scanReminder(rows, &rem); err != nil {
 			return nil, fmt.Errorf("scan reminder: %w", err)
 		}
 		result = append(result, rem)
@@ -182,9 +542,42 @@ func (r *ReminderRepo) ListByUserIDPaged(ctx context.Context, userID uuid.UUID,
 	return &PagedReminders{Items: result, TotalPages: totalPages, TotalItems: total}, nil
 }
 
+// ListIDsByFilter возвращает ID напоминаний, доступных пользователю и
+// подходящих под фильтры таблицы, — не больше limit штук, новые первыми.
+func (r *ReminderRepo) ListIDsByFilter(ctx context.Context, userID uuid.UUID, filters []model.ActiveFilter, limit int) ([]uuid.UUID, error) {
+	builder := r.pg.Builder.
+		Select("id").
+		From("reminders").
+		Where(visibleTo(userID)).
+		OrderBy("created_at DESC").
+		Limit(uint64(limit))
+	builder = ApplyFilters(builder, filters, reminderFilterWhitelist)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query: %w", err)
+	}
+
+	rows, err := r.pg.DB(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query reminder ids: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("scan reminder id: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
 func (r *ReminderRepo) GetByID(ctx context.Context, id uuid.UUID) (*Reminder, error) {
 	query, args, err := r.pg.Builder.
-		Select("id", "user_id", "title", "description", "remind_at", "COALESCE(workflow_id, '')", "status", "require_confirmation", "repeat_interval_minutes", "created_at", "updated_at").
+		Select(reminderColumns...).
 		From("reminders").
 		Where(squirrel.Eq{"id": id}).
 		ToSql()
@@ -193,11 +586,7 @@ func (r *ReminderRepo) GetByID(ctx context.Context, id uuid.UUID) (*Reminder, er
 	}
 
 	var rem Reminder
-	err = r.pg.Pool.QueryRow(ctx, query, args...).Scan(
-		&rem.ID, &rem.UserID, &rem.Title, &rem.Description, &rem.RemindAt,
-		&rem.WorkflowID, &rem.Status, &rem.RequireConfirmation, &rem.RepeatIntervalMinutes,
-		&rem.CreatedAt, &rem.UpdatedAt,
-	)
+	err = scanReminder(r.pg.DB(ctx).QueryRow(ctx, query, args...), &rem)
 	if err != nil {
 		if err == pgx.ErrNoRows {
 			return nil, nil
@@ -218,7 +607,7 @@ func (r *ReminderRepo) UpdateWorkflowID(ctx context.Context, id uuid.UUID, workf
 		return fmt.Errorf("build query: %w", err)
 	}
 
-	_, err = r.pg.Pool.Exec(ctx, query, args...)
+	_, err = r.pg.DB(ctx).Exec(ctx, query, args...)
 	if err != nil {
 		return fmt.Errorf("update workflow_id: %w", err)
 	}
@@ -236,13 +625,400 @@ func (r *ReminderRepo) UpdateStatus(ctx context.Context, id uuid.UUID, status st
 		return fmt.Errorf("build query: %w", err)
 	}
 
-	_, err = r.pg.Pool.Exec(ctx, query, args...)
+	_, err = r.pg.DB(ctx).Exec(ctx, query, args...)
 	if err != nil {
 		return fmt.Errorf("update status: %w", err)
 	}
 	return nil
 }
 
+// UpdateDetails меняет заголовок, описание и, если remindAt задан, время напоминания.
+func (r *ReminderRepo) UpdateDetails(ctx context.Context, id uuid.UUID, title, description string, remindAt *time.Time) error {
+	builder := r.pg.Builder.
+		Update("reminders").
+		Set("title", title).
+		Set("description", description).
+		Set("updated_at", squirrel.Expr("NOW()")).
+		Where(squirrel.Eq{"id": id})
+	if remindAt != nil {
+		builder = builder.Set("remind_at", *remindAt)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	_, err = r.pg.DB(ctx).Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("update reminder details: %w", err)
+	}
+	return nil
+}
+
+// UpdateScheduleID сохраняет ID Temporal Schedule повторяющегося напоминания.
+func (r *ReminderRepo) UpdateScheduleID(ctx context.Context, id uuid.UUID, scheduleID string) error {
+	query, args, err := r.pg.Builder.
+		Update("reminders").
+		Set("schedule_id", scheduleID).
+		Set("updated_at", squirrel.Expr("NOW()")).
+		Where(squirrel.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	_, err = r.pg.DB(ctx).Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("update schedule_id: %w", err)
+	}
+	return nil
+}
+
+// UpdateTags заменяет теги напоминания.
+func (r *ReminderRepo) UpdateTags(ctx context.Context, id uuid.UUID, tags []string) error {
+	query, args, err := r.pg.Builder.
+		Update("reminders").
+		Set("tags", arrayOrEmpty(tags)).
+		Set("updated_at", squirrel.Expr("NOW()")).
+		Where(squirrel.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	_, err = r.pg.DB(ctx).Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("update tags: %w", err)
+	}
+	return nil
+}
+
+// UpdateTimezone переносит напоминание в таймзону tz с новым временем remindAt.
+func (r *ReminderRepo) UpdateTimezone(ctx context.Context, id uuid.UUID, tz string, remindAt time.Time) error {
+	query, args, err := r.pg.Builder.
+		Update("reminders").
+		Set("timezone", tz).
+		Set("remind_at", remindAt).
+		Set("updated_at", squirrel.Expr("NOW()")).
+		Where(squirrel.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	_, err = r.pg.DB(ctx).Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("update timezone: %w", err)
+	}
+	return nil
+}
+
+// UpdateRecurrenceRule меняет правило повторения напоминания.
+func (r *ReminderRepo) UpdateRecurrenceRule(ctx context.Context, id uuid.UUID, rule string) error {
+	query, args, err := r.pg.Builder.
+		Update("reminders").
+		Set("recurrence_rule", rule).
+		Set("updated_at", squirrel.Expr("NOW()")).
+		Where(squirrel.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	_, err = r.pg.DB(ctx).Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("update recurrence_rule: %w", err)
+	}
+	return nil
+}
+
+// recordOccurrenceQuery сохраняет статус срабатывания и при первом упоминании
+// workflow увеличивает счётчик срабатываний напоминания и сбрасывает отметки
+// чек-листа. Повторный вызов (ретрай activity) только обновляет статус.
+const recordOccurrenceQuery = `
+WITH occ AS (
+	INSERT INTO reminder_occurrences (reminder_id, workflow_id, status)
+	VALUES ($1, $2, $3)
+	ON CONFLICT (workflow_id) DO UPDATE SET status = EXCLUDED.status, updated_at = NOW()
+	RETURNING (xmax = 0) AS inserted
+), checklist AS (
+	UPDATE reminder_checklist_items
+	SET done = FALSE, done_by = NULL, done_at = NULL
+	WHERE reminder_id = $1 AND done AND (SELECT inserted FROM occ)
+)
+UPDATE reminders
+SET workflow_id = $2,
+	occurrence_count = occurrence_count + 1,
+	last_occurrence_at = NOW(),
+	escalation_level = 0,
+	updated_at = NOW()
+FROM occ
+WHERE reminders.id = $1 AND occ.inserted`
+
+// RecordOccurrence фиксирует статус очередного срабатывания повторяющегося напоминания.
+func (r *ReminderRepo) RecordOccurrence(ctx context.Context, reminderID uuid.UUID, workflowID, status string) error {
+	if _, err := r.pg.DB(ctx).Exec(ctx, recordOccurrenceQuery, reminderID, workflowID, status); err != nil {
+		return fmt.Errorf("record occurrence: %w", err)
+	}
+	return nil
+}
+
+// RecordSnooze увеличивает счётчик откладываний и запоминает, до какого момента
+// отложено уведомление. Ретрай activity с тем же until счётчик не увеличивает.
+func (r *ReminderRepo) RecordSnooze(ctx context.Context, id uuid.UUID, until time.Time) error {
+	query, args, err := r.pg.Builder.
+		Update("reminders").
+		Set("snooze_count", squirrel.Expr("snooze_count + 1")).
+		Set("snoozed_until", until).
+		Set("updated_at", squirrel.Expr("NOW()")).
+		Where(squirrel.Eq{"id": id}).
+		Where(squirrel.Expr("snoozed_until IS DISTINCT FROM ?", until)).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	_, err = r.pg.DB(ctx).Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("record snooze: %w", err)
+	}
+	return nil
+}
+
+// Escalation — сработавший шаг эскалации.
+type Escalation struct {
+	ID         uuid.UUID
+	ReminderID uuid.UUID
+	WorkflowID string
+	Step       int
+	Channel    string
+	Address    string
+	Status     string
+	Error      string
+	CreatedAt  time.Time
+}
+
+// recordEscalationQuery сохраняет шаг эскалации; ретрай activity только
+// обновляет результат. Успешный шаг поднимает уровень эскалации напоминания.
+const recordEscalationQuery = `
+WITH esc AS (
+	INSERT INTO reminder_escalations (reminder_id, workflow_id, step, channel, address, status, error)
+	VALUES ($1, $2, $3, $4, $5, $6, $7)
+	ON CONFLICT (workflow_id, step) DO UPDATE SET status = EXCLUDED.status, error = EXCLUDED.error
+	RETURNING step, status
+)
+UPDATE reminders
+SET escalation_level = GREATEST(escalation_level, esc.step),
+	updated_at = NOW()
+FROM esc
+WHERE reminders.id = $1 AND esc.status = 'sent'`
+
+// RecordEscalation фиксирует результат шага эскалации.
+func (r *ReminderRepo) RecordEscalation(ctx context.Context, e Escalation) error {
+	_, err := r.pg.DB(ctx).Exec(ctx, recordEscalationQuery,
+		e.ReminderID, e.WorkflowID, e.Step, e.Channel, e.Address, e.Status, e.Error)
+	if err != nil {
+		return fmt.Errorf("record escalation: %w", err)
+	}
+	return nil
+}
+
+// ListEscalations возвращает шаги эскалации напоминания, новые сверху.
+func (r *ReminderRepo) ListEscalations(ctx context.Context, reminderID uuid.UUID) ([]Escalation, error) {
+	query, args, err := r.pg.Builder.
+		Select("id", "reminder_id", "workflow_id", "step", "channel", "address", "status", "error", "created_at").
+		From("reminder_escalations").
+		Where(squirrel.Eq{"reminder_id": reminderID}).
+		OrderBy("created_at DESC", "step DESC").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query: %w", err)
+	}
+
+	rows, err := r.pg.DB(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("list escalations: %w", err)
+	}
+	defer rows.Close()
+
+	var escalations []Escalation
+	for rows.Next() {
+		var e Escalation
+		if err := rows.Scan(&e.ID, &e.ReminderID, &e.WorkflowID, &e.Step, &e.Channel, &e.Address, &e.Status, &e.Error, &e.CreatedAt); err != nil {
+			return nil, fmt.Errorf("scan escalation: %w", err)
+		}
+		escalations = append(escalations, e)
+	}
+	return escalations, nil
+}
+
+// Статусы записей истории доставки.
+const (
+	DeliverySent         = "sent"
+	DeliveryFailed       = "failed"
+	DeliveryAcknowledged = "acknowledged"
+)
+
+// Delivery — попытка доставки уведомления или подтверждение напоминания.
+type Delivery struct {
+	ID         uuid.UUID
+	ReminderID uuid.UUID
+	WorkflowID string
+	// Delivery — номер отправки в workflow: 1 — первая, дальше повторы.
+	// Для подтверждения — сколько отправок было до него.
+	Delivery int
+	// Channel — канал отправки; пусто для подтверждения.
+	Channel string
+	// Attempt — попытка activity: ретраи Temporal внутри одной отправки.
+	Attempt int
+	Status  string
+	Error   string
+	// TelegramMessageID — ID отправленного сообщения в Telegram, 0 — нет.
+	TelegramMessageID int64
+	CreatedAt         time.Time
+}
+
+// RecordDelivery сохраняет попытку доставки или подтверждение.
+// Повторная запись подтверждения того же workflow игнорируется.
+func (r *ReminderRepo) RecordDelivery(ctx context.Context, d Delivery) error {
+	query, args, err := r.pg.Builder.
+		Insert("reminder_deliveries").
+		Columns("reminder_id", "workflow_id", "delivery", "channel", "attempt", "status", "error", "telegram_message_id").
+		Values(d.ReminderID, d.WorkflowID, d.Delivery, d.Channel, d.Attempt, d.Status, d.Error, squirrel.Expr("NULLIF(?::BIGINT, 0)", d.TelegramMessageID)).
+		Suffix("ON CONFLICT (workflow_id) WHERE status = 'acknowledged' DO NOTHING").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	if _, err := r.pg.DB(ctx).Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("record delivery: %w", err)
+	}
+	return nil
+}
+
+// ListDeliveries возвращает историю доставки напоминания в хронологическом порядке.
+func (r *ReminderRepo) ListDeliveries(ctx context.Context, reminderID uuid.UUID) ([]Delivery, error) {
+	query, args, err := r.pg.Builder.
+		Select("id", "reminder_id", "workflow_id", "delivery", "channel", "attempt", "status", "error",
+			"COALESCE(telegram_message_id, 0)", "created_at").
+		From("reminder_deliveries").
+		Where(squirrel.Eq{"reminder_id": reminderID}).
+		OrderBy("created_at", "id").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query: %w", err)
+	}
+
+	rows, err := r.pg.DB(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("list deliveries: %w", err)
+	}
+	defer rows.Close()
+
+	var deliveries []Delivery
+	for rows.Next() {
+		var d Delivery
+		if err := rows.Scan(&d.ID, &d.ReminderID, &d.WorkflowID, &d.Delivery, &d.Channel, &d.Attempt,
+			&d.Status, &d.Error, &d.TelegramMessageID, &d.CreatedAt); err != nil {
+			return nil, fmt.Errorf("scan delivery: %w", err)
+		}
+		deliveries = append(deliveries, d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("list deliveries: %w", err)
+	}
+	return deliveries, nil
+}
+
+// Attachment — файл, приложенный к напоминанию. Содержимое лежит
+// в хранилище вложений под StorageKey.
+type Attachment struct {
+	ID          uuid.UUID
+	ReminderID  uuid.UUID
+	StorageKey  string
+	Filename    string
+	ContentType string
+	Size        int64
+	CreatedAt   time.Time
+}
+
+var attachmentColumns = []string{"id", "reminder_id", "storage_key", "filename", "content_type", "size", "created_at"}
+
+// CreateAttachment сохраняет вложение; ID и CreatedAt заполняются из БД.
+func (r *ReminderRepo) CreateAttachment(ctx context.Context, a *Attachment) error {
+	query, args, err := r.pg.Builder.
+		Insert("reminder_attachments").
+		Columns("reminder_id", "storage_key", "filename", "content_type", "size").
+		Values(a.ReminderID, a.StorageKey, a.Filename, a.ContentType, a.Size).
+		Suffix("RETURNING id, created_at").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	if err := r.pg.DB(ctx).QueryRow(ctx, query, args...).Scan(&a.ID, &a.CreatedAt); err != nil {
+		return fmt.Errorf("create attachment: %w", err)
+	}
+	return nil
+}
+
+// ListAttachments возвращает вложения напоминания в порядке добавления.
+func (r *ReminderRepo) ListAttachments(ctx context.Context, reminderID uuid.UUID) ([]Attachment, error) {
+	query, args, err := r.pg.Builder.
+		Select(attachmentColumns...).
+		From("reminder_attachments").
+		Where(squirrel.Eq{"reminder_id": reminderID}).
+		OrderBy("created_at", "id").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query: %w", err)
+	}
+
+	rows, err := r.pg.DB(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("list attachments: %w", err)
+	}
+	defer rows.Close()
+
+	var attachments []Attachment
+	for rows.Next() {
+		var a Attachment
+		if err := rows.Scan(&a.ID, &a.ReminderID, &a.StorageKey, &a.Filename, &a.ContentType, &a.Size, &a.CreatedAt); err != nil {
+			return nil, fmt.Errorf("scan attachment: %w", err)
+		}
+		attachments = append(attachments, a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("list attachments: %w", err)
+	}
+	return attachments, nil
+}
+
+// DeleteAttachment удаляет вложение напоминания и возвращает его; nil — не найдено.
+func (r *ReminderRepo) DeleteAttachment(ctx context.Context, reminderID, id uuid.UUID) (*Attachment, error) {
+	query, args, err := r.pg.Builder.
+		Delete("reminder_attachments").
+		Where(squirrel.Eq{"id": id, "reminder_id": reminderID}).
+		Suffix("RETURNING " + strings.Join(attachmentColumns, ", ")).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query: %w", err)
+	}
+
+	var a Attachment
+	err = r.pg.DB(ctx).QueryRow(ctx, query, args...).
+		Scan(&a.ID, &a.ReminderID, &a.StorageKey, &a.Filename, &a.ContentType, &a.Size, &a.CreatedAt)
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("delete attachment: %w", err)
+	}
+	return &a, nil
+}
+
 func (r *ReminderRepo) Delete(ctx context.Context, id uuid.UUID) error {
 	query, args, err := r.pg.Builder.
 		Delete("reminders").
@@ -252,9 +1028,17 @@ func (r *ReminderRepo) Delete(ctx context.Context, id uuid.UUID) error {
 		return fmt.Errorf("build query: %w", err)
 	}
 
-	_, err = r.pg.Pool.Exec(ctx, query, args...)
+	_, err = r.pg.DB(ctx).Exec(ctx, query, args...)
 	if err != nil {
 		return fmt.Errorf("delete reminder: %w", err)
 	}
 	return nil
 }
+
+// arrayOrEmpty заменяет nil пустым массивом: колонки channels и tags NOT NULL.
+func arrayOrEmpty(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/vovanwin/template/internal/model"
+	"github.com/vovanwin/template/internal/pkg/search"
+)
+
+// searchQuery — tsquery полнотекстового поиска: запрос в синтаксисе
+// веб-поиска («купить молоко», "точная фраза", -исключить, or) разбирается
+// с русской и английской морфологией. Принимает запрос дважды.
+const searchQuery = "(websearch_to_tsquery('russian', ?) || websearch_to_tsquery('english', ?))"
+
+// searchValue возвращает запрос полнотекстового фильтра; "" — поиска нет.
+func searchValue(filters []model.ActiveFilter) string {
+	for _, f := range filters {
+		if f.Type == model.FilterSearch {
+			return f.Value
+		}
+	}
+	return ""
+}
+
+// Highlight подсвечивает совпадения с запросом q в названии и описании
+// напоминаний ids (см. пакет search). Считается только для показанной
+// страницы: ts_headline заново разбирает текст и дорог на больших выборках.
+func (r *ReminderRepo) Highlight(ctx context.Context, ids []uuid.UUID, q string) (map[uuid.UUID]search.Highlight, error) {
+	if len(ids) == 0 || q == "" {
+		return nil, nil
+	}
+	query, args, err := r.pg.Builder.
+		Select("id").
+		Column(squirrel.Expr("ts_headline('russian', title, "+searchQuery+", ?)", q, q, search.TitleOptions)).
+		Column(squirrel.Expr("ts_headline('russian', coalesce(description, ''), "+searchQuery+", ?)", q, q, search.SnippetOptions)).
+		From("reminders").
+		Where(squirrel.Eq{"id": ids}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query: %w", err)
+	}
+
+	rows, err := r.pg.DB(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("highlight reminders: %w", err)
+	}
+	defer rows.Close()
+
+	result := make(map[uuid.UUID]search.Highlight, len(ids))
+	for rows.Next() {
+		var (
+			id uuid.UUID
+			h  search.Highlight
+		)
+		if err := rows.Scan(&id, &h.Title, &h.Snippet); err != nil {
+			return nil, fmt.Errorf("scan highlight: %w", err)
+		}
+		// Без совпадений ts_headline возвращает начало описания: его не показываем.
+		if !search.HasMatch(h.Snippet) {
+			h.Snippet = ""
+		}
+		result[id] = h
+	}
+	return result, rows.Err()
+}
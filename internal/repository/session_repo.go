@@ -17,6 +17,7 @@ type Session struct {
 	RefreshTokenHash string
 	IP               string
 	UserAgent        string
+	DPoPJKT          string
 	ExpiresAt        time.Time
 	CreatedAt        time.Time
 }
@@ -29,20 +30,20 @@ func NewSessionRepo(pg *postgres.Postgres) *SessionRepo {
 	return &SessionRepo{pg: pg}
 }
 
-func (r *SessionRepo) Create(ctx context.Context, userID uuid.UUID, refreshTokenHash, ip, userAgent string, expiresAt time.Time) (*Session, error) {
+func (r *SessionRepo) Create(ctx context.Context, userID uuid.UUID, refreshTokenHash, ip, userAgent, dpopJKT string, expiresAt time.Time) (*Session, error) {
 	query, args, err := r.pg.Builder.
 		Insert("sessions").
-		Columns("user_id", "refresh_token_hash", "ip", "user_agent", "expires_at").
-		Values(userID, refreshTokenHash, ip, userAgent, expiresAt).
-		Suffix("RETURNING id, user_id, refresh_token_hash, ip, user_agent, expires_at, created_at").
+		Columns("user_id", "refresh_token_hash", "ip", "user_agent", "dpop_jkt", "expires_at").
+		Values(userID, refreshTokenHash, ip, userAgent, dpopJKT, expiresAt).
+		Suffix("RETURNING id, user_id, refresh_token_hash, ip, user_agent, dpop_jkt, expires_at, created_at").
 		ToSql()
 	if err != nil {
 		return nil, fmt.Errorf("build query: %w", err)
 	}
 
 	var s Session
-	err = r.pg.Pool.QueryRow(ctx, query, args...).Scan(
-		&s.ID, &s.UserID, &s.RefreshTokenHash, &s.IP, &s.UserAgent, &s.ExpiresAt, &s.CreatedAt,
+	err = r.pg.DB(ctx).QueryRow(ctx, query, args...).Scan(
+		&s.ID, &s.UserID, &s.RefreshTokenHash, &s.IP, &s.UserAgent, &s.DPoPJKT, &s.ExpiresAt, &s.CreatedAt,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("insert session: %w", err)
@@ -52,7 +53,7 @@ func (r *SessionRepo) Create(ctx context.Context, userID uuid.UUID, refreshToken
 
 func (r *SessionRepo) GetByTokenHash(ctx context.Context, hash string) (*Session, error) {
 	query, args, err := r.pg.Builder.
-		Select("id", "user_id", "refresh_token_hash", "ip", "user_agent", "expires_at", "created_at").
+		Select("id", "user_id", "refresh_token_hash", "ip", "user_agent", "dpop_jkt", "expires_at", "created_at").
 		From("sessions").
 		Where(squirrel.Eq{"refresh_token_hash": hash}).
 		ToSql()
@@ -61,8 +62,8 @@ func (r *SessionRepo) GetByTokenHash(ctx context.Context, hash string) (*Session
 	}
 
 	var s Session
-	err = r.pg.Pool.QueryRow(ctx, query, args...).Scan(
-		&s.ID, &s.UserID, &s.RefreshTokenHash, &s.IP, &s.UserAgent, &s.ExpiresAt, &s.CreatedAt,
+	err = r.pg.DB(ctx).QueryRow(ctx, query, args...).Scan(
+		&s.ID, &s.UserID, &s.RefreshTokenHash, &s.IP, &s.UserAgent, &s.DPoPJKT, &s.ExpiresAt, &s.CreatedAt,
 	)
 	if err != nil {
 		if err == pgx.ErrNoRows {
@@ -75,7 +76,7 @@ func (r *SessionRepo) GetByTokenHash(ctx context.Context, hash string) (*Session
 
 func (r *SessionRepo) ListByUserID(ctx context.Context, userID uuid.UUID) ([]Session, error) {
 	query, args, err := r.pg.Builder.
-		Select("id", "user_id", "refresh_token_hash", "ip", "user_agent", "expires_at", "created_at").
+		Select("id", "user_id", "refresh_token_hash", "ip", "user_agent", "dpop_jkt", "expires_at", "created_at").
 		From("sessions").
 		Where(squirrel.Eq{"user_id": userID}).
 		OrderBy("created_at DESC").
@@ -84,7 +85,7 @@ func (r *SessionRepo) ListByUserID(ctx context.Context, userID uuid.UUID) ([]Ses
 		return nil, fmt.Errorf("build query: %w", err)
 	}
 
-	rows, err := r.pg.Pool.Query(ctx, query, args...)
+	rows, err := r.pg.DB(ctx).Query(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("list sessions: %w", err)
 	}
@@ -93,7 +94,7 @@ func (r *SessionRepo) ListByUserID(ctx context.Context, userID uuid.UUID) ([]Ses
 	var sessions []Session
 	for rows.Next() {
 		var s Session
-		if err := rows.Scan(&s.ID, &s.UserID, &s.RefreshTokenHash, &s.IP, &s.UserAgent, &s.ExpiresAt, &s.CreatedAt); err != nil {
+		if err := rows.Scan(&s.ID, &s.UserID, &s.RefreshTokenHash, &s.IP, &s.UserAgent, &s.DPoPJKT, &s.ExpiresAt, &s.CreatedAt); err != nil {
 			return nil, fmt.Errorf("scan session: %w", err)
 		}
 		sessions = append(sessions, s)
@@ -110,7 +111,7 @@ func (r *SessionRepo) Delete(ctx context.Context, sessionID uuid.UUID) error {
 		return fmt.Errorf("build query: %w", err)
 	}
 
-	_, err = r.pg.Pool.Exec(ctx, query, args...)
+	_, err = r.pg.DB(ctx).Exec(ctx, query, args...)
 	if err != nil {
 		return fmt.Errorf("delete session: %w", err)
 	}
@@ -126,7 +127,7 @@ func (r *SessionRepo) DeleteByTokenHash(ctx context.Context, hash string) error
 		return fmt.Errorf("build query: %w", err)
 	}
 
-	_, err = r.pg.Pool.Exec(ctx, query, args...)
+	_, err = r.pg.DB(ctx).Exec(ctx, query, args...)
 	if err != nil {
 		return fmt.Errorf("delete session by token hash: %w", err)
 	}
@@ -142,7 +143,7 @@ func (r *SessionRepo) DeleteAllByUserID(ctx context.Context, userID uuid.UUID) e
 		return fmt.Errorf("build query: %w", err)
 	}
 
-	_, err = r.pg.Pool.Exec(ctx, query, args...)
+	_, err = r.pg.DB(ctx).Exec(ctx, query, args...)
 	if err != nil {
 		return fmt.Errorf("delete all sessions: %w", err)
 	}
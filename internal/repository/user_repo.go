@@ -198,3 +198,252 @@ func (r *UserRepo) Update(ctx context.Context, id uuid.UUID, fields map[string]i
 	}
 	return &u, nil
 }
+
+// NotificationSettings — адреса и порядок каналов доставки пользователя.
+type NotificationSettings struct {
+	Email          string
+	TelegramChatID int64
+	Channels       []string
+	WebhookURL     string
+	// QuietHours — расписание тихих часов (quiet.Schedule.String()).
+	QuietHours string
+	// DNDUntil — до какого момента включён режим «не беспокоить»; nil — выключен.
+	DNDUntil *time.Time
+	// Timezone — таймзона IANA пользователя; пусто — не выбрана.
+	Timezone string
+	// TimezoneFollow — при смене таймзоны личные напоминания сохраняют
+	// местное время, а не момент.
+	TimezoneFollow bool
+	// DigestEnabled — присылать утреннюю сводку напоминаний.
+	DigestEnabled bool
+	// DigestTime — местное время сводки «ЧЧ:ММ».
+	DigestTime string
+}
+
+// GetNotificationSettings возвращает настройки доставки уведомлений.
+func (r *UserRepo) GetNotificationSettings(ctx context.Context, id uuid.UUID) (*NotificationSettings, error) {
+	query, args, err := r.pg.Builder.
+		Select("email", "COALESCE(telegram_chat_id, 0)", "notification_channels", "webhook_url", "quiet_hours", "dnd_until", "timezone", "timezone_follow",
+			"digest_enabled", "digest_time").
+		From("users").
+		Where(squirrel.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query: %w", err)
+	}
+
+	var ns NotificationSettings
+	err = r.pg.Pool.QueryRow(ctx, query, args...).Scan(&ns.Email, &ns.TelegramChatID, &ns.Channels, &ns.WebhookURL, &ns.QuietHours, &ns.DNDUntil, &ns.Timezone, &ns.TimezoneFollow,
+		&ns.DigestEnabled, &ns.DigestTime)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get notification settings: %w", err)
+	}
+	return &ns, nil
+}
+
+// UpdateNotificationSettings сохраняет порядок каналов и URL webhook.
+func (r *UserRepo) UpdateNotificationSettings(ctx context.Context, id uuid.UUID, channels []string, webhookURL string) error {
+	if channels == nil {
+		channels = []string{}
+	}
+	query, args, err := r.pg.Builder.
+		Update("users").
+		Set("notification_channels", channels).
+		Set("webhook_url", webhookURL).
+		Set("updated_at", squirrel.Expr("NOW()")).
+		Where(squirrel.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	if _, err := r.pg.Pool.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("update notification settings: %w", err)
+	}
+	return nil
+}
+
+// UpdateQuietHours сохраняет расписание тихих часов.
+func (r *UserRepo) UpdateQuietHours(ctx context.Context, id uuid.UUID, quietHours string) error {
+	query, args, err := r.pg.Builder.
+		Update("users").
+		Set("quiet_hours", quietHours).
+		Set("updated_at", squirrel.Expr("NOW()")).
+		Where(squirrel.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	if _, err := r.pg.Pool.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("update quiet hours: %w", err)
+	}
+	return nil
+}
+
+// SetDND включает режим «не беспокоить» до until; nil — выключает.
+func (r *UserRepo) SetDND(ctx context.Context, id uuid.UUID, until *time.Time) error {
+	query, args, err := r.pg.Builder.
+		Update("users").
+		Set("dnd_until", until).
+		Set("updated_at", squirrel.Expr("NOW()")).
+		Where(squirrel.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	if _, err := r.pg.Pool.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("set dnd: %w", err)
+	}
+	return nil
+}
+
+// UpdateTimezone сохраняет таймзону пользователя и поведение напоминаний при её смене.
+func (r *UserRepo) UpdateTimezone(ctx context.Context, id uuid.UUID, tz string, follow bool) error {
+	query, args, err := r.pg.Builder.
+		Update("users").
+		Set("timezone", tz).
+		Set("timezone_follow", follow).
+		Set("updated_at", squirrel.Expr("NOW()")).
+		Where(squirrel.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	if _, err := r.pg.DB(ctx).Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("update timezone: %w", err)
+	}
+	return nil
+}
+
+// UpdateDigest включает или выключает утреннюю сводку и задаёт её время.
+func (r *UserRepo) UpdateDigest(ctx context.Context, id uuid.UUID, enabled bool, at string) error {
+	query, args, err := r.pg.Builder.
+		Update("users").
+		Set("digest_enabled", enabled).
+		Set("digest_time", at).
+		Set("updated_at", squirrel.Expr("NOW()")).
+		Where(squirrel.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	if _, err := r.pg.DB(ctx).Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("update digest: %w", err)
+	}
+	return nil
+}
+
+// SetTimezoneFollow меняет поведение напоминаний при смене таймзоны.
+func (r *UserRepo) SetTimezoneFollow(ctx context.Context, id uuid.UUID, follow bool) error {
+	query, args, err := r.pg.Builder.
+		Update("users").
+		Set("timezone_follow", follow).
+		Set("updated_at", squirrel.Expr("NOW()")).
+		Where(squirrel.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	if _, err := r.pg.Pool.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("set timezone follow: %w", err)
+	}
+	return nil
+}
+
+// GetTimezone возвращает таймзону пользователя; пусто — не выбрана.
+func (r *UserRepo) GetTimezone(ctx context.Context, id uuid.UUID) (string, error) {
+	query, args, err := r.pg.Builder.
+		Select("timezone").
+		From("users").
+		Where(squirrel.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return "", fmt.Errorf("build query: %w", err)
+	}
+
+	var tz string
+	err = r.pg.Pool.QueryRow(ctx, query, args...).Scan(&tz)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return "", nil
+		}
+		return "", fmt.Errorf("get timezone: %w", err)
+	}
+	return tz, nil
+}
+
+// HasCalendarFeed сообщает, включена ли календарная лента пользователя.
+func (r *UserRepo) HasCalendarFeed(ctx context.Context, id uuid.UUID) (bool, error) {
+	query, args, err := r.pg.Builder.
+		Select("calendar_token_hash IS NOT NULL").
+		From("users").
+		Where(squirrel.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return false, fmt.Errorf("build query: %w", err)
+	}
+
+	var enabled bool
+	if err := r.pg.Pool.QueryRow(ctx, query, args...).Scan(&enabled); err != nil {
+		if err == pgx.ErrNoRows {
+			return false, nil
+		}
+		return false, fmt.Errorf("get calendar feed: %w", err)
+	}
+	return enabled, nil
+}
+
+// SetCalendarTokenHash сохраняет хэш токена календарной ленты; пустой хэш выключает ленту.
+func (r *UserRepo) SetCalendarTokenHash(ctx context.Context, id uuid.UUID, tokenHash string) error {
+	var value any
+	if tokenHash != "" {
+		value = tokenHash
+	}
+	query, args, err := r.pg.Builder.
+		Update("users").
+		Set("calendar_token_hash", value).
+		Set("updated_at", squirrel.Expr("NOW()")).
+		Where(squirrel.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	if _, err := r.pg.Pool.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("set calendar token: %w", err)
+	}
+	return nil
+}
+
+// GetByCalendarTokenHash находит пользователя по хэшу токена календарной ленты.
+func (r *UserRepo) GetByCalendarTokenHash(ctx context.Context, tokenHash string) (*User, error) {
+	query, args, err := r.pg.Builder.
+		Select("id", "email", "password_hash", "COALESCE(name, '')", "COALESCE(avatar_url, '')", "role", "is_active", "COALESCE(telegram_chat_id, 0)", "created_at", "updated_at").
+		From("users").
+		Where(squirrel.Eq{"calendar_token_hash": tokenHash}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query: %w", err)
+	}
+
+	var u User
+	err = r.pg.Pool.QueryRow(ctx, query, args...).Scan(
+		&u.ID, &u.Email, &u.PasswordHash, &u.FirstName, &u.AvatarURL,
+		&u.Role, &u.IsActive, &u.TelegramChatID, &u.CreatedAt, &u.UpdatedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get user by calendar token: %w", err)
+	}
+	return &u, nil
+}
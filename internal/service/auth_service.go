@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"log/slog"
 	"time"
@@ -11,10 +12,18 @@ import (
 	"github.com/google/uuid"
 	"github.com/vovanwin/template/internal/pkg/events"
 	"github.com/vovanwin/template/internal/pkg/jwt"
+	"github.com/vovanwin/template/internal/pkg/storage/postgres"
 	"github.com/vovanwin/template/internal/pkg/utils/hasher"
 	"github.com/vovanwin/template/internal/repository"
 )
 
+var (
+	// ErrDPoPProofRequired — сессия привязана к ключу, а клиент не прислал DPoP proof.
+	ErrDPoPProofRequired = errors.New("dpop proof is required for this session")
+	// ErrDPoPKeyMismatch — DPoP proof подписан не ключом сессии.
+	ErrDPoPKeyMismatch = errors.New("dpop key does not match session")
+)
+
 type AuthResult struct {
 	AccessToken  string
 	RefreshToken string
@@ -53,6 +62,7 @@ type AuthService struct {
 	sessionRepo *repository.SessionRepo
 	jwt         jwt.JWTService
 	bus         *events.Bus
+	tx          *postgres.TxManager
 	log         *slog.Logger
 }
 
@@ -61,6 +71,7 @@ func NewAuthService(
 	sessionRepo *repository.SessionRepo,
 	jwtService jwt.JWTService,
 	bus *events.Bus,
+	tx *postgres.TxManager,
 	log *slog.Logger,
 ) *AuthService {
 	return &AuthService{
@@ -68,6 +79,7 @@ func NewAuthService(
 		sessionRepo: sessionRepo,
 		jwt:         jwtService,
 		bus:         bus,
+		tx:          tx,
 		log:         log,
 	}
 }
@@ -97,7 +109,7 @@ func (s *AuthService) Register(ctx context.Context, email, password, name string
 	}
 
 	refreshHash := hashToken(tokens.RefreshToken)
-	_, err = s.sessionRepo.Create(ctx, user.ID, refreshHash, "", "", time.Now().Add(30*24*time.Hour))
+	_, err = s.sessionRepo.Create(ctx, user.ID, refreshHash, "", "", "", time.Now().Add(30*24*time.Hour))
 	if err != nil {
 		return nil, fmt.Errorf("create session: %w", err)
 	}
@@ -109,7 +121,9 @@ func (s *AuthService) Register(ctx context.Context, email, password, name string
 	}, nil
 }
 
-func (s *AuthService) Login(ctx context.Context, email, password, ip, userAgent string) (*AuthResult, error) {
+// Login аутентифицирует пользователя. Непустой dpopJKT привязывает сессию и токены
+// к ключу клиента: refresh будет возможен только с DPoP proof этим ключом.
+func (s *AuthService) Login(ctx context.Context, email, password, ip, userAgent, dpopJKT string) (*AuthResult, error) {
 	user, err := s.userRepo.GetByEmail(ctx, email)
 	if err != nil {
 		return nil, fmt.Errorf("get user: %w", err)
@@ -129,24 +143,28 @@ func (s *AuthService) Login(ctx context.Context, email, password, ip, userAgent
 		return nil, fmt.Errorf("invalid credentials")
 	}
 
-	tokens, err := s.jwt.GenerateTokenPair(user.ID.String(), user.Email)
+	tokens, err := s.jwt.GenerateBoundTokenPair(user.ID.String(), user.Email, dpopJKT)
 	if err != nil {
 		return nil, fmt.Errorf("generate tokens: %w", err)
 	}
 
 	refreshHash := hashToken(tokens.RefreshToken)
-	_, err = s.sessionRepo.Create(ctx, user.ID, refreshHash, ip, userAgent, time.Now().Add(30*24*time.Hour))
+	// Сессия и приветственное уведомление пишутся одной транзакцией.
+	err = s.tx.RunInTx(ctx, func(ctx context.Context) error {
+		_, err := s.sessionRepo.Create(ctx, user.ID, refreshHash, ip, userAgent, dpopJKT, time.Now().Add(30*24*time.Hour))
+		if err != nil {
+			return fmt.Errorf("create session: %w", err)
+		}
+		return s.bus.Publish(ctx, events.Event{
+			UserID:  user.ID.String(),
+			Message: fmt.Sprintf("👋 Привет, %s! Успешный вход в систему.", user.FirstName),
+			Type:    "success",
+		})
+	})
 	if err != nil {
-		return nil, fmt.Errorf("create session: %w", err)
+		return nil, err
 	}
 
-	// Отправляем уведомление пользователю
-	_ = s.bus.Publish(events.Event{
-		UserID:  user.ID.String(),
-		Message: fmt.Sprintf("👋 Привет, %s! Успешный вход в систему.", user.FirstName),
-		Type:    "success",
-	})
-
 	return &AuthResult{
 		AccessToken:  tokens.AccessToken,
 		RefreshToken: tokens.RefreshToken,
@@ -159,7 +177,10 @@ func (s *AuthService) Logout(ctx context.Context, refreshToken string) error {
 	return s.sessionRepo.DeleteByTokenHash(ctx, refreshHash)
 }
 
-func (s *AuthService) RefreshToken(ctx context.Context, refreshToken, ip, userAgent string) (*AuthResult, error) {
+// RefreshToken выпускает новую пару токенов. Для сессии, привязанной к ключу (DPoP),
+// dpopJKT должен совпадать с thumbprint ключа сессии. Сессия без привязки
+// привязывается к ключу, если клиент прислал proof.
+func (s *AuthService) RefreshToken(ctx context.Context, refreshToken, ip, userAgent, dpopJKT string) (*AuthResult, error) {
 	claims, err := s.jwt.ValidateToken(refreshToken)
 	if err != nil {
 		return nil, fmt.Errorf("invalid refresh token: %w", err)
@@ -177,6 +198,11 @@ func (s *AuthService) RefreshToken(ctx context.Context, refreshToken, ip, userAg
 		return nil, fmt.Errorf("session not found")
 	}
 
+	dpopJKT, err = sessionDPoPKey(session.DPoPJKT, dpopJKT)
+	if err != nil {
+		return nil, err
+	}
+
 	// Delete old session
 	if err := s.sessionRepo.DeleteByTokenHash(ctx, refreshHash); err != nil {
 		return nil, fmt.Errorf("delete old session: %w", err)
@@ -196,13 +222,13 @@ func (s *AuthService) RefreshToken(ctx context.Context, refreshToken, ip, userAg
 	}
 
 	// Generate new token pair
-	tokens, err := s.jwt.GenerateTokenPair(user.ID.String(), user.Email)
+	tokens, err := s.jwt.GenerateBoundTokenPair(user.ID.String(), user.Email, dpopJKT)
 	if err != nil {
 		return nil, fmt.Errorf("generate tokens: %w", err)
 	}
 
 	newRefreshHash := hashToken(tokens.RefreshToken)
-	_, err = s.sessionRepo.Create(ctx, user.ID, newRefreshHash, ip, userAgent, time.Now().Add(30*24*time.Hour))
+	_, err = s.sessionRepo.Create(ctx, user.ID, newRefreshHash, ip, userAgent, dpopJKT, time.Now().Add(30*24*time.Hour))
 	if err != nil {
 		return nil, fmt.Errorf("create new session: %w", err)
 	}
@@ -214,6 +240,23 @@ func (s *AuthService) RefreshToken(ctx context.Context, refreshToken, ip, userAg
 	}, nil
 }
 
+// sessionDPoPKey проверяет DPoP proof при обновлении сессии и возвращает
+// thumbprint ключа, к которому привязывается новая сессия. Привязанная сессия
+// требует proof тем же ключом; непривязанная привязывается к ключу proof,
+// а без proof остаётся непривязанной.
+func sessionDPoPKey(sessionJKT, proofJKT string) (string, error) {
+	if sessionJKT == "" {
+		return proofJKT, nil
+	}
+	if proofJKT == "" {
+		return "", ErrDPoPProofRequired
+	}
+	if proofJKT != sessionJKT {
+		return "", ErrDPoPKeyMismatch
+	}
+	return sessionJKT, nil
+}
+
 func (s *AuthService) GetProfile(ctx context.Context, userID uuid.UUID) (*Profile, error) {
 	user, err := s.userRepo.GetByID(ctx, userID)
 	if err != nil {
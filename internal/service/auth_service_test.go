@@ -0,0 +1,27 @@
+package service
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSessionDPoPKey(t *testing.T) {
+	for _, tt := range []struct {
+		name       string
+		sessionJKT string
+		proofJKT   string
+		want       string
+		wantErr    error
+	}{
+		{name: "unbound without proof"},
+		{name: "first-time binding", proofJKT: "key-a", want: "key-a"},
+		{name: "bound with the same key", sessionJKT: "key-a", proofJKT: "key-a", want: "key-a"},
+		{name: "bound without proof", sessionJKT: "key-a", wantErr: ErrDPoPProofRequired},
+		{name: "key mismatch", sessionJKT: "key-a", proofJKT: "key-b", wantErr: ErrDPoPKeyMismatch},
+	} {
+		got, err := sessionDPoPKey(tt.sessionJKT, tt.proofJKT)
+		if !errors.Is(err, tt.wantErr) || got != tt.want {
+			t.Errorf("%s: sessionDPoPKey = %q, %v; want %q, %v", tt.name, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
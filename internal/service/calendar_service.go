@@ -0,0 +1,175 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vovanwin/template/internal/model"
+	"github.com/vovanwin/template/internal/pkg/ical"
+	"github.com/vovanwin/template/internal/pkg/recurrence"
+	"github.com/vovanwin/template/internal/pkg/timezone"
+	"github.com/vovanwin/template/internal/repository"
+)
+
+const (
+	calendarProdID = "-//vovanwin//template reminders//RU"
+	// calendarEventDuration — длительность события напоминания в календаре.
+	calendarEventDuration = 15 * time.Minute
+	// calendarCronHorizon и calendarCronLimit ограничивают развёртку cron-правил,
+	// которые iCalendar не выражает: отдельные события на 60 дней вперёд, не больше 100.
+	calendarCronHorizon = 60 * 24 * time.Hour
+	calendarCronLimit   = 100
+)
+
+// ErrCalendarNotFound — токен ленты неизвестен или лента выключена.
+var ErrCalendarNotFound = errors.New("calendar feed not found")
+
+// CalendarService отдаёт напоминания пользователя лентой iCalendar по секретному токену.
+type CalendarService struct {
+	userRepo     *repository.UserRepo
+	reminderRepo *repository.ReminderRepo
+	log          *slog.Logger
+}
+
+func NewCalendarService(userRepo *repository.UserRepo, reminderRepo *repository.ReminderRepo, log *slog.Logger) *CalendarService {
+	return &CalendarService{userRepo: userRepo, reminderRepo: reminderRepo, log: log}
+}
+
+// FeedEnabled сообщает, включена ли лента пользователя. Сам токен хранится
+// только хэшем, поэтому ссылку можно показать лишь при выпуске токена.
+func (s *CalendarService) FeedEnabled(ctx context.Context, userID uuid.UUID) (bool, error) {
+	return s.userRepo.HasCalendarFeed(ctx, userID)
+}
+
+// RotateFeedToken выпускает новый токен; ссылка со старым перестаёт работать.
+// В БД сохраняется SHA-256 токена, как у refresh токенов сессий.
+func (s *CalendarService) RotateFeedToken(ctx context.Context, userID uuid.UUID) (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generate calendar token: %w", err)
+	}
+	token := base64.RawURLEncoding.EncodeToString(b)
+
+	if err := s.userRepo.SetCalendarTokenHash(ctx, userID, hashToken(token)); err != nil {
+		return "", err
+	}
+	s.log.Info("calendar token rotated", slog.String("user_id", userID.String()))
+	return token, nil
+}
+
+// DisableFeed выключает ленту пользователя.
+func (s *CalendarService) DisableFeed(ctx context.Context, userID uuid.UUID) error {
+	if err := s.userRepo.SetCalendarTokenHash(ctx, userID, ""); err != nil {
+		return err
+	}
+	s.log.Info("calendar feed disabled", slog.String("user_id", userID.String()))
+	return nil
+}
+
+// Feed возвращает ленту iCalendar с будущими и повторяющимися напоминаниями,
+// которые получает владелец токена.
+func (s *CalendarService) Feed(ctx context.Context, token string) ([]byte, error) {
+	if token == "" {
+		return nil, ErrCalendarNotFound
+	}
+	user, err := s.userRepo.GetByCalendarTokenHash(ctx, hashToken(token))
+	if err != nil {
+		return nil, err
+	}
+	if user == nil || !user.IsActive {
+		return nil, ErrCalendarNotFound
+	}
+
+	reminders, err := s.reminderRepo.ListForCalendar(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	tz, err := s.userRepo.GetTimezone(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	events := make([]ical.Event, 0, len(reminders))
+	for _, rem := range reminders {
+		events = append(events, calendarEvents(rem, now)...)
+	}
+
+	var buf bytes.Buffer
+	err = ical.Encode(&buf, ical.Calendar{
+		ProdID:   calendarProdID,
+		Name:     "Напоминания",
+		Location: timezone.Load(tz),
+		Events:   events,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("encode calendar: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// calendarEvents превращает напоминание в события календаря. RRULE передаётся
+// как есть, cron-правило разворачивается в отдельные события.
+func calendarEvents(rem repository.Reminder, now time.Time) []ical.Event {
+	event := ical.Event{
+		UID:         rem.ID.String() + "@reminders",
+		Summary:     rem.Title,
+		Description: rem.Description,
+		Start:       rem.RemindAt,
+		Duration:    calendarEventDuration,
+		Categories:  rem.Tags,
+		Priority:    calendarPriority(rem.Priority),
+		Alarm:       true,
+		Modified:    rem.UpdatedAt,
+	}
+	if !rem.IsRecurring() {
+		if rem.Status == model.ReminderStatusSnoozed.String() && rem.SnoozedUntil != nil {
+			event.Start = *rem.SnoozedUntil
+		}
+		return []ical.Event{event}
+	}
+
+	rule, err := recurrence.Parse(rem.RecurrenceRule)
+	if err != nil {
+		return nil
+	}
+	if !rule.IsCron() {
+		event.RRule = rule.String()
+		return []ical.Event{event}
+	}
+
+	var events []ical.Event
+	until := now.Add(calendarCronHorizon)
+	for after := now; len(events) < calendarCronLimit; {
+		next := rule.Next(rem.RemindAt, after, timezone.Load(rem.Timezone))
+		if next.IsZero() || next.After(until) {
+			break
+		}
+		e := event
+		e.UID = fmt.Sprintf("%s-%d@reminders", rem.ID, next.Unix())
+		e.Start = next
+		events = append(events, e)
+		after = next
+	}
+	return events
+}
+
+// calendarPriority переводит приоритет напоминания в PRIORITY iCalendar (1 — высший, 9 — низший).
+func calendarPriority(p model.ReminderPriority) int {
+	switch p {
+	case model.ReminderPriorityHigh:
+		return 1
+	case model.ReminderPriorityLow:
+		return 9
+	default:
+		return 0
+	}
+}
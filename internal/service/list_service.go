@@ -0,0 +1,137 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/vovanwin/template/internal/model"
+	"github.com/vovanwin/template/internal/repository"
+)
+
+// maxListNameLen — ограничение длины названия списка.
+const maxListNameLen = 100
+
+var (
+	// ErrInvalidList — некорректные данные списка или участника.
+	ErrInvalidList = errors.New("invalid list")
+	// ErrListNotFound — список не существует или пользователь в нём не состоит.
+	ErrListNotFound = errors.New("list not found")
+)
+
+// ListService управляет общими списками напоминаний и их участниками.
+// Участников добавляет и удаляет только владелец списка.
+type ListService struct {
+	repo     *repository.ListRepo
+	userRepo *repository.UserRepo
+	log      *slog.Logger
+}
+
+func NewListService(repo *repository.ListRepo, userRepo *repository.UserRepo, log *slog.Logger) *ListService {
+	return &ListService{repo: repo, userRepo: userRepo, log: log}
+}
+
+// CreateList создаёт список; создатель становится его владельцем.
+func (s *ListService) CreateList(ctx context.Context, userID uuid.UUID, name string) (*repository.ReminderList, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || len([]rune(name)) > maxListNameLen {
+		return nil, fmt.Errorf("%w: name must be 1..%d characters", ErrInvalidList, maxListNameLen)
+	}
+
+	list, err := s.repo.Create(ctx, userID, name)
+	if err != nil {
+		return nil, fmt.Errorf("create list: %w", err)
+	}
+	s.log.Info("reminder list created", slog.String("list_id", list.ID.String()), slog.String("user_id", userID.String()))
+	return list, nil
+}
+
+// ListLists возвращает списки, в которых состоит пользователь.
+func (s *ListService) ListLists(ctx context.Context, userID uuid.UUID) ([]repository.ReminderList, error) {
+	return s.repo.ListByMember(ctx, userID)
+}
+
+// ListMembers возвращает участников списка; доступно любому участнику.
+func (s *ListService) ListMembers(ctx context.Context, userID, listID uuid.UUID) ([]repository.ListMember, error) {
+	if _, err := s.memberRole(ctx, listID, userID); err != nil {
+		return nil, err
+	}
+	return s.repo.ListMembers(ctx, listID)
+}
+
+// AddMember добавляет пользователя в список по email или меняет его роль.
+// Роль владельца передать нельзя — у списка один владелец.
+func (s *ListService) AddMember(ctx context.Context, userID, listID uuid.UUID, email string, role model.ListRole) error {
+	if err := s.requireOwner(ctx, listID, userID); err != nil {
+		return err
+	}
+	if role == model.ListRoleOwner || !role.IsAListRole() {
+		return fmt.Errorf("%w: role must be viewer or editor", ErrInvalidList)
+	}
+
+	user, err := s.userRepo.GetByEmail(ctx, strings.TrimSpace(email))
+	if err != nil {
+		return fmt.Errorf("get user: %w", err)
+	}
+	if user == nil {
+		return fmt.Errorf("%w: user %s not found", ErrInvalidList, email)
+	}
+	if user.ID == userID {
+		return fmt.Errorf("%w: owner role cannot be changed", ErrInvalidList)
+	}
+
+	if err := s.repo.UpsertMember(ctx, listID, user.ID, role); err != nil {
+		return err
+	}
+	s.log.Info("list member added",
+		slog.String("list_id", listID.String()),
+		slog.String("member_id", user.ID.String()),
+		slog.String("role", role.String()),
+	)
+	return nil
+}
+
+// RemoveMember удаляет участника. Напоминания, назначенные ему, остаются в списке.
+func (s *ListService) RemoveMember(ctx context.Context, userID, listID, memberID uuid.UUID) error {
+	if err := s.requireOwner(ctx, listID, userID); err != nil {
+		return err
+	}
+	if memberID == userID {
+		return fmt.Errorf("%w: owner cannot leave the list", ErrInvalidList)
+	}
+	return s.repo.RemoveMember(ctx, listID, memberID)
+}
+
+// DeleteList удаляет список; напоминания остаются у создателей без списка.
+func (s *ListService) DeleteList(ctx context.Context, userID, listID uuid.UUID) error {
+	if err := s.requireOwner(ctx, listID, userID); err != nil {
+		return err
+	}
+	return s.repo.Delete(ctx, listID)
+}
+
+// memberRole возвращает роль пользователя или ErrListNotFound, если он не участник.
+func (s *ListService) memberRole(ctx context.Context, listID, userID uuid.UUID) (model.ListRole, error) {
+	role, ok, err := s.repo.MemberRole(ctx, listID, userID)
+	if err != nil {
+		return 0, err
+	}
+	if !ok {
+		return 0, ErrListNotFound
+	}
+	return role, nil
+}
+
+func (s *ListService) requireOwner(ctx context.Context, listID, userID uuid.UUID) error {
+	role, err := s.memberRole(ctx, listID, userID)
+	if err != nil {
+		return err
+	}
+	if role != model.ListRoleOwner {
+		return ErrActionNotAllowed
+	}
+	return nil
+}
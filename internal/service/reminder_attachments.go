@@ -0,0 +1,147 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/vovanwin/template/internal/repository"
+)
+
+const (
+	// MaxAttachmentSize — предельный размер вложения: столько Telegram
+	// принимает в sendPhoto, документы до 50 МБ укладываются с запасом.
+	MaxAttachmentSize = 10 << 20
+	// MaxAttachments — сколько вложений можно приложить к одному напоминанию.
+	MaxAttachments = 5
+)
+
+// AttachmentUpload — файл, который прикладывается к напоминанию.
+type AttachmentUpload struct {
+	Filename string
+	// ContentType — MIME-тип; пусто — по расширению имени файла.
+	ContentType string
+	Size        int64
+	Data        io.Reader
+}
+
+// validateUploads проверяет число, размер и имена вложений и дополняет
+// пустые имена и типы.
+func validateUploads(uploads []AttachmentUpload, existing int) error {
+	if existing+len(uploads) > MaxAttachments {
+		return fmt.Errorf("%w: at most %d attachments", ErrInvalidReminder, MaxAttachments)
+	}
+	for i := range uploads {
+		up := &uploads[i]
+		if up.Size <= 0 {
+			return fmt.Errorf("%w: attachment %q is empty", ErrInvalidReminder, up.Filename)
+		}
+		if up.Size > MaxAttachmentSize {
+			return fmt.Errorf("%w: attachment %q is larger than %d MB", ErrInvalidReminder, up.Filename, MaxAttachmentSize>>20)
+		}
+		up.Filename = strings.TrimSpace(filepath.Base(filepath.ToSlash(up.Filename)))
+		if up.Filename == "" || up.Filename == "." || up.Filename == "/" {
+			up.Filename = "file"
+		}
+		if up.ContentType == "" {
+			up.ContentType = mime.TypeByExtension(strings.ToLower(filepath.Ext(up.Filename)))
+		}
+		if up.ContentType == "" {
+			up.ContentType = "application/octet-stream"
+		}
+	}
+	return nil
+}
+
+// storeAttachment кладёт файл в хранилище и записывает вложение в БД;
+// если запись не удалась, файл удаляется.
+func (s *ReminderService) storeAttachment(ctx context.Context, reminderID uuid.UUID, up AttachmentUpload) (*repository.Attachment, error) {
+	a := &repository.Attachment{
+		ReminderID:  reminderID,
+		StorageKey:  fmt.Sprintf("reminders/%s/%s", reminderID, uuid.New()),
+		Filename:    up.Filename,
+		ContentType: up.ContentType,
+		Size:        up.Size,
+	}
+	if err := s.blobs.Put(ctx, a.StorageKey, a.ContentType, io.LimitReader(up.Data, up.Size), up.Size); err != nil {
+		return nil, fmt.Errorf("store attachment: %w", err)
+	}
+	if err := s.repo.CreateAttachment(ctx, a); err != nil {
+		s.deleteBlobs(ctx, a.StorageKey)
+		return nil, err
+	}
+	return a, nil
+}
+
+// deleteBlobs удаляет файлы вложений. Оставшийся файл только занимает место,
+// поэтому ошибка не прерывает операцию, а пишется в лог.
+func (s *ReminderService) deleteBlobs(ctx context.Context, keys ...string) {
+	for _, key := range keys {
+		if err := s.blobs.Delete(ctx, key); err != nil {
+			s.log.Warn("failed to delete attachment blob", slog.Any("err", err), slog.String("key", key))
+		}
+	}
+}
+
+// AddAttachment прикладывает файл к напоминанию.
+func (s *ReminderService) AddAttachment(ctx context.Context, userID, reminderID uuid.UUID, up AttachmentUpload) (*repository.Attachment, error) {
+	if _, err := s.authorize(ctx, userID, reminderID, accessEdit); err != nil {
+		return nil, err
+	}
+	existing, err := s.repo.ListAttachments(ctx, reminderID)
+	if err != nil {
+		return nil, err
+	}
+	uploads := []AttachmentUpload{up}
+	if err := validateUploads(uploads, len(existing)); err != nil {
+		return nil, err
+	}
+	return s.storeAttachment(ctx, reminderID, uploads[0])
+}
+
+// ListAttachments возвращает вложения напоминания, доступного пользователю.
+func (s *ReminderService) ListAttachments(ctx context.Context, userID, reminderID uuid.UUID) ([]repository.Attachment, error) {
+	if _, err := s.authorize(ctx, userID, reminderID, accessView); err != nil {
+		return nil, err
+	}
+	return s.repo.ListAttachments(ctx, reminderID)
+}
+
+// OpenAttachment открывает файл вложения на чтение; закрыть его должен вызывающий.
+func (s *ReminderService) OpenAttachment(ctx context.Context, userID, reminderID, attachmentID uuid.UUID) (*repository.Attachment, io.ReadCloser, error) {
+	attachments, err := s.ListAttachments(ctx, userID, reminderID)
+	if err != nil {
+		return nil, nil, err
+	}
+	for i := range attachments {
+		if a := &attachments[i]; a.ID == attachmentID {
+			data, err := s.blobs.Get(ctx, a.StorageKey)
+			if err != nil {
+				return nil, nil, fmt.Errorf("open attachment: %w", err)
+			}
+			return a, data, nil
+		}
+	}
+	return nil, nil, ErrReminderNotFound
+}
+
+// DeleteAttachment удаляет вложение напоминания вместе с файлом.
+func (s *ReminderService) DeleteAttachment(ctx context.Context, userID, reminderID, attachmentID uuid.UUID) error {
+	if _, err := s.authorize(ctx, userID, reminderID, accessEdit); err != nil {
+		return err
+	}
+	a, err := s.repo.DeleteAttachment(ctx, reminderID, attachmentID)
+	if err != nil {
+		return err
+	}
+	if a == nil {
+		return ErrReminderNotFound
+	}
+	s.deleteBlobs(ctx, a.StorageKey)
+	return nil
+}
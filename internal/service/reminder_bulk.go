@@ -0,0 +1,247 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vovanwin/template/internal/model"
+	"github.com/vovanwin/template/internal/pkg/nldate"
+	"github.com/vovanwin/template/internal/pkg/tags"
+)
+
+const (
+	// MaxBulkReminders — сколько напоминаний можно изменить одним массовым действием.
+	MaxBulkReminders = 500
+	// bulkParallelism — сколько напоминаний обрабатывается одновременно:
+	// каждое действие ходит в БД и Temporal, и без ограничения выбор
+	// «всех по фильтру» выедал бы пул соединений.
+	bulkParallelism = 8
+)
+
+// ErrTooManyReminders — под массовое действие попало больше MaxBulkReminders напоминаний.
+var ErrTooManyReminders = errors.New("too many reminders")
+
+// BulkFailure — напоминание, которое массовое действие не смогло изменить.
+type BulkFailure struct {
+	ReminderID uuid.UUID
+	// Title — заголовок напоминания; пустой, если напоминание не найдено.
+	Title string
+	Err   error
+}
+
+// BulkResult — итог массового действия: сколько напоминаний выбрано,
+// сколько изменено и почему не изменены остальные.
+type BulkResult struct {
+	Total     int
+	Succeeded int
+	Failures  []BulkFailure
+}
+
+// BulkTarget — какие напоминания затрагивает массовое действие:
+// явно выбранные IDs или, при All, все доступные пользователю по Filters.
+type BulkTarget struct {
+	IDs     []uuid.UUID
+	All     bool
+	Filters []model.ActiveFilter
+}
+
+// resolveBulkTarget возвращает ID напоминаний действия без повторов.
+func (s *ReminderService) resolveBulkTarget(ctx context.Context, userID uuid.UUID, target BulkTarget) ([]uuid.UUID, error) {
+	ids := target.IDs
+	if target.All {
+		var err error
+		// Берём на одно больше лимита, чтобы отличить «ровно лимит» от «больше».
+		ids, err = s.repo.ListIDsByFilter(ctx, userID, target.Filters, MaxBulkReminders+1)
+		if err != nil {
+			return nil, err
+		}
+	}
+	seen := make(map[uuid.UUID]bool, len(ids))
+	unique := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	if len(unique) > MaxBulkReminders {
+		return nil, fmt.Errorf("%w: at most %d per action", ErrTooManyReminders, MaxBulkReminders)
+	}
+	return unique, nil
+}
+
+// runBulk применяет action к каждому напоминанию не более чем в bulkParallelism
+// горутин. Ошибка одного напоминания не останавливает остальные — она попадает
+// в Failures в порядке исходного списка.
+func (s *ReminderService) runBulk(ctx context.Context, userID uuid.UUID, target BulkTarget, name string, action func(ctx context.Context, id uuid.UUID) error) (*BulkResult, error) {
+	ids, err := s.resolveBulkTarget(ctx, userID, target)
+	if err != nil {
+		return nil, err
+	}
+
+	errs := make([]error, len(ids))
+	sem := make(chan struct{}, bulkParallelism)
+	var wg sync.WaitGroup
+	for i, id := range ids {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			errs[i] = action(ctx, id)
+		}()
+	}
+	wg.Wait()
+
+	result := &BulkResult{Total: len(ids)}
+	for i, id := range ids {
+		if errs[i] == nil {
+			result.Succeeded++
+			continue
+		}
+		failure := BulkFailure{ReminderID: id, Err: errs[i]}
+		if rem, err := s.repo.GetByID(ctx, id); err == nil && rem != nil {
+			failure.Title = rem.Title
+		}
+		result.Failures = append(result.Failures, failure)
+	}
+
+	s.log.Info("bulk reminder action",
+		slog.String("action", name),
+		slog.String("user_id", userID.String()),
+		slog.Int("total", result.Total),
+		slog.Int("failed", len(result.Failures)),
+	)
+	return result, nil
+}
+
+// BulkCancel отменяет напоминания, как CancelReminder каждое.
+func (s *ReminderService) BulkCancel(ctx context.Context, userID uuid.UUID, target BulkTarget) (*BulkResult, error) {
+	return s.runBulk(ctx, userID, target, "cancel", func(ctx context.Context, id uuid.UUID) error {
+		return s.CancelReminder(ctx, userID, id)
+	})
+}
+
+// BulkDelete удаляет напоминания, как DeleteReminder каждое.
+func (s *ReminderService) BulkDelete(ctx context.Context, userID uuid.UUID, target BulkTarget) (*BulkResult, error) {
+	return s.runBulk(ctx, userID, target, "delete", func(ctx context.Context, id uuid.UUID) error {
+		return s.DeleteReminder(ctx, userID, id)
+	})
+}
+
+// BulkRetag меняет теги напоминаний. raw — теги через запятую или пробел:
+// «работа -дом» добавляет тег «работа» и снимает «дом».
+func (s *ReminderService) BulkRetag(ctx context.Context, userID uuid.UUID, target BulkTarget, raw string) (*BulkResult, error) {
+	var add, remove []string
+	for _, token := range strings.FieldsFunc(raw, func(r rune) bool { return r == ',' || r == ' ' }) {
+		if tag, ok := strings.CutPrefix(token, "-"); ok {
+			remove = append(remove, tag)
+		} else {
+			add = append(add, token)
+		}
+	}
+	add, err := tags.Normalize(add)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidReminder, err)
+	}
+	if remove, err = tags.Normalize(remove); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidReminder, err)
+	}
+	if len(add) == 0 && len(remove) == 0 {
+		return nil, fmt.Errorf("%w: no tags to add or remove", ErrInvalidReminder)
+	}
+
+	return s.runBulk(ctx, userID, target, "retag", func(ctx context.Context, id uuid.UUID) error {
+		rem, err := s.authorize(ctx, userID, id, accessEdit)
+		if err != nil {
+			return err
+		}
+		updated := slices.DeleteFunc(slices.Clone(rem.Tags), func(tag string) bool {
+			return slices.Contains(remove, tag)
+		})
+		updated, err = tags.Normalize(append(updated, add...))
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidReminder, err)
+		}
+		if slices.Equal(updated, rem.Tags) {
+			return nil
+		}
+		return s.repo.UpdateTags(ctx, id, updated)
+	})
+}
+
+// BulkReschedule переносит напоминания. when — сдвиг относительно текущего
+// времени каждого напоминания («+1h», «-30m», «+2d») или новое время
+// на естественном языке («завтра в 9:00») в таймзоне пользователя.
+// Перенос идёт через UpdateReminder: ожидающие workflow получают Temporal Update.
+func (s *ReminderService) BulkReschedule(ctx context.Context, userID uuid.UUID, target BulkTarget, when string) (*BulkResult, error) {
+	when = strings.TrimSpace(when)
+	shift, isShift, err := parseShift(when)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidReminder, err)
+	}
+	var at time.Time
+	if !isShift {
+		loc, err := s.Location(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		res, err := nldate.Parse(when, time.Now(), loc)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidReminder, err)
+		}
+		if res.Rule != "" {
+			return nil, fmt.Errorf("%w: recurrence is not allowed here, use «Изменить повтор»", ErrInvalidReminder)
+		}
+		at = res.At
+	}
+
+	return s.runBulk(ctx, userID, target, "reschedule", func(ctx context.Context, id uuid.UUID) error {
+		rem, err := s.authorize(ctx, userID, id, accessEdit)
+		if err != nil {
+			return err
+		}
+		remindAt := at
+		if isShift {
+			remindAt = rem.RemindAt.Add(shift)
+		}
+		_, err = s.UpdateReminder(ctx, userID, id, UpdateReminderInput{
+			Title:       rem.Title,
+			Description: rem.Description,
+			RemindAt:    &remindAt,
+		})
+		return err
+	})
+}
+
+// parseShift разбирает сдвиг «+1h30m», «-2d»; isShift=false — when не сдвиг.
+// Дни указываются только целым числом без других единиц.
+func parseShift(when string) (d time.Duration, isShift bool, err error) {
+	if !strings.HasPrefix(when, "+") && !strings.HasPrefix(when, "-") {
+		return 0, false, nil
+	}
+	sign, value := time.Duration(1), when[1:]
+	if when[0] == '-' {
+		sign = -1
+	}
+	if days, ok := strings.CutSuffix(value, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil || n <= 0 {
+			return 0, true, fmt.Errorf("invalid shift %q", when)
+		}
+		return sign * time.Duration(n) * 24 * time.Hour, true, nil
+	}
+	d, err = time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		return 0, true, fmt.Errorf("invalid shift %q", when)
+	}
+	return sign * d, true, nil
+}
@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestParseShift(t *testing.T) {
+	for _, tt := range []struct {
+		when    string
+		want    time.Duration
+		isShift bool
+		wantErr bool
+	}{
+		{when: "+1h30m", want: 90 * time.Minute, isShift: true},
+		{when: "-2d", want: -48 * time.Hour, isShift: true},
+		{when: "+3d", want: 72 * time.Hour, isShift: true},
+		{when: "завтра 10:00"},
+		{when: ""},
+		{when: "+0h", isShift: true, wantErr: true},
+		{when: "-0d", isShift: true, wantErr: true},
+		{when: "+1d2h", isShift: true, wantErr: true},
+		{when: "+", isShift: true, wantErr: true},
+		{when: "-d", isShift: true, wantErr: true},
+		{when: "+-1h", isShift: true, wantErr: true},
+	} {
+		d, isShift, err := parseShift(tt.when)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parseShift(%q) error = %v, wantErr %v", tt.when, err, tt.wantErr)
+			continue
+		}
+		if d != tt.want || isShift != tt.isShift {
+			t.Errorf("parseShift(%q) = %v, %v; want %v, %v", tt.when, d, isShift, tt.want, tt.isShift)
+		}
+	}
+}
+
+func TestResolveBulkTarget(t *testing.T) {
+	s := &ReminderService{}
+	ctx := context.Background()
+
+	a, b := uuid.New(), uuid.New()
+	ids, err := s.resolveBulkTarget(ctx, uuid.New(), BulkTarget{IDs: []uuid.UUID{a, b, a, b, a}})
+	if err != nil {
+		t.Fatalf("resolveBulkTarget: %v", err)
+	}
+	if len(ids) != 2 || ids[0] != a || ids[1] != b {
+		t.Errorf("ids = %v, want [%s %s]", ids, a, b)
+	}
+
+	// Ровно лимит с повторами проходит: повторы не считаются.
+	limit := make([]uuid.UUID, 0, MaxBulkReminders+1)
+	for range MaxBulkReminders {
+		limit = append(limit, uuid.New())
+	}
+	limit = append(limit, limit[0])
+	if ids, err := s.resolveBulkTarget(ctx, uuid.New(), BulkTarget{IDs: limit}); err != nil || len(ids) != MaxBulkReminders {
+		t.Errorf("resolveBulkTarget(%d ids) = %d, %v; want %d", MaxBulkReminders, len(ids), err, MaxBulkReminders)
+	}
+
+	over := append(limit[:MaxBulkReminders:MaxBulkReminders], uuid.New())
+	if _, err := s.resolveBulkTarget(ctx, uuid.New(), BulkTarget{IDs: over}); !errors.Is(err, ErrTooManyReminders) {
+		t.Errorf("resolveBulkTarget(%d ids) error = %v, want ErrTooManyReminders", len(over), err)
+	}
+}
@@ -0,0 +1,162 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/vovanwin/template/internal/model"
+	"github.com/vovanwin/template/internal/repository"
+	reminderv1 "github.com/vovanwin/template/pkg/temporal/reminder"
+	"go.temporal.io/api/serviceerror"
+)
+
+const (
+	// MaxChecklistItems — сколько пунктов может быть в чек-листе: каждый
+	// становится кнопкой под уведомлением в Telegram.
+	MaxChecklistItems = 20
+	// MaxChecklistItemLen — максимальная длина пункта в символах.
+	MaxChecklistItemLen = 100
+)
+
+// ChecklistToggle — чек-лист после отметки пункта.
+type ChecklistToggle struct {
+	ReminderID uuid.UUID
+	Items      []repository.ChecklistItem
+	// Acknowledged — отмечен последний пункт, и напоминание подтверждено.
+	Acknowledged bool
+}
+
+// checklistTexts убирает лишние пробелы и пустые пункты и проверяет число
+// и длину пунктов; existing — сколько пунктов уже есть.
+func checklistTexts(items []string, existing int) ([]string, error) {
+	texts := make([]string, 0, len(items))
+	for _, item := range items {
+		text := strings.Join(strings.Fields(item), " ")
+		if text == "" {
+			continue
+		}
+		if utf8.RuneCountInString(text) > MaxChecklistItemLen {
+			return nil, fmt.Errorf("%w: checklist item is longer than %d characters", ErrInvalidReminder, MaxChecklistItemLen)
+		}
+		texts = append(texts, text)
+	}
+	if existing+len(texts) > MaxChecklistItems {
+		return nil, fmt.Errorf("%w: at most %d checklist items", ErrInvalidReminder, MaxChecklistItems)
+	}
+	return texts, nil
+}
+
+// ListChecklist возвращает чек-лист напоминания, доступного пользователю.
+func (s *ReminderService) ListChecklist(ctx context.Context, userID, reminderID uuid.UUID) ([]repository.ChecklistItem, error) {
+	if _, err := s.authorize(ctx, userID, reminderID, accessView); err != nil {
+		return nil, err
+	}
+	return s.repo.ListChecklist(ctx, reminderID)
+}
+
+// AddChecklistItem добавляет пункт в конец чек-листа напоминания.
+func (s *ReminderService) AddChecklistItem(ctx context.Context, userID, reminderID uuid.UUID, text string) error {
+	if _, err := s.authorize(ctx, userID, reminderID, accessEdit); err != nil {
+		return err
+	}
+	items, err := s.repo.ListChecklist(ctx, reminderID)
+	if err != nil {
+		return err
+	}
+	texts, err := checklistTexts([]string{text}, len(items))
+	if err != nil {
+		return err
+	}
+	if len(texts) == 0 {
+		return fmt.Errorf("%w: checklist item is empty", ErrInvalidReminder)
+	}
+	return s.repo.AddChecklistItems(ctx, reminderID, texts)
+}
+
+// DeleteChecklistItem удаляет пункт чек-листа напоминания.
+func (s *ReminderService) DeleteChecklistItem(ctx context.Context, userID, reminderID, itemID uuid.UUID) error {
+	if _, err := s.authorize(ctx, userID, reminderID, accessEdit); err != nil {
+		return err
+	}
+	item, err := s.repo.DeleteChecklistItem(ctx, reminderID, itemID)
+	if err != nil {
+		return err
+	}
+	if item == nil {
+		return ErrReminderNotFound
+	}
+	return nil
+}
+
+// ToggleChecklistItem отмечает пункт выполненным или снимает отметку. Отметка
+// последнего пункта подтверждает напоминание, как кнопка «Подтвердить»;
+// отмечать пункты может тот, кто подтверждает напоминание.
+func (s *ReminderService) ToggleChecklistItem(ctx context.Context, userID, itemID uuid.UUID) (*ChecklistToggle, error) {
+	item, err := s.repo.GetChecklistItem(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+	if item == nil {
+		return nil, ErrReminderNotFound
+	}
+	rem, err := s.authorize(ctx, userID, item.ReminderID, accessRespond)
+	if err != nil {
+		return nil, err
+	}
+
+	changed, err := s.repo.SetChecklistItemDone(ctx, itemID, !item.Done, userID)
+	if err != nil {
+		return nil, err
+	}
+	items, err := s.repo.ListChecklist(ctx, item.ReminderID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ChecklistToggle{ReminderID: item.ReminderID, Items: items}
+	if changed && !item.Done && checklistDone(items) {
+		// Отметка уже сохранена: неудачное подтверждение её не отменяет.
+		if result.Acknowledged, err = s.acknowledgeChecklist(ctx, rem); err != nil {
+			s.log.Warn("failed to acknowledge completed checklist", slog.Any("err", err), slog.String("reminder_id", rem.ID.String()))
+		}
+	}
+	return result, nil
+}
+
+// checklistDone сообщает, что все пункты чек-листа отмечены.
+func checklistDone(items []repository.ChecklistItem) bool {
+	for _, item := range items {
+		if !item.Done {
+			return false
+		}
+	}
+	return len(items) > 0
+}
+
+// acknowledgeChecklist подтверждает напоминание с выполненным чек-листом.
+// Если уведомление ещё не отправлено, сигнал дождётся отправки и подтвердит
+// напоминание сразу после неё. Завершённому workflow подтверждать нечего.
+func (s *ReminderService) acknowledgeChecklist(ctx context.Context, rem *repository.Reminder) (bool, error) {
+	switch rem.Status {
+	case model.ReminderStatusCancelled.String(), model.ReminderStatusFailed.String(), model.ReminderStatusExpired.String():
+		return false, nil
+	}
+	if rem.WorkflowID == "" {
+		return false, nil
+	}
+
+	err := s.temporal.GetClient().GetClient().SignalWorkflow(ctx, rem.WorkflowID, "", reminderv1.AcknowledgeReminderSignalName, nil)
+	var notFound *serviceerror.NotFound
+	if errors.As(err, &notFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("acknowledge workflow: %w", err)
+	}
+	return true, nil
+}
@@ -0,0 +1,59 @@
+package service
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/vovanwin/template/internal/repository"
+)
+
+func TestChecklistTexts(t *testing.T) {
+	texts, err := checklistTexts([]string{"  купить\tмолоко ", "", "   ", "позвонить\n маме"}, 0)
+	if err != nil {
+		t.Fatalf("checklistTexts: %v", err)
+	}
+	if want := []string{"купить молоко", "позвонить маме"}; !slices.Equal(texts, want) {
+		t.Errorf("texts = %q, want %q", texts, want)
+	}
+
+	long := strings.Repeat("я", MaxChecklistItemLen)
+	if texts, err := checklistTexts([]string{long}, 0); err != nil || len(texts) != 1 {
+		t.Errorf("checklistTexts(%d runes) = %q, %v; want one item", MaxChecklistItemLen, texts, err)
+	}
+	if _, err := checklistTexts([]string{long + "я"}, 0); !errors.Is(err, ErrInvalidReminder) {
+		t.Errorf("checklistTexts(%d runes) error = %v, want ErrInvalidReminder", MaxChecklistItemLen+1, err)
+	}
+
+	items := make([]string, MaxChecklistItems)
+	for i := range items {
+		items[i] = "пункт"
+	}
+	if texts, err := checklistTexts(append(items, " "), 0); err != nil || len(texts) != MaxChecklistItems {
+		t.Errorf("checklistTexts(%d items) = %d, %v; want %d", MaxChecklistItems, len(texts), err, MaxChecklistItems)
+	}
+	if _, err := checklistTexts(items, 1); !errors.Is(err, ErrInvalidReminder) {
+		t.Errorf("checklistTexts over limit error = %v, want ErrInvalidReminder", err)
+	}
+	if _, err := checklistTexts([]string{"пункт"}, MaxChecklistItems); !errors.Is(err, ErrInvalidReminder) {
+		t.Errorf("checklistTexts to full checklist error = %v, want ErrInvalidReminder", err)
+	}
+}
+
+func TestChecklistDone(t *testing.T) {
+	for _, tt := range []struct {
+		name  string
+		items []repository.ChecklistItem
+		want  bool
+	}{
+		{name: "empty"},
+		{name: "none done", items: []repository.ChecklistItem{{}, {}}},
+		{name: "partly done", items: []repository.ChecklistItem{{Done: true}, {}}},
+		{name: "all done", items: []repository.ChecklistItem{{Done: true}, {Done: true}}, want: true},
+	} {
+		if got := checklistDone(tt.items); got != tt.want {
+			t.Errorf("%s: checklistDone = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/vovanwin/template/internal/pkg/digest"
+	"github.com/vovanwin/template/internal/pkg/outbox"
+	"github.com/vovanwin/template/internal/pkg/timezone"
+	reminderv1 "github.com/vovanwin/template/pkg/temporal/reminder"
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/client"
+	sdktemporal "go.temporal.io/sdk/temporal"
+)
+
+// syncDigestMessage — привести расписание сводки пользователя к его настройкам.
+type syncDigestMessage struct {
+	UserID uuid.UUID `json:"user_id"`
+}
+
+// UpdateDigest включает или выключает утреннюю сводку и задаёт её местное
+// время «ЧЧ:ММ». Расписание Temporal обновляется через outbox.
+func (s *ReminderService) UpdateDigest(ctx context.Context, userID uuid.UUID, enabled bool, at string) error {
+	clock, err := digest.ParseClock(at)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidReminder, err)
+	}
+	return s.tx.RunInTx(ctx, func(ctx context.Context) error {
+		if err := s.userRepo.UpdateDigest(ctx, userID, enabled, clock.String()); err != nil {
+			return err
+		}
+		return s.enqueueDigestSync(ctx, userID)
+	})
+}
+
+// enqueueDigestSync ставит в outbox синхронизацию расписания сводки. Сообщения
+// одного пользователя доставляются по порядку, обработчик читает настройки из БД.
+func (s *ReminderService) enqueueDigestSync(ctx context.Context, userID uuid.UUID) error {
+	msg, err := outbox.New(OutboxSyncDigest, digestOutboxKey(userID), syncDigestMessage{UserID: userID})
+	if err != nil {
+		return err
+	}
+	return s.outbox.Add(ctx, msg)
+}
+
+// handleSyncDigestMessage создаёт, обновляет или удаляет расписание сводки
+// по текущим настройкам пользователя.
+func (s *ReminderService) handleSyncDigestMessage(ctx context.Context, msg outbox.Message) error {
+	var m syncDigestMessage
+	if err := msg.Decode(&m); err != nil {
+		return err
+	}
+
+	settings, err := s.userRepo.GetNotificationSettings(ctx, m.UserID)
+	if err != nil {
+		return fmt.Errorf("get notification settings: %w", err)
+	}
+	scheduleID := digestScheduleID(m.UserID)
+	if settings == nil || !settings.DigestEnabled {
+		err := s.temporal.GetClient().GetSchedule(ctx, scheduleID).Delete(ctx)
+		var notFound *serviceerror.NotFound
+		if err != nil && !errors.As(err, &notFound) {
+			return fmt.Errorf("delete digest schedule: %w", err)
+		}
+		return nil
+	}
+
+	clock, err := digest.ParseClock(settings.DigestTime)
+	if err != nil {
+		return outbox.Permanent(err)
+	}
+	spec := digest.Spec(clock, timezone.Load(settings.Timezone))
+
+	_, err = s.temporal.GetClient().ScheduleWorkflow(ctx, scheduleID, spec, &client.ScheduleWorkflowAction{
+		// Temporal добавляет к ID время запуска: digest/<user_id>-<timestamp>
+		ID:        fmt.Sprintf("digest/%s", m.UserID.String()),
+		Workflow:  reminderv1.DailyDigestWorkflowName,
+		Args:      []interface{}{&reminderv1.DailyDigestRequest{UserId: m.UserID.String()}},
+		TaskQueue: reminderv1.ReminderTaskQueue,
+	}, client.ScheduleOptions{
+		// Сводка, не успевшая уйти до следующей, устарела.
+		Overlap: enums.SCHEDULE_OVERLAP_POLICY_SKIP,
+	})
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, sdktemporal.ErrScheduleAlreadyRunning) {
+		return fmt.Errorf("create digest schedule: %w", err)
+	}
+
+	// Расписание уже есть: меняем только время и таймзону.
+	err = s.temporal.GetClient().GetSchedule(ctx, scheduleID).Update(ctx, client.ScheduleUpdateOptions{
+		DoUpdate: func(in client.ScheduleUpdateInput) (*client.ScheduleUpdate, error) {
+			schedule := in.Description.Schedule
+			schedule.Spec = &spec
+			return &client.ScheduleUpdate{Schedule: &schedule}, nil
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("update digest schedule: %w", err)
+	}
+	return nil
+}
+
+// digestScheduleID — ID расписания сводки пользователя.
+func digestScheduleID(userID uuid.UUID) string {
+	return "digest-schedule/" + userID.String()
+}
+
+// digestOutboxKey — ключ сообщений outbox сводки пользователя.
+func digestOutboxKey(userID uuid.UUID) string {
+	return "digest/" + userID.String()
+}
@@ -0,0 +1,89 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vovanwin/template/internal/pkg/importer"
+	"github.com/vovanwin/template/internal/repository"
+)
+
+// ImportResult — итог импорта: все строки файла с ошибками и число созданных напоминаний.
+type ImportResult struct {
+	Rows    []importer.Row
+	Created int
+}
+
+// PreviewImport разбирает файл и проверяет строки, ничего не создавая.
+// Время без зоны читается в таймзоне пользователя.
+func (s *ReminderService) PreviewImport(ctx context.Context, userID uuid.UUID, filename string, data []byte) ([]importer.Row, error) {
+	loc, err := s.Location(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return importer.Parse(filename, data, time.Now(), loc)
+}
+
+// ImportReminders создаёт личные напоминания из строк файла без ошибок.
+// Напоминания и запуск их workflow вставляются одной транзакцией; workflow
+// запускает outbox по очереди, не перегружая Temporal.
+func (s *ReminderService) ImportReminders(ctx context.Context, userID uuid.UUID, filename string, data []byte) (*ImportResult, error) {
+	rows, err := s.PreviewImport(ctx, userID, filename, data)
+	if err != nil {
+		return nil, err
+	}
+
+	var prepared []*preparedReminder
+	for i := range rows {
+		row := &rows[i]
+		if !row.Valid() {
+			continue
+		}
+		p, err := s.prepareReminder(ctx, CreateReminderInput{
+			UserID:         userID,
+			Title:          row.Title,
+			Description:    row.Description,
+			RemindAt:       row.RemindAt,
+			RecurrenceRule: row.RecurrenceRule,
+			Priority:       row.Priority,
+			Tags:           row.Tags,
+		})
+		if err != nil {
+			row.Errors = append(row.Errors, err.Error())
+			continue
+		}
+		prepared = append(prepared, p)
+	}
+	result := &ImportResult{Rows: rows}
+	if len(prepared) == 0 {
+		return result, nil
+	}
+
+	params := make([]repository.CreateReminderParams, len(prepared))
+	for i, p := range prepared {
+		params[i] = p.params
+	}
+	err = s.tx.RunInTx(ctx, func(ctx context.Context) error {
+		reminders, err := s.repo.CreateBatch(ctx, params)
+		if err != nil {
+			return err
+		}
+		ids := make([]uuid.UUID, len(reminders))
+		for i := range reminders {
+			ids[i] = reminders[i].ID
+		}
+		result.Created = len(reminders)
+		return s.enqueueStart(ctx, 0, ids...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.log.Info("reminders imported",
+		slog.String("user_id", userID.String()),
+		slog.Int("rows", len(rows)),
+		slog.Int("created", result.Created),
+	)
+	return result, nil
+}
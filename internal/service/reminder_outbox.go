@@ -0,0 +1,150 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/vovanwin/template/internal/model"
+	"github.com/vovanwin/template/internal/pkg/outbox"
+	"github.com/vovanwin/template/internal/repository"
+	reminderv1 "github.com/vovanwin/template/pkg/temporal/reminder"
+	"go.temporal.io/api/serviceerror"
+)
+
+// Виды сообщений outbox, которые доставляет ReminderService.
+const (
+	OutboxStartReminder  = "reminder.start"
+	OutboxSignalReminder = "reminder.signal"
+	OutboxDeleteSchedule = "reminder.delete_schedule"
+	OutboxSyncDigest     = "digest.sync"
+)
+
+// startReminderMessage — запустить workflow или расписание напоминания.
+type startReminderMessage struct {
+	ReminderID     uuid.UUID `json:"reminder_id"`
+	TelegramChatID int64     `json:"telegram_chat_id,omitempty"`
+}
+
+// signalReminderMessage — отправить сигнал без аргументов в workflow напоминания.
+type signalReminderMessage struct {
+	WorkflowID string `json:"workflow_id"`
+	Signal     string `json:"signal"`
+}
+
+// deleteScheduleMessage — удалить расписание повторяющегося напоминания.
+type deleteScheduleMessage struct {
+	ScheduleID string `json:"schedule_id"`
+}
+
+// RegisterOutboxHandlers подключает обработчики сообщений напоминаний к relay.
+func (s *ReminderService) RegisterOutboxHandlers(relay *outbox.Relay) {
+	relay.Handle(OutboxStartReminder, s.handleStartMessage)
+	relay.Handle(OutboxSignalReminder, s.handleSignalMessage)
+	relay.Handle(OutboxDeleteSchedule, s.handleDeleteScheduleMessage)
+	relay.Handle(OutboxSyncDigest, s.handleSyncDigestMessage)
+}
+
+// enqueueStart ставит в outbox запуск workflow созданных напоминаний.
+// telegramChatID — чат, из которого они созданы; 0 — чат из настроек получателя.
+func (s *ReminderService) enqueueStart(ctx context.Context, telegramChatID int64, reminderIDs ...uuid.UUID) error {
+	msgs := make([]outbox.Message, 0, len(reminderIDs))
+	for _, id := range reminderIDs {
+		msg, err := outbox.New(OutboxStartReminder, repository.ReminderOutboxKey(id), startReminderMessage{
+			ReminderID:     id,
+			TelegramChatID: telegramChatID,
+		})
+		if err != nil {
+			return err
+		}
+		msgs = append(msgs, msg)
+	}
+	return s.outbox.Add(ctx, msgs...)
+}
+
+// enqueueStop ставит в outbox удаление расписания и, если cancelWorkflow,
+// сигнал отмены workflow напоминания.
+func (s *ReminderService) enqueueStop(ctx context.Context, rem *repository.Reminder, cancelWorkflow bool) error {
+	key := repository.ReminderOutboxKey(rem.ID)
+	var msgs []outbox.Message
+	if rem.ScheduleID != "" {
+		msg, err := outbox.New(OutboxDeleteSchedule, key, deleteScheduleMessage{ScheduleID: rem.ScheduleID})
+		if err != nil {
+			return err
+		}
+		msgs = append(msgs, msg)
+	}
+	if cancelWorkflow {
+		msg, err := outbox.New(OutboxSignalReminder, key, signalReminderMessage{
+			WorkflowID: rem.WorkflowID,
+			Signal:     reminderv1.CancelReminderSignalName,
+		})
+		if err != nil {
+			return err
+		}
+		msgs = append(msgs, msg)
+	}
+	return s.outbox.Add(ctx, msgs...)
+}
+
+// handleStartMessage запускает workflow по текущим данным напоминания из БД.
+// Удалённое или уже не активное напоминание пропускается.
+func (s *ReminderService) handleStartMessage(ctx context.Context, msg outbox.Message) error {
+	var m startReminderMessage
+	if err := msg.Decode(&m); err != nil {
+		return err
+	}
+
+	rem, err := s.repo.GetByID(ctx, m.ReminderID)
+	if err != nil {
+		return fmt.Errorf("get reminder: %w", err)
+	}
+	if rem == nil {
+		return nil
+	}
+	active := isActiveStatus(rem.Status)
+	if rem.IsRecurring() {
+		active = rem.Status != model.ReminderStatusCancelled.String()
+	}
+	if !active {
+		return nil
+	}
+
+	run, err := s.buildRun(ctx, rem, m.TelegramChatID)
+	if err != nil {
+		return outbox.Permanent(err)
+	}
+	return s.startReminder(ctx, rem, run)
+}
+
+// handleSignalMessage отправляет сигнал; workflow, которого нет или который
+// уже завершился, сигнал не ждёт.
+func (s *ReminderService) handleSignalMessage(ctx context.Context, msg outbox.Message) error {
+	var m signalReminderMessage
+	if err := msg.Decode(&m); err != nil {
+		return err
+	}
+
+	err := s.temporal.GetClient().GetClient().SignalWorkflow(ctx, m.WorkflowID, "", m.Signal, nil)
+	var notFound *serviceerror.NotFound
+	if err != nil && !errors.As(err, &notFound) {
+		return fmt.Errorf("signal %s: %w", m.Signal, err)
+	}
+	return nil
+}
+
+// handleDeleteScheduleMessage удаляет расписание; уже удалённое — не ошибка.
+func (s *ReminderService) handleDeleteScheduleMessage(ctx context.Context, msg outbox.Message) error {
+	var m deleteScheduleMessage
+	if err := msg.Decode(&m); err != nil {
+		return err
+	}
+
+	err := s.temporal.GetClient().GetSchedule(ctx, m.ScheduleID).Delete(ctx)
+	var notFound *serviceerror.NotFound
+	if err != nil && !errors.As(err, &notFound) {
+		return fmt.Errorf("delete schedule: %w", err)
+	}
+	return nil
+}
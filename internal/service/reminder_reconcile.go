@@ -0,0 +1,415 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vovanwin/template/internal/model"
+	"github.com/vovanwin/template/internal/repository"
+	reminderv1 "github.com/vovanwin/template/pkg/temporal/reminder"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/client"
+)
+
+// reconcileLockKey — ключ advisory-блокировки: сверку выполняет один экземпляр приложения.
+const reconcileLockKey int64 = 0x72656d696e64
+
+// reconcileAction — что сверка сделала с напоминанием.
+type reconcileAction string
+
+const (
+	reconcileNone reconcileAction = ""
+	// reconcileStarted — workflow не запускался (сообщение outbox о запуске не доставлено).
+	reconcileStarted reconcileAction = "started"
+	// reconcileRestarted — workflow пропал из Temporal.
+	reconcileRestarted reconcileAction = "restarted"
+	// reconcileRescheduled — расписание повторяющегося напоминания создано заново.
+	reconcileRescheduled reconcileAction = "rescheduled"
+	// reconcileStatusFixed — статус в БД исправлен по workflow или расписанию.
+	reconcileStatusFixed reconcileAction = "status_fixed"
+)
+
+// ReconcilerConfig — параметры сверки напоминаний с Temporal.
+type ReconcilerConfig struct {
+	// Interval — период сверки; 0 — сверка выключена.
+	Interval time.Duration
+	// GracePeriod — сколько напоминание не должно меняться, чтобы попасть в сверку:
+	// не трогаем только что созданные и те, чей статус сейчас обновляет workflow.
+	GracePeriod time.Duration
+	// BatchSize — сколько напоминаний читается из БД за раз.
+	BatchSize int
+}
+
+// ReconcileReport — итог одного прохода сверки.
+type ReconcileReport struct {
+	Checked     int
+	Started     int
+	Restarted   int
+	Rescheduled int
+	StatusFixed int
+	Errors      int
+}
+
+func (r *ReconcileReport) add(action reconcileAction) {
+	switch action {
+	case reconcileStarted:
+		r.Started++
+	case reconcileRestarted:
+		r.Restarted++
+	case reconcileRescheduled:
+		r.Rescheduled++
+	case reconcileStatusFixed:
+		r.StatusFixed++
+	}
+}
+
+// ReminderReconciler периодически сверяет активные напоминания в БД с Temporal:
+// запускает workflow, которые не стартовали или пропали, пересоздаёт
+// потерянные расписания и исправляет статус, разошедшийся с workflow.
+// Закрытые workflow не перезапускаются — по ним исправляется только статус.
+type ReminderReconciler struct {
+	reminders *ReminderService
+	repo      *repository.ReminderRepo
+	cfg       ReconcilerConfig
+	log       *slog.Logger
+
+	checked metric.Int64Counter
+	actions metric.Int64Counter
+	errors  metric.Int64Counter
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func NewReminderReconciler(reminders *ReminderService, repo *repository.ReminderRepo, cfg ReconcilerConfig, log *slog.Logger) (*ReminderReconciler, error) {
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = 100
+	}
+
+	meter := otel.Meter("github.com/vovanwin/template/internal/service")
+	checked, err := meter.Int64Counter("reminders.reconcile.checked",
+		metric.WithDescription("Напоминания, проверенные сверкой с Temporal"))
+	if err != nil {
+		return nil, fmt.Errorf("create reconcile checked counter: %w", err)
+	}
+	actions, err := meter.Int64Counter("reminders.reconcile.actions",
+		metric.WithDescription("Исправления сверки с Temporal по виду action"))
+	if err != nil {
+		return nil, fmt.Errorf("create reconcile actions counter: %w", err)
+	}
+	errs, err := meter.Int64Counter("reminders.reconcile.errors",
+		metric.WithDescription("Напоминания, которые сверка не смогла проверить или исправить"))
+	if err != nil {
+		return nil, fmt.Errorf("create reconcile errors counter: %w", err)
+	}
+
+	return &ReminderReconciler{
+		reminders: reminders,
+		repo:      repo,
+		cfg:       cfg,
+		log:       log,
+		checked:   checked,
+		actions:   actions,
+		errors:    errs,
+	}, nil
+}
+
+// Start запускает периодическую сверку в фоне. Контекст OnStart fx имеет
+// дедлайн, поэтому цикл живёт в своём контексте до Stop.
+func (r *ReminderReconciler) Start() {
+	if r.cfg.Interval <= 0 {
+		r.log.Info("reminder reconciler disabled")
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+	r.done = make(chan struct{})
+	go r.run(ctx)
+}
+
+// Stop останавливает сверку и ждёт завершения текущего прохода или ctx.
+func (r *ReminderReconciler) Stop(ctx context.Context) {
+	if r.cancel == nil {
+		return
+	}
+	r.cancel()
+	select {
+	case <-r.done:
+	case <-ctx.Done():
+	}
+}
+
+func (r *ReminderReconciler) run(ctx context.Context) {
+	defer close(r.done)
+
+	ticker := time.NewTicker(r.cfg.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		report, err := r.Reconcile(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				r.log.Error("reminder reconciliation failed", slog.Any("err", err))
+			}
+			continue
+		}
+		if report == nil {
+			continue
+		}
+		level := slog.LevelDebug
+		if report.Started+report.Restarted+report.Rescheduled+report.StatusFixed+report.Errors > 0 {
+			level = slog.LevelInfo
+		}
+		r.log.Log(ctx, level, "reminders reconciled",
+			slog.Int("checked", report.Checked),
+			slog.Int("started", report.Started),
+			slog.Int("restarted", report.Restarted),
+			slog.Int("rescheduled", report.Rescheduled),
+			slog.Int("status_fixed", report.StatusFixed),
+			slog.Int("errors", report.Errors),
+		)
+	}
+}
+
+// Reconcile выполняет один проход сверки. Если проход уже идёт в другом
+// экземпляре приложения, возвращает nil-отчёт без ошибки.
+func (r *ReminderReconciler) Reconcile(ctx context.Context) (*ReconcileReport, error) {
+	report := &ReconcileReport{}
+	locked, err := r.repo.WithAdvisoryLock(ctx, reconcileLockKey, func(ctx context.Context) error {
+		before := time.Now().Add(-r.cfg.GracePeriod)
+		var after uuid.UUID
+		for {
+			batch, err := r.repo.ListForReconcile(ctx, before, after, r.cfg.BatchSize)
+			if err != nil {
+				return err
+			}
+			for i := range batch {
+				if err := ctx.Err(); err != nil {
+					return err
+				}
+				r.reconcileOne(ctx, &batch[i], report)
+			}
+			if len(batch) < r.cfg.BatchSize {
+				return nil
+			}
+			after = batch[len(batch)-1].ID
+		}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("reconcile reminders: %w", err)
+	}
+	if !locked {
+		return nil, nil
+	}
+	return report, nil
+}
+
+// temporalState — что сверка узнала о напоминании в Temporal.
+type temporalState struct {
+	// Found — workflow или расписание напоминания существует.
+	Found bool
+	// Execution — статус выполнения workflow разового напоминания.
+	Execution enums.WorkflowExecutionStatus
+	// Status — статус напоминания из query работающего workflow или из
+	// результата завершённого.
+	Status string
+	// Paused — расписание повторяющегося напоминания на паузе.
+	Paused bool
+}
+
+// decideReconcile решает, что сделать с напоминанием по его записи в БД и
+// состоянию в Temporal на момент now. Для reconcileStatusFixed возвращает
+// статус для записи в БД.
+func decideReconcile(rem *repository.Reminder, state temporalState, now time.Time) (reconcileAction, string) {
+	var status string
+	if rem.IsRecurring() {
+		if rem.ScheduleID == "" || !state.Found {
+			return reconcileRescheduled, ""
+		}
+		status = model.ReminderStatusPending.String()
+		if state.Paused {
+			status = model.ReminderStatusPaused.String()
+		}
+	} else {
+		if rem.WorkflowID == "" {
+			return reconcileStarted, ""
+		}
+		if !state.Found {
+			return lostWorkflow(rem, now)
+		}
+		switch state.Execution {
+		case enums.WORKFLOW_EXECUTION_STATUS_RUNNING, enums.WORKFLOW_EXECUTION_STATUS_COMPLETED:
+			status = state.Status
+		case enums.WORKFLOW_EXECUTION_STATUS_CANCELED:
+			status = model.ReminderStatusCancelled.String()
+		default:
+			// Workflow упал, завершён через terminate или по таймауту — не перезапускаем.
+			status = model.ReminderStatusFailed.String()
+		}
+	}
+	if status == "" || status == rem.Status {
+		return reconcileNone, ""
+	}
+	return reconcileStatusFixed, status
+}
+
+// lostWorkflow решает судьбу напоминания, workflow которого нет в Temporal
+// (например, удалён по retention). Перезапуск отправил бы уведомление заново,
+// поэтому перезапускается только то, что ещё не отправлено: pending и
+// snoozed ждут срабатывания, processing — только до remind_at (или
+// snoozed_until). Иначе уведомление уже ушло, и напоминание закрывается:
+// без подтверждения — sent, с подтверждением — expired, так как
+// подтверждение до БД не дошло.
+func lostWorkflow(rem *repository.Reminder, now time.Time) (reconcileAction, string) {
+	fireAt := rem.RemindAt
+	if rem.SnoozedUntil != nil && rem.SnoozedUntil.After(fireAt) {
+		fireAt = *rem.SnoozedUntil
+	}
+	if rem.Status != model.ReminderStatusProcessing.String() || fireAt.After(now) {
+		return reconcileRestarted, ""
+	}
+	if rem.RequireConfirmation {
+		return reconcileStatusFixed, model.ReminderStatusExpired.String()
+	}
+	return reconcileStatusFixed, model.ReminderStatusSent.String()
+}
+
+// reconcileOne сверяет напоминание, учитывая результат в отчёте и метриках.
+func (r *ReminderReconciler) reconcileOne(ctx context.Context, rem *repository.Reminder, report *ReconcileReport) {
+	report.Checked++
+	r.checked.Add(ctx, 1)
+
+	var state temporalState
+	var err error
+	if rem.IsRecurring() {
+		state, err = r.describeSchedule(ctx, rem)
+	} else {
+		state, err = r.describeWorkflow(ctx, rem)
+	}
+	action := reconcileNone
+	if err == nil {
+		var status string
+		action, status = decideReconcile(rem, state, time.Now())
+		err = r.apply(ctx, rem, action, status)
+	}
+	if err != nil {
+		report.Errors++
+		r.errors.Add(ctx, 1)
+		r.log.Warn("failed to reconcile reminder",
+			slog.Any("err", err),
+			slog.String("reminder_id", rem.ID.String()),
+			slog.String("action", string(action)),
+		)
+		return
+	}
+	if action == reconcileNone {
+		return
+	}
+	report.add(action)
+	r.actions.Add(ctx, 1, metric.WithAttributes(attribute.String("action", string(action))))
+	r.log.Info("reminder reconciled",
+		slog.String("reminder_id", rem.ID.String()),
+		slog.String("action", string(action)),
+		slog.String("status", rem.Status),
+	)
+}
+
+// apply выполняет решение decideReconcile.
+func (r *ReminderReconciler) apply(ctx context.Context, rem *repository.Reminder, action reconcileAction, status string) error {
+	switch action {
+	case reconcileStarted, reconcileRestarted, reconcileRescheduled:
+		return r.restart(ctx, rem)
+	case reconcileStatusFixed:
+		if err := r.repo.UpdateStatus(ctx, rem.ID, status); err != nil {
+			return err
+		}
+		rem.Status = status
+	}
+	return nil
+}
+
+// describeWorkflow читает состояние workflow разового напоминания.
+func (r *ReminderReconciler) describeWorkflow(ctx context.Context, rem *repository.Reminder) (temporalState, error) {
+	if rem.WorkflowID == "" {
+		return temporalState{}, nil
+	}
+
+	raw := r.reminders.temporal.GetClient().GetClient()
+	desc, err := raw.DescribeWorkflowExecution(ctx, rem.WorkflowID, "")
+	var notFound *serviceerror.NotFound
+	if errors.As(err, &notFound) {
+		return temporalState{}, nil
+	}
+	if err != nil {
+		return temporalState{}, fmt.Errorf("describe workflow: %w", err)
+	}
+
+	state := temporalState{Found: true, Execution: desc.GetWorkflowExecutionInfo().GetStatus()}
+	switch state.Execution {
+	case enums.WORKFLOW_EXECUTION_STATUS_RUNNING:
+		resp, err := reminderv1.NewReminderClient(raw).GetReminderStatus(ctx, rem.WorkflowID, "")
+		if err != nil {
+			return temporalState{}, fmt.Errorf("query reminder status: %w", err)
+		}
+		state.Status = resp.GetStatus()
+	case enums.WORKFLOW_EXECUTION_STATUS_COMPLETED:
+		var resp reminderv1.ScheduleReminderResponse
+		if err := r.reminders.temporal.GetClient().GetWorkflow(ctx, rem.WorkflowID, "").Get(ctx, &resp); err != nil {
+			return temporalState{}, fmt.Errorf("get workflow result: %w", err)
+		}
+		state.Status = resp.GetStatus()
+	}
+	return state, nil
+}
+
+// describeSchedule читает состояние расписания повторяющегося напоминания.
+func (r *ReminderReconciler) describeSchedule(ctx context.Context, rem *repository.Reminder) (temporalState, error) {
+	if rem.ScheduleID == "" {
+		return temporalState{}, nil
+	}
+
+	desc, err := r.reminders.temporal.GetClient().GetSchedule(ctx, rem.ScheduleID).Describe(ctx)
+	var notFound *serviceerror.NotFound
+	if errors.As(err, &notFound) {
+		return temporalState{}, nil
+	}
+	if err != nil {
+		return temporalState{}, fmt.Errorf("describe schedule: %w", err)
+	}
+
+	state := desc.Schedule.State
+	return temporalState{Found: true, Paused: state != nil && state.Paused}, nil
+}
+
+// restart запускает workflow или расписание напоминания заново по данным из БД.
+// Приостановленное расписание после пересоздания снова ставится на паузу.
+func (r *ReminderReconciler) restart(ctx context.Context, rem *repository.Reminder) error {
+	run, err := r.reminders.buildRun(ctx, rem, 0)
+	if err != nil {
+		return err
+	}
+	if err := r.reminders.startReminder(ctx, rem, run); err != nil {
+		return err
+	}
+	if rem.IsRecurring() && rem.Status == model.ReminderStatusPaused.String() {
+		if err := r.reminders.temporal.GetClient().GetSchedule(ctx, rem.ScheduleID).Pause(ctx, client.SchedulePauseOptions{
+			Note: "paused by user",
+		}); err != nil {
+			return fmt.Errorf("pause schedule: %w", err)
+		}
+	}
+	return nil
+}
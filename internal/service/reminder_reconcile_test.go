@@ -0,0 +1,114 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/vovanwin/template/internal/model"
+	"github.com/vovanwin/template/internal/repository"
+	"go.temporal.io/api/enums/v1"
+)
+
+func TestDecideReconcile(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	var (
+		pending    = model.ReminderStatusPending.String()
+		processing = model.ReminderStatusProcessing.String()
+		snoozed    = model.ReminderStatusSnoozed.String()
+		expired    = model.ReminderStatusExpired.String()
+		sent       = model.ReminderStatusSent.String()
+		paused     = model.ReminderStatusPaused.String()
+		cancelled  = model.ReminderStatusCancelled.String()
+		failed     = model.ReminderStatusFailed.String()
+	)
+	once := func(status, workflowID string) *repository.Reminder {
+		return &repository.Reminder{Status: status, WorkflowID: workflowID, RemindAt: now.Add(-time.Hour)}
+	}
+	// lost — разовое напоминание, workflow которого пропал из Temporal.
+	lost := func(status string, remindAt time.Time, confirm bool) *repository.Reminder {
+		return &repository.Reminder{Status: status, WorkflowID: "reminder/1", RemindAt: remindAt, RequireConfirmation: confirm}
+	}
+	snoozedUntil := func(rem *repository.Reminder, until time.Time) *repository.Reminder {
+		rem.SnoozedUntil = &until
+		return rem
+	}
+	recurring := func(status, scheduleID string) *repository.Reminder {
+		return &repository.Reminder{Status: status, ScheduleID: scheduleID, RecurrenceRule: "FREQ=DAILY"}
+	}
+	running := func(status string) temporalState {
+		return temporalState{Found: true, Execution: enums.WORKFLOW_EXECUTION_STATUS_RUNNING, Status: status}
+	}
+
+	for _, tt := range []struct {
+		name       string
+		rem        *repository.Reminder
+		state      temporalState
+		wantAction reconcileAction
+		wantStatus string
+	}{
+		{name: "workflow never started", rem: once(pending, ""), wantAction: reconcileStarted},
+		{name: "workflow lost", rem: once(pending, "reminder/1"), wantAction: reconcileRestarted},
+		{name: "lost before remind_at", rem: lost(pending, now.Add(time.Hour), false), wantAction: reconcileRestarted},
+		{name: "lost snoozed", rem: snoozedUntil(lost(snoozed, now.Add(-2*time.Hour), false), now.Add(time.Hour)), wantAction: reconcileRestarted},
+		{name: "lost processing before remind_at", rem: lost(processing, now.Add(time.Hour), true), wantAction: reconcileRestarted},
+		{
+			name:       "lost processing after snooze fired",
+			rem:        snoozedUntil(lost(processing, now.Add(-2*time.Hour), false), now.Add(-time.Hour)),
+			wantAction: reconcileStatusFixed,
+			wantStatus: sent,
+		},
+		{name: "lost processing after delivery", rem: lost(processing, now.Add(-time.Hour), false), wantAction: reconcileStatusFixed, wantStatus: sent},
+		{name: "lost awaiting confirmation", rem: lost(processing, now.Add(-time.Hour), true), wantAction: reconcileStatusFixed, wantStatus: expired},
+		{name: "running in sync", rem: once(pending, "reminder/1"), state: running(pending)},
+		{name: "running ahead of db", rem: once(pending, "reminder/1"), state: running(sent), wantAction: reconcileStatusFixed, wantStatus: sent},
+		{name: "running with empty query status", rem: once(pending, "reminder/1"), state: running("")},
+		{
+			name:       "completed",
+			rem:        once(sent, "reminder/1"),
+			state:      temporalState{Found: true, Execution: enums.WORKFLOW_EXECUTION_STATUS_COMPLETED, Status: model.ReminderStatusExpired.String()},
+			wantAction: reconcileStatusFixed,
+			wantStatus: model.ReminderStatusExpired.String(),
+		},
+		{
+			name:       "cancelled in temporal",
+			rem:        once(pending, "reminder/1"),
+			state:      temporalState{Found: true, Execution: enums.WORKFLOW_EXECUTION_STATUS_CANCELED},
+			wantAction: reconcileStatusFixed,
+			wantStatus: cancelled,
+		},
+		{
+			name:       "terminated is not restarted",
+			rem:        once(pending, "reminder/1"),
+			state:      temporalState{Found: true, Execution: enums.WORKFLOW_EXECUTION_STATUS_TERMINATED},
+			wantAction: reconcileStatusFixed,
+			wantStatus: failed,
+		},
+		{
+			name:  "timed out and already failed",
+			rem:   once(failed, "reminder/1"),
+			state: temporalState{Found: true, Execution: enums.WORKFLOW_EXECUTION_STATUS_TIMED_OUT},
+		},
+		{name: "schedule never created", rem: recurring(pending, ""), wantAction: reconcileRescheduled},
+		{name: "schedule lost", rem: recurring(paused, "reminder-schedule/1"), wantAction: reconcileRescheduled},
+		{name: "schedule in sync", rem: recurring(pending, "reminder-schedule/1"), state: temporalState{Found: true}},
+		{
+			name:       "schedule paused in temporal",
+			rem:        recurring(pending, "reminder-schedule/1"),
+			state:      temporalState{Found: true, Paused: true},
+			wantAction: reconcileStatusFixed,
+			wantStatus: paused,
+		},
+		{
+			name:       "schedule resumed in temporal",
+			rem:        recurring(paused, "reminder-schedule/1"),
+			state:      temporalState{Found: true},
+			wantAction: reconcileStatusFixed,
+			wantStatus: pending,
+		},
+	} {
+		action, status := decideReconcile(tt.rem, tt.state, now)
+		if action != tt.wantAction || status != tt.wantStatus {
+			t.Errorf("%s: decideReconcile = %q, %q; want %q, %q", tt.name, action, status, tt.wantAction, tt.wantStatus)
+		}
+	}
+}
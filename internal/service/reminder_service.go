@@ -2,41 +2,410 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/vovanwin/template/internal/model"
+	"github.com/vovanwin/template/internal/pkg/blob"
+	"github.com/vovanwin/template/internal/pkg/confirm"
+	"github.com/vovanwin/template/internal/pkg/cursor"
+	"github.com/vovanwin/template/internal/pkg/escalation"
+	"github.com/vovanwin/template/internal/pkg/notify"
+	"github.com/vovanwin/template/internal/pkg/quiet"
+	"github.com/vovanwin/template/internal/pkg/recurrence"
+	"github.com/vovanwin/template/internal/pkg/storage/postgres"
+	"github.com/vovanwin/template/internal/pkg/tags"
 	"github.com/vovanwin/template/internal/pkg/temporal"
+	"github.com/vovanwin/template/internal/pkg/timezone"
 	"github.com/vovanwin/template/internal/repository"
 	reminderv1 "github.com/vovanwin/template/pkg/temporal/reminder"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/enums/v1"
 	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/converter"
+	sdktemporal "go.temporal.io/sdk/temporal"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+var (
+	// ErrInvalidReminder — изменение напоминания отклонено проверкой (в сервисе или в workflow).
+	ErrInvalidReminder = errors.New("invalid reminder")
+	// ErrReminderNotFound — напоминание не найдено.
+	ErrReminderNotFound = errors.New("reminder not found")
+	// ErrReminderForbidden — напоминание принадлежит другому пользователю.
+	ErrReminderForbidden = errors.New("forbidden")
+	// ErrActionNotAllowed — напоминание доступно пользователю, но действие ему запрещено
+	// (например, подтвердить может только исполнитель, а изменить — создатель или редактор списка).
+	ErrActionNotAllowed = errors.New("action not allowed")
+)
+
+// highPriorityRepeatMinutes — интервал повтора для напоминания с высоким
+// приоритетом, если пользователь не выбрал свой.
+const highPriorityRepeatMinutes = 15
+
+// access — действие с напоминанием, для которого проверяются права.
+type access int
+
+const (
+	// accessView — просмотр: создатель, исполнитель, участники списка.
+	accessView access = iota
+	// accessRespond — подтверждение и откладывание: получатель уведомления.
+	accessRespond
+	// accessEdit — изменение, отмена, пауза и удаление: создатель и редакторы списка.
+	accessEdit
+)
+
 type ReminderService struct {
 	repo     *repository.ReminderRepo
+	userRepo *repository.UserRepo
+	listRepo *repository.ListRepo
+	outbox   *repository.OutboxRepo
+	tx       *postgres.TxManager
 	temporal *temporal.Service
+	blobs    blob.Storage
 	log      *slog.Logger
 }
 
 func NewReminderService(
 	repo *repository.ReminderRepo,
+	userRepo *repository.UserRepo,
+	listRepo *repository.ListRepo,
+	outboxRepo *repository.OutboxRepo,
+	tx *postgres.TxManager,
 	temporalSvc *temporal.Service,
+	blobs blob.Storage,
 	log *slog.Logger,
 ) *ReminderService {
 	return &ReminderService{
 		repo:     repo,
+		userRepo: userRepo,
+		listRepo: listRepo,
+		outbox:   outboxRepo,
+		tx:       tx,
 		temporal: temporalSvc,
+		blobs:    blobs,
 		log:      log,
 	}
 }
 
-func (s *ReminderService) CreateReminder(ctx context.Context, userID uuid.UUID, title, description string, remindAt time.Time, telegramChatID int64, requireConfirmation bool, repeatIntervalMinutes int) (*repository.Reminder, error) {
-	rem, err := s.repo.Create(ctx, userID, title, description, remindAt, requireConfirmation, repeatIntervalMinutes)
+// CreateReminderInput — параметры создания напоминания.
+type CreateReminderInput struct {
+	UserID                uuid.UUID
+	Title                 string
+	Description           string
+	RemindAt              time.Time
+	TelegramChatID        int64
+	RequireConfirmation   bool
+	RepeatIntervalMinutes int
+	// RecurrenceRule — RRULE или cron; пустое значение — разовое напоминание.
+	RecurrenceRule string
+	// Channels — каналы доставки в порядке приоритета; пусто — настройки пользователя.
+	Channels []string
+	// EscalationPolicy — цепочка эскалации (см. пакет escalation); работает
+	// только с подтверждением и интервалом повтора.
+	EscalationPolicy string
+	// Priority — приоритет; высокий включает подтверждение (см. ForcesConfirmation).
+	Priority model.ReminderPriority
+	// Tags — теги напоминания, нормализуются пакетом tags.
+	Tags []string
+	// ListID — общий список; создатель должен быть в нём редактором или владельцем.
+	ListID *uuid.UUID
+	// AssigneeEmail — участник списка, которому доставляется напоминание;
+	// пусто — самому создателю.
+	AssigneeEmail string
+	// OverrideQuietHours — доставлять, не дожидаясь конца тихих часов получателя.
+	OverrideQuietHours bool
+	// Attachments — файлы, которые придут вместе с уведомлением (не больше MaxAttachments).
+	Attachments []AttachmentUpload
+	// Checklist — пункты чек-листа по порядку; пустые строки пропускаются.
+	Checklist []string
+	// ConfirmWindowMinutes, ResendBackoff и MaxResends — политика ожидания
+	// подтверждения (см. пакет confirm); задаются только вместе с подтверждением.
+	ConfirmWindowMinutes int
+	ResendBackoff        string
+	MaxResends           int
+}
+
+func (s *ReminderService) CreateReminder(ctx context.Context, in CreateReminderInput) (*repository.Reminder, error) {
+	p, err := s.prepareReminder(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateUploads(in.Attachments, 0); err != nil {
+		return nil, err
+	}
+	checklist, err := checklistTexts(in.Checklist, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	// Напоминание, вложения, чек-лист и запуск workflow пишутся одной транзакцией:
+	// workflow запустит outbox, даже если Temporal сейчас недоступен, и не
+	// сработает раньше, чем вложения сохранены.
+	var rem *repository.Reminder
+	var stored []string
+	err = s.tx.RunInTx(ctx, func(ctx context.Context) error {
+		var err error
+		rem, err = s.repo.Create(ctx, p.params)
+		if err != nil {
+			return fmt.Errorf("create reminder in db: %w", err)
+		}
+		for _, up := range in.Attachments {
+			a, err := s.storeAttachment(ctx, rem.ID, up)
+			if err != nil {
+				return err
+			}
+			stored = append(stored, a.StorageKey)
+		}
+		if err := s.repo.AddChecklistItems(ctx, rem.ID, checklist); err != nil {
+			return err
+		}
+		return s.enqueueStart(ctx, p.telegramChatID, rem.ID)
+	})
 	if err != nil {
-		return nil, fmt.Errorf("create reminder in db: %w", err)
+		// Строки вложений откатились вместе с транзакцией, файлы — удаляем.
+		s.deleteBlobs(ctx, stored...)
+		return nil, err
+	}
+	return rem, nil
+}
+
+// preparedReminder — проверенное напоминание: строка для БД и чат Telegram,
+// в который уйдёт уведомление (0 — чат из настроек получателя).
+type preparedReminder struct {
+	params         repository.CreateReminderParams
+	telegramChatID int64
+}
+
+// reminderRun — всё для запуска workflow или расписания сохранённого напоминания.
+type reminderRun struct {
+	req  *reminderv1.ScheduleReminderRequest
+	rule *recurrence.Rule
+}
+
+// prepareReminder проверяет параметры и собирает строку напоминания для БД.
+func (s *ReminderService) prepareReminder(ctx context.Context, in CreateReminderInput) (*preparedReminder, error) {
+	// Время и повторения напоминания привязаны к таймзоне создателя.
+	tz, err := s.userRepo.GetTimezone(ctx, in.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if in.RecurrenceRule != "" {
+		rule, err := parseRecurrence(in.RecurrenceRule, in.RemindAt, timezone.Load(tz))
+		if err != nil {
+			return nil, err
+		}
+		in.RecurrenceRule = rule.String()
+	}
+
+	channels, err := notify.ParseList(in.Channels)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidReminder, err)
+	}
+
+	if !in.Priority.IsAReminderPriority() {
+		return nil, fmt.Errorf("%w: unknown priority %d", ErrInvalidReminder, in.Priority)
+	}
+	if in.Priority.ForcesConfirmation() {
+		in.RequireConfirmation = true
+		if in.RepeatIntervalMinutes <= 0 {
+			in.RepeatIntervalMinutes = highPriorityRepeatMinutes
+		}
+	}
+	reminderTags, err := tags.Normalize(in.Tags)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidReminder, err)
+	}
+
+	policy, err := escalation.Parse(in.EscalationPolicy)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidReminder, err)
+	}
+	if len(policy) > 0 && (!in.RequireConfirmation || in.RepeatIntervalMinutes <= 0) {
+		return nil, fmt.Errorf("%w: escalation requires confirmation with a repeat interval", ErrInvalidReminder)
+	}
+
+	backoff, err := confirmPolicy(in)
+	if err != nil {
+		return nil, err
+	}
+
+	assigneeID, err := s.resolveAssignee(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkEscalationContacts(ctx, policy, in.UserID, in.ListID); err != nil {
+		return nil, err
+	}
+
+	// Уведомление в Telegram создателя уходит в чат, из которого создано напоминание.
+	if assigneeID != nil {
+		in.TelegramChatID = 0
+	}
+
+	params := repository.CreateReminderParams{
+		UserID:                in.UserID,
+		Title:                 in.Title,
+		Description:           in.Description,
+		RemindAt:              in.RemindAt,
+		RequireConfirmation:   in.RequireConfirmation,
+		RepeatIntervalMinutes: in.RepeatIntervalMinutes,
+		RecurrenceRule:        in.RecurrenceRule,
+		Channels:              notify.Strings(channels),
+		EscalationPolicy:      policy.String(),
+		Priority:              in.Priority,
+		Tags:                  reminderTags,
+		ListID:                in.ListID,
+		AssigneeID:            assigneeID,
+		OverrideQuietHours:    in.OverrideQuietHours,
+		Timezone:              tz,
+		ConfirmWindowMinutes:  in.ConfirmWindowMinutes,
+		ResendBackoff:         string(backoff),
+		MaxResends:            in.MaxResends,
+	}
+	return &preparedReminder{params: params, telegramChatID: in.TelegramChatID}, nil
+}
+
+// confirmPolicy проверяет политику ожидания подтверждения и возвращает
+// способ роста интервала. Окно и число повторов без подтверждения не имеют смысла.
+func confirmPolicy(in CreateReminderInput) (confirm.Backoff, error) {
+	backoff, err := confirm.ParseBackoff(in.ResendBackoff)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrInvalidReminder, err)
+	}
+	if !in.RequireConfirmation || in.RepeatIntervalMinutes <= 0 {
+		if in.ConfirmWindowMinutes != 0 || in.MaxResends != 0 || backoff != confirm.BackoffFixed {
+			return "", fmt.Errorf("%w: confirmation policy requires confirmation with a repeat interval", ErrInvalidReminder)
+		}
+		return backoff, nil
+	}
+	policy := confirm.Policy{
+		Interval:   time.Duration(in.RepeatIntervalMinutes) * time.Minute,
+		Window:     time.Duration(in.ConfirmWindowMinutes) * time.Minute,
+		Backoff:    backoff,
+		MaxResends: in.MaxResends,
+	}
+	if err := policy.Validate(); err != nil {
+		return "", fmt.Errorf("%w: %w", ErrInvalidReminder, err)
+	}
+	return backoff, nil
+}
+
+// scheduleRequest собирает запрос workflow по настройкам доставки получателя:
+// уведомление получает исполнитель, если он назначен. telegramChatID — чат,
+// из которого создано напоминание; 0 — чат из настроек получателя.
+func (s *ReminderService) scheduleRequest(ctx context.Context, p repository.CreateReminderParams, channels []notify.Channel, policy escalation.Policy, telegramChatID int64) (*reminderv1.ScheduleReminderRequest, error) {
+	recipientID := p.UserID
+	if p.AssigneeID != nil {
+		recipientID = *p.AssigneeID
+	}
+	settings, err := s.userRepo.GetNotificationSettings(ctx, recipientID)
+	if err != nil {
+		return nil, fmt.Errorf("get notification settings: %w", err)
+	}
+	if settings == nil {
+		settings = &repository.NotificationSettings{}
+	}
+	if telegramChatID == 0 {
+		telegramChatID = settings.TelegramChatID
+	}
+
+	req := &reminderv1.ScheduleReminderRequest{
+		UserId:                recipientID.String(),
+		Title:                 p.Title,
+		Description:           p.Description,
+		RemindAt:              timestamppb.New(p.RemindAt),
+		TelegramChatId:        telegramChatID,
+		RequireConfirmation:   p.RequireConfirmation,
+		RepeatIntervalMinutes: int32(p.RepeatIntervalMinutes),
+		Channels:              notify.Strings(resolveChannels(channels, settings.Channels)),
+		Email:                 settings.Email,
+		WebhookUrl:            settings.WebhookURL,
+		Escalation:            escalationSteps(policy),
+		Priority:              p.Priority.String(),
+		OverrideQuietHours:    p.OverrideQuietHours,
+		ConfirmWindowMinutes:  int32(p.ConfirmWindowMinutes),
+		ResendBackoff:         p.ResendBackoff,
+		MaxResends:            int32(p.MaxResends),
+	}
+	if p.AssigneeID != nil {
+		req.CreatorId = p.UserID.String()
+	}
+	return req, nil
+}
+
+// buildRun собирает запуск сохранённого напоминания по данным из БД и
+// настройкам получателя. telegramChatID — чат, из которого напоминание
+// создано; 0 — чат из настроек. Отложенное напоминание сработает в
+// snoozed_until, а расписание учтёт прошедшие срабатывания в COUNT.
+func (s *ReminderService) buildRun(ctx context.Context, rem *repository.Reminder, telegramChatID int64) (*reminderRun, error) {
+	channels, err := notify.ParseList(rem.Channels)
+	if err != nil {
+		return nil, fmt.Errorf("parse channels: %w", err)
+	}
+	policy, err := escalation.Parse(rem.EscalationPolicy)
+	if err != nil {
+		return nil, fmt.Errorf("parse escalation policy: %w", err)
+	}
+
+	var rule *recurrence.Rule
+	if rem.IsRecurring() {
+		rule, err = recurrence.Parse(rem.RecurrenceRule)
+		if err != nil {
+			return nil, fmt.Errorf("parse recurrence rule: %w", err)
+		}
+		if rule.Count > 0 {
+			rule.Count -= rem.OccurrenceCount
+			if rule.Count <= 0 {
+				return nil, fmt.Errorf("recurrence rule has no occurrences left")
+			}
+		}
+	}
+
+	params := repository.CreateReminderParams{
+		UserID:                rem.UserID,
+		Title:                 rem.Title,
+		Description:           rem.Description,
+		RemindAt:              rem.RemindAt,
+		RequireConfirmation:   rem.RequireConfirmation,
+		RepeatIntervalMinutes: rem.RepeatIntervalMinutes,
+		RecurrenceRule:        rem.RecurrenceRule,
+		Channels:              rem.Channels,
+		EscalationPolicy:      rem.EscalationPolicy,
+		Priority:              rem.Priority,
+		Tags:                  rem.Tags,
+		ListID:                rem.ListID,
+		AssigneeID:            rem.AssigneeID,
+		OverrideQuietHours:    rem.OverrideQuietHours,
+		Timezone:              rem.Timezone,
+		ConfirmWindowMinutes:  rem.ConfirmWindowMinutes,
+		ResendBackoff:         rem.ResendBackoff,
+		MaxResends:            rem.MaxResends,
+	}
+	if rem.Status == model.ReminderStatusSnoozed.String() && rem.SnoozedUntil != nil {
+		params.RemindAt = *rem.SnoozedUntil
+	}
+	req, err := s.scheduleRequest(ctx, params, channels, policy, telegramChatID)
+	if err != nil {
+		return nil, err
+	}
+	return &reminderRun{req: req, rule: rule}, nil
+}
+
+// startReminder запускает workflow разового напоминания или расписание повторяющегося.
+// Повторный вызов безопасен: уже запущенный или завершённый workflow с тем же
+// ID не запускается снова, существующее расписание не пересоздаётся.
+func (s *ReminderService) startReminder(ctx context.Context, rem *repository.Reminder, run *reminderRun) error {
+	req := run.req
+	req.ReminderId = rem.ID.String()
+
+	if run.rule != nil {
+		return s.scheduleRecurring(ctx, rem, run.rule, req)
 	}
 
 	// Запускаем Temporal workflow на очереди из proto (reminder-v1)
@@ -44,29 +413,197 @@ func (s *ReminderService) CreateReminder(ctx context.Context, userID uuid.UUID,
 	opts := client.StartWorkflowOptions{
 		ID:        workflowID,
 		TaskQueue: reminderv1.ReminderTaskQueue,
+		// Повтор запуска после завершения workflow отправил бы уведомление ещё раз.
+		WorkflowIDReusePolicy: enums.WORKFLOW_ID_REUSE_POLICY_REJECT_DUPLICATE,
 	}
 
-	req := &reminderv1.ScheduleReminderRequest{
-		ReminderId:            rem.ID.String(),
-		UserId:                userID.String(),
-		Title:                 title,
-		Description:           description,
-		RemindAt:              timestamppb.New(remindAt),
-		TelegramChatId:        telegramChatID,
-		RequireConfirmation:   requireConfirmation,
-		RepeatIntervalMinutes: int32(repeatIntervalMinutes),
+	wf, err := s.temporal.GetClient().ExecuteWorkflow(ctx, opts, reminderv1.ScheduleReminderWorkflowName, req)
+	if err != nil {
+		return fmt.Errorf("start reminder workflow: %w", err)
+	}
+	if err := s.repo.UpdateWorkflowID(ctx, rem.ID, wf.GetID()); err != nil {
+		return err
 	}
+	rem.WorkflowID = wf.GetID()
+	return nil
+}
 
-	run, err := s.temporal.GetClient().ExecuteWorkflow(ctx, opts, reminderv1.ScheduleReminderWorkflowName, req)
+// scheduleRecurring создаёт Temporal Schedule, который запускает workflow
+// напоминания на каждое срабатывание правила.
+func (s *ReminderService) scheduleRecurring(ctx context.Context, rem *repository.Reminder, rule *recurrence.Rule, req *reminderv1.ScheduleReminderRequest) error {
+	spec, err := rule.ScheduleSpec(rem.RemindAt, timezone.Load(rem.Timezone))
 	if err != nil {
-		s.log.Error("failed to start reminder workflow", slog.Any("err", err), slog.String("reminder_id", rem.ID.String()))
-		// Не возвращаем ошибку — напоминание создано в БД, workflow можно перезапустить
-	} else {
-		_ = s.repo.UpdateWorkflowID(ctx, rem.ID, run.GetID())
-		rem.WorkflowID = run.GetID()
+		return fmt.Errorf("build reminder schedule: %w", err)
 	}
 
-	return rem, nil
+	// Срабатывание стартует в нужный момент, поэтому remind_at не передаём.
+	req.RemindAt = nil
+	req.Recurring = true
+
+	scheduleID := fmt.Sprintf("reminder-schedule/%s", rem.ID.String())
+	_, err = s.temporal.GetClient().ScheduleWorkflow(ctx, scheduleID, spec, &client.ScheduleWorkflowAction{
+		// Temporal добавляет к ID время запуска: reminder/<id>-<timestamp>
+		ID:        fmt.Sprintf("reminder/%s", rem.ID.String()),
+		Workflow:  reminderv1.ScheduleReminderWorkflowName,
+		Args:      []interface{}{req},
+		TaskQueue: reminderv1.ReminderTaskQueue,
+	}, client.ScheduleOptions{
+		// Срабатывания независимы: неподтверждённое предыдущее не блокирует следующее.
+		Overlap:          enums.SCHEDULE_OVERLAP_POLICY_ALLOW_ALL,
+		RemainingActions: rule.Count,
+	})
+	// Расписание уже есть, если при прошлом запуске не сохранился его ID.
+	if err != nil && !errors.Is(err, sdktemporal.ErrScheduleAlreadyRunning) {
+		return fmt.Errorf("create reminder schedule: %w", err)
+	}
+
+	// Ошибку возвращаем, чтобы outbox повторил шаг: повторное создание
+	// расписания обработано выше.
+	if err := s.repo.UpdateScheduleID(ctx, rem.ID, scheduleID); err != nil {
+		return err
+	}
+	rem.ScheduleID = scheduleID
+	return nil
+}
+
+// resolveChannels выбирает порядок доставки: каналы напоминания, затем
+// настройки пользователя, затем каналы по умолчанию.
+func resolveChannels(reminder []notify.Channel, user []string) []notify.Channel {
+	if len(reminder) > 0 {
+		return reminder
+	}
+	if parsed, err := notify.ParseList(user); err == nil && len(parsed) > 0 {
+		return parsed
+	}
+	return notify.DefaultChannels
+}
+
+// resolveAssignee проверяет права на список и находит исполнителя:
+// создатель должен редактировать список, исполнитель — состоять в нём.
+// checkEscalationContacts разрешает эскалацию только на адреса из настроек
+// доставки создателя и участников списка напоминания. Иначе бот и SMTP
+// рассылали бы уведомления по любым чужим адресам.
+func (s *ReminderService) checkEscalationContacts(ctx context.Context, policy escalation.Policy, userID uuid.UUID, listID *uuid.UUID) error {
+	if len(policy) == 0 {
+		return nil
+	}
+	userIDs := []uuid.UUID{userID}
+	if listID != nil {
+		members, err := s.listRepo.ListMembers(ctx, *listID)
+		if err != nil {
+			return err
+		}
+		for _, m := range members {
+			userIDs = append(userIDs, m.UserID)
+		}
+	}
+
+	contacts := make([]repository.NotificationSettings, 0, len(userIDs))
+	for _, id := range userIDs {
+		settings, err := s.userRepo.GetNotificationSettings(ctx, id)
+		if err != nil {
+			return fmt.Errorf("get notification settings: %w", err)
+		}
+		if settings != nil {
+			contacts = append(contacts, *settings)
+		}
+	}
+	return allowedEscalation(policy, contacts)
+}
+
+// allowedEscalation проверяет, что каждый шаг политики ведёт на адрес из contacts.
+func allowedEscalation(policy escalation.Policy, contacts []repository.NotificationSettings) error {
+	allowed := make(map[notify.Recipient]bool)
+	for _, c := range contacts {
+		for _, to := range []notify.Recipient{
+			{ChatID: c.TelegramChatID},
+			{Email: strings.ToLower(c.Email)},
+			{WebhookURL: c.WebhookURL},
+		} {
+			if to != (notify.Recipient{}) {
+				allowed[to] = true
+			}
+		}
+	}
+
+	for _, step := range policy {
+		to, err := step.Recipient()
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidReminder, err)
+		}
+		to.Email = strings.ToLower(to.Email)
+		if !allowed[to] {
+			return fmt.Errorf("%w: escalation contact %s:%s is neither yours nor a list member's", ErrInvalidReminder, step.Channel, step.Address)
+		}
+	}
+	return nil
+}
+
+func (s *ReminderService) resolveAssignee(ctx context.Context, in CreateReminderInput) (*uuid.UUID, error) {
+	email := strings.TrimSpace(in.AssigneeEmail)
+	if in.ListID == nil {
+		if email != "" {
+			return nil, fmt.Errorf("%w: assignee requires a shared list", ErrInvalidReminder)
+		}
+		return nil, nil
+	}
+
+	role, ok, err := s.listRepo.MemberRole(ctx, *in.ListID, in.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrListNotFound
+	}
+	if !role.CanEdit() {
+		return nil, ErrActionNotAllowed
+	}
+	if email == "" {
+		return nil, nil
+	}
+
+	user, err := s.userRepo.GetByEmail(ctx, email)
+	if err != nil {
+		return nil, fmt.Errorf("get assignee: %w", err)
+	}
+	if user == nil {
+		return nil, fmt.Errorf("%w: assignee %s is not a list member", ErrInvalidReminder, email)
+	}
+	if user.ID == in.UserID {
+		return nil, nil
+	}
+	if _, ok, err := s.listRepo.MemberRole(ctx, *in.ListID, user.ID); err != nil {
+		return nil, err
+	} else if !ok {
+		return nil, fmt.Errorf("%w: assignee %s is not a list member", ErrInvalidReminder, email)
+	}
+	return &user.ID, nil
+}
+
+// escalationSteps переводит политику эскалации в шаги для workflow.
+func escalationSteps(policy escalation.Policy) []*reminderv1.EscalationStep {
+	steps := make([]*reminderv1.EscalationStep, len(policy))
+	for i, s := range policy {
+		steps[i] = &reminderv1.EscalationStep{
+			AfterRepeats: int32(s.AfterRepeats),
+			Channel:      string(s.Channel),
+			Address:      s.Address,
+		}
+	}
+	return steps
+}
+
+// parseRecurrence разбирает правило и проверяет, что его можно выразить
+// расписанием в таймзоне loc.
+func parseRecurrence(raw string, start time.Time, loc *time.Location) (*recurrence.Rule, error) {
+	rule, err := recurrence.Parse(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid recurrence rule: %w", err)
+	}
+	if _, err := rule.ScheduleSpec(start, loc); err != nil {
+		return nil, fmt.Errorf("invalid recurrence rule: %w", err)
+	}
+	return rule, nil
 }
 
 func (s *ReminderService) ListReminders(ctx context.Context, userID uuid.UUID) ([]repository.Reminder, error) {
@@ -83,42 +620,75 @@ func (s *ReminderService) ListRemindersPaged(ctx context.Context, userID uuid.UU
 	if pageSize > 100 {
 		pageSize = 100
 	}
-	return s.repo.ListByUserIDPaged(ctx, userID, page, pageSize, sortField, sortOrder, filters)
+	paged, err := s.repo.ListByUserIDPaged(ctx, userID, page, pageSize, sortField, sortOrder, filters)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.highlight(ctx, paged, filters); err != nil {
+		return nil, err
+	}
+	return paged, nil
 }
 
-func (s *ReminderService) CancelReminder(ctx context.Context, userID, reminderID uuid.UUID) error {
-	rem, err := s.repo.GetByID(ctx, reminderID)
-	if err != nil {
-		return fmt.Errorf("get reminder: %w", err)
+// ListRemindersFeed возвращает порцию напоминаний после курсора after
+// (keyset-пагинация, см. ReminderRepo.ListByUserIDKeyset); пустой after — первая порция.
+func (s *ReminderService) ListRemindersFeed(ctx context.Context, userID uuid.UUID, after string, limit int, sortField, sortOrder string, filters []model.ActiveFilter) (*repository.PagedReminders, error) {
+	if limit < 5 {
+		limit = 5
 	}
-	if rem == nil {
-		return fmt.Errorf("reminder not found")
+	if limit > 100 {
+		limit = 100
+	}
+	paged, err := s.repo.ListByUserIDKeyset(ctx, userID, after, limit, sortField, sortOrder, filters)
+	if err != nil {
+		if errors.Is(err, cursor.ErrInvalid) {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidReminder, err)
+		}
+		return nil, err
 	}
-	if rem.UserID != userID {
-		return fmt.Errorf("forbidden")
+	if err := s.highlight(ctx, paged, filters); err != nil {
+		return nil, err
 	}
+	return paged, nil
+}
 
-	// Отправляем сигнал отмены в workflow
-	if rem.WorkflowID != "" {
-		err = s.temporal.GetClient().GetClient().SignalWorkflow(ctx, rem.WorkflowID, "", reminderv1.CancelReminderSignalName, nil)
-		if err != nil {
-			s.log.Warn("failed to cancel workflow", slog.Any("err", err), slog.String("workflow_id", rem.WorkflowID))
+// highlight подсвечивает совпадения поиска в показанных напоминаниях.
+func (s *ReminderService) highlight(ctx context.Context, paged *repository.PagedReminders, filters []model.ActiveFilter) error {
+	for _, f := range filters {
+		if f.Type != model.FilterSearch || f.Value == "" {
+			continue
+		}
+		ids := make([]uuid.UUID, len(paged.Items))
+		for i, rem := range paged.Items {
+			ids[i] = rem.ID
+		}
+		var err error
+		if paged.Highlights, err = s.repo.Highlight(ctx, ids, f.Value); err != nil {
+			return err
 		}
 	}
+	return nil
+}
+
+func (s *ReminderService) CancelReminder(ctx context.Context, userID, reminderID uuid.UUID) error {
+	rem, err := s.authorize(ctx, userID, reminderID, accessEdit)
+	if err != nil {
+		return err
+	}
 
-	return s.repo.UpdateStatus(ctx, reminderID, model.ReminderStatusCancelled.String())
+	// Расписание удаляется и workflow получает сигнал отмены через outbox.
+	return s.tx.RunInTx(ctx, func(ctx context.Context) error {
+		if err := s.repo.UpdateStatus(ctx, reminderID, model.ReminderStatusCancelled.String()); err != nil {
+			return err
+		}
+		return s.enqueueStop(ctx, rem, rem.WorkflowID != "")
+	})
 }
 
 func (s *ReminderService) AcknowledgeReminder(ctx context.Context, userID, reminderID uuid.UUID) error {
-	rem, err := s.repo.GetByID(ctx, reminderID)
+	rem, err := s.authorize(ctx, userID, reminderID, accessRespond)
 	if err != nil {
-		return fmt.Errorf("get reminder: %w", err)
-	}
-	if rem == nil {
-		return fmt.Errorf("reminder not found")
-	}
-	if rem.UserID != userID {
-		return fmt.Errorf("forbidden")
+		return err
 	}
 
 	if rem.WorkflowID != "" {
@@ -132,22 +702,548 @@ func (s *ReminderService) AcknowledgeReminder(ctx context.Context, userID, remin
 	return nil
 }
 
+// SnoozeReminder откладывает уведомление на d: workflow заново входит в ожидание таймера.
+func (s *ReminderService) SnoozeReminder(ctx context.Context, userID, reminderID uuid.UUID, d time.Duration) error {
+	if d <= 0 {
+		return fmt.Errorf("%w: snooze duration must be positive", ErrInvalidReminder)
+	}
+
+	rem, err := s.authorize(ctx, userID, reminderID, accessRespond)
+	if err != nil {
+		return err
+	}
+	if err := requireWorkflow(rem); err != nil {
+		return err
+	}
+
+	err = s.temporal.GetClient().GetClient().SignalWorkflow(ctx, rem.WorkflowID, "", reminderv1.SnoozeReminderSignalName,
+		&reminderv1.SnoozeReminderRequest{DurationSeconds: int64(d / time.Second)})
+	if err != nil {
+		s.log.Warn("failed to snooze workflow", slog.Any("err", err), slog.String("workflow_id", rem.WorkflowID))
+		return fmt.Errorf("snooze workflow: %w", err)
+	}
+
+	return nil
+}
+
 func (s *ReminderService) DeleteReminder(ctx context.Context, userID, reminderID uuid.UUID) error {
+	rem, err := s.authorize(ctx, userID, reminderID, accessEdit)
+	if err != nil {
+		return err
+	}
+
+	attachments, err := s.repo.ListAttachments(ctx, reminderID)
+	if err != nil {
+		return err
+	}
+
+	// Если workflow активен — отменяем его через outbox вместе с расписанием.
+	cancelWorkflow := rem.WorkflowID != "" && (rem.Status == model.ReminderStatusPending.String() || rem.IsRecurring())
+	err = s.tx.RunInTx(ctx, func(ctx context.Context) error {
+		if err := s.repo.Delete(ctx, reminderID); err != nil {
+			return err
+		}
+		return s.enqueueStop(ctx, rem, cancelWorkflow)
+	})
+	if err != nil {
+		return err
+	}
+	// Строки вложений удалены каскадом, файлы убираем после коммита.
+	for _, a := range attachments {
+		s.deleteBlobs(ctx, a.StorageKey)
+	}
+	return nil
+}
+
+// PauseReminder приостанавливает расписание повторяющегося напоминания.
+func (s *ReminderService) PauseReminder(ctx context.Context, userID, reminderID uuid.UUID) error {
+	rem, err := s.authorize(ctx, userID, reminderID, accessEdit)
+	if err != nil {
+		return err
+	}
+	if err := requireSchedule(rem); err != nil {
+		return err
+	}
+
+	if err := s.temporal.GetClient().GetSchedule(ctx, rem.ScheduleID).Pause(ctx, client.SchedulePauseOptions{
+		Note: "paused by user",
+	}); err != nil {
+		return fmt.Errorf("pause schedule: %w", err)
+	}
+
+	return s.repo.UpdateStatus(ctx, reminderID, model.ReminderStatusPaused.String())
+}
+
+// ResumeReminder возобновляет приостановленное повторяющееся напоминание.
+func (s *ReminderService) ResumeReminder(ctx context.Context, userID, reminderID uuid.UUID) error {
+	rem, err := s.authorize(ctx, userID, reminderID, accessEdit)
+	if err != nil {
+		return err
+	}
+	if err := requireSchedule(rem); err != nil {
+		return err
+	}
+
+	if err := s.temporal.GetClient().GetSchedule(ctx, rem.ScheduleID).Unpause(ctx, client.ScheduleUnpauseOptions{
+		Note: "resumed by user",
+	}); err != nil {
+		return fmt.Errorf("unpause schedule: %w", err)
+	}
+
+	return s.repo.UpdateStatus(ctx, reminderID, model.ReminderStatusPending.String())
+}
+
+// UpdateRecurrence меняет правило повторения: обновляет спецификацию
+// расписания в Temporal и правило в БД. COUNT учитывает уже прошедшие срабатывания.
+func (s *ReminderService) UpdateRecurrence(ctx context.Context, userID, reminderID uuid.UUID, rawRule string) (*repository.Reminder, error) {
+	rem, err := s.authorize(ctx, userID, reminderID, accessEdit)
+	if err != nil {
+		return nil, err
+	}
+	if err := requireSchedule(rem); err != nil {
+		return nil, err
+	}
+
+	loc := timezone.Load(rem.Timezone)
+	rule, err := parseRecurrence(rawRule, rem.RemindAt, loc)
+	if err != nil {
+		return nil, err
+	}
+	spec, err := rule.ScheduleSpec(rem.RemindAt, loc)
+	if err != nil {
+		return nil, fmt.Errorf("invalid recurrence rule: %w", err)
+	}
+
+	remaining, err := remainingOccurrences(rule, rem.OccurrenceCount)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.temporal.GetClient().GetSchedule(ctx, rem.ScheduleID).Update(ctx, client.ScheduleUpdateOptions{
+		DoUpdate: func(in client.ScheduleUpdateInput) (*client.ScheduleUpdate, error) {
+			schedule := in.Description.Schedule
+			schedule.Spec = &spec
+			if schedule.State == nil {
+				schedule.State = &client.ScheduleState{}
+			}
+			schedule.State.LimitedActions = remaining > 0
+			schedule.State.RemainingActions = remaining
+			return &client.ScheduleUpdate{Schedule: &schedule}, nil
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("update schedule: %w", err)
+	}
+
+	if err := s.repo.UpdateRecurrenceRule(ctx, reminderID, rule.String()); err != nil {
+		return nil, err
+	}
+	rem.RecurrenceRule = rule.String()
+	return rem, nil
+}
+
+// requireWorkflow проверяет, что у напоминания есть workflow, которому можно послать сигнал.
+func requireWorkflow(rem *repository.Reminder) error {
+	if rem.WorkflowID == "" {
+		return fmt.Errorf("%w: reminder has no running workflow", ErrInvalidReminder)
+	}
+	return nil
+}
+
+// requireSchedule проверяет, что напоминание повторяющееся и у него есть расписание.
+func requireSchedule(rem *repository.Reminder) error {
+	if rem.ScheduleID == "" {
+		return fmt.Errorf("%w: reminder is not recurring", ErrInvalidReminder)
+	}
+	return nil
+}
+
+// remainingOccurrences возвращает, сколько срабатываний осталось по COUNT
+// правила после occurred прошедших; 0 — правило без COUNT.
+func remainingOccurrences(rule *recurrence.Rule, occurred int) (int, error) {
+	if rule.Count <= 0 {
+		return 0, nil
+	}
+	remaining := rule.Count - occurred
+	if remaining <= 0 {
+		return 0, fmt.Errorf("%w: COUNT must exceed %d past occurrences", ErrInvalidReminder, occurred)
+	}
+	return remaining, nil
+}
+
+// GetReminder возвращает напоминание, доступное пользователю.
+func (s *ReminderService) GetReminder(ctx context.Context, userID, reminderID uuid.UUID) (*repository.Reminder, error) {
+	return s.authorize(ctx, userID, reminderID, accessView)
+}
+
+// UpdateReminderInput — новые значения полей напоминания.
+type UpdateReminderInput struct {
+	Title       string
+	Description string
+	// RemindAt — новое время; nil — время не меняется.
+	RemindAt *time.Time
+}
+
+// UpdateReminder меняет заголовок, описание и время напоминания.
+// Для ожидающего разового напоминания изменение идёт через Temporal Update
+// UpdateReminder: workflow проверяет его, сохраняет в БД и перезапускает таймер.
+// Для повторяющегося обновляется действие и спецификация расписания.
+func (s *ReminderService) UpdateReminder(ctx context.Context, userID, reminderID uuid.UUID, in UpdateReminderInput) (*repository.Reminder, error) {
+	in.Title = strings.TrimSpace(in.Title)
+	if in.Title == "" {
+		return nil, fmt.Errorf("%w: title is required", ErrInvalidReminder)
+	}
+	if in.RemindAt != nil && !in.RemindAt.After(time.Now()) {
+		return nil, fmt.Errorf("%w: remind_at must be in the future", ErrInvalidReminder)
+	}
+
+	rem, err := s.authorize(ctx, userID, reminderID, accessEdit)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case rem.IsRecurring():
+		err = s.updateRecurringDetails(ctx, rem, in)
+	case rem.WorkflowID != "" && isActiveStatus(rem.Status):
+		err = s.updateWorkflowDetails(ctx, rem, in)
+	case in.RemindAt != nil:
+		err = fmt.Errorf("%w: reminder is already %s", ErrInvalidReminder, rem.Status)
+	default:
+		// Завершённое напоминание: меняем только текст в БД.
+		err = s.repo.UpdateDetails(ctx, reminderID, in.Title, in.Description, nil)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetByID(ctx, reminderID)
+}
+
+// updateWorkflowDetails отправляет Temporal Update в workflow напоминания.
+func (s *ReminderService) updateWorkflowDetails(ctx context.Context, rem *repository.Reminder, in UpdateReminderInput) error {
+	req := &reminderv1.UpdateReminderRequest{
+		Title:       in.Title,
+		Description: in.Description,
+	}
+	if in.RemindAt != nil {
+		req.RemindAt = timestamppb.New(*in.RemindAt)
+	}
+
+	_, err := reminderv1.NewReminderClient(s.temporal.GetClient().GetClient()).UpdateReminder(ctx, rem.WorkflowID, "", req)
+	if err != nil {
+		var appErr *sdktemporal.ApplicationError
+		if errors.As(err, &appErr) {
+			return fmt.Errorf("%w: %s", ErrInvalidReminder, appErr.Message())
+		}
+		return fmt.Errorf("update workflow: %w", err)
+	}
+	return nil
+}
+
+// updateRecurringDetails обновляет аргументы и, при смене времени, спецификацию расписания.
+func (s *ReminderService) updateRecurringDetails(ctx context.Context, rem *repository.Reminder, in UpdateReminderInput) error {
+	var spec *client.ScheduleSpec
+	if in.RemindAt != nil {
+		loc := timezone.Load(rem.Timezone)
+		rule, err := parseRecurrence(rem.RecurrenceRule, *in.RemindAt, loc)
+		if err != nil {
+			return err
+		}
+		newSpec, err := rule.ScheduleSpec(*in.RemindAt, loc)
+		if err != nil {
+			return fmt.Errorf("invalid recurrence rule: %w", err)
+		}
+		spec = &newSpec
+	}
+
+	if rem.ScheduleID != "" {
+		err := s.temporal.GetClient().GetSchedule(ctx, rem.ScheduleID).Update(ctx, client.ScheduleUpdateOptions{
+			DoUpdate: func(u client.ScheduleUpdateInput) (*client.ScheduleUpdate, error) {
+				schedule := u.Description.Schedule
+				if action, ok := schedule.Action.(*client.ScheduleWorkflowAction); ok {
+					req := &reminderv1.ScheduleReminderRequest{}
+					if len(action.Args) > 0 {
+						if payload, ok := action.Args[0].(*commonpb.Payload); ok {
+							if err := converter.GetDefaultDataConverter().FromPayload(payload, req); err != nil {
+								return nil, fmt.Errorf("decode schedule args: %w", err)
+							}
+						}
+					}
+					req.Title = in.Title
+					req.Description = in.Description
+					action.Args = []interface{}{req}
+				}
+				if spec != nil {
+					schedule.Spec = spec
+				}
+				return &client.ScheduleUpdate{Schedule: &schedule}, nil
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("update schedule: %w", err)
+		}
+	}
+
+	return s.repo.UpdateDetails(ctx, rem.ID, in.Title, in.Description, in.RemindAt)
+}
+
+// isActiveStatus сообщает, что workflow разового напоминания ещё ждёт отправки или реакции.
+func isActiveStatus(status string) bool {
+	switch status {
+	case model.ReminderStatusPending.String(), model.ReminderStatusProcessing.String(), model.ReminderStatusSnoozed.String():
+		return true
+	}
+	return false
+}
+
+// authorize загружает напоминание и проверяет права пользователя на действие.
+// Напоминание, которого пользователь не видит, — ErrReminderForbidden;
+// видимое, но с запрещённым действием — ErrActionNotAllowed.
+func (s *ReminderService) authorize(ctx context.Context, userID, reminderID uuid.UUID, want access) (*repository.Reminder, error) {
 	rem, err := s.repo.GetByID(ctx, reminderID)
 	if err != nil {
-		return fmt.Errorf("get reminder: %w", err)
+		return nil, fmt.Errorf("get reminder: %w", err)
 	}
 	if rem == nil {
-		return fmt.Errorf("reminder not found")
+		return nil, ErrReminderNotFound
+	}
+
+	var role model.ListRole
+	isMember := false
+	if rem.ListID != nil && rem.UserID != userID {
+		role, isMember, err = s.listRepo.MemberRole(ctx, *rem.ListID, userID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := checkAccess(rem, userID, role, isMember, want); err != nil {
+		return nil, err
+	}
+	return rem, nil
+}
+
+// checkAccess проверяет право userID на действие с напоминанием; role
+// учитывается, только если пользователь сейчас участник списка напоминания.
+func checkAccess(rem *repository.Reminder, userID uuid.UUID, role model.ListRole, isMember bool, want access) error {
+	isCreator := rem.UserID == userID
+	isRecipient := rem.RecipientID() == userID
+	if !isCreator && !isRecipient && !isMember {
+		return ErrReminderForbidden
+	}
+	switch want {
+	case accessRespond:
+		if !isRecipient {
+			return ErrActionNotAllowed
+		}
+	case accessEdit:
+		if !isCreator && !(isMember && role.CanEdit()) {
+			return ErrActionNotAllowed
+		}
+	}
+	return nil
+}
+
+// GetNotificationSettings возвращает настройки каналов доставки пользователя.
+func (s *ReminderService) GetNotificationSettings(ctx context.Context, userID uuid.UUID) (*repository.NotificationSettings, error) {
+	settings, err := s.userRepo.GetNotificationSettings(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if settings == nil {
+		return nil, ErrReminderNotFound
+	}
+	return settings, nil
+}
+
+// UpdateNotificationSettings сохраняет порядок каналов и URL webhook.
+// Новые напоминания без своих каналов используют этот порядок.
+func (s *ReminderService) UpdateNotificationSettings(ctx context.Context, userID uuid.UUID, channels []string, webhookURL string) error {
+	parsed, err := notify.ParseList(channels)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidReminder, err)
+	}
+	webhookURL = strings.TrimSpace(webhookURL)
+	if slices.Contains(parsed, notify.ChannelWebhook) && webhookURL == "" {
+		return fmt.Errorf("%w: webhook channel requires a URL", ErrInvalidReminder)
+	}
+	if webhookURL != "" {
+		if err := notify.ValidateWebhookURL(webhookURL); err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidReminder, err)
+		}
+	}
+	return s.userRepo.UpdateNotificationSettings(ctx, userID, notify.Strings(parsed), webhookURL)
+}
+
+// UpdateQuietHours проверяет и сохраняет расписание тихих часов
+// (см. пакет quiet). Запущенные workflow читают его перед каждой отправкой.
+func (s *ReminderService) UpdateQuietHours(ctx context.Context, userID uuid.UUID, raw string) error {
+	schedule, err := quiet.Parse(raw)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidReminder, err)
+	}
+	return s.userRepo.UpdateQuietHours(ctx, userID, schedule.String())
+}
+
+// SetDND включает режим «не беспокоить» на d; d <= 0 — выключает.
+// Возвращает момент окончания режима или nil.
+func (s *ReminderService) SetDND(ctx context.Context, userID uuid.UUID, d time.Duration) (*time.Time, error) {
+	var until *time.Time
+	if d > 0 {
+		t := time.Now().Add(d)
+		until = &t
+	}
+	if err := s.userRepo.SetDND(ctx, userID, until); err != nil {
+		return nil, err
+	}
+	return until, nil
+}
+
+// Location возвращает таймзону пользователя; не выбранная — таймзона по умолчанию.
+func (s *ReminderService) Location(ctx context.Context, userID uuid.UUID) (*time.Location, error) {
+	tz, err := s.userRepo.GetTimezone(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return timezone.Load(tz), nil
+}
+
+// UpdateTimezone сохраняет таймзону пользователя (имя IANA). С follow личные
+// напоминания переезжают в новую таймзону с тем же местным временем: 09:00 по
+// Москве становится 09:00 по новой зоне. Без follow они срабатывают в прежний
+// момент и повторяются по своей таймзоне. Возвращает число перенесённых напоминаний.
+func (s *ReminderService) UpdateTimezone(ctx context.Context, userID uuid.UUID, name string, follow bool) (int, error) {
+	name = strings.TrimSpace(name)
+	if !timezone.Valid(name) {
+		return 0, fmt.Errorf("%w: unknown timezone %q", ErrInvalidReminder, name)
+	}
+	// Расписание сводки переезжает в новую таймзону вместе с настройкой.
+	err := s.tx.RunInTx(ctx, func(ctx context.Context) error {
+		if err := s.userRepo.UpdateTimezone(ctx, userID, name, follow); err != nil {
+			return err
+		}
+		return s.enqueueDigestSync(ctx, userID)
+	})
+	if err != nil {
+		return 0, err
+	}
+	if !follow {
+		return 0, nil
+	}
+
+	reminders, err := s.repo.ListPersonalActive(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	loc := timezone.Load(name)
+	moved := 0
+	for i := range reminders {
+		rem := &reminders[i]
+		if rem.Timezone == name {
+			continue
+		}
+		// Одно неперенесённое напоминание не отменяет смену таймзоны:
+		// оно сработает в прежний момент.
+		if err := s.moveToTimezone(ctx, rem, loc); err != nil {
+			s.log.Warn("failed to move reminder to timezone",
+				slog.Any("err", err),
+				slog.String("reminder_id", rem.ID.String()),
+				slog.String("timezone", name),
+			)
+			continue
+		}
+		moved++
 	}
-	if rem.UserID != userID {
-		return fmt.Errorf("forbidden")
+	s.log.Info("timezone updated",
+		slog.String("user_id", userID.String()),
+		slog.String("timezone", name),
+		slog.Int("moved", moved),
+	)
+	return moved, nil
+}
+
+// SetTimezoneFollow меняет поведение напоминаний при следующих сменах таймзоны;
+// уже запланированные напоминания не трогает.
+func (s *ReminderService) SetTimezoneFollow(ctx context.Context, userID uuid.UUID, follow bool) error {
+	return s.userRepo.SetTimezoneFollow(ctx, userID, follow)
+}
+
+// DetectTimezone сохраняет таймзону, определённую клиентом, если пользователь
+// ещё не выбрал свою. Возвращает true, если таймзона сохранена.
+func (s *ReminderService) DetectTimezone(ctx context.Context, userID uuid.UUID, name string) (bool, error) {
+	settings, err := s.GetNotificationSettings(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	if settings.Timezone != "" {
+		return false, nil
 	}
+	if _, err := s.UpdateTimezone(ctx, userID, name, settings.TimezoneFollow); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// moveToTimezone переносит напоминание в loc с тем же местным временем.
+// Ожидающее разовое меняет время через Temporal Update, повторяющееся —
+// спецификацию расписания. Отложенное и уже прошедшее по новому времени
+// сохраняют момент срабатывания и меняют только таймзону.
+func (s *ReminderService) moveToTimezone(ctx context.Context, rem *repository.Reminder, loc *time.Location) error {
+	remindAt := timezone.SameClock(rem.RemindAt.In(timezone.Load(rem.Timezone)), loc)
 
-	// Если workflow активен — пробуем отменить
-	if rem.WorkflowID != "" && rem.Status == model.ReminderStatusPending.String() {
-		_ = s.temporal.GetClient().GetClient().SignalWorkflow(ctx, rem.WorkflowID, "", reminderv1.CancelReminderSignalName, nil)
+	switch {
+	case rem.IsRecurring():
+		if rem.ScheduleID == "" {
+			// Расписание ещё не создано: outbox построит его по новой таймзоне.
+			break
+		}
+		rule, err := recurrence.Parse(rem.RecurrenceRule)
+		if err != nil {
+			return fmt.Errorf("parse recurrence rule: %w", err)
+		}
+		spec, err := rule.ScheduleSpec(remindAt, loc)
+		if err != nil {
+			return fmt.Errorf("build reminder schedule: %w", err)
+		}
+		err = s.temporal.GetClient().GetSchedule(ctx, rem.ScheduleID).Update(ctx, client.ScheduleUpdateOptions{
+			DoUpdate: func(in client.ScheduleUpdateInput) (*client.ScheduleUpdate, error) {
+				schedule := in.Description.Schedule
+				schedule.Spec = &spec
+				return &client.ScheduleUpdate{Schedule: &schedule}, nil
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("update schedule: %w", err)
+		}
+	case rem.Status != model.ReminderStatusPending.String() || !remindAt.After(time.Now()):
+		remindAt = rem.RemindAt
+	case rem.WorkflowID != "":
+		err := s.updateWorkflowDetails(ctx, rem, UpdateReminderInput{
+			Title:       rem.Title,
+			Description: rem.Description,
+			RemindAt:    &remindAt,
+		})
+		if err != nil {
+			return err
+		}
 	}
+	return s.repo.UpdateTimezone(ctx, rem.ID, loc.String(), remindAt)
+}
 
-	return s.repo.Delete(ctx, reminderID)
+// ListEscalations возвращает сработавшие шаги эскалации напоминания.
+func (s *ReminderService) ListEscalations(ctx context.Context, userID, reminderID uuid.UUID) ([]repository.Escalation, error) {
+	if _, err := s.authorize(ctx, userID, reminderID, accessView); err != nil {
+		return nil, err
+	}
+	return s.repo.ListEscalations(ctx, reminderID)
+}
+
+// ListDeliveries возвращает историю доставки напоминания: попытки отправки
+// по каналам и подтверждения.
+func (s *ReminderService) ListDeliveries(ctx context.Context, userID, reminderID uuid.UUID) ([]repository.Delivery, error) {
+	if _, err := s.authorize(ctx, userID, reminderID, accessView); err != nil {
+		return nil, err
+	}
+	return s.repo.ListDeliveries(ctx, reminderID)
 }
@@ -0,0 +1,161 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vovanwin/template/internal/model"
+	"github.com/vovanwin/template/internal/pkg/escalation"
+	"github.com/vovanwin/template/internal/pkg/recurrence"
+	"github.com/vovanwin/template/internal/repository"
+)
+
+func TestUpdateNotificationSettingsRejectsInternalWebhook(t *testing.T) {
+	s := &ReminderService{}
+	for _, webhookURL := range []string{"http://127.0.0.1:8080/hook", "http://10.0.0.5/hook", "http://169.254.169.254/", "gopher://example.com/"} {
+		err := s.UpdateNotificationSettings(context.Background(), uuid.New(), []string{"webhook"}, webhookURL)
+		if !errors.Is(err, ErrInvalidReminder) {
+			t.Errorf("UpdateNotificationSettings(%s) error = %v, want ErrInvalidReminder", webhookURL, err)
+		}
+	}
+}
+
+func TestAllowedEscalation(t *testing.T) {
+	contacts := []repository.NotificationSettings{
+		{TelegramChatID: 111, Email: "Owner@example.com", WebhookURL: "https://hooks.example.com/owner"},
+		{TelegramChatID: 222, Email: "member@example.com"},
+	}
+	for _, tt := range []struct {
+		name    string
+		policy  string
+		wantErr bool
+	}{
+		{name: "own telegram", policy: "1 telegram:111"},
+		{name: "own email in other case", policy: "1 email:owner@EXAMPLE.com"},
+		{name: "own webhook", policy: "1 webhook:https://hooks.example.com/owner"},
+		{name: "member contacts", policy: "1 telegram:222; 2 email:member@example.com"},
+		{name: "foreign chat", policy: "1 telegram:-1001234567890", wantErr: true},
+		{name: "foreign email", policy: "1 telegram:111; 2 email:victim@example.com", wantErr: true},
+		{name: "foreign webhook", policy: "1 webhook:https://attacker.example.com/", wantErr: true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			policy, err := escalation.Parse(tt.policy)
+			if err != nil {
+				t.Fatalf("Parse: %v", err)
+			}
+			err = allowedEscalation(policy, contacts)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidReminder) {
+					t.Errorf("allowedEscalation error = %v, want ErrInvalidReminder", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("allowedEscalation: %v", err)
+			}
+		})
+	}
+
+	policy, _ := escalation.Parse("1 telegram:111")
+	if err := allowedEscalation(policy, nil); !errors.Is(err, ErrInvalidReminder) {
+		t.Errorf("allowedEscalation without contacts error = %v, want ErrInvalidReminder", err)
+	}
+}
+
+func TestSnoozeReminderRejectsNonPositiveDuration(t *testing.T) {
+	s := &ReminderService{}
+	for _, d := range []time.Duration{0, -time.Minute} {
+		err := s.SnoozeReminder(context.Background(), uuid.New(), uuid.New(), d)
+		if !errors.Is(err, ErrInvalidReminder) {
+			t.Errorf("SnoozeReminder(%s) error = %v, want ErrInvalidReminder", d, err)
+		}
+	}
+}
+
+func TestRequireWorkflowAndSchedule(t *testing.T) {
+	empty := &repository.Reminder{}
+	if err := requireWorkflow(empty); !errors.Is(err, ErrInvalidReminder) {
+		t.Errorf("requireWorkflow() error = %v, want ErrInvalidReminder", err)
+	}
+	if err := requireSchedule(empty); !errors.Is(err, ErrInvalidReminder) {
+		t.Errorf("requireSchedule() error = %v, want ErrInvalidReminder", err)
+	}
+
+	running := &repository.Reminder{WorkflowID: "reminder-1", ScheduleID: "schedule-1"}
+	if err := requireWorkflow(running); err != nil {
+		t.Errorf("requireWorkflow() error = %v", err)
+	}
+	if err := requireSchedule(running); err != nil {
+		t.Errorf("requireSchedule() error = %v", err)
+	}
+}
+
+func TestRemainingOccurrences(t *testing.T) {
+	for _, tt := range []struct {
+		count, occurred int
+		want            int
+		wantErr         bool
+	}{
+		{count: 0, occurred: 7, want: 0},
+		{count: 5, occurred: 3, want: 2},
+		{count: 3, occurred: 3, wantErr: true},
+		{count: 2, occurred: 3, wantErr: true},
+	} {
+		got, err := remainingOccurrences(&recurrence.Rule{Count: tt.count}, tt.occurred)
+		if tt.wantErr {
+			if !errors.Is(err, ErrInvalidReminder) {
+				t.Errorf("remainingOccurrences(%d, %d) error = %v, want ErrInvalidReminder", tt.count, tt.occurred, err)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("remainingOccurrences(%d, %d) = %d, %v, want %d", tt.count, tt.occurred, got, err, tt.want)
+		}
+	}
+}
+
+func TestCheckAccess(t *testing.T) {
+	creator, assignee, member := uuid.New(), uuid.New(), uuid.New()
+	listID := uuid.New()
+	shared := &repository.Reminder{UserID: creator, AssigneeID: &assignee, ListID: &listID}
+	personal := &repository.Reminder{UserID: creator}
+
+	type want struct{ view, respond, edit error }
+	for _, tt := range []struct {
+		name     string
+		rem      *repository.Reminder
+		user     uuid.UUID
+		role     model.ListRole
+		isMember bool
+		want     want
+	}{
+		{"creator of own reminder", personal, creator, 0, false, want{nil, nil, nil}},
+		{"creator of assigned reminder", shared, creator, 0, false, want{nil, ErrActionNotAllowed, nil}},
+		{"assignee", shared, assignee, 0, false, want{nil, nil, ErrActionNotAllowed}},
+		{"assignee with viewer role", shared, assignee, model.ListRoleViewer, true, want{nil, nil, ErrActionNotAllowed}},
+		{"list owner", shared, member, model.ListRoleOwner, true, want{nil, ErrActionNotAllowed, nil}},
+		{"list editor", shared, member, model.ListRoleEditor, true, want{nil, ErrActionNotAllowed, nil}},
+		{"list viewer", shared, member, model.ListRoleViewer, true, want{nil, ErrActionNotAllowed, ErrActionNotAllowed}},
+		{"non-member", shared, uuid.New(), 0, false, want{ErrReminderForbidden, ErrReminderForbidden, ErrReminderForbidden}},
+		{"removed editor", shared, member, model.ListRoleEditor, false, want{ErrReminderForbidden, ErrReminderForbidden, ErrReminderForbidden}},
+		{"stranger to personal reminder", personal, member, 0, false, want{ErrReminderForbidden, ErrReminderForbidden, ErrReminderForbidden}},
+	} {
+		for _, c := range []struct {
+			name string
+			a    access
+			want error
+		}{
+			{"view", accessView, tt.want.view},
+			{"respond", accessRespond, tt.want.respond},
+			{"edit", accessEdit, tt.want.edit},
+		} {
+			err := checkAccess(tt.rem, tt.user, tt.role, tt.isMember, c.a)
+			if !errors.Is(err, c.want) {
+				t.Errorf("%s: %s error = %v, want %v", tt.name, c.name, err, c.want)
+			}
+		}
+	}
+}
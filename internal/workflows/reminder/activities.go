@@ -2,54 +2,393 @@ package reminder
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
-	"github.com/go-telegram/bot/models"
 	"github.com/google/uuid"
+	"github.com/vovanwin/template/internal/model"
+	"github.com/vovanwin/template/internal/pkg/blob"
+	"github.com/vovanwin/template/internal/pkg/escalation"
+	"github.com/vovanwin/template/internal/pkg/notify"
+	"github.com/vovanwin/template/internal/pkg/quiet"
 	"github.com/vovanwin/template/internal/pkg/telegram"
+	"github.com/vovanwin/template/internal/pkg/timezone"
 	"github.com/vovanwin/template/internal/repository"
 	reminderv1 "github.com/vovanwin/template/pkg/temporal/reminder"
+	"go.temporal.io/sdk/activity"
+	sdktemporal "go.temporal.io/sdk/temporal"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // Activities реализует интерфейс ReminderActivities.
 type Activities struct {
-	bot  *telegram.Bot
-	repo *repository.ReminderRepo
+	repo     *repository.ReminderRepo
+	userRepo *repository.UserRepo
+	telegram *TelegramNotifier
+	inApp    notify.Notifier
+	email    notify.Notifier
+	webhook  notify.Notifier
+	blobs    blob.Storage
 }
 
-func NewActivities(bot *telegram.Bot, repo *repository.ReminderRepo) *Activities {
-	return &Activities{bot: bot, repo: repo}
+func NewActivities(
+	bot *telegram.Bot,
+	repo *repository.ReminderRepo,
+	userRepo *repository.UserRepo,
+	inApp *notify.InAppNotifier,
+	email *notify.EmailNotifier,
+	webhook *notify.WebhookNotifier,
+	blobs blob.Storage,
+) *Activities {
+	return &Activities{
+		repo:     repo,
+		userRepo: userRepo,
+		telegram: NewTelegramNotifier(bot),
+		inApp:    inApp,
+		email:    email,
+		webhook:  webhook,
+		blobs:    blobs,
+	}
 }
 
 // SendTelegramNotification отправляет уведомление о напоминании в Telegram.
+// Чек-лист читается при каждой отправке: повтор показывает уже отмеченные пункты.
 func (a *Activities) SendTelegramNotification(ctx context.Context, req *reminderv1.SendTelegramNotificationRequest) error {
-	text := fmt.Sprintf("🔔 %s", req.GetTitle())
+	var checklist []repository.ChecklistItem
+	if id, err := uuid.Parse(req.GetReminderId()); err == nil {
+		if checklist, err = a.repo.ListChecklist(ctx, id); err != nil {
+			return err
+		}
+	}
+	messageID, err := a.telegram.SendMessage(ctx, notify.Recipient{ChatID: req.GetChatId()}, notify.Message{
+		ReminderID:          req.GetReminderId(),
+		Title:               req.GetTitle(),
+		Description:         req.GetDescription(),
+		RequireConfirmation: req.GetRequireConfirmation(),
+		Priority:            parsePriority(req.GetPriority()),
+	}, checklist)
+	a.recordDelivery(ctx, req.GetReminderId(), req.GetDelivery(), notify.ChannelTelegram, int64(messageID), err)
+	// Вложения приходят вслед за первым уведомлением; повторы их не дублируют.
+	if err == nil && req.GetDelivery() <= 1 {
+		a.sendAttachments(ctx, req.GetChatId(), req.GetReminderId())
+	}
+	return err
+}
+
+// sendAttachments отправляет в чат вложения напоминания. Уведомление уже
+// доставлено, и ретрай activity отправил бы его повторно, поэтому ошибки
+// вложений только логируются.
+func (a *Activities) sendAttachments(ctx context.Context, chatID int64, reminderID string) {
+	id, err := uuid.Parse(reminderID)
+	if err != nil {
+		return
+	}
+	logger := activity.GetLogger(ctx)
+	attachments, err := a.repo.ListAttachments(ctx, id)
+	if err != nil {
+		logger.Warn("failed to list attachments", "error", err, "reminder_id", reminderID)
+		return
+	}
+	for _, att := range attachments {
+		data, err := a.blobs.Get(ctx, att.StorageKey)
+		if err != nil {
+			logger.Warn("failed to open attachment", "error", err, "reminder_id", reminderID, "attachment_id", att.ID.String())
+			continue
+		}
+		err = a.telegram.SendAttachment(ctx, notify.Recipient{ChatID: chatID}, att, data)
+		data.Close()
+		if err != nil {
+			logger.Warn("failed to send attachment", "error", err, "reminder_id", reminderID, "attachment_id", att.ID.String())
+		}
+	}
+}
+
+// SendInAppNotification публикует уведомление в персональный канал Centrifugo.
+func (a *Activities) SendInAppNotification(ctx context.Context, req *reminderv1.ChannelNotificationRequest) error {
+	return a.deliver(ctx, notify.ChannelInApp, notify.Recipient{UserID: req.GetRecipient()}, req)
+}
+
+// SendEmailNotification отправляет уведомление письмом.
+func (a *Activities) SendEmailNotification(ctx context.Context, req *reminderv1.ChannelNotificationRequest) error {
+	return a.deliver(ctx, notify.ChannelEmail, notify.Recipient{Email: req.GetRecipient()}, req)
+}
+
+// SendWebhookNotification отправляет уведомление на webhook пользователя.
+func (a *Activities) SendWebhookNotification(ctx context.Context, req *reminderv1.ChannelNotificationRequest) error {
+	return a.deliver(ctx, notify.ChannelWebhook, notify.Recipient{WebhookURL: req.GetRecipient()}, req)
+}
+
+// deliver отправляет уведомление через канал и сохраняет попытку в историю доставки.
+func (a *Activities) deliver(ctx context.Context, ch notify.Channel, to notify.Recipient, req *reminderv1.ChannelNotificationRequest) error {
+	err := send(ctx, a.notifier(ch), to, channelMessage(req))
+	a.recordDelivery(ctx, req.GetReminderId(), req.GetDelivery(), ch, 0, err)
+	return err
+}
+
+// recordDelivery сохраняет попытку отправки в историю доставки. История не
+// должна мешать доставке: ошибка записи только логируется, иначе ретрай
+// activity отправил бы уведомление ещё раз.
+func (a *Activities) recordDelivery(ctx context.Context, reminderID string, delivery int32, ch notify.Channel, messageID int64, sendErr error) {
+	id, err := uuid.Parse(reminderID)
+	if err != nil {
+		return
+	}
+	d := deliveryRecord(activity.GetInfo(ctx), id, delivery, ch, messageID, sendErr)
+	if err := a.repo.RecordDelivery(ctx, d); err != nil {
+		activity.GetLogger(ctx).Warn("failed to record delivery", "error", err, "reminder_id", reminderID)
+	}
+}
+
+// deliveryRecord собирает запись истории для одной попытки activity: все
+// каналы и ретраи одной отправки идут под одним номером delivery, а попытки
+// различаются по Attempt.
+func deliveryRecord(info activity.Info, reminderID uuid.UUID, delivery int32, ch notify.Channel, messageID int64, sendErr error) repository.Delivery {
+	d := repository.Delivery{
+		ReminderID:        reminderID,
+		WorkflowID:        info.WorkflowExecution.ID,
+		Delivery:          int(delivery),
+		Channel:           string(ch),
+		Attempt:           int(info.Attempt),
+		Status:            repository.DeliverySent,
+		TelegramMessageID: messageID,
+	}
+	if sendErr != nil {
+		d.Status = repository.DeliveryFailed
+		d.Error = sendErr.Error()
+	}
+	return d
+}
+
+// acknowledgementRecord собирает запись истории о подтверждении. Подтверждение
+// одно на workflow, поэтому ретраи activity пишут ту же запись, а повтор
+// отбрасывает уникальный индекс.
+func acknowledgementRecord(reminderID uuid.UUID, req *reminderv1.RecordAcknowledgementRequest) repository.Delivery {
+	return repository.Delivery{
+		ReminderID: reminderID,
+		WorkflowID: req.GetWorkflowId(),
+		Delivery:   int(req.GetDelivery()),
+		Attempt:    1,
+		Status:     repository.DeliveryAcknowledged,
+	}
+}
+
+// SendEscalation уведомляет контакт очередного шага эскалации.
+func (a *Activities) SendEscalation(ctx context.Context, req *reminderv1.SendEscalationRequest) error {
+	step := escalation.Step{Channel: notify.Channel(req.GetChannel()), Address: req.GetAddress()}
+	to, err := step.Recipient()
+	if err != nil {
+		return sdktemporal.NewNonRetryableApplicationError(err.Error(), "NotifyConfiguration", err)
+	}
+
+	msg := notify.Message{
+		ReminderID:  req.GetReminderId(),
+		Title:       "⚠️ Не подтверждено: " + req.GetTitle(),
+		Description: fmt.Sprintf("Эскалация, шаг %d: напоминание отправлено повторно %d раз без подтверждения.", req.GetStep(), req.GetRepeats()),
+	}
 	if desc := req.GetDescription(); desc != "" {
-		text += fmt.Sprintf("\n\n%s", desc)
-	}
-
-	if req.GetRequireConfirmation() && req.GetReminderId() != "" {
-		markup := &models.InlineKeyboardMarkup{
-			InlineKeyboard: [][]models.InlineKeyboardButton{
-				{
-					{
-						Text:         "✅ Подтвердить",
-						CallbackData: fmt.Sprintf("ack_reminder:%s", req.GetReminderId()),
-					},
-				},
-			},
+		msg.Description = desc + "\n\n" + msg.Description
+	}
+
+	n := a.webhook
+	switch step.Channel {
+	case notify.ChannelTelegram:
+		// Кнопки подтверждения и откладывания работают только в чате владельца.
+		msg.ReminderID = ""
+		n = a.telegram
+	case notify.ChannelEmail:
+		n = a.email
+	}
+	return send(ctx, n, to, msg)
+}
+
+// RecordEscalation сохраняет результат шага эскалации.
+func (a *Activities) RecordEscalation(ctx context.Context, req *reminderv1.RecordEscalationRequest) error {
+	id, err := uuid.Parse(req.GetReminderId())
+	if err != nil {
+		return fmt.Errorf("parse reminder id: %w", err)
+	}
+	return a.repo.RecordEscalation(ctx, repository.Escalation{
+		ReminderID: id,
+		WorkflowID: req.GetWorkflowId(),
+		Step:       int(req.GetStep()),
+		Channel:    req.GetChannel(),
+		Address:    req.GetAddress(),
+		Status:     req.GetStatus(),
+		Error:      req.GetError(),
+	})
+}
+
+// CheckQuietHours возвращает, до какого момента получателю нельзя отправлять
+// уведомления. Испорченное расписание в БД не должно блокировать доставку —
+// тогда учитывается только режим «не беспокоить».
+func (a *Activities) CheckQuietHours(ctx context.Context, req *reminderv1.CheckQuietHoursRequest) (*reminderv1.CheckQuietHoursResponse, error) {
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, sdktemporal.NewNonRetryableApplicationError("parse user id", "NotifyConfiguration", err)
+	}
+	settings, err := a.userRepo.GetNotificationSettings(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	resp := &reminderv1.CheckQuietHoursResponse{}
+	if settings == nil {
+		return resp, nil
+	}
+
+	qs := quiet.Settings{Location: timezone.Load(settings.Timezone)}
+	if qs.Schedule, err = quiet.Parse(settings.QuietHours); err != nil {
+		activity.GetLogger(ctx).Warn("invalid quiet hours", "error", err, "user_id", req.GetUserId())
+	}
+	if settings.DNDUntil != nil {
+		qs.DNDUntil = *settings.DNDUntil
+	}
+	if until := qs.Until(req.GetAt().AsTime()); !until.IsZero() {
+		resp.Until = timestamppb.New(until)
+	}
+	return resp, nil
+}
+
+// RecordAcknowledgement сохраняет подтверждение напоминания в историю доставки.
+func (a *Activities) RecordAcknowledgement(ctx context.Context, req *reminderv1.RecordAcknowledgementRequest) error {
+	id, err := uuid.Parse(req.GetReminderId())
+	if err != nil {
+		return fmt.Errorf("parse reminder id: %w", err)
+	}
+	return a.repo.RecordDelivery(ctx, acknowledgementRecord(id, req))
+}
+
+// NotifyAcknowledged сообщает создателю, что исполнитель подтвердил напоминание.
+// Каналы перебираются в порядке из настроек создателя до первой успешной отправки.
+func (a *Activities) NotifyAcknowledged(ctx context.Context, req *reminderv1.NotifyAcknowledgedRequest) error {
+	creatorID, err := uuid.Parse(req.GetCreatorId())
+	if err != nil {
+		return fmt.Errorf("parse creator id: %w", err)
+	}
+	assigneeID, err := uuid.Parse(req.GetAssigneeId())
+	if err != nil {
+		return fmt.Errorf("parse assignee id: %w", err)
+	}
+
+	settings, err := a.userRepo.GetNotificationSettings(ctx, creatorID)
+	if err != nil {
+		return err
+	}
+	if settings == nil {
+		return sdktemporal.NewNonRetryableApplicationError("creator not found", "NotifyConfiguration", nil)
+	}
+	assignee, err := a.userRepo.GetByID(ctx, assigneeID)
+	if err != nil {
+		return err
+	}
+	who := req.GetAssigneeId()
+	if assignee != nil {
+		who = assignee.Email
+		if assignee.FirstName != "" {
+			who = assignee.FirstName + " <" + assignee.Email + ">"
 		}
-		return a.bot.SendMessageWithMarkup(ctx, req.GetChatId(), text, markup)
 	}
 
-	return a.bot.SendMessage(ctx, req.GetChatId(), text)
+	to := notify.Recipient{
+		UserID:     creatorID.String(),
+		ChatID:     settings.TelegramChatID,
+		Email:      settings.Email,
+		WebhookURL: settings.WebhookURL,
+	}
+	msg := notify.Message{
+		Title:       "✅ Подтверждено: " + req.GetTitle(),
+		Description: "Исполнитель: " + who,
+	}
+
+	channels, err := notify.ParseList(settings.Channels)
+	if err != nil || len(channels) == 0 {
+		channels = notify.DefaultChannels
+	}
+	var errs []error
+	for _, ch := range channels {
+		if err := send(ctx, a.notifier(ch), to, msg); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", ch, err))
+			continue
+		}
+		return nil
+	}
+	return errors.Join(errs...)
+}
+
+// notifier возвращает отправителя для канала.
+func (a *Activities) notifier(ch notify.Channel) notify.Notifier {
+	switch ch {
+	case notify.ChannelInApp:
+		return a.inApp
+	case notify.ChannelEmail:
+		return a.email
+	case notify.ChannelWebhook:
+		return a.webhook
+	default:
+		return a.telegram
+	}
+}
+
+// channelMessage собирает уведомление из запроса к каналу.
+func channelMessage(req *reminderv1.ChannelNotificationRequest) notify.Message {
+	return notify.Message{
+		ReminderID:          req.GetReminderId(),
+		Title:               req.GetTitle(),
+		Description:         req.GetDescription(),
+		RequireConfirmation: req.GetRequireConfirmation(),
+		Priority:            parsePriority(req.GetPriority()),
+	}
+}
+
+// parsePriority разбирает приоритет из запроса; пустой или неизвестный — обычный.
+func parsePriority(raw string) model.ReminderPriority {
+	p, err := model.ReminderPriorityString(raw)
+	if err != nil {
+		return model.ReminderPriorityNormal
+	}
+	return p
+}
+
+// send отправляет уведомление через канал. Ошибки конфигурации не исправятся
+// повтором, поэтому помечаются non-retryable — workflow сразу переходит к
+// следующему каналу.
+func send(ctx context.Context, n notify.Notifier, to notify.Recipient, msg notify.Message) error {
+	err := n.Send(ctx, to, msg)
+	if errors.Is(err, notify.ErrNoAddress) || errors.Is(err, notify.ErrNotConfigured) || errors.Is(err, notify.ErrForbiddenAddress) {
+		return sdktemporal.NewNonRetryableApplicationError(err.Error(), "NotifyConfiguration", err)
+	}
+	return err
 }
 
 // UpdateReminderStatus обновляет статус напоминания в базе данных.
+// Для срабатываний повторяющегося напоминания статус пишется в историю срабатываний.
+// Вместе со статусом snoozed приходит snoozed_until — тогда учитывается откладывание.
 func (a *Activities) UpdateReminderStatus(ctx context.Context, req *reminderv1.UpdateReminderStatusRequest) error {
 	id, err := uuid.Parse(req.GetReminderId())
 	if err != nil {
 		return fmt.Errorf("parse reminder id: %w", err)
 	}
+	if until := req.GetSnoozedUntil(); until != nil {
+		if err := a.repo.RecordSnooze(ctx, id, until.AsTime()); err != nil {
+			return err
+		}
+	}
+	if workflowID := req.GetOccurrenceWorkflowId(); workflowID != "" {
+		return a.repo.RecordOccurrence(ctx, id, workflowID, req.GetStatus())
+	}
 	return a.repo.UpdateStatus(ctx, id, req.GetStatus())
 }
+
+// SaveReminderDetails сохраняет поля, изменённые через UpdateReminder.
+func (a *Activities) SaveReminderDetails(ctx context.Context, req *reminderv1.SaveReminderDetailsRequest) error {
+	id, err := uuid.Parse(req.GetReminderId())
+	if err != nil {
+		return fmt.Errorf("parse reminder id: %w", err)
+	}
+	var remindAt *time.Time
+	if ts := req.GetRemindAt(); ts != nil {
+		t := ts.AsTime()
+		remindAt = &t
+	}
+	return a.repo.UpdateDetails(ctx, id, req.GetTitle(), req.GetDescription(), remindAt)
+}
@@ -0,0 +1,56 @@
+package reminder
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/vovanwin/template/internal/pkg/notify"
+	"github.com/vovanwin/template/internal/repository"
+	reminderv1 "github.com/vovanwin/template/pkg/temporal/reminder"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/workflow"
+)
+
+func TestDeliveryRecord(t *testing.T) {
+	id := uuid.New()
+	info := activity.Info{WorkflowExecution: workflow.Execution{ID: "reminder-1"}, Attempt: 3}
+
+	got := deliveryRecord(info, id, 2, notify.ChannelTelegram, 777, nil)
+	want := repository.Delivery{
+		ReminderID:        id,
+		WorkflowID:        "reminder-1",
+		Delivery:          2,
+		Channel:           "telegram",
+		Attempt:           3,
+		Status:            repository.DeliverySent,
+		TelegramMessageID: 777,
+	}
+	if got != want {
+		t.Errorf("sent record = %+v, want %+v", got, want)
+	}
+
+	got = deliveryRecord(info, id, 1, notify.ChannelEmail, 0, errors.New("smtp: 421"))
+	if got.Status != repository.DeliveryFailed || got.Error != "smtp: 421" || got.Channel != "email" || got.Attempt != 3 {
+		t.Errorf("failed record = %+v", got)
+	}
+}
+
+func TestAcknowledgementRecord(t *testing.T) {
+	id := uuid.New()
+	got := acknowledgementRecord(id, &reminderv1.RecordAcknowledgementRequest{
+		ReminderId: id.String(),
+		WorkflowId: "reminder-1",
+		Delivery:   4,
+	})
+	want := repository.Delivery{
+		ReminderID: id,
+		WorkflowID: "reminder-1",
+		Delivery:   4,
+		Attempt:    1,
+		Status:     repository.DeliveryAcknowledged,
+	}
+	if got != want {
+		t.Errorf("acknowledgement record = %+v, want %+v", got, want)
+	}
+}
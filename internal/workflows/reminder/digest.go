@@ -0,0 +1,158 @@
+package reminder
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vovanwin/template/internal/model"
+	"github.com/vovanwin/template/internal/pkg/digest"
+	"github.com/vovanwin/template/internal/pkg/recurrence"
+	"github.com/vovanwin/template/internal/pkg/timezone"
+	"github.com/vovanwin/template/internal/repository"
+	reminderv1 "github.com/vovanwin/template/pkg/temporal/reminder"
+	"go.temporal.io/sdk/activity"
+	sdktemporal "go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxDigestOccurrences ограничивает число срабатываний одного повторяющегося
+// напоминания за день (cron каждые несколько минут) в сводке.
+const maxDigestOccurrences = digest.MaxItems
+
+// DailyDigest запускается расписанием пользователя (см. ReminderService.UpdateDigest)
+// и отправляет утреннюю сводку в Telegram и in-app.
+func (w *Workflows) DailyDigest(_ workflow.Context, input *reminderv1.DailyDigestWorkflowInput) (reminderv1.DailyDigestWorkflow, error) {
+	return &dailyDigestWorkflow{req: input.Req}, nil
+}
+
+type dailyDigestWorkflow struct {
+	req *reminderv1.DailyDigestRequest
+}
+
+// Execute собирает сводку на текущий день и отправляет её в каналы. Пустая
+// сводка не отправляется. Каналы независимы: сбой одного не отменяет другой,
+// а повтор activity не дублирует уже доставленное сообщение.
+func (w *dailyDigestWorkflow) Execute(ctx workflow.Context) error {
+	log := workflow.GetLogger(ctx)
+	userID := w.req.GetUserId()
+
+	d, err := reminderv1.BuildDailyDigest(ctx, &reminderv1.BuildDailyDigestRequest{
+		UserId: userID,
+		At:     timestamppb.New(workflow.Now(ctx)),
+	})
+	if err != nil {
+		return fmt.Errorf("build daily digest: %w", err)
+	}
+	if d.GetText() == "" {
+		log.Info("daily digest is empty", "user_id", userID)
+		return nil
+	}
+
+	if chatID := d.GetTelegramChatId(); chatID != 0 {
+		err := reminderv1.SendTelegramNotification(ctx, &reminderv1.SendTelegramNotificationRequest{
+			ChatId:      chatID,
+			Title:       d.GetTitle(),
+			Description: d.GetText(),
+		})
+		if err != nil {
+			log.Error("failed to send daily digest to telegram", "error", err, "user_id", userID)
+		}
+	}
+	err = reminderv1.SendInAppNotification(ctx, &reminderv1.ChannelNotificationRequest{
+		Title:       d.GetTitle(),
+		Description: d.GetText(),
+		Recipient:   userID,
+	})
+	if err != nil {
+		log.Error("failed to send daily digest in-app", "error", err, "user_id", userID)
+	}
+	return nil
+}
+
+// BuildDailyDigest собирает сводку на местные сутки пользователя, в которые
+// попадает req.at: напоминания, которые он получит сегодня, и вчерашние
+// разовые без подтверждения. Выключенная сводка и сводка без напоминаний
+// возвращаются пустыми.
+func (a *Activities) BuildDailyDigest(ctx context.Context, req *reminderv1.BuildDailyDigestRequest) (*reminderv1.BuildDailyDigestResponse, error) {
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, sdktemporal.NewNonRetryableApplicationError("parse user id", "DigestConfiguration", err)
+	}
+	settings, err := a.userRepo.GetNotificationSettings(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	resp := &reminderv1.BuildDailyDigestResponse{}
+	// Пользователь удалён или выключил сводку, а расписание ещё не удалено.
+	if settings == nil || !settings.DigestEnabled {
+		activity.GetLogger(ctx).Info("daily digest is disabled", "user_id", req.GetUserId())
+		return resp, nil
+	}
+
+	loc := timezone.Load(settings.Timezone)
+	start, end := digest.Day(req.GetAt().AsTime(), loc)
+	d := digest.Digest{Day: start}
+
+	active, err := a.repo.ListForCalendar(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, rem := range active {
+		for _, at := range occurrencesBetween(rem, start, end) {
+			d.Today = append(d.Today, digest.Item{Title: rem.Title, At: at, Priority: rem.Priority})
+		}
+	}
+	slices.SortStableFunc(d.Today, func(x, y digest.Item) int { return x.At.Compare(y.At) })
+
+	unacknowledged, err := a.repo.ListUnacknowledged(ctx, userID, start.AddDate(0, 0, -1), start)
+	if err != nil {
+		return nil, err
+	}
+	for _, rem := range unacknowledged {
+		d.Unacknowledged = append(d.Unacknowledged, digest.Item{Title: rem.Title, At: rem.RemindAt, Priority: rem.Priority})
+	}
+
+	if d.Empty() {
+		return resp, nil
+	}
+	resp.Title = d.Title()
+	resp.Text = d.Text()
+	resp.TelegramChatId = settings.TelegramChatID
+	return resp, nil
+}
+
+// occurrencesBetween возвращает срабатывания напоминания в [from, to):
+// у разового — время срабатывания с учётом откладывания, у повторяющегося —
+// срабатывания правила, не больше maxDigestOccurrences.
+func occurrencesBetween(rem repository.Reminder, from, to time.Time) []time.Time {
+	if !rem.IsRecurring() {
+		at := rem.RemindAt
+		if rem.Status == model.ReminderStatusSnoozed.String() && rem.SnoozedUntil != nil {
+			at = *rem.SnoozedUntil
+		}
+		if at.Before(from) || !at.Before(to) {
+			return nil
+		}
+		return []time.Time{at}
+	}
+
+	rule, err := recurrence.Parse(rem.RecurrenceRule)
+	if err != nil {
+		return nil
+	}
+	loc := timezone.Load(rem.Timezone)
+	var result []time.Time
+	for after := from.Add(-time.Nanosecond); len(result) < maxDigestOccurrences; {
+		next := rule.Next(rem.RemindAt, after, loc)
+		if next.IsZero() || !next.Before(to) {
+			break
+		}
+		result = append(result, next)
+		after = next
+	}
+	return result
+}
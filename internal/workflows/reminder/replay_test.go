@@ -0,0 +1,34 @@
+package reminder
+
+import (
+	"path/filepath"
+	"testing"
+
+	reminderv1 "github.com/vovanwin/template/pkg/temporal/reminder"
+	"go.temporal.io/sdk/worker"
+)
+
+// TestReplayHistories воспроизводит истории из testdata/histories на текущем
+// коде workflow. Ошибка означает, что изменение несовместимо с уже запущенными
+// напоминаниями и его нужно обернуть в workflow.GetVersion (см. versions.go).
+// Свежие истории выгружает команда cmd/histories.
+func TestReplayHistories(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("testdata", "histories", "*.json"))
+	if err != nil {
+		t.Fatalf("glob histories: %v", err)
+	}
+	if len(files) == 0 {
+		t.Skip("no histories in testdata/histories")
+	}
+
+	replayer := worker.NewWorkflowReplayer()
+	reminderv1.RegisterReminderWorkflows(replayer, NewWorkflows())
+
+	for _, file := range files {
+		t.Run(filepath.Base(file), func(t *testing.T) {
+			if err := replayer.ReplayWorkflowHistoryFromJSONFile(nil, file); err != nil {
+				t.Errorf("replay %s: %v", file, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package reminder
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/go-telegram/bot/models"
+	"github.com/vovanwin/template/internal/pkg/notify"
+	"github.com/vovanwin/template/internal/pkg/snooze"
+	"github.com/vovanwin/template/internal/pkg/telegram"
+	"github.com/vovanwin/template/internal/repository"
+)
+
+// photoTypes — форматы, которые Telegram принимает в sendPhoto; остальные
+// файлы отправляются документом.
+var photoTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+	"image/webp": true,
+}
+
+// TelegramNotifier отправляет уведомление в чат пользователя.
+// К уведомлению прикладываются кнопки откладывания, пунктов чек-листа и,
+// если нужно, подтверждения.
+type TelegramNotifier struct {
+	bot *telegram.Bot
+}
+
+func NewTelegramNotifier(bot *telegram.Bot) *TelegramNotifier {
+	return &TelegramNotifier{bot: bot}
+}
+
+func (n *TelegramNotifier) Send(ctx context.Context, to notify.Recipient, msg notify.Message) error {
+	_, err := n.SendMessage(ctx, to, msg, nil)
+	return err
+}
+
+// SendMessage отправляет уведомление с кнопками пунктов checklist и
+// возвращает ID сообщения в Telegram.
+func (n *TelegramNotifier) SendMessage(ctx context.Context, to notify.Recipient, msg notify.Message, checklist []repository.ChecklistItem) (int, error) {
+	if to.ChatID == 0 {
+		return 0, fmt.Errorf("%w: %s", notify.ErrNoAddress, notify.ChannelTelegram)
+	}
+
+	text := "🔔 " + msg.Text()
+	if msg.ReminderID == "" {
+		return n.bot.SendMessage(ctx, to.ChatID, text)
+	}
+
+	snoozeRow := make([]models.InlineKeyboardButton, 0, len(snooze.Options))
+	for _, o := range snooze.Options {
+		snoozeRow = append(snoozeRow, models.InlineKeyboardButton{
+			Text:         "⏰ " + o.Label,
+			CallbackData: fmt.Sprintf("snooze_reminder:%s:%s", msg.ReminderID, o.Key),
+		})
+	}
+	markup := &models.InlineKeyboardMarkup{
+		InlineKeyboard: append(telegram.ChecklistRows(checklist), snoozeRow),
+	}
+
+	if msg.RequireConfirmation {
+		markup.InlineKeyboard = append([][]models.InlineKeyboardButton{
+			{
+				{
+					Text:         "✅ Подтвердить",
+					CallbackData: fmt.Sprintf("ack_reminder:%s", msg.ReminderID),
+				},
+			},
+		}, markup.InlineKeyboard...)
+	}
+
+	return n.bot.SendMessageWithMarkup(ctx, to.ChatID, text, markup)
+}
+
+// SendAttachment отправляет вложение напоминания: изображение — фото,
+// остальное — документом с исходным именем файла.
+func (n *TelegramNotifier) SendAttachment(ctx context.Context, to notify.Recipient, a repository.Attachment, data io.Reader) error {
+	if to.ChatID == 0 {
+		return fmt.Errorf("%w: %s", notify.ErrNoAddress, notify.ChannelTelegram)
+	}
+	if photoTypes[a.ContentType] {
+		return n.bot.SendPhoto(ctx, to.ChatID, a.Filename, data, "")
+	}
+	return n.bot.SendDocument(ctx, to.ChatID, a.Filename, data, "")
+}
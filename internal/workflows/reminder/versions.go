@@ -0,0 +1,58 @@
+package reminder
+
+// Версионирование workflow ScheduleReminder.
+//
+// Разовое напоминание может ждать срабатывания до 30 дней, и всё это время
+// его workflow при каждом replay заново проходит Execute с самого начала.
+// Изменение, после которого workflow выдаёт другие команды (activity, таймеры,
+// маркеры, дочерние workflow) или выдаёт их в другом порядке, ломает replay
+// уже запущенных напоминаний с ошибкой недетерминизма. Поэтому:
+//
+//   - новая ветка поведения оборачивается в workflow.GetVersion с новым
+//     changeID из списка ниже, старая ветка остаётся под DefaultVersion
+//     (или под предыдущей версией того же changeID);
+//   - changeID не переименовываются и не переиспользуются, maxSupported
+//     только растёт;
+//   - старую ветку можно удалить (поднять minSupported), когда завершились
+//     все workflow, начатые до изменения, то есть не раньше чем через 30 дней
+//     после выкладки, и когда её истории не осталось в testdata/histories;
+//   - перед выкладкой истории работающих напоминаний выгружаются командой
+//     cmd/histories в testdata/histories, а TestReplayHistories проверяет,
+//     что текущий код воспроизводит их без ошибок.
+//
+// Без версии можно менять только то, что не влияет на команды: входные
+// данные activity, логи, обработку результатов, запросы (query).
+//
+// Без версии также остались изменения, новые команды которых зависят только
+// от полей запроса, которых нет у ранее запущенных workflow:
+//
+//   - каналы доставки: пустой channels отправляет через ту же activity
+//     SendTelegramNotification, а без chat_id завершает workflow статусом
+//     failed без activity, как и раньше;
+//   - эскалация (SendEscalation, RecordEscalation): шаги приходят в escalation;
+//   - уведомление создателя (NotifyAcknowledged): нужен creator_id;
+//   - откладывание и UpdateReminder: выполняются только по сигналу и update,
+//     которых старые workflow не получали.
+//
+// Тихие часы и запись подтверждения зависят от user_id и самого
+// подтверждения, которые были и раньше, поэтому они под версией.
+const (
+	// changeConfirmPolicy — ожидание подтверждения по политике confirm
+	// (окно, рост интервала, лимит повторов) и статус expired после окна.
+	// DefaultVersion — повтор каждые repeat_interval в течение 10 часов,
+	// после окна статус sent.
+	changeConfirmPolicy = "confirm-policy"
+	// changeQuietHours — проверка тихих часов получателя (CheckQuietHours)
+	// перед отправкой и повторами. DefaultVersion — отправка без проверки.
+	changeQuietHours = "quiet-hours"
+	// changeRecordAcknowledgement — запись подтверждения в историю доставки
+	// (RecordAcknowledgement). DefaultVersion — без записи.
+	changeRecordAcknowledgement = "record-acknowledgement"
+)
+
+// Поддерживаемые версии изменений.
+const (
+	confirmPolicyVersion         = 1
+	quietHoursVersion            = 1
+	recordAcknowledgementVersion = 1
+)
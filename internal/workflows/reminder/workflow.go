@@ -1,11 +1,18 @@
 package reminder
 
 import (
+	"errors"
+	"fmt"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/vovanwin/template/internal/model"
+	"github.com/vovanwin/template/internal/pkg/confirm"
+	"github.com/vovanwin/template/internal/pkg/notify"
 	reminderv1 "github.com/vovanwin/template/pkg/temporal/reminder"
 	"go.temporal.io/sdk/workflow"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // Workflows реализует интерфейс ReminderWorkflows.
@@ -15,12 +22,30 @@ func NewWorkflows() *Workflows {
 	return &Workflows{}
 }
 
+// maxTitleLength — длина колонки reminders.title.
+const maxTitleLength = 255
+
+// Ограничения цикла ожидания реакции на уведомление.
+const (
+	// snoozeWindow — сколько после отправки принимать откладывание
+	// для напоминаний без подтверждения.
+	snoozeWindow = 12 * time.Hour
+	// minSnooze — минимальная задержка при откладывании.
+	minSnooze = time.Minute
+	// quietRecheck — как часто во время тихих часов перепроверять настройки
+	// получателя: выключенный режим «не беспокоить» вступает в силу не позже.
+	quietRecheck = 30 * time.Minute
+)
+
 // ScheduleReminder запускает workflow: ждёт до remind_at, затем отправляет уведомление.
+// Уведомление можно отложить сигналом SnoozeReminder — workflow снова ждёт таймер.
 func (w *Workflows) ScheduleReminder(ctx workflow.Context, input *reminderv1.ScheduleReminderWorkflowInput) (reminderv1.ScheduleReminderWorkflow, error) {
 	return &scheduleReminderWorkflow{
 		req:         input.Req,
 		cancel:      input.CancelReminder,
 		acknowledge: input.AcknowledgeReminder,
+		snooze:      input.SnoozeReminder,
+		rescheduled: workflow.NewBufferedChannel(ctx, 1),
 		status:      model.ReminderStatusPending,
 	}, nil
 }
@@ -29,9 +54,38 @@ type scheduleReminderWorkflow struct {
 	req         *reminderv1.ScheduleReminderRequest
 	cancel      *reminderv1.CancelReminderSignal
 	acknowledge *reminderv1.AcknowledgeReminderSignal
+	snooze      *reminderv1.SnoozeReminderSignal
+	// rescheduled получает значение, когда UpdateReminder меняет время срабатывания.
+	rescheduled workflow.Channel
 	status      model.ReminderStatus
+	snoozeCount int
+	// fireAt — момент следующей отправки уведомления.
+	fireAt time.Time
+	// waiting — уведомление ещё не отправлено (или отложено), время можно менять.
+	waiting bool
+	// repeats — повторные отправки без подтверждения в текущем ожидании.
+	repeats int
+	// escalated — сколько шагов цепочки эскалации уже сработало.
+	escalated int
+	// deliveries — сколько раз уведомление отправлялось, включая повторы.
+	deliveries int
 }
 
+// outcome — чем закончилось ожидание в workflow.
+type outcome int
+
+const (
+	outcomeFired outcome = iota
+	outcomeAcknowledged
+	outcomeCancelled
+	outcomeSnoozed
+	outcomeRescheduled
+	outcomeTimeout
+	// outcomeExpired — окно подтверждения закончилось без подтверждения.
+	outcomeExpired
+	outcomeFailed
+)
+
 func (w *scheduleReminderWorkflow) Execute(ctx workflow.Context) (*reminderv1.ScheduleReminderResponse, error) {
 	log := workflow.GetLogger(ctx)
 	reminderID := w.req.GetReminderId()
@@ -39,6 +93,14 @@ func (w *scheduleReminderWorkflow) Execute(ctx workflow.Context) (*reminderv1.Sc
 
 	// Retry-политики и таймауты заданы в proto (reminder.proto) для каждой activity:
 	//   SendTelegramNotification: start_to_close=30s, max_attempts=5
+	//   SendInAppNotification:    start_to_close=10s, max_attempts=3
+	//   SendEmailNotification:    start_to_close=60s, max_attempts=4
+	//   SendWebhookNotification:  start_to_close=15s, max_attempts=6
+	//   SendEscalation:           start_to_close=30s, max_attempts=5
+	//   RecordEscalation:         start_to_close=10s, max_attempts=10
+	//   RecordAcknowledgement:    start_to_close=10s, max_attempts=10
+	//   CheckQuietHours:          start_to_close=10s, max_attempts=5
+	//   NotifyAcknowledged:       start_to_close=30s, max_attempts=5
 	//   UpdateReminderStatus:     start_to_close=10s, max_attempts=10
 	// Proto-сгенерированные хелперы автоматически применяют эти настройки.
 
@@ -46,167 +108,454 @@ func (w *scheduleReminderWorkflow) Execute(ctx workflow.Context) (*reminderv1.Sc
 		"reminder_id", reminderID,
 		"workflow_id", workflowID,
 		"remind_at", w.req.GetRemindAt().AsTime(),
+		"recurring", w.req.GetRecurring(),
 	)
 
 	// 1. Обновляем статус в БД на "processing"
 	w.setStatus(ctx, reminderID, model.ReminderStatusProcessing)
 
-	remindAt := w.req.GetRemindAt().AsTime()
-	now := workflow.Now(ctx)
-	duration := remindAt.Sub(now)
-	if duration < 0 {
-		duration = 0
+	// Срабатывания повторяющегося напоминания запускаются расписанием
+	// в нужный момент и приходят без remind_at — таймер срабатывает сразу.
+	w.fireAt = workflow.Now(ctx)
+	if remindAt := w.req.GetRemindAt(); remindAt != nil {
+		w.fireAt = remindAt.AsTime()
 	}
 
-	// Создаём таймер и канал отмены
-	timerCtx, timerCancel := workflow.WithCancel(ctx)
-	timerFuture := workflow.NewTimer(timerCtx, duration)
+	for {
+		// 2. Ждём до fireAt, отмены, откладывания или смены времени через UpdateReminder
+		w.waiting = true
+		result, snoozeFor := w.wait(ctx, max(w.fireAt.Sub(workflow.Now(ctx)), 0), false)
+		switch result {
+		case outcomeCancelled:
+			log.Info("reminder cancelled", "reminder_id", reminderID)
+			w.setStatus(ctx, reminderID, model.ReminderStatusCancelled)
+			return w.finish(ctx, workflowID), nil
+		case outcomeFailed:
+			// Таймер отменён не через наш сигнал (например terminate из Temporal UI)
+			w.setStatus(ctx, reminderID, model.ReminderStatusFailed)
+			return w.finish(ctx, workflowID), nil
+		case outcomeSnoozed:
+			w.snoozed(ctx, reminderID, snoozeFor)
+			continue
+		case outcomeRescheduled:
+			log.Info("reminder rescheduled", "reminder_id", reminderID, "remind_at", w.fireAt)
+			continue
+		}
 
-	// Selector для отслеживания таймера и сигнала отмены
-	sel := workflow.NewSelector(ctx)
+		// Тихие часы получателя: откладываем отправку, продолжая принимать
+		// отмену, откладывание и смену времени.
+		if d := w.quietDelay(ctx); d > 0 {
+			w.fireAt = workflow.Now(ctx).Add(d)
+			log.Info("reminder deferred by quiet hours", "reminder_id", reminderID, "until", w.fireAt)
+			continue
+		}
+		w.waiting = false
 
-	var cancelled bool
-	var timerErr error
+		if w.status == model.ReminderStatusSnoozed {
+			w.setStatus(ctx, reminderID, model.ReminderStatusProcessing)
+		}
 
-	sel.AddFuture(timerFuture, func(f workflow.Future) {
-		timerErr = f.Get(timerCtx, nil)
-	})
+		// 3. Отправляем уведомление по каналам пользователя по очереди.
+		// Каждая activity ретраится по своей политике из proto; после её провала
+		// пробуем следующий канал. Если не сработал ни один — workflow завершается
+		// со статусом "failed", а НЕ возвращает error (иначе Temporal бесконечно
+		// ретраит весь workflow).
+		if err := w.notify(ctx); err != nil {
+			log.Error("failed to send notification over all channels",
+				"error", err,
+				"reminder_id", reminderID,
+			)
+			w.setStatus(ctx, reminderID, model.ReminderStatusFailed)
+			return w.finish(ctx, workflowID), nil
+		}
 
-	sel.AddReceive(w.cancel.Channel, func(ch workflow.ReceiveChannel, more bool) {
-		cancelled = true
-		timerCancel()
-	})
+		// 4. Ждём реакции: подтверждения (с повторными уведомлениями), отмены или откладывания
+		result, snoozeFor = w.awaitReaction(ctx, reminderID)
+		if result == outcomeSnoozed {
+			w.snoozed(ctx, reminderID, snoozeFor)
+			continue
+		}
+		if result == outcomeCancelled {
+			log.Info("reminder cancelled after notification", "reminder_id", reminderID)
+			w.setStatus(ctx, reminderID, model.ReminderStatusCancelled)
+			return w.finish(ctx, workflowID), nil
+		}
 
-	sel.Select(ctx)
+		if result == outcomeAcknowledged {
+			w.recordAcknowledgement(ctx, reminderID)
+			w.notifyCreator(ctx, reminderID)
+		}
+		if result == outcomeExpired {
+			w.setStatus(ctx, reminderID, model.ReminderStatusExpired)
+			return w.finish(ctx, workflowID), nil
+		}
 
-	// 2. Обработка отмены
-	if cancelled {
-		log.Info("reminder cancelled", "reminder_id", reminderID)
-		w.setStatus(ctx, reminderID, model.ReminderStatusCancelled)
-		return w.response(workflowID), nil
+		// 5. Обновляем статус в БД на "sent"
+		log.Info("reminder sent", "reminder_id", reminderID, "snoozed", w.snoozeCount)
+		if w.status != model.ReminderStatusSent {
+			w.setStatus(ctx, reminderID, model.ReminderStatusSent)
+		}
+		return w.finish(ctx, workflowID), nil
 	}
+}
 
-	// Таймер отменён не через наш сигнал (например terminate из Temporal UI)
-	if timerErr != nil {
-		log.Error("timer error", "error", timerErr, "reminder_id", reminderID)
-		w.setStatus(ctx, reminderID, model.ReminderStatusFailed)
-		return w.response(workflowID), nil
+// awaitReaction ждёт реакции пользователя на отправленное уведомление.
+// С подтверждением: повторяет уведомление по политике confirm (интервал
+// repeat_interval, фиксированный или растущий, не больше max_resends раз) и
+// ждёт подтверждения до конца окна confirm_window; по мере повторов проходит
+// цепочку эскалации. Не подтверждённое в окно напоминание — outcomeExpired.
+// Повторы, выпавшие на тихие часы, не отправляются: после них уходит один
+// повтор, а время ожидания в тихие часы не учитывается в окне.
+// Без подтверждения: помечает напоминание отправленным и ещё snoozeWindow
+// принимает откладывание.
+func (w *scheduleReminderWorkflow) awaitReaction(ctx workflow.Context, reminderID string) (outcome, time.Duration) {
+	log := workflow.GetLogger(ctx)
+	policy := w.confirmPolicy()
+	awaitAck := w.req.GetRequireConfirmation() && policy.Interval > 0
+	expire := outcomeExpired
+	// fullDelay — повтор ждёт полный интервал, даже если окно закончится раньше.
+	fullDelay := false
+
+	window := snoozeWindow
+	if awaitAck {
+		// Напоминания, начатые до политики подтверждения, доживают по старым
+		// правилам: последний повтор уходит уже после окна.
+		if workflow.GetVersion(ctx, changeConfirmPolicy, workflow.DefaultVersion, confirmPolicyVersion) == workflow.DefaultVersion {
+			policy = confirm.Policy{Interval: policy.Interval, Window: confirm.DefaultWindow, Backoff: confirm.BackoffFixed}
+			expire = outcomeTimeout
+			fullDelay = true
+		}
+		window = policy.EffectiveWindow()
+	} else {
+		w.setStatus(ctx, reminderID, model.ReminderStatusSent)
 	}
+	deadline := workflow.Now(ctx).Add(window)
+	w.repeats = 0
+	// hold — сколько ещё ждать до перепроверки тихих часов вместо обычного повтора.
+	var hold time.Duration
+
+	for {
+		remaining := deadline.Sub(workflow.Now(ctx))
+		if remaining <= 0 {
+			if awaitAck {
+				log.Info("confirmation window expired", "reminder_id", reminderID, "repeats", w.repeats)
+				return expire, 0
+			}
+			return outcomeTimeout, 0
+		}
+
+		// С подтверждением ждём следующего повтора, пока они не исчерпаны, иначе до конца окна
+		wait := remaining
+		repeat := false
+		if awaitAck && !policy.Exhausted(w.repeats) {
+			if delay := policy.Delay(w.repeats); delay < remaining || fullDelay {
+				wait, repeat = delay, true
+			}
+		}
+		if hold > 0 {
+			wait, repeat = hold, true
+		}
+
+		result, snoozeFor := w.wait(ctx, wait, true)
+		switch result {
+		case outcomeAcknowledged:
+			log.Info("reminder acknowledged", "reminder_id", reminderID)
+			return outcomeAcknowledged, 0
+		case outcomeCancelled, outcomeSnoozed:
+			return result, snoozeFor
+		case outcomeFailed:
+			log.Error("repeat timer failed", "reminder_id", reminderID)
+			return outcomeTimeout, 0
+		}
+
+		if !repeat {
+			continue
+		}
+
+		if hold = w.quietDelay(ctx); hold > 0 {
+			deadline = deadline.Add(hold)
+			log.Info("reminder resend deferred by quiet hours", "reminder_id", reminderID, "for", hold)
+			continue
+		}
 
-	// 3. Проверяем chatID
-	chatID := w.req.GetTelegramChatId()
-	if chatID == 0 {
-		log.Error("telegram chat_id is 0, cannot send notification", "reminder_id", reminderID)
-		w.setStatus(ctx, reminderID, model.ReminderStatusFailed)
-		return w.response(workflowID), nil
+		// Повторная отправка уведомления
+		if err := w.notify(ctx); err != nil {
+			log.Error("failed to resend notification", "error", err, "reminder_id", reminderID)
+		}
+		w.repeats++
+		w.escalate(ctx, reminderID)
 	}
+}
 
-	// 4. Отправляем уведомление в Telegram
-	// При ошибке proto-хелпер сделает до 5 retry (заданы в proto).
-	// Если все попытки провалились — workflow завершается со статусом "failed",
-	// а НЕ возвращает error (иначе Temporal бесконечно ретраит весь workflow).
-	err := reminderv1.SendTelegramNotification(ctx, &reminderv1.SendTelegramNotificationRequest{
-		ChatId:              chatID,
-		Title:               w.req.GetTitle(),
-		Description:         w.req.GetDescription(),
-		RequireConfirmation: w.req.GetRequireConfirmation(),
-		ReminderId:          reminderID,
-	})
+// confirmPolicy собирает политику ожидания подтверждения из запроса.
+// Workflow, запущенные до появления политики, получают окно по умолчанию и
+// повторы с фиксированным интервалом без ограничения числа.
+func (w *scheduleReminderWorkflow) confirmPolicy() confirm.Policy {
+	backoff, err := confirm.ParseBackoff(w.req.GetResendBackoff())
 	if err != nil {
-		log.Error("failed to send telegram notification after retries",
-			"error", err,
-			"reminder_id", reminderID,
-		)
-		w.setStatus(ctx, reminderID, model.ReminderStatusFailed)
-		return w.response(workflowID), nil
+		backoff = confirm.BackoffFixed
 	}
+	return confirm.Policy{
+		Interval:   time.Duration(w.req.GetRepeatIntervalMinutes()) * time.Minute,
+		Window:     time.Duration(w.req.GetConfirmWindowMinutes()) * time.Minute,
+		Backoff:    backoff,
+		MaxResends: int(w.req.GetMaxResends()),
+	}
+}
 
-	// 5. Если требуется подтверждение — цикл повторных уведомлений
-	if w.req.GetRequireConfirmation() && w.req.GetRepeatIntervalMinutes() > 0 {
-		repeatInterval := time.Duration(w.req.GetRepeatIntervalMinutes()) * time.Minute
-		maxRetryDuration := 10 * time.Hour
-		startTime := workflow.Now(ctx)
-
-		for {
-			elapsed := workflow.Now(ctx).Sub(startTime)
-			if elapsed >= maxRetryDuration {
-				log.Info("confirmation timeout exceeded, marking as sent", "reminder_id", reminderID)
-				break
-			}
+// escalate уведомляет контакты шагов эскалации, порог которых достигнут.
+// Каждый шаг срабатывает один раз за workflow и сохраняется в БД, даже
+// если отправка не удалась.
+func (w *scheduleReminderWorkflow) escalate(ctx workflow.Context, reminderID string) {
+	steps := w.req.GetEscalation()
+	for w.escalated < len(steps) && int(steps[w.escalated].GetAfterRepeats()) <= w.repeats {
+		step := steps[w.escalated]
+		w.escalated++
+
+		record := &reminderv1.RecordEscalationRequest{
+			ReminderId: reminderID,
+			WorkflowId: workflow.GetInfo(ctx).WorkflowExecution.ID,
+			Step:       int32(w.escalated),
+			Channel:    step.GetChannel(),
+			Address:    step.GetAddress(),
+			Status:     "sent",
+		}
+		err := reminderv1.SendEscalation(ctx, &reminderv1.SendEscalationRequest{
+			ReminderId:  reminderID,
+			Title:       w.req.GetTitle(),
+			Description: w.req.GetDescription(),
+			Step:        record.Step,
+			Channel:     step.GetChannel(),
+			Address:     step.GetAddress(),
+			Repeats:     int32(w.repeats),
+		})
+		if err != nil {
+			workflow.GetLogger(ctx).Error("failed to send escalation",
+				"error", err,
+				"reminder_id", reminderID,
+				"step", record.Step,
+			)
+			record.Status = "failed"
+			record.Error = err.Error()
+		}
 
-			// Ждём repeat_interval, AcknowledgeReminder или CancelReminder
-			repeatTimerCtx, repeatTimerCancel := workflow.WithCancel(ctx)
-			repeatFuture := workflow.NewTimer(repeatTimerCtx, repeatInterval)
+		if err := reminderv1.RecordEscalation(ctx, record); err != nil {
+			workflow.GetLogger(ctx).Error("failed to record escalation", "error", err, "reminder_id", reminderID)
+		}
+	}
+}
 
-			repeatSel := workflow.NewSelector(ctx)
+// quietDelay возвращает, сколько ждать перед отправкой из-за тихих часов или
+// режима «не беспокоить» получателя, но не больше quietRecheck; 0 — отправлять
+// сразу. Настройки читает activity, поэтому решение записано в историю и
+// детерминировано при replay. Если activity не удалась, уведомление не задерживается.
+func (w *scheduleReminderWorkflow) quietDelay(ctx workflow.Context) time.Duration {
+	if w.req.GetOverrideQuietHours() || w.req.GetPriority() == model.ReminderPriorityHigh.String() || w.req.GetUserId() == "" {
+		return 0
+	}
+	// Напоминания, начатые до тихих часов, отправляются без проверки.
+	if workflow.GetVersion(ctx, changeQuietHours, workflow.DefaultVersion, quietHoursVersion) == workflow.DefaultVersion {
+		return 0
+	}
+	now := workflow.Now(ctx)
+	resp, err := reminderv1.CheckQuietHours(ctx, &reminderv1.CheckQuietHoursRequest{
+		UserId: w.req.GetUserId(),
+		At:     timestamppb.New(now),
+	})
+	if err != nil {
+		workflow.GetLogger(ctx).Error("failed to check quiet hours", "error", err, "reminder_id", w.req.GetReminderId())
+		return 0
+	}
+	if resp.GetUntil() == nil {
+		return 0
+	}
+	return min(max(resp.GetUntil().AsTime().Sub(now), 0), quietRecheck)
+}
 
-			var acknowledged, repeatCancelled bool
-			var repeatTimerErr error
+// recordAcknowledgement сохраняет подтверждение в историю доставки.
+// Напоминания, начатые до истории доставки, подтверждение не записывают.
+func (w *scheduleReminderWorkflow) recordAcknowledgement(ctx workflow.Context, reminderID string) {
+	if workflow.GetVersion(ctx, changeRecordAcknowledgement, workflow.DefaultVersion, recordAcknowledgementVersion) == workflow.DefaultVersion {
+		return
+	}
+	err := reminderv1.RecordAcknowledgement(ctx, &reminderv1.RecordAcknowledgementRequest{
+		ReminderId: reminderID,
+		WorkflowId: workflow.GetInfo(ctx).WorkflowExecution.ID,
+		Delivery:   int32(w.deliveries),
+	})
+	if err != nil {
+		workflow.GetLogger(ctx).Error("failed to record acknowledgement", "error", err, "reminder_id", reminderID)
+	}
+}
 
-			repeatSel.AddFuture(repeatFuture, func(f workflow.Future) {
-				repeatTimerErr = f.Get(repeatTimerCtx, nil)
-			})
+// notifyCreator сообщает создателю назначенного напоминания о подтверждении.
+// Для своих напоминаний creator_id пуст — activity не запускается.
+func (w *scheduleReminderWorkflow) notifyCreator(ctx workflow.Context, reminderID string) {
+	creatorID := w.req.GetCreatorId()
+	if creatorID == "" || creatorID == w.req.GetUserId() {
+		return
+	}
+	err := reminderv1.NotifyAcknowledged(ctx, &reminderv1.NotifyAcknowledgedRequest{
+		ReminderId: reminderID,
+		Title:      w.req.GetTitle(),
+		CreatorId:  creatorID,
+		AssigneeId: w.req.GetUserId(),
+	})
+	if err != nil {
+		workflow.GetLogger(ctx).Error("failed to notify creator", "error", err, "reminder_id", reminderID)
+	}
+}
 
-			repeatSel.AddReceive(w.acknowledge.Channel, func(ch workflow.ReceiveChannel, more bool) {
-				acknowledged = true
-				repeatTimerCancel()
-			})
+// wait ждёт d, сигнала отмены, откладывания и подтверждения (если acknowledgeable)
+// либо смены времени через UpdateReminder (если нет).
+func (w *scheduleReminderWorkflow) wait(ctx workflow.Context, d time.Duration, acknowledgeable bool) (outcome, time.Duration) {
+	timerCtx, timerCancel := workflow.WithCancel(ctx)
+	defer timerCancel()
 
-			repeatSel.AddReceive(w.cancel.Channel, func(ch workflow.ReceiveChannel, more bool) {
-				repeatCancelled = true
-				repeatTimerCancel()
-			})
+	result := outcomeFired
+	var snoozeFor time.Duration
 
-			repeatSel.Select(ctx)
+	sel := workflow.NewSelector(ctx)
+	sel.AddFuture(workflow.NewTimer(timerCtx, d), func(f workflow.Future) {
+		if err := f.Get(timerCtx, nil); err != nil {
+			workflow.GetLogger(ctx).Error("timer error", "error", err, "reminder_id", w.req.GetReminderId())
+			result = outcomeFailed
+		}
+	})
+	sel.AddReceive(w.cancel.Channel, func(ch workflow.ReceiveChannel, more bool) {
+		ch.Receive(ctx, nil)
+		result = outcomeCancelled
+	})
+	w.snooze.Select(sel, func(req *reminderv1.SnoozeReminderRequest) {
+		result = outcomeSnoozed
+		snoozeFor = max(time.Duration(req.GetDurationSeconds())*time.Second, minSnooze)
+	})
+	if acknowledgeable {
+		sel.AddReceive(w.acknowledge.Channel, func(ch workflow.ReceiveChannel, more bool) {
+			ch.Receive(ctx, nil)
+			result = outcomeAcknowledged
+		})
+	} else {
+		sel.AddReceive(w.rescheduled, func(ch workflow.ReceiveChannel, more bool) {
+			ch.Receive(ctx, nil)
+			result = outcomeRescheduled
+		})
+	}
 
-			if acknowledged {
-				log.Info("reminder acknowledged", "reminder_id", reminderID)
-				w.setStatus(ctx, reminderID, model.ReminderStatusSent)
-				return w.response(workflowID), nil
-			}
+	sel.Select(ctx)
+	return result, snoozeFor
+}
 
-			if repeatCancelled {
-				log.Info("reminder cancelled during confirmation loop", "reminder_id", reminderID)
-				w.setStatus(ctx, reminderID, model.ReminderStatusCancelled)
-				return w.response(workflowID), nil
-			}
+// snoozed фиксирует откладывание и переносит момент срабатывания.
+func (w *scheduleReminderWorkflow) snoozed(ctx workflow.Context, reminderID string, d time.Duration) {
+	fireAt := workflow.Now(ctx).Add(d)
+	w.fireAt = fireAt
+	w.snoozeCount++
+	workflow.GetLogger(ctx).Info("reminder snoozed",
+		"reminder_id", reminderID,
+		"until", fireAt,
+		"snooze_count", w.snoozeCount,
+	)
+	w.setStatusWith(ctx, reminderID, model.ReminderStatusSnoozed, func(req *reminderv1.UpdateReminderStatusRequest) {
+		req.SnoozedUntil = timestamppb.New(fireAt)
+	})
+}
 
-			if repeatTimerErr != nil {
-				log.Error("repeat timer error", "error", repeatTimerErr, "reminder_id", reminderID)
-				break
-			}
+// notify отправляет уведомление в первый канал, который его доставит.
+// Каналы без адреса пропускаются без activity. Workflow, запущенные до
+// появления каналов, приходят с пустым списком и используют только Telegram.
+// Все каналы одной отправки записываются в историю под одним номером.
+func (w *scheduleReminderWorkflow) notify(ctx workflow.Context) error {
+	w.deliveries++
+	channels := w.req.GetChannels()
+	if len(channels) == 0 {
+		channels = []string{string(notify.ChannelTelegram)}
+	}
 
-			// Повторная отправка уведомления
-			err := reminderv1.SendTelegramNotification(ctx, &reminderv1.SendTelegramNotificationRequest{
-				ChatId:              chatID,
-				Title:               w.req.GetTitle(),
-				Description:         w.req.GetDescription(),
-				RequireConfirmation: true,
-				ReminderId:          reminderID,
-			})
-			if err != nil {
-				log.Error("failed to resend notification", "error", err, "reminder_id", reminderID)
-			}
+	var errs []error
+	for _, name := range channels {
+		err := w.notifyChannel(ctx, notify.Channel(name))
+		if err == nil {
+			return nil
 		}
+		workflow.GetLogger(ctx).Warn("notification channel failed",
+			"channel", name,
+			"error", err,
+			"reminder_id", w.req.GetReminderId(),
+		)
+		errs = append(errs, fmt.Errorf("%s: %w", name, err))
 	}
+	return errors.Join(errs...)
+}
 
-	// 6. Обновляем статус в БД на "sent"
-	log.Info("reminder sent", "reminder_id", reminderID, "chat_id", chatID)
-	w.setStatus(ctx, reminderID, model.ReminderStatusSent)
+// notifyChannel отправляет уведомление в один канал.
+func (w *scheduleReminderWorkflow) notifyChannel(ctx workflow.Context, channel notify.Channel) error {
+	req := &reminderv1.ChannelNotificationRequest{
+		ReminderId:          w.req.GetReminderId(),
+		Title:               w.req.GetTitle(),
+		Description:         w.req.GetDescription(),
+		RequireConfirmation: w.req.GetRequireConfirmation(),
+		Priority:            w.req.GetPriority(),
+		Delivery:            int32(w.deliveries),
+	}
 
-	return w.response(workflowID), nil
+	switch channel {
+	case notify.ChannelTelegram:
+		chatID := w.req.GetTelegramChatId()
+		if chatID == 0 {
+			return notify.ErrNoAddress
+		}
+		return reminderv1.SendTelegramNotification(ctx, &reminderv1.SendTelegramNotificationRequest{
+			ChatId:              chatID,
+			Title:               req.Title,
+			Description:         req.Description,
+			RequireConfirmation: req.RequireConfirmation,
+			ReminderId:          req.ReminderId,
+			Priority:            req.Priority,
+			Delivery:            req.Delivery,
+		})
+	case notify.ChannelInApp:
+		req.Recipient = w.req.GetUserId()
+		return reminderv1.SendInAppNotification(ctx, req)
+	case notify.ChannelEmail:
+		if req.Recipient = w.req.GetEmail(); req.Recipient == "" {
+			return notify.ErrNoAddress
+		}
+		return reminderv1.SendEmailNotification(ctx, req)
+	case notify.ChannelWebhook:
+		if req.Recipient = w.req.GetWebhookUrl(); req.Recipient == "" {
+			return notify.ErrNoAddress
+		}
+		return reminderv1.SendWebhookNotification(ctx, req)
+	default:
+		return notify.ErrUnknownChannel
+	}
 }
 
 // setStatus обновляет статус в workflow и в БД через activity.
 func (w *scheduleReminderWorkflow) setStatus(ctx workflow.Context, reminderID string, status model.ReminderStatus) {
+	w.setStatusWith(ctx, reminderID, status, nil)
+}
+
+// setStatusWith обновляет статус, дополняя запрос к activity через fill.
+func (w *scheduleReminderWorkflow) setStatusWith(ctx workflow.Context, reminderID string, status model.ReminderStatus, fill func(*reminderv1.UpdateReminderStatusRequest)) {
 	w.status = status
 	if reminderID == "" {
 		return
 	}
-	err := reminderv1.UpdateReminderStatus(ctx, &reminderv1.UpdateReminderStatusRequest{
+	req := &reminderv1.UpdateReminderStatusRequest{
 		ReminderId: reminderID,
 		Status:     status.String(),
-	})
+	}
+	// Срабатывание повторяющегося напоминания меняет статус только своей
+	// записи в reminder_occurrences, само напоминание остаётся активным.
+	if w.req.GetRecurring() {
+		req.OccurrenceWorkflowId = workflow.GetInfo(ctx).WorkflowExecution.ID
+	}
+	if fill != nil {
+		fill(req)
+	}
+	err := reminderv1.UpdateReminderStatus(ctx, req)
 	if err != nil {
 		workflow.GetLogger(ctx).Error("failed to update reminder status",
 			"error", err,
@@ -216,6 +565,73 @@ func (w *scheduleReminderWorkflow) setStatus(ctx workflow.Context, reminderID st
 	}
 }
 
+// ValidateUpdateReminder отклоняет изменение до записи в историю workflow.
+func (w *scheduleReminderWorkflow) ValidateUpdateReminder(ctx workflow.Context, req *reminderv1.UpdateReminderRequest) error {
+	title := strings.TrimSpace(req.GetTitle())
+	switch {
+	case title == "":
+		return errors.New("title is required")
+	case utf8.RuneCountInString(title) > maxTitleLength:
+		return fmt.Errorf("title must be at most %d characters", maxTitleLength)
+	case w.status == model.ReminderStatusSent || w.status == model.ReminderStatusCancelled || w.status == model.ReminderStatusFailed || w.status == model.ReminderStatusExpired:
+		return fmt.Errorf("reminder is already %s", w.status)
+	}
+
+	if req.GetRemindAt() == nil {
+		return nil
+	}
+	switch {
+	case w.req.GetRecurring():
+		return errors.New("remind_at of a recurring occurrence cannot be changed")
+	case !w.waiting:
+		return errors.New("reminder has already fired, snooze it instead")
+	case !req.GetRemindAt().AsTime().After(workflow.Now(ctx)):
+		return errors.New("remind_at must be in the future")
+	}
+	return nil
+}
+
+// UpdateReminder сохраняет новые поля в БД и применяет их к workflow.
+// Новое время сразу перезапускает ожидание таймера.
+func (w *scheduleReminderWorkflow) UpdateReminder(ctx workflow.Context, req *reminderv1.UpdateReminderRequest) (*reminderv1.UpdateReminderResponse, error) {
+	title := strings.TrimSpace(req.GetTitle())
+	err := reminderv1.SaveReminderDetails(ctx, &reminderv1.SaveReminderDetailsRequest{
+		ReminderId:  w.req.GetReminderId(),
+		Title:       title,
+		Description: req.GetDescription(),
+		RemindAt:    req.GetRemindAt(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("save reminder details: %w", err)
+	}
+
+	w.req.Title = title
+	w.req.Description = req.GetDescription()
+	if remindAt := req.GetRemindAt(); remindAt != nil {
+		w.req.RemindAt = remindAt
+		w.fireAt = remindAt.AsTime()
+		w.rescheduled.SendAsync(true)
+	}
+
+	workflow.GetLogger(ctx).Info("reminder updated",
+		"reminder_id", w.req.GetReminderId(),
+		"remind_at", w.fireAt,
+	)
+
+	return &reminderv1.UpdateReminderResponse{
+		Status:   w.status.String(),
+		RemindAt: timestamppb.New(w.fireAt),
+	}, nil
+}
+
+// finish дожидается незавершённых обработчиков UpdateReminder и формирует ответ.
+func (w *scheduleReminderWorkflow) finish(ctx workflow.Context, workflowID string) *reminderv1.ScheduleReminderResponse {
+	if err := workflow.Await(ctx, func() bool { return workflow.AllHandlersFinished(ctx) }); err != nil {
+		workflow.GetLogger(ctx).Warn("waiting for update handlers", "error", err)
+	}
+	return w.response(workflowID)
+}
+
 // response формирует ответ с текущим статусом.
 func (w *scheduleReminderWorkflow) response(workflowID string) *reminderv1.ScheduleReminderResponse {
 	return &reminderv1.ScheduleReminderResponse{
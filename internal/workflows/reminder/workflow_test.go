@@ -0,0 +1,605 @@
+package reminder
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	reminderv1 "github.com/vovanwin/template/pkg/temporal/reminder"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/testsuite"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// testStart — момент запуска workflow во всех тестах; remind_at — через час.
+var testStart = time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+
+// deliveryCall — одна попытка activity отправки уведомления.
+type deliveryCall struct {
+	channel  string
+	delivery int32
+	attempt  int32
+	failed   bool
+}
+
+// fakeActivities записывает вызовы activity вместе со временем тестовых часов.
+// Обработчики выполняются вне горутины workflow, поэтому поля под мьютексом.
+type fakeActivities struct {
+	env *testsuite.TestWorkflowEnvironment
+
+	mu          sync.Mutex
+	statuses    []string
+	sends       []time.Time
+	deliveries  []deliveryCall
+	escalations []*reminderv1.SendEscalationRequest
+	acks        []int32
+	saved       []*reminderv1.SaveReminderDetailsRequest
+	// quietUntil возвращает конец тихих часов в момент at; нулевое время — не тихо.
+	quietUntil func(at time.Time) time.Time
+	// failing — каналы, отправка в которые всегда завершается ошибкой.
+	failing map[string]bool
+}
+
+// deliver записывает попытку отправки в канал и возвращает её результат.
+func (f *fakeActivities) deliver(ctx context.Context, channel string, delivery int32) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	call := deliveryCall{channel: channel, delivery: delivery, attempt: activity.GetInfo(ctx).Attempt, failed: f.failing[channel]}
+	f.deliveries = append(f.deliveries, call)
+	if call.failed {
+		return errors.New(channel + " unavailable")
+	}
+	if channel == "telegram" {
+		f.sends = append(f.sends, f.env.Now())
+	}
+	return nil
+}
+
+func (f *fakeActivities) BuildDailyDigest(context.Context, *reminderv1.BuildDailyDigestRequest) (*reminderv1.BuildDailyDigestResponse, error) {
+	return &reminderv1.BuildDailyDigestResponse{}, nil
+}
+
+func (f *fakeActivities) CheckQuietHours(_ context.Context, req *reminderv1.CheckQuietHoursRequest) (*reminderv1.CheckQuietHoursResponse, error) {
+	if f.quietUntil == nil {
+		return &reminderv1.CheckQuietHoursResponse{}, nil
+	}
+	until := f.quietUntil(req.GetAt().AsTime())
+	if until.IsZero() {
+		return &reminderv1.CheckQuietHoursResponse{}, nil
+	}
+	return &reminderv1.CheckQuietHoursResponse{Until: timestamppb.New(until)}, nil
+}
+
+func (f *fakeActivities) NotifyAcknowledged(context.Context, *reminderv1.NotifyAcknowledgedRequest) error {
+	return nil
+}
+
+func (f *fakeActivities) RecordAcknowledgement(_ context.Context, req *reminderv1.RecordAcknowledgementRequest) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.acks = append(f.acks, req.GetDelivery())
+	return nil
+}
+
+func (f *fakeActivities) RecordEscalation(context.Context, *reminderv1.RecordEscalationRequest) error {
+	return nil
+}
+
+func (f *fakeActivities) SaveReminderDetails(_ context.Context, req *reminderv1.SaveReminderDetailsRequest) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.saved = append(f.saved, req)
+	return nil
+}
+
+func (f *fakeActivities) SendEmailNotification(ctx context.Context, req *reminderv1.ChannelNotificationRequest) error {
+	return f.deliver(ctx, "email", req.GetDelivery())
+}
+
+func (f *fakeActivities) SendEscalation(_ context.Context, req *reminderv1.SendEscalationRequest) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.escalations = append(f.escalations, req)
+	return nil
+}
+
+func (f *fakeActivities) SendInAppNotification(ctx context.Context, req *reminderv1.ChannelNotificationRequest) error {
+	return f.deliver(ctx, "in_app", req.GetDelivery())
+}
+
+func (f *fakeActivities) SendTelegramNotification(ctx context.Context, req *reminderv1.SendTelegramNotificationRequest) error {
+	return f.deliver(ctx, "telegram", req.GetDelivery())
+}
+
+func (f *fakeActivities) SendWebhookNotification(ctx context.Context, req *reminderv1.ChannelNotificationRequest) error {
+	return f.deliver(ctx, "webhook", req.GetDelivery())
+}
+
+func (f *fakeActivities) UpdateReminderStatus(_ context.Context, req *reminderv1.UpdateReminderStatusRequest) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.statuses = append(f.statuses, req.GetStatus())
+	return nil
+}
+
+// newTestEnv создаёт тестовое окружение с workflow напоминания и fakeActivities.
+func newTestEnv(t *testing.T) (*testsuite.TestWorkflowEnvironment, *fakeActivities) {
+	t.Helper()
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestWorkflowEnvironment()
+	env.SetStartTime(testStart)
+
+	acts := &fakeActivities{env: env}
+	reminderv1.RegisterReminderWorkflows(env, NewWorkflows())
+	reminderv1.RegisterReminderActivities(env, acts)
+	return env, acts
+}
+
+// testRequest — напоминание в Telegram через час после testStart.
+func testRequest() *reminderv1.ScheduleReminderRequest {
+	return &reminderv1.ScheduleReminderRequest{
+		ReminderId:     "4f7a4d0e-8a51-4c1b-9d43-2f0c9a1e6b11",
+		Title:          "Позвонить врачу",
+		RemindAt:       timestamppb.New(testStart.Add(time.Hour)),
+		TelegramChatId: 123456789,
+	}
+}
+
+// signalAt посылает сигнал в момент testStart+after.
+func signalAt(env *testsuite.TestWorkflowEnvironment, after time.Duration, name string, arg any) {
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow(name, arg)
+	}, after)
+}
+
+// snoozeFor — сигнал откладывания на d.
+func snoozeFor(d time.Duration) *reminderv1.SnoozeReminderRequest {
+	return &reminderv1.SnoozeReminderRequest{DurationSeconds: int64(d / time.Second)}
+}
+
+// runReminder выполняет workflow и возвращает его итоговый статус.
+func runReminder(t *testing.T, env *testsuite.TestWorkflowEnvironment, req *reminderv1.ScheduleReminderRequest) string {
+	t.Helper()
+	env.ExecuteWorkflow(reminderv1.ScheduleReminderWorkflowName, req)
+	if !env.IsWorkflowCompleted() {
+		t.Fatal("workflow is not completed")
+	}
+	if err := env.GetWorkflowError(); err != nil {
+		t.Fatalf("workflow error: %v", err)
+	}
+	var resp reminderv1.ScheduleReminderResponse
+	if err := env.GetWorkflowResult(&resp); err != nil {
+		t.Fatalf("workflow result: %v", err)
+	}
+	return resp.GetStatus()
+}
+
+// offsets переводит моменты отправки в смещения от testStart.
+func offsets(times []time.Time) []time.Duration {
+	out := make([]time.Duration, len(times))
+	for i, at := range times {
+		out[i] = at.Sub(testStart)
+	}
+	return out
+}
+
+func checkSends(t *testing.T, acts *fakeActivities, want ...time.Duration) {
+	t.Helper()
+	if got := offsets(acts.sends); !slices.Equal(got, want) {
+		t.Errorf("sends at %v, want %v", got, want)
+	}
+}
+
+func checkStatuses(t *testing.T, acts *fakeActivities, want ...string) {
+	t.Helper()
+	if !slices.Equal(acts.statuses, want) {
+		t.Errorf("statuses = %v, want %v", acts.statuses, want)
+	}
+}
+
+func TestSnoozeBeforeSend(t *testing.T) {
+	env, acts := newTestEnv(t)
+	signalAt(env, 30*time.Minute, reminderv1.SnoozeReminderSignalName, snoozeFor(2*time.Hour))
+
+	if status := runReminder(t, env, testRequest()); status != "sent" {
+		t.Errorf("status = %s, want sent", status)
+	}
+	checkSends(t, acts, 2*time.Hour+30*time.Minute)
+	checkStatuses(t, acts, "processing", "snoozed", "processing", "sent")
+}
+
+func TestSnoozeAfterSend(t *testing.T) {
+	env, acts := newTestEnv(t)
+	signalAt(env, time.Hour+10*time.Minute, reminderv1.SnoozeReminderSignalName, snoozeFor(time.Hour))
+
+	if status := runReminder(t, env, testRequest()); status != "sent" {
+		t.Errorf("status = %s, want sent", status)
+	}
+	checkSends(t, acts, time.Hour, 2*time.Hour+10*time.Minute)
+	checkStatuses(t, acts, "processing", "sent", "snoozed", "processing", "sent")
+}
+
+func TestSnoozeWindowCloses(t *testing.T) {
+	env, acts := newTestEnv(t)
+
+	if status := runReminder(t, env, testRequest()); status != "sent" {
+		t.Errorf("status = %s, want sent", status)
+	}
+	checkSends(t, acts, time.Hour)
+	checkStatuses(t, acts, "processing", "sent")
+	if got, want := env.Now().Sub(testStart), time.Hour+snoozeWindow; got != want {
+		t.Errorf("workflow finished after %s, want %s", got, want)
+	}
+}
+
+func TestSnoozeMinimum(t *testing.T) {
+	env, acts := newTestEnv(t)
+	signalAt(env, time.Hour+time.Minute, reminderv1.SnoozeReminderSignalName, snoozeFor(time.Second))
+
+	runReminder(t, env, testRequest())
+	checkSends(t, acts, time.Hour, time.Hour+time.Minute+minSnooze)
+}
+
+func TestSnoozeWhileAwaitingConfirmation(t *testing.T) {
+	env, acts := newTestEnv(t)
+	req := testRequest()
+	req.RequireConfirmation = true
+	req.RepeatIntervalMinutes = 30
+	req.ConfirmWindowMinutes = 120
+	signalAt(env, time.Hour+10*time.Minute, reminderv1.SnoozeReminderSignalName, snoozeFor(time.Hour))
+	signalAt(env, 2*time.Hour+20*time.Minute, reminderv1.AcknowledgeReminderSignalName, nil)
+
+	if status := runReminder(t, env, req); status != "sent" {
+		t.Errorf("status = %s, want sent", status)
+	}
+	checkSends(t, acts, time.Hour, 2*time.Hour+10*time.Minute)
+	checkStatuses(t, acts, "processing", "snoozed", "processing", "sent")
+	if !slices.Equal(acts.acks, []int32{2}) {
+		t.Errorf("acknowledged deliveries = %v, want [2]", acts.acks)
+	}
+}
+
+func TestSnoozeDuringQuietHours(t *testing.T) {
+	env, acts := newTestEnv(t)
+	req := testRequest()
+	req.UserId = "8d3c2b1a-0f9e-4d8c-b7a6-5e4d3c2b1a09"
+	quietEnd := testStart.Add(3 * time.Hour)
+	acts.quietUntil = func(at time.Time) time.Time {
+		if at.Before(quietEnd) {
+			return quietEnd
+		}
+		return time.Time{}
+	}
+	signalAt(env, time.Hour+10*time.Minute, reminderv1.SnoozeReminderSignalName, snoozeFor(4*time.Hour))
+
+	if status := runReminder(t, env, req); status != "sent" {
+		t.Errorf("status = %s, want sent", status)
+	}
+	checkSends(t, acts, 5*time.Hour+10*time.Minute)
+	checkStatuses(t, acts, "processing", "snoozed", "processing", "sent")
+}
+
+func TestConfirmPolicy(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  func(req *reminderv1.ScheduleReminderRequest)
+		ackAt   time.Duration
+		quiet   [2]time.Duration
+		status  string
+		sends   []time.Duration
+		finish  time.Duration
+		repeats []int32
+	}{
+		{
+			name: "fixed interval expires with the window",
+			policy: func(req *reminderv1.ScheduleReminderRequest) {
+				req.RepeatIntervalMinutes, req.ConfirmWindowMinutes = 30, 120
+			},
+			status: "expired",
+			sends:  []time.Duration{time.Hour, 90 * time.Minute, 2 * time.Hour, 150 * time.Minute},
+			finish: 3 * time.Hour,
+		},
+		{
+			name: "exponential backoff doubles the interval",
+			policy: func(req *reminderv1.ScheduleReminderRequest) {
+				req.RepeatIntervalMinutes, req.ConfirmWindowMinutes, req.ResendBackoff = 15, 120, "exponential"
+			},
+			status: "expired",
+			sends:  []time.Duration{time.Hour, 75 * time.Minute, 105 * time.Minute, 165 * time.Minute},
+			finish: 3 * time.Hour,
+		},
+		{
+			name: "max resends stops repeats before the window ends",
+			policy: func(req *reminderv1.ScheduleReminderRequest) {
+				req.RepeatIntervalMinutes, req.ConfirmWindowMinutes, req.MaxResends = 30, 240, 2
+			},
+			status: "expired",
+			sends:  []time.Duration{time.Hour, 90 * time.Minute, 2 * time.Hour},
+			finish: 5 * time.Hour,
+		},
+		{
+			name: "acknowledgement stops repeats",
+			policy: func(req *reminderv1.ScheduleReminderRequest) {
+				req.RepeatIntervalMinutes, req.ConfirmWindowMinutes = 30, 120
+			},
+			ackAt:  100 * time.Minute,
+			status: "sent",
+			sends:  []time.Duration{time.Hour, 90 * time.Minute},
+			finish: 100 * time.Minute,
+		},
+		{
+			name: "escalation steps fire at their repeat",
+			policy: func(req *reminderv1.ScheduleReminderRequest) {
+				req.RepeatIntervalMinutes, req.ConfirmWindowMinutes = 30, 180
+				req.Escalation = []*reminderv1.EscalationStep{
+					{AfterRepeats: 1, Channel: "telegram", Address: "111"},
+					{AfterRepeats: 3, Channel: "email", Address: "boss@example.com"},
+					{AfterRepeats: 10, Channel: "telegram", Address: "222"},
+				}
+			},
+			status:  "expired",
+			sends:   []time.Duration{time.Hour, 90 * time.Minute, 2 * time.Hour, 150 * time.Minute, 3 * time.Hour, 210 * time.Minute},
+			finish:  4 * time.Hour,
+			repeats: []int32{1, 3},
+		},
+		{
+			name: "quiet hours hold a repeat and extend the window",
+			policy: func(req *reminderv1.ScheduleReminderRequest) {
+				req.RepeatIntervalMinutes, req.ConfirmWindowMinutes = 30, 120
+			},
+			quiet:  [2]time.Duration{80 * time.Minute, 130 * time.Minute},
+			status: "expired",
+			sends:  []time.Duration{time.Hour, 130 * time.Minute, 160 * time.Minute, 190 * time.Minute},
+			finish: 220 * time.Minute,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env, acts := newTestEnv(t)
+			req := testRequest()
+			req.RequireConfirmation = true
+			tt.policy(req)
+			if tt.quiet[1] > 0 {
+				req.UserId = "8d3c2b1a-0f9e-4d8c-b7a6-5e4d3c2b1a09"
+				from, until := testStart.Add(tt.quiet[0]), testStart.Add(tt.quiet[1])
+				acts.quietUntil = func(at time.Time) time.Time {
+					if !at.Before(from) && at.Before(until) {
+						return until
+					}
+					return time.Time{}
+				}
+			}
+			if tt.ackAt > 0 {
+				signalAt(env, tt.ackAt, reminderv1.AcknowledgeReminderSignalName, nil)
+			}
+
+			if status := runReminder(t, env, req); status != tt.status {
+				t.Errorf("status = %s, want %s", status, tt.status)
+			}
+			checkSends(t, acts, tt.sends...)
+			checkStatuses(t, acts, "processing", tt.status)
+			if got := env.Now().Sub(testStart); got != tt.finish {
+				t.Errorf("workflow finished after %s, want %s", got, tt.finish)
+			}
+
+			var repeats []int32
+			for i, esc := range acts.escalations {
+				if esc.GetStep() != int32(i+1) {
+					t.Errorf("escalation %d has step %d", i, esc.GetStep())
+				}
+				repeats = append(repeats, esc.GetRepeats())
+			}
+			if !slices.Equal(repeats, tt.repeats) {
+				t.Errorf("escalations at repeats %v, want %v", repeats, tt.repeats)
+			}
+		})
+	}
+}
+
+// updateResult — чем закончился вызов UpdateReminder в тесте.
+type updateResult struct {
+	accepted bool
+	rejected error
+	resp     *reminderv1.UpdateReminderResponse
+}
+
+// updateAt вызывает UpdateReminder в момент testStart+after.
+func updateAt(env *testsuite.TestWorkflowEnvironment, after time.Duration, req *reminderv1.UpdateReminderRequest) *updateResult {
+	res := &updateResult{}
+	env.RegisterDelayedCallback(func() {
+		env.UpdateWorkflow(reminderv1.UpdateReminderUpdateName, "", &testsuite.TestUpdateCallback{
+			OnAccept: func() { res.accepted = true },
+			OnReject: func(err error) { res.rejected = err },
+			OnComplete: func(v interface{}, err error) {
+				if resp, ok := v.(*reminderv1.UpdateReminderResponse); ok {
+					res.resp = resp
+				}
+			},
+		}, req)
+	}, after)
+	return res
+}
+
+func TestUpdateReminder(t *testing.T) {
+	at := func(d time.Duration) *timestamppb.Timestamp { return timestamppb.New(testStart.Add(d)) }
+	tests := []struct {
+		name     string
+		confirm  bool
+		after    time.Duration
+		update   *reminderv1.UpdateReminderRequest
+		accepted bool
+		sends    []time.Duration
+	}{
+		{
+			name:     "new time moves the timer",
+			after:    30 * time.Minute,
+			update:   &reminderv1.UpdateReminderRequest{Title: "Позвонить в клинику", RemindAt: at(3 * time.Hour)},
+			accepted: true,
+			sends:    []time.Duration{3 * time.Hour},
+		},
+		{
+			name:     "earlier time fires sooner",
+			after:    10 * time.Minute,
+			update:   &reminderv1.UpdateReminderRequest{Title: "Позвонить врачу", RemindAt: at(20 * time.Minute)},
+			accepted: true,
+			sends:    []time.Duration{20 * time.Minute},
+		},
+		{
+			name:     "title only keeps the timer",
+			after:    30 * time.Minute,
+			update:   &reminderv1.UpdateReminderRequest{Title: "Позвонить в клинику"},
+			accepted: true,
+			sends:    []time.Duration{time.Hour},
+		},
+		{
+			name:   "time in the past",
+			after:  30 * time.Minute,
+			update: &reminderv1.UpdateReminderRequest{Title: "Позвонить врачу", RemindAt: at(15 * time.Minute)},
+			sends:  []time.Duration{time.Hour},
+		},
+		{
+			name:   "empty title",
+			after:  30 * time.Minute,
+			update: &reminderv1.UpdateReminderRequest{Title: "  ", RemindAt: at(3 * time.Hour)},
+			sends:  []time.Duration{time.Hour},
+		},
+		{
+			name:   "sent reminder in the snooze window",
+			after:  2 * time.Hour,
+			update: &reminderv1.UpdateReminderRequest{Title: "Позвонить в клинику"},
+			sends:  []time.Duration{time.Hour},
+		},
+		{
+			name:    "new time while awaiting confirmation",
+			confirm: true,
+			after:   70 * time.Minute,
+			update:  &reminderv1.UpdateReminderRequest{Title: "Позвонить врачу", RemindAt: at(5 * time.Hour)},
+			sends:   []time.Duration{time.Hour, 90 * time.Minute, 2 * time.Hour, 150 * time.Minute},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env, acts := newTestEnv(t)
+			req := testRequest()
+			if tt.confirm {
+				req.RequireConfirmation = true
+				req.RepeatIntervalMinutes, req.ConfirmWindowMinutes = 30, 120
+			}
+			res := updateAt(env, tt.after, tt.update)
+
+			runReminder(t, env, req)
+			checkSends(t, acts, tt.sends...)
+			if res.accepted != tt.accepted {
+				t.Fatalf("accepted = %v, rejected with %v, want accepted = %v", res.accepted, res.rejected, tt.accepted)
+			}
+			if !tt.accepted {
+				if res.rejected == nil {
+					t.Error("update was not rejected")
+				}
+				if len(acts.saved) != 0 {
+					t.Errorf("rejected update saved %d times", len(acts.saved))
+				}
+				return
+			}
+
+			if len(acts.saved) != 1 || acts.saved[0].GetTitle() != tt.update.GetTitle() {
+				t.Errorf("saved = %v, want one save with title %q", acts.saved, tt.update.GetTitle())
+			}
+			if res.resp == nil {
+				t.Fatal("no update response")
+			}
+			if got, want := res.resp.GetRemindAt().AsTime(), testStart.Add(tt.sends[0]); !got.Equal(want) {
+				t.Errorf("response remind_at = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestDeliveryHistory(t *testing.T) {
+	attempts := func(channel string, delivery int32, n int32, failed bool) []deliveryCall {
+		calls := make([]deliveryCall, n)
+		for i := range calls {
+			calls[i] = deliveryCall{channel: channel, delivery: delivery, attempt: int32(i) + 1, failed: failed}
+		}
+		return calls
+	}
+	tests := []struct {
+		name     string
+		setup    func(req *reminderv1.ScheduleReminderRequest, acts *fakeActivities)
+		ackAt    time.Duration
+		status   string
+		want     []deliveryCall
+		wantAcks []int32
+	}{
+		{
+			name:   "single delivery",
+			status: "sent",
+			want:   attempts("telegram", 1, 1, false),
+		},
+		{
+			name: "fallback channel keeps the delivery number",
+			setup: func(req *reminderv1.ScheduleReminderRequest, acts *fakeActivities) {
+				req.Channels = []string{"telegram", "email"}
+				req.Email = "user@example.com"
+				acts.failing = map[string]bool{"telegram": true}
+			},
+			status: "sent",
+			want:   append(attempts("telegram", 1, 5, true), attempts("email", 1, 1, false)...),
+		},
+		{
+			name: "every channel failed",
+			setup: func(req *reminderv1.ScheduleReminderRequest, acts *fakeActivities) {
+				req.Channels = []string{"telegram", "email"}
+				req.Email = "user@example.com"
+				acts.failing = map[string]bool{"telegram": true, "email": true}
+			},
+			status: "failed",
+			want:   append(attempts("telegram", 1, 5, true), attempts("email", 1, 4, true)...),
+		},
+		{
+			name: "resends are numbered and acknowledged once",
+			setup: func(req *reminderv1.ScheduleReminderRequest, _ *fakeActivities) {
+				req.RequireConfirmation = true
+				req.RepeatIntervalMinutes, req.ConfirmWindowMinutes = 30, 120
+			},
+			ackAt:    100 * time.Minute,
+			status:   "sent",
+			want:     append(attempts("telegram", 1, 1, false), attempts("telegram", 2, 1, false)...),
+			wantAcks: []int32{2},
+		},
+		{
+			name: "expired reminder has no acknowledgement",
+			setup: func(req *reminderv1.ScheduleReminderRequest, _ *fakeActivities) {
+				req.RequireConfirmation = true
+				req.RepeatIntervalMinutes, req.ConfirmWindowMinutes, req.MaxResends = 30, 120, 1
+			},
+			status: "expired",
+			want:   append(attempts("telegram", 1, 1, false), attempts("telegram", 2, 1, false)...),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env, acts := newTestEnv(t)
+			req := testRequest()
+			if tt.setup != nil {
+				tt.setup(req, acts)
+			}
+			if tt.ackAt > 0 {
+				signalAt(env, tt.ackAt, reminderv1.AcknowledgeReminderSignalName, nil)
+			}
+
+			if status := runReminder(t, env, req); status != tt.status {
+				t.Errorf("status = %s, want %s", status, tt.status)
+			}
+			if !slices.Equal(acts.deliveries, tt.want) {
+				t.Errorf("deliveries = %+v, want %+v", acts.deliveries, tt.want)
+			}
+			if !slices.Equal(acts.acks, tt.wantAcks) {
+				t.Errorf("acknowledged deliveries = %v, want %v", acts.acks, tt.wantAcks)
+			}
+		})
+	}
+}
@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE reminders ADD COLUMN recurrence_rule TEXT NOT NULL DEFAULT '';
+ALTER TABLE reminders ADD COLUMN schedule_id VARCHAR(255) NOT NULL DEFAULT '';
+ALTER TABLE reminders ADD COLUMN occurrence_count INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE reminders ADD COLUMN last_occurrence_at TIMESTAMP WITH TIME ZONE;
+
+CREATE TABLE IF NOT EXISTS reminder_occurrences (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    reminder_id UUID NOT NULL REFERENCES reminders(id) ON DELETE CASCADE,
+    workflow_id VARCHAR(255) NOT NULL UNIQUE,
+    status VARCHAR(50) NOT NULL DEFAULT 'processing',
+    fired_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_reminder_occurrences_reminder_id ON reminder_occurrences(reminder_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS reminder_occurrences;
+ALTER TABLE reminders DROP COLUMN IF EXISTS last_occurrence_at;
+ALTER TABLE reminders DROP COLUMN IF EXISTS occurrence_count;
+ALTER TABLE reminders DROP COLUMN IF EXISTS schedule_id;
+ALTER TABLE reminders DROP COLUMN IF EXISTS recurrence_rule;
+-- +goose StatementEnd
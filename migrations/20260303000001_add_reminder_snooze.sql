@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE reminders ADD COLUMN snooze_count INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE reminders ADD COLUMN snoozed_until TIMESTAMP WITH TIME ZONE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE reminders DROP COLUMN IF EXISTS snoozed_until;
+ALTER TABLE reminders DROP COLUMN IF EXISTS snooze_count;
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Порядок каналов доставки пользователя: при ошибке канала пробуется следующий
+ALTER TABLE users ADD COLUMN notification_channels TEXT[] NOT NULL DEFAULT '{telegram,inapp}';
+ALTER TABLE users ADD COLUMN webhook_url TEXT NOT NULL DEFAULT '';
+-- Каналы конкретного напоминания; пустой массив — настройки пользователя
+ALTER TABLE reminders ADD COLUMN channels TEXT[] NOT NULL DEFAULT '{}';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE reminders DROP COLUMN IF EXISTS channels;
+ALTER TABLE users DROP COLUMN IF EXISTS webhook_url;
+ALTER TABLE users DROP COLUMN IF EXISTS notification_channels;
+-- +goose StatementEnd
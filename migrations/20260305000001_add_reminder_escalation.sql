@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE reminders ADD COLUMN escalation_policy TEXT NOT NULL DEFAULT '';
+ALTER TABLE reminders ADD COLUMN escalation_level INTEGER NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS reminder_escalations (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    reminder_id UUID NOT NULL REFERENCES reminders(id) ON DELETE CASCADE,
+    workflow_id VARCHAR(255) NOT NULL,
+    step INTEGER NOT NULL,
+    channel VARCHAR(50) NOT NULL,
+    address TEXT NOT NULL,
+    status VARCHAR(50) NOT NULL,
+    error TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    UNIQUE (workflow_id, step)
+);
+
+CREATE INDEX IF NOT EXISTS idx_reminder_escalations_reminder_id ON reminder_escalations(reminder_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS reminder_escalations;
+ALTER TABLE reminders DROP COLUMN IF EXISTS escalation_level;
+ALTER TABLE reminders DROP COLUMN IF EXISTS escalation_policy;
+-- +goose StatementEnd
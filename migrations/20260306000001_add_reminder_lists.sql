@@ -0,0 +1,34 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS reminder_lists (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name VARCHAR(255) NOT NULL,
+    owner_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS reminder_list_members (
+    list_id UUID NOT NULL REFERENCES reminder_lists(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    role VARCHAR(20) NOT NULL CHECK (role IN ('owner', 'editor', 'viewer')),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    PRIMARY KEY (list_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_reminder_list_members_user_id ON reminder_list_members(user_id);
+
+ALTER TABLE reminders ADD COLUMN list_id UUID REFERENCES reminder_lists(id) ON DELETE SET NULL;
+ALTER TABLE reminders ADD COLUMN assignee_id UUID REFERENCES users(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_reminders_list_id ON reminders(list_id);
+CREATE INDEX IF NOT EXISTS idx_reminders_assignee_id ON reminders(assignee_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE reminders DROP COLUMN IF EXISTS assignee_id;
+ALTER TABLE reminders DROP COLUMN IF EXISTS list_id;
+DROP TABLE IF EXISTS reminder_list_members;
+DROP TABLE IF EXISTS reminder_lists;
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE reminders ADD COLUMN priority VARCHAR(10) NOT NULL DEFAULT 'normal'
+    CHECK (priority IN ('low', 'normal', 'high'));
+ALTER TABLE reminders ADD COLUMN tags TEXT[] NOT NULL DEFAULT '{}';
+
+CREATE INDEX IF NOT EXISTS idx_reminders_tags ON reminders USING GIN (tags);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_reminders_tags;
+ALTER TABLE reminders DROP COLUMN IF EXISTS tags;
+ALTER TABLE reminders DROP COLUMN IF EXISTS priority;
+-- +goose StatementEnd
@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS reminder_deliveries (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    reminder_id UUID NOT NULL REFERENCES reminders(id) ON DELETE CASCADE,
+    workflow_id VARCHAR(255) NOT NULL,
+    delivery INTEGER NOT NULL,
+    channel VARCHAR(50) NOT NULL DEFAULT '',
+    attempt INTEGER NOT NULL DEFAULT 1,
+    status VARCHAR(50) NOT NULL,
+    error TEXT NOT NULL DEFAULT '',
+    telegram_message_id BIGINT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_reminder_deliveries_reminder_id ON reminder_deliveries(reminder_id, created_at);
+-- Подтверждение пишется один раз на workflow, даже если activity повторилась.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_reminder_deliveries_acknowledged
+    ON reminder_deliveries(workflow_id) WHERE status = 'acknowledged';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS reminder_deliveries;
+-- +goose StatementEnd
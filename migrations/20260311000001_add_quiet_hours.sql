@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE users ADD COLUMN quiet_hours TEXT NOT NULL DEFAULT '';
+ALTER TABLE users ADD COLUMN dnd_until TIMESTAMP WITH TIME ZONE;
+ALTER TABLE reminders ADD COLUMN override_quiet_hours BOOLEAN NOT NULL DEFAULT FALSE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE reminders DROP COLUMN IF EXISTS override_quiet_hours;
+ALTER TABLE users DROP COLUMN IF EXISTS dnd_until;
+ALTER TABLE users DROP COLUMN IF EXISTS quiet_hours;
+-- +goose StatementEnd
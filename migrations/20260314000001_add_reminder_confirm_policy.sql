@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE reminders ADD COLUMN confirm_window_minutes INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE reminders ADD COLUMN resend_backoff TEXT NOT NULL DEFAULT 'fixed';
+ALTER TABLE reminders ADD COLUMN max_resends INTEGER NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+UPDATE reminders SET status = 'sent' WHERE status = 'expired';
+UPDATE reminder_occurrences SET status = 'sent' WHERE status = 'expired';
+ALTER TABLE reminders DROP COLUMN IF EXISTS max_resends;
+ALTER TABLE reminders DROP COLUMN IF EXISTS resend_backoff;
+ALTER TABLE reminders DROP COLUMN IF EXISTS confirm_window_minutes;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Полнотекстовый поиск по названию (вес A) и описанию (вес B) с русской и
+-- английской морфологией. Колонка пересчитывается при каждом изменении строки.
+ALTER TABLE reminders ADD COLUMN search tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('russian', title), 'A') ||
+    setweight(to_tsvector('english', title), 'A') ||
+    setweight(to_tsvector('russian', coalesce(description, '')), 'B') ||
+    setweight(to_tsvector('english', coalesce(description, '')), 'B')
+) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_reminders_search ON reminders USING GIN (search);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_reminders_search;
+ALTER TABLE reminders DROP COLUMN IF EXISTS search;
+-- +goose StatementEnd
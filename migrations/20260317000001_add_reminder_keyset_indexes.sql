@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Keyset-пагинация по основным сортировкам: ключ и id для равных значений.
+CREATE INDEX IF NOT EXISTS idx_reminders_created_at_id ON reminders(created_at, id);
+CREATE INDEX IF NOT EXISTS idx_reminders_remind_at_id ON reminders(remind_at, id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_reminders_remind_at_id;
+DROP INDEX IF EXISTS idx_reminders_created_at_id;
+-- +goose StatementEnd
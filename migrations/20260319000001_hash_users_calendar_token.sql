@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Токен ленты хранится как SHA-256, как refresh токены сессий: утечка БД не
+-- раскрывает ссылки на календари. Выданные ссылки продолжают работать.
+ALTER TABLE users RENAME COLUMN calendar_token TO calendar_token_hash;
+
+UPDATE users SET calendar_token_hash = encode(sha256(convert_to(calendar_token_hash, 'UTF8')), 'hex')
+WHERE calendar_token_hash IS NOT NULL;
+
+ALTER INDEX IF EXISTS idx_users_calendar_token RENAME TO idx_users_calendar_token_hash;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- Токены по хэшу не восстановить: ленты выключаются, ссылки нужно создать заново.
+ALTER INDEX IF EXISTS idx_users_calendar_token_hash RENAME TO idx_users_calendar_token;
+
+ALTER TABLE users RENAME COLUMN calendar_token_hash TO calendar_token;
+
+UPDATE users SET calendar_token = NULL WHERE calendar_token IS NOT NULL;
+-- +goose StatementEnd
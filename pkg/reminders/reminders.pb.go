@@ -0,0 +1,1719 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: reminders/reminders.proto
+
+package reminders
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Reminder — напоминание пользователя.
+type Reminder struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title       string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	RemindAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=remind_at,json=remindAt,proto3" json:"remind_at,omitempty"`
+	// Статус: pending, processing, sent, cancelled, failed, paused, snoozed, expired.
+	// expired — окно подтверждения истекло, а напоминание так и не подтвердили
+	Status                string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	RequireConfirmation   bool   `protobuf:"varint,6,opt,name=require_confirmation,json=requireConfirmation,proto3" json:"require_confirmation,omitempty"`
+	RepeatIntervalMinutes int32  `protobuf:"varint,7,opt,name=repeat_interval_minutes,json=repeatIntervalMinutes,proto3" json:"repeat_interval_minutes,omitempty"`
+	// Правило повторения (RRULE или CRON:<expr>); пусто для разового напоминания
+	RecurrenceRule  string                 `protobuf:"bytes,8,opt,name=recurrence_rule,json=recurrenceRule,proto3" json:"recurrence_rule,omitempty"`
+	OccurrenceCount int32                  `protobuf:"varint,9,opt,name=occurrence_count,json=occurrenceCount,proto3" json:"occurrence_count,omitempty"`
+	SnoozeCount     int32                  `protobuf:"varint,10,opt,name=snooze_count,json=snoozeCount,proto3" json:"snooze_count,omitempty"`
+	SnoozedUntil    *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=snoozed_until,json=snoozedUntil,proto3" json:"snoozed_until,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Каналы доставки в порядке попыток; пусто — порядок из настроек пользователя
+	Channels []string `protobuf:"bytes,14,rep,name=channels,proto3" json:"channels,omitempty"`
+	// Цепочка эскалации; пусто — без эскалации
+	EscalationPolicy string `protobuf:"bytes,15,opt,name=escalation_policy,json=escalationPolicy,proto3" json:"escalation_policy,omitempty"`
+	// Сколько шагов эскалации сработало в текущем срабатывании
+	EscalationLevel int32 `protobuf:"varint,16,opt,name=escalation_level,json=escalationLevel,proto3" json:"escalation_level,omitempty"`
+	// Общий список; пусто — личное напоминание
+	ListId string `protobuf:"bytes,17,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"`
+	// Название общего списка
+	ListName string `protobuf:"bytes,18,opt,name=list_name,json=listName,proto3" json:"list_name,omitempty"`
+	// Исполнитель, которому доставляется напоминание; пусто — создатель
+	AssigneeId    string `protobuf:"bytes,19,opt,name=assignee_id,json=assigneeId,proto3" json:"assignee_id,omitempty"`
+	AssigneeEmail string `protobuf:"bytes,20,opt,name=assignee_email,json=assigneeEmail,proto3" json:"assignee_email,omitempty"`
+	// ID создателя напоминания
+	UserId string `protobuf:"bytes,21,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Приоритет: low, normal, high
+	Priority string   `protobuf:"bytes,22,opt,name=priority,proto3" json:"priority,omitempty"`
+	Tags     []string `protobuf:"bytes,23,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Доставлять, не дожидаясь конца тихих часов получателя
+	OverrideQuietHours bool `protobuf:"varint,24,opt,name=override_quiet_hours,json=overrideQuietHours,proto3" json:"override_quiet_hours,omitempty"`
+	// Часовой пояс IANA, в котором считаются время и повторения; пусто — по умолчанию
+	Timezone string `protobuf:"bytes,25,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// Сколько ждать подтверждения, в минутах; 0 — 10 часов
+	ConfirmWindowMinutes int32 `protobuf:"varint,26,opt,name=confirm_window_minutes,json=confirmWindowMinutes,proto3" json:"confirm_window_minutes,omitempty"`
+	// Рост интервала между повторами: fixed или exponential
+	ResendBackoff string `protobuf:"bytes,27,opt,name=resend_backoff,json=resendBackoff,proto3" json:"resend_backoff,omitempty"`
+	// Сколько раз повторить уведомление; 0 — до конца окна подтверждения
+	MaxResends    int32 `protobuf:"varint,28,opt,name=max_resends,json=maxResends,proto3" json:"max_resends,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Reminder) Reset() {
+	*x = Reminder{}
+	mi := &file_reminders_reminders_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reminder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reminder) ProtoMessage() {}
+
+func (x *Reminder) ProtoReflect() protoreflect.Message {
+	mi := &file_reminders_reminders_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reminder.ProtoReflect.Descriptor instead.
+func (*Reminder) Descriptor() ([]byte, []int) {
+	return file_reminders_reminders_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Reminder) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Reminder) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Reminder) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Reminder) GetRemindAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RemindAt
+	}
+	return nil
+}
+
+func (x *Reminder) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Reminder) GetRequireConfirmation() bool {
+	if x != nil {
+		return x.RequireConfirmation
+	}
+	return false
+}
+
+func (x *Reminder) GetRepeatIntervalMinutes() int32 {
+	if x != nil {
+		return x.RepeatIntervalMinutes
+	}
+	return 0
+}
+
+func (x *Reminder) GetRecurrenceRule() string {
+	if x != nil {
+		return x.RecurrenceRule
+	}
+	return ""
+}
+
+func (x *Reminder) GetOccurrenceCount() int32 {
+	if x != nil {
+		return x.OccurrenceCount
+	}
+	return 0
+}
+
+func (x *Reminder) GetSnoozeCount() int32 {
+	if x != nil {
+		return x.SnoozeCount
+	}
+	return 0
+}
+
+func (x *Reminder) GetSnoozedUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SnoozedUntil
+	}
+	return nil
+}
+
+func (x *Reminder) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Reminder) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Reminder) GetChannels() []string {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *Reminder) GetEscalationPolicy() string {
+	if x != nil {
+		return x.EscalationPolicy
+	}
+	return ""
+}
+
+func (x *Reminder) GetEscalationLevel() int32 {
+	if x != nil {
+		return x.EscalationLevel
+	}
+	return 0
+}
+
+func (x *Reminder) GetListId() string {
+	if x != nil {
+		return x.ListId
+	}
+	return ""
+}
+
+func (x *Reminder) GetListName() string {
+	if x != nil {
+		return x.ListName
+	}
+	return ""
+}
+
+func (x *Reminder) GetAssigneeId() string {
+	if x != nil {
+		return x.AssigneeId
+	}
+	return ""
+}
+
+func (x *Reminder) GetAssigneeEmail() string {
+	if x != nil {
+		return x.AssigneeEmail
+	}
+	return ""
+}
+
+func (x *Reminder) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Reminder) GetPriority() string {
+	if x != nil {
+		return x.Priority
+	}
+	return ""
+}
+
+func (x *Reminder) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Reminder) GetOverrideQuietHours() bool {
+	if x != nil {
+		return x.OverrideQuietHours
+	}
+	return false
+}
+
+func (x *Reminder) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *Reminder) GetConfirmWindowMinutes() int32 {
+	if x != nil {
+		return x.ConfirmWindowMinutes
+	}
+	return 0
+}
+
+func (x *Reminder) GetResendBackoff() string {
+	if x != nil {
+		return x.ResendBackoff
+	}
+	return ""
+}
+
+func (x *Reminder) GetMaxResends() int32 {
+	if x != nil {
+		return x.MaxResends
+	}
+	return 0
+}
+
+// CreateReminderRequest — данные нового напоминания.
+type CreateReminderRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Title               string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description         string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	RemindAt            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=remind_at,json=remindAt,proto3" json:"remind_at,omitempty"`
+	RequireConfirmation bool                   `protobuf:"varint,4,opt,name=require_confirmation,json=requireConfirmation,proto3" json:"require_confirmation,omitempty"`
+	// Интервал повторной отправки до подтверждения, в минутах
+	RepeatIntervalMinutes int32 `protobuf:"varint,5,opt,name=repeat_interval_minutes,json=repeatIntervalMinutes,proto3" json:"repeat_interval_minutes,omitempty"`
+	// RRULE (FREQ=WEEKLY;BYDAY=MO) или cron-выражение; пусто — разовое напоминание
+	RecurrenceRule string `protobuf:"bytes,6,opt,name=recurrence_rule,json=recurrenceRule,proto3" json:"recurrence_rule,omitempty"`
+	// Каналы доставки в порядке попыток: telegram, inapp, email, webhook; пусто — из настроек
+	Channels []string `protobuf:"bytes,7,rep,name=channels,proto3" json:"channels,omitempty"`
+	// Цепочка эскалации: «<повторов> <telegram|email|webhook>:<адрес>» через «;»,
+	// например «3 telegram:-1001234567890; 6 email:lead@example.com».
+	// Требует require_confirmation и repeat_interval_minutes
+	EscalationPolicy string `protobuf:"bytes,8,opt,name=escalation_policy,json=escalationPolicy,proto3" json:"escalation_policy,omitempty"`
+	// Общий список: создатель должен быть в нём редактором или владельцем
+	ListId string `protobuf:"bytes,9,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"`
+	// Email участника списка, которому назначено напоминание; требует list_id
+	AssigneeEmail string `protobuf:"bytes,10,opt,name=assignee_email,json=assigneeEmail,proto3" json:"assignee_email,omitempty"`
+	// Приоритет: low, normal (по умолчанию), high. Высокий всегда требует подтверждения,
+	// без repeat_interval_minutes повтор — каждые 15 минут
+	Priority string `protobuf:"bytes,11,opt,name=priority,proto3" json:"priority,omitempty"`
+	// Теги: буквы, цифры, «-» и «_», до 10 штук; ведущий «#» и регистр не важны
+	Tags []string `protobuf:"bytes,12,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Доставлять, не дожидаясь конца тихих часов и режима «не беспокоить» получателя;
+	// высокий приоритет игнорирует их всегда
+	OverrideQuietHours bool `protobuf:"varint,13,opt,name=override_quiet_hours,json=overrideQuietHours,proto3" json:"override_quiet_hours,omitempty"`
+	// Сколько ждать подтверждения, в минутах, не больше недели; 0 — 10 часов.
+	// Не подтверждённое в окно напоминание получает статус expired
+	ConfirmWindowMinutes int32 `protobuf:"varint,14,opt,name=confirm_window_minutes,json=confirmWindowMinutes,proto3" json:"confirm_window_minutes,omitempty"`
+	// Рост интервала между повторами: fixed (по умолчанию) — каждые
+	// repeat_interval_minutes, exponential — интервал удваивается после каждого повтора
+	ResendBackoff string `protobuf:"bytes,15,opt,name=resend_backoff,json=resendBackoff,proto3" json:"resend_backoff,omitempty"`
+	// Сколько раз повторить уведомление, до 100; 0 — до конца окна подтверждения
+	MaxResends    int32 `protobuf:"varint,16,opt,name=max_resends,json=maxResends,proto3" json:"max_resends,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReminderRequest) Reset() {
+	*x = CreateReminderRequest{}
+	mi := &file_reminders_reminders_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReminderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReminderRequest) ProtoMessage() {}
+
+func (x *CreateReminderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reminders_reminders_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReminderRequest.ProtoReflect.Descriptor instead.
+func (*CreateReminderRequest) Descriptor() ([]byte, []int) {
+	return file_reminders_reminders_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateReminderRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateReminderRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateReminderRequest) GetRemindAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RemindAt
+	}
+	return nil
+}
+
+func (x *CreateReminderRequest) GetRequireConfirmation() bool {
+	if x != nil {
+		return x.RequireConfirmation
+	}
+	return false
+}
+
+func (x *CreateReminderRequest) GetRepeatIntervalMinutes() int32 {
+	if x != nil {
+		return x.RepeatIntervalMinutes
+	}
+	return 0
+}
+
+func (x *CreateReminderRequest) GetRecurrenceRule() string {
+	if x != nil {
+		return x.RecurrenceRule
+	}
+	return ""
+}
+
+func (x *CreateReminderRequest) GetChannels() []string {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *CreateReminderRequest) GetEscalationPolicy() string {
+	if x != nil {
+		return x.EscalationPolicy
+	}
+	return ""
+}
+
+func (x *CreateReminderRequest) GetListId() string {
+	if x != nil {
+		return x.ListId
+	}
+	return ""
+}
+
+func (x *CreateReminderRequest) GetAssigneeEmail() string {
+	if x != nil {
+		return x.AssigneeEmail
+	}
+	return ""
+}
+
+func (x *CreateReminderRequest) GetPriority() string {
+	if x != nil {
+		return x.Priority
+	}
+	return ""
+}
+
+func (x *CreateReminderRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreateReminderRequest) GetOverrideQuietHours() bool {
+	if x != nil {
+		return x.OverrideQuietHours
+	}
+	return false
+}
+
+func (x *CreateReminderRequest) GetConfirmWindowMinutes() int32 {
+	if x != nil {
+		return x.ConfirmWindowMinutes
+	}
+	return 0
+}
+
+func (x *CreateReminderRequest) GetResendBackoff() string {
+	if x != nil {
+		return x.ResendBackoff
+	}
+	return ""
+}
+
+func (x *CreateReminderRequest) GetMaxResends() int32 {
+	if x != nil {
+		return x.MaxResends
+	}
+	return 0
+}
+
+// GetReminderRequest — запрос напоминания по ID.
+type GetReminderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReminderRequest) Reset() {
+	*x = GetReminderRequest{}
+	mi := &file_reminders_reminders_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReminderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReminderRequest) ProtoMessage() {}
+
+func (x *GetReminderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reminders_reminders_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReminderRequest.ProtoReflect.Descriptor instead.
+func (*GetReminderRequest) Descriptor() ([]byte, []int) {
+	return file_reminders_reminders_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetReminderRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// ListRemindersRequest — страница напоминаний с фильтрами и сортировкой.
+type ListRemindersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Номер страницы, начиная с 1; не используется в режиме keyset
+	Page int32 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	// Размер страницы: 5..100, по умолчанию 20
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Поле сортировки: remind_at, created_at, title, status, priority;
+	// rank — по релевантности поиска query
+	SortField string `protobuf:"bytes,3,opt,name=sort_field,json=sortField,proto3" json:"sort_field,omitempty"`
+	// Направление сортировки: asc, desc
+	SortOrder string `protobuf:"bytes,4,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+	// Фильтр по статусу
+	Status string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	// Поиск по подстроке в названии
+	Title string `protobuf:"bytes,6,opt,name=title,proto3" json:"title,omitempty"`
+	// Диапазон времени напоминания, даты в формате YYYY-MM-DD
+	RemindAtFrom string `protobuf:"bytes,7,opt,name=remind_at_from,json=remindAtFrom,proto3" json:"remind_at_from,omitempty"`
+	RemindAtTo   string `protobuf:"bytes,8,opt,name=remind_at_to,json=remindAtTo,proto3" json:"remind_at_to,omitempty"`
+	// Диапазон даты создания, даты в формате YYYY-MM-DD
+	CreatedAtFrom string `protobuf:"bytes,9,opt,name=created_at_from,json=createdAtFrom,proto3" json:"created_at_from,omitempty"`
+	CreatedAtTo   string `protobuf:"bytes,10,opt,name=created_at_to,json=createdAtTo,proto3" json:"created_at_to,omitempty"`
+	// Фильтр по приоритету: low, normal, high
+	Priority string `protobuf:"bytes,11,opt,name=priority,proto3" json:"priority,omitempty"`
+	// Поиск по подстроке в тегах
+	Tag string `protobuf:"bytes,12,opt,name=tag,proto3" json:"tag,omitempty"`
+	// Полнотекстовый поиск по названию и описанию с русской и английской
+	// морфологией. Синтаксис веб-поиска: слова через пробел, "точная фраза",
+	// -исключить, or. Найденные совпадения подсвечиваются в highlights
+	Query string `protobuf:"bytes,13,opt,name=query,proto3" json:"query,omitempty"`
+	// Курсор из next_cursor предыдущего ответа: следующая порция в режиме keyset.
+	// Сортировка берётся из курсора, фильтры должны совпадать с первым запросом
+	Cursor string `protobuf:"bytes,14,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// Режим keyset для первой порции: вместо page — курсоры, total_items и
+	// total_pages не считаются. Страницы не сдвигаются при вставке и удалении строк
+	Keyset        bool `protobuf:"varint,15,opt,name=keyset,proto3" json:"keyset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRemindersRequest) Reset() {
+	*x = ListRemindersRequest{}
+	mi := &file_reminders_reminders_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRemindersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRemindersRequest) ProtoMessage() {}
+
+func (x *ListRemindersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reminders_reminders_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRemindersRequest.ProtoReflect.Descriptor instead.
+func (*ListRemindersRequest) Descriptor() ([]byte, []int) {
+	return file_reminders_reminders_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListRemindersRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListRemindersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListRemindersRequest) GetSortField() string {
+	if x != nil {
+		return x.SortField
+	}
+	return ""
+}
+
+func (x *ListRemindersRequest) GetSortOrder() string {
+	if x != nil {
+		return x.SortOrder
+	}
+	return ""
+}
+
+func (x *ListRemindersRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListRemindersRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ListRemindersRequest) GetRemindAtFrom() string {
+	if x != nil {
+		return x.RemindAtFrom
+	}
+	return ""
+}
+
+func (x *ListRemindersRequest) GetRemindAtTo() string {
+	if x != nil {
+		return x.RemindAtTo
+	}
+	return ""
+}
+
+func (x *ListRemindersRequest) GetCreatedAtFrom() string {
+	if x != nil {
+		return x.CreatedAtFrom
+	}
+	return ""
+}
+
+func (x *ListRemindersRequest) GetCreatedAtTo() string {
+	if x != nil {
+		return x.CreatedAtTo
+	}
+	return ""
+}
+
+func (x *ListRemindersRequest) GetPriority() string {
+	if x != nil {
+		return x.Priority
+	}
+	return ""
+}
+
+func (x *ListRemindersRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *ListRemindersRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *ListRemindersRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListRemindersRequest) GetKeyset() bool {
+	if x != nil {
+		return x.Keyset
+	}
+	return false
+}
+
+// ListRemindersResponse — страница напоминаний.
+type ListRemindersResponse struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Reminders  []*Reminder            `protobuf:"bytes,1,rep,name=reminders,proto3" json:"reminders,omitempty"`
+	TotalItems int32                  `protobuf:"varint,2,opt,name=total_items,json=totalItems,proto3" json:"total_items,omitempty"`
+	TotalPages int32                  `protobuf:"varint,3,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	// Подсветка совпадений для напоминаний страницы; только при поиске query
+	Highlights []*SearchHighlight `protobuf:"bytes,4,rep,name=highlights,proto3" json:"highlights,omitempty"`
+	// Курсор следующей порции в режиме keyset; пусто — порций больше нет
+	NextCursor    string `protobuf:"bytes,5,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRemindersResponse) Reset() {
+	*x = ListRemindersResponse{}
+	mi := &file_reminders_reminders_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRemindersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRemindersResponse) ProtoMessage() {}
+
+func (x *ListRemindersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reminders_reminders_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRemindersResponse.ProtoReflect.Descriptor instead.
+func (*ListRemindersResponse) Descriptor() ([]byte, []int) {
+	return file_reminders_reminders_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListRemindersResponse) GetReminders() []*Reminder {
+	if x != nil {
+		return x.Reminders
+	}
+	return nil
+}
+
+func (x *ListRemindersResponse) GetTotalItems() int32 {
+	if x != nil {
+		return x.TotalItems
+	}
+	return 0
+}
+
+func (x *ListRemindersResponse) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+func (x *ListRemindersResponse) GetHighlights() []*SearchHighlight {
+	if x != nil {
+		return x.Highlights
+	}
+	return nil
+}
+
+func (x *ListRemindersResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// SearchHighlight — совпадения с поисковым запросом в напоминании.
+// Текст экранирован для HTML, совпадения обрамлены тегом <mark>.
+type SearchHighlight struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ReminderId string                 `protobuf:"bytes,1,opt,name=reminder_id,json=reminderId,proto3" json:"reminder_id,omitempty"`
+	// Название с подсвеченными совпадениями
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// Фрагменты описания с совпадениями; пусто — в описании совпадений нет
+	Snippet       string `protobuf:"bytes,3,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchHighlight) Reset() {
+	*x = SearchHighlight{}
+	mi := &file_reminders_reminders_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchHighlight) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchHighlight) ProtoMessage() {}
+
+func (x *SearchHighlight) ProtoReflect() protoreflect.Message {
+	mi := &file_reminders_reminders_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchHighlight.ProtoReflect.Descriptor instead.
+func (*SearchHighlight) Descriptor() ([]byte, []int) {
+	return file_reminders_reminders_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SearchHighlight) GetReminderId() string {
+	if x != nil {
+		return x.ReminderId
+	}
+	return ""
+}
+
+func (x *SearchHighlight) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SearchHighlight) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+// UpdateReminderRequest — новые значения полей напоминания.
+type UpdateReminderRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title       string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Новое время; пустое значение — время не меняется
+	RemindAt      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=remind_at,json=remindAt,proto3" json:"remind_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateReminderRequest) Reset() {
+	*x = UpdateReminderRequest{}
+	mi := &file_reminders_reminders_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateReminderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateReminderRequest) ProtoMessage() {}
+
+func (x *UpdateReminderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reminders_reminders_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateReminderRequest.ProtoReflect.Descriptor instead.
+func (*UpdateReminderRequest) Descriptor() ([]byte, []int) {
+	return file_reminders_reminders_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateReminderRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateReminderRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdateReminderRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateReminderRequest) GetRemindAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RemindAt
+	}
+	return nil
+}
+
+// CancelReminderRequest — отмена напоминания.
+type CancelReminderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelReminderRequest) Reset() {
+	*x = CancelReminderRequest{}
+	mi := &file_reminders_reminders_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelReminderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelReminderRequest) ProtoMessage() {}
+
+func (x *CancelReminderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reminders_reminders_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelReminderRequest.ProtoReflect.Descriptor instead.
+func (*CancelReminderRequest) Descriptor() ([]byte, []int) {
+	return file_reminders_reminders_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CancelReminderRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// AcknowledgeReminderRequest — подтверждение получения напоминания.
+type AcknowledgeReminderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcknowledgeReminderRequest) Reset() {
+	*x = AcknowledgeReminderRequest{}
+	mi := &file_reminders_reminders_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcknowledgeReminderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcknowledgeReminderRequest) ProtoMessage() {}
+
+func (x *AcknowledgeReminderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reminders_reminders_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcknowledgeReminderRequest.ProtoReflect.Descriptor instead.
+func (*AcknowledgeReminderRequest) Descriptor() ([]byte, []int) {
+	return file_reminders_reminders_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AcknowledgeReminderRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// DeleteReminderRequest — удаление напоминания.
+type DeleteReminderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteReminderRequest) Reset() {
+	*x = DeleteReminderRequest{}
+	mi := &file_reminders_reminders_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteReminderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteReminderRequest) ProtoMessage() {}
+
+func (x *DeleteReminderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reminders_reminders_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteReminderRequest.ProtoReflect.Descriptor instead.
+func (*DeleteReminderRequest) Descriptor() ([]byte, []int) {
+	return file_reminders_reminders_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteReminderRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// ListReminderDeliveriesRequest — запрос истории доставки напоминания.
+type ListReminderDeliveriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReminderDeliveriesRequest) Reset() {
+	*x = ListReminderDeliveriesRequest{}
+	mi := &file_reminders_reminders_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReminderDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReminderDeliveriesRequest) ProtoMessage() {}
+
+func (x *ListReminderDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reminders_reminders_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReminderDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListReminderDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_reminders_reminders_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListReminderDeliveriesRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// ReminderDelivery — попытка доставки уведомления или подтверждение.
+type ReminderDelivery struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID workflow; у каждого срабатывания повторяющегося напоминания свой
+	WorkflowId string `protobuf:"bytes,1,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	// Номер отправки в workflow: 1 — первая, дальше повторы.
+	// Для подтверждения — сколько отправок было до него
+	Delivery int32 `protobuf:"varint,2,opt,name=delivery,proto3" json:"delivery,omitempty"`
+	// Канал: telegram, inapp, email, webhook; пусто для подтверждения
+	Channel string `protobuf:"bytes,3,opt,name=channel,proto3" json:"channel,omitempty"`
+	// Попытка внутри отправки (ретраи при ошибке канала)
+	Attempt int32 `protobuf:"varint,4,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	// Статус: sent, failed, acknowledged
+	Status string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Error  string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	// ID сообщения в Telegram; 0 — не Telegram или бот не настроен
+	TelegramMessageId int64                  `protobuf:"varint,7,opt,name=telegram_message_id,json=telegramMessageId,proto3" json:"telegram_message_id,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ReminderDelivery) Reset() {
+	*x = ReminderDelivery{}
+	mi := &file_reminders_reminders_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReminderDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReminderDelivery) ProtoMessage() {}
+
+func (x *ReminderDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_reminders_reminders_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReminderDelivery.ProtoReflect.Descriptor instead.
+func (*ReminderDelivery) Descriptor() ([]byte, []int) {
+	return file_reminders_reminders_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ReminderDelivery) GetWorkflowId() string {
+	if x != nil {
+		return x.WorkflowId
+	}
+	return ""
+}
+
+func (x *ReminderDelivery) GetDelivery() int32 {
+	if x != nil {
+		return x.Delivery
+	}
+	return 0
+}
+
+func (x *ReminderDelivery) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *ReminderDelivery) GetAttempt() int32 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
+func (x *ReminderDelivery) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ReminderDelivery) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ReminderDelivery) GetTelegramMessageId() int64 {
+	if x != nil {
+		return x.TelegramMessageId
+	}
+	return 0
+}
+
+func (x *ReminderDelivery) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// ListReminderDeliveriesResponse — история доставки напоминания.
+type ListReminderDeliveriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deliveries    []*ReminderDelivery    `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReminderDeliveriesResponse) Reset() {
+	*x = ListReminderDeliveriesResponse{}
+	mi := &file_reminders_reminders_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReminderDeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReminderDeliveriesResponse) ProtoMessage() {}
+
+func (x *ListReminderDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reminders_reminders_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReminderDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*ListReminderDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_reminders_reminders_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListReminderDeliveriesResponse) GetDeliveries() []*ReminderDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+// ImportRemindersRequest — файл для импорта.
+type ImportRemindersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Имя файла; формат определяется по содержимому, .csv — по расширению
+	Filename string `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	// Содержимое файла до 1 МБ (в JSON — base64)
+	Content []byte `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	// Только проверить строки, ничего не создавая
+	DryRun        bool `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportRemindersRequest) Reset() {
+	*x = ImportRemindersRequest{}
+	mi := &file_reminders_reminders_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRemindersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRemindersRequest) ProtoMessage() {}
+
+func (x *ImportRemindersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reminders_reminders_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRemindersRequest.ProtoReflect.Descriptor instead.
+func (*ImportRemindersRequest) Descriptor() ([]byte, []int) {
+	return file_reminders_reminders_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ImportRemindersRequest) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *ImportRemindersRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *ImportRemindersRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// ImportRow — напоминание из одной строки файла.
+type ImportRow struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Номер строки CSV или порядковый номер записи календаря
+	Line           int32                  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	Title          string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description    string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	RemindAt       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=remind_at,json=remindAt,proto3" json:"remind_at,omitempty"`
+	RecurrenceRule string                 `protobuf:"bytes,5,opt,name=recurrence_rule,json=recurrenceRule,proto3" json:"recurrence_rule,omitempty"`
+	Tags           []string               `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Приоритет: low, normal, high
+	Priority string `protobuf:"bytes,7,opt,name=priority,proto3" json:"priority,omitempty"`
+	// Причины, по которым строка не импортируется; пусто — строка корректна
+	Errors        []string `protobuf:"bytes,8,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportRow) Reset() {
+	*x = ImportRow{}
+	mi := &file_reminders_reminders_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRow) ProtoMessage() {}
+
+func (x *ImportRow) ProtoReflect() protoreflect.Message {
+	mi := &file_reminders_reminders_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRow.ProtoReflect.Descriptor instead.
+func (*ImportRow) Descriptor() ([]byte, []int) {
+	return file_reminders_reminders_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ImportRow) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *ImportRow) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ImportRow) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ImportRow) GetRemindAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RemindAt
+	}
+	return nil
+}
+
+func (x *ImportRow) GetRecurrenceRule() string {
+	if x != nil {
+		return x.RecurrenceRule
+	}
+	return ""
+}
+
+func (x *ImportRow) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ImportRow) GetPriority() string {
+	if x != nil {
+		return x.Priority
+	}
+	return ""
+}
+
+func (x *ImportRow) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+// ImportRemindersResponse — результат импорта.
+type ImportRemindersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Rows  []*ImportRow           `protobuf:"bytes,1,rep,name=rows,proto3" json:"rows,omitempty"`
+	// Сколько напоминаний создано; 0 при dry_run
+	Created       int32 `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportRemindersResponse) Reset() {
+	*x = ImportRemindersResponse{}
+	mi := &file_reminders_reminders_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRemindersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRemindersResponse) ProtoMessage() {}
+
+func (x *ImportRemindersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reminders_reminders_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRemindersResponse.ProtoReflect.Descriptor instead.
+func (*ImportRemindersResponse) Descriptor() ([]byte, []int) {
+	return file_reminders_reminders_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ImportRemindersResponse) GetRows() []*ImportRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *ImportRemindersResponse) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+var File_reminders_reminders_proto protoreflect.FileDescriptor
+
+const file_reminders_reminders_proto_rawDesc = "" +
+	"\n" +
+	"\x19reminders/reminders.proto\x12\freminders.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\"\xc3\b\n" +
+	"\bReminder\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x127\n" +
+	"\tremind_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bremindAt\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x121\n" +
+	"\x14require_confirmation\x18\x06 \x01(\bR\x13requireConfirmation\x126\n" +
+	"\x17repeat_interval_minutes\x18\a \x01(\x05R\x15repeatIntervalMinutes\x12'\n" +
+	"\x0frecurrence_rule\x18\b \x01(\tR\x0erecurrenceRule\x12)\n" +
+	"\x10occurrence_count\x18\t \x01(\x05R\x0foccurrenceCount\x12!\n" +
+	"\fsnooze_count\x18\n" +
+	" \x01(\x05R\vsnoozeCount\x12?\n" +
+	"\rsnoozed_until\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\fsnoozedUntil\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1a\n" +
+	"\bchannels\x18\x0e \x03(\tR\bchannels\x12+\n" +
+	"\x11escalation_policy\x18\x0f \x01(\tR\x10escalationPolicy\x12)\n" +
+	"\x10escalation_level\x18\x10 \x01(\x05R\x0fescalationLevel\x12\x17\n" +
+	"\alist_id\x18\x11 \x01(\tR\x06listId\x12\x1b\n" +
+	"\tlist_name\x18\x12 \x01(\tR\blistName\x12\x1f\n" +
+	"\vassignee_id\x18\x13 \x01(\tR\n" +
+	"assigneeId\x12%\n" +
+	"\x0eassignee_email\x18\x14 \x01(\tR\rassigneeEmail\x12\x17\n" +
+	"\auser_id\x18\x15 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bpriority\x18\x16 \x01(\tR\bpriority\x12\x12\n" +
+	"\x04tags\x18\x17 \x03(\tR\x04tags\x120\n" +
+	"\x14override_quiet_hours\x18\x18 \x01(\bR\x12overrideQuietHours\x12\x1a\n" +
+	"\btimezone\x18\x19 \x01(\tR\btimezone\x124\n" +
+	"\x16confirm_window_minutes\x18\x1a \x01(\x05R\x14confirmWindowMinutes\x12%\n" +
+	"\x0eresend_backoff\x18\x1b \x01(\tR\rresendBackoff\x12\x1f\n" +
+	"\vmax_resends\x18\x1c \x01(\x05R\n" +
+	"maxResends\"\x85\x05\n" +
+	"\x15CreateReminderRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x127\n" +
+	"\tremind_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bremindAt\x121\n" +
+	"\x14require_confirmation\x18\x04 \x01(\bR\x13requireConfirmation\x126\n" +
+	"\x17repeat_interval_minutes\x18\x05 \x01(\x05R\x15repeatIntervalMinutes\x12'\n" +
+	"\x0frecurrence_rule\x18\x06 \x01(\tR\x0erecurrenceRule\x12\x1a\n" +
+	"\bchannels\x18\a \x03(\tR\bchannels\x12+\n" +
+	"\x11escalation_policy\x18\b \x01(\tR\x10escalationPolicy\x12\x17\n" +
+	"\alist_id\x18\t \x01(\tR\x06listId\x12%\n" +
+	"\x0eassignee_email\x18\n" +
+	" \x01(\tR\rassigneeEmail\x12\x1a\n" +
+	"\bpriority\x18\v \x01(\tR\bpriority\x12\x12\n" +
+	"\x04tags\x18\f \x03(\tR\x04tags\x120\n" +
+	"\x14override_quiet_hours\x18\r \x01(\bR\x12overrideQuietHours\x124\n" +
+	"\x16confirm_window_minutes\x18\x0e \x01(\x05R\x14confirmWindowMinutes\x12%\n" +
+	"\x0eresend_backoff\x18\x0f \x01(\tR\rresendBackoff\x12\x1f\n" +
+	"\vmax_resends\x18\x10 \x01(\x05R\n" +
+	"maxResends\"$\n" +
+	"\x12GetReminderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xbb\x03\n" +
+	"\x14ListRemindersRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"sort_field\x18\x03 \x01(\tR\tsortField\x12\x1d\n" +
+	"\n" +
+	"sort_order\x18\x04 \x01(\tR\tsortOrder\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x14\n" +
+	"\x05title\x18\x06 \x01(\tR\x05title\x12$\n" +
+	"\x0eremind_at_from\x18\a \x01(\tR\fremindAtFrom\x12 \n" +
+	"\fremind_at_to\x18\b \x01(\tR\n" +
+	"remindAtTo\x12&\n" +
+	"\x0fcreated_at_from\x18\t \x01(\tR\rcreatedAtFrom\x12\"\n" +
+	"\rcreated_at_to\x18\n" +
+	" \x01(\tR\vcreatedAtTo\x12\x1a\n" +
+	"\bpriority\x18\v \x01(\tR\bpriority\x12\x10\n" +
+	"\x03tag\x18\f \x01(\tR\x03tag\x12\x14\n" +
+	"\x05query\x18\r \x01(\tR\x05query\x12\x16\n" +
+	"\x06cursor\x18\x0e \x01(\tR\x06cursor\x12\x16\n" +
+	"\x06keyset\x18\x0f \x01(\bR\x06keyset\"\xef\x01\n" +
+	"\x15ListRemindersResponse\x124\n" +
+	"\treminders\x18\x01 \x03(\v2\x16.reminders.v1.ReminderR\treminders\x12\x1f\n" +
+	"\vtotal_items\x18\x02 \x01(\x05R\n" +
+	"totalItems\x12\x1f\n" +
+	"\vtotal_pages\x18\x03 \x01(\x05R\n" +
+	"totalPages\x12=\n" +
+	"\n" +
+	"highlights\x18\x04 \x03(\v2\x1d.reminders.v1.SearchHighlightR\n" +
+	"highlights\x12\x1f\n" +
+	"\vnext_cursor\x18\x05 \x01(\tR\n" +
+	"nextCursor\"b\n" +
+	"\x0fSearchHighlight\x12\x1f\n" +
+	"\vreminder_id\x18\x01 \x01(\tR\n" +
+	"reminderId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
+	"\asnippet\x18\x03 \x01(\tR\asnippet\"\x98\x01\n" +
+	"\x15UpdateReminderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x127\n" +
+	"\tremind_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bremindAt\"'\n" +
+	"\x15CancelReminderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\",\n" +
+	"\x1aAcknowledgeReminderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"'\n" +
+	"\x15DeleteReminderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"/\n" +
+	"\x1dListReminderDeliveriesRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x9c\x02\n" +
+	"\x10ReminderDelivery\x12\x1f\n" +
+	"\vworkflow_id\x18\x01 \x01(\tR\n" +
+	"workflowId\x12\x1a\n" +
+	"\bdelivery\x18\x02 \x01(\x05R\bdelivery\x12\x18\n" +
+	"\achannel\x18\x03 \x01(\tR\achannel\x12\x18\n" +
+	"\aattempt\x18\x04 \x01(\x05R\aattempt\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x14\n" +
+	"\x05error\x18\x06 \x01(\tR\x05error\x12.\n" +
+	"\x13telegram_message_id\x18\a \x01(\x03R\x11telegramMessageId\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"`\n" +
+	"\x1eListReminderDeliveriesResponse\x12>\n" +
+	"\n" +
+	"deliveries\x18\x01 \x03(\v2\x1e.reminders.v1.ReminderDeliveryR\n" +
+	"deliveries\"g\n" +
+	"\x16ImportRemindersRequest\x12\x1a\n" +
+	"\bfilename\x18\x01 \x01(\tR\bfilename\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\fR\acontent\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\"\x81\x02\n" +
+	"\tImportRow\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\x05R\x04line\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x127\n" +
+	"\tremind_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bremindAt\x12'\n" +
+	"\x0frecurrence_rule\x18\x05 \x01(\tR\x0erecurrenceRule\x12\x12\n" +
+	"\x04tags\x18\x06 \x03(\tR\x04tags\x12\x1a\n" +
+	"\bpriority\x18\a \x01(\tR\bpriority\x12\x16\n" +
+	"\x06errors\x18\b \x03(\tR\x06errors\"`\n" +
+	"\x17ImportRemindersResponse\x12+\n" +
+	"\x04rows\x18\x01 \x03(\v2\x17.reminders.v1.ImportRowR\x04rows\x12\x18\n" +
+	"\acreated\x18\x02 \x01(\x05R\acreated2\xe0\b\n" +
+	"\x0fReminderService\x12k\n" +
+	"\x0eCreateReminder\x12#.reminders.v1.CreateReminderRequest\x1a\x16.reminders.v1.Reminder\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/api/v1/reminders\x12g\n" +
+	"\vGetReminder\x12 .reminders.v1.GetReminderRequest\x1a\x16.reminders.v1.Reminder\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/reminders/{id}\x12s\n" +
+	"\rListReminders\x12\".reminders.v1.ListRemindersRequest\x1a#.reminders.v1.ListRemindersResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/api/v1/reminders\x12p\n" +
+	"\x0eUpdateReminder\x12#.reminders.v1.UpdateReminderRequest\x1a\x16.reminders.v1.Reminder\"!\x82\xd3\xe4\x93\x02\x1b:\x01*2\x16/api/v1/reminders/{id}\x12t\n" +
+	"\x0eCancelReminder\x12#.reminders.v1.CancelReminderRequest\x1a\x16.google.protobuf.Empty\"%\x82\xd3\xe4\x93\x02\x1f\"\x1d/api/v1/reminders/{id}/cancel\x12\x83\x01\n" +
+	"\x13AcknowledgeReminder\x12(.reminders.v1.AcknowledgeReminderRequest\x1a\x16.google.protobuf.Empty\"*\x82\xd3\xe4\x93\x02$\"\"/api/v1/reminders/{id}/acknowledge\x12m\n" +
+	"\x0eDeleteReminder\x12#.reminders.v1.DeleteReminderRequest\x1a\x16.google.protobuf.Empty\"\x1e\x82\xd3\xe4\x93\x02\x18*\x16/api/v1/reminders/{id}\x12\x9e\x01\n" +
+	"\x16ListReminderDeliveries\x12+.reminders.v1.ListReminderDeliveriesRequest\x1a,.reminders.v1.ListReminderDeliveriesResponse\")\x82\xd3\xe4\x93\x02#\x12!/api/v1/reminders/{id}/deliveries\x12\x83\x01\n" +
+	"\x0fImportReminders\x12$.reminders.v1.ImportRemindersRequest\x1a%.reminders.v1.ImportRemindersResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/api/v1/reminders:importB6Z4github.com/vovanwin/template/pkg/reminders;remindersb\x06proto3"
+
+var (
+	file_reminders_reminders_proto_rawDescOnce sync.Once
+	file_reminders_reminders_proto_rawDescData []byte
+)
+
+func file_reminders_reminders_proto_rawDescGZIP() []byte {
+	file_reminders_reminders_proto_rawDescOnce.Do(func() {
+		file_reminders_reminders_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_reminders_reminders_proto_rawDesc), len(file_reminders_reminders_proto_rawDesc)))
+	})
+	return file_reminders_reminders_proto_rawDescData
+}
+
+var file_reminders_reminders_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_reminders_reminders_proto_goTypes = []any{
+	(*Reminder)(nil),                       // 0: reminders.v1.Reminder
+	(*CreateReminderRequest)(nil),          // 1: reminders.v1.CreateReminderRequest
+	(*GetReminderRequest)(nil),             // 2: reminders.v1.GetReminderRequest
+	(*ListRemindersRequest)(nil),           // 3: reminders.v1.ListRemindersRequest
+	(*ListRemindersResponse)(nil),          // 4: reminders.v1.ListRemindersResponse
+	(*SearchHighlight)(nil),                // 5: reminders.v1.SearchHighlight
+	(*UpdateReminderRequest)(nil),          // 6: reminders.v1.UpdateReminderRequest
+	(*CancelReminderRequest)(nil),          // 7: reminders.v1.CancelReminderRequest
+	(*AcknowledgeReminderRequest)(nil),     // 8: reminders.v1.AcknowledgeReminderRequest
+	(*DeleteReminderRequest)(nil),          // 9: reminders.v1.DeleteReminderRequest
+	(*ListReminderDeliveriesRequest)(nil),  // 10: reminders.v1.ListReminderDeliveriesRequest
+	(*ReminderDelivery)(nil),               // 11: reminders.v1.ReminderDelivery
+	(*ListReminderDeliveriesResponse)(nil), // 12: reminders.v1.ListReminderDeliveriesResponse
+	(*ImportRemindersRequest)(nil),         // 13: reminders.v1.ImportRemindersRequest
+	(*ImportRow)(nil),                      // 14: reminders.v1.ImportRow
+	(*ImportRemindersResponse)(nil),        // 15: reminders.v1.ImportRemindersResponse
+	(*timestamppb.Timestamp)(nil),          // 16: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                  // 17: google.protobuf.Empty
+}
+var file_reminders_reminders_proto_depIdxs = []int32{
+	16, // 0: reminders.v1.Reminder.remind_at:type_name -> google.protobuf.Timestamp
+	16, // 1: reminders.v1.Reminder.snoozed_until:type_name -> google.protobuf.Timestamp
+	16, // 2: reminders.v1.Reminder.created_at:type_name -> google.protobuf.Timestamp
+	16, // 3: reminders.v1.Reminder.updated_at:type_name -> google.protobuf.Timestamp
+	16, // 4: reminders.v1.CreateReminderRequest.remind_at:type_name -> google.protobuf.Timestamp
+	0,  // 5: reminders.v1.ListRemindersResponse.reminders:type_name -> reminders.v1.Reminder
+	5,  // 6: reminders.v1.ListRemindersResponse.highlights:type_name -> reminders.v1.SearchHighlight
+	16, // 7: reminders.v1.UpdateReminderRequest.remind_at:type_name -> google.protobuf.Timestamp
+	16, // 8: reminders.v1.ReminderDelivery.created_at:type_name -> google.protobuf.Timestamp
+	11, // 9: reminders.v1.ListReminderDeliveriesResponse.deliveries:type_name -> reminders.v1.ReminderDelivery
+	16, // 10: reminders.v1.ImportRow.remind_at:type_name -> google.protobuf.Timestamp
+	14, // 11: reminders.v1.ImportRemindersResponse.rows:type_name -> reminders.v1.ImportRow
+	1,  // 12: reminders.v1.ReminderService.CreateReminder:input_type -> reminders.v1.CreateReminderRequest
+	2,  // 13: reminders.v1.ReminderService.GetReminder:input_type -> reminders.v1.GetReminderRequest
+	3,  // 14: reminders.v1.ReminderService.ListReminders:input_type -> reminders.v1.ListRemindersRequest
+	6,  // 15: reminders.v1.ReminderService.UpdateReminder:input_type -> reminders.v1.UpdateReminderRequest
+	7,  // 16: reminders.v1.ReminderService.CancelReminder:input_type -> reminders.v1.CancelReminderRequest
+	8,  // 17: reminders.v1.ReminderService.AcknowledgeReminder:input_type -> reminders.v1.AcknowledgeReminderRequest
+	9,  // 18: reminders.v1.ReminderService.DeleteReminder:input_type -> reminders.v1.DeleteReminderRequest
+	10, // 19: reminders.v1.ReminderService.ListReminderDeliveries:input_type -> reminders.v1.ListReminderDeliveriesRequest
+	13, // 20: reminders.v1.ReminderService.ImportReminders:input_type -> reminders.v1.ImportRemindersRequest
+	0,  // 21: reminders.v1.ReminderService.CreateReminder:output_type -> reminders.v1.Reminder
+	0,  // 22: reminders.v1.ReminderService.GetReminder:output_type -> reminders.v1.Reminder
+	4,  // 23: reminders.v1.ReminderService.ListReminders:output_type -> reminders.v1.ListRemindersResponse
+	0,  // 24: reminders.v1.ReminderService.UpdateReminder:output_type -> reminders.v1.Reminder
+	17, // 25: reminders.v1.ReminderService.CancelReminder:output_type -> google.protobuf.Empty
+	17, // 26: reminders.v1.ReminderService.AcknowledgeReminder:output_type -> google.protobuf.Empty
+	17, // 27: reminders.v1.ReminderService.DeleteReminder:output_type -> google.protobuf.Empty
+	12, // 28: reminders.v1.ReminderService.ListReminderDeliveries:output_type -> reminders.v1.ListReminderDeliveriesResponse
+	15, // 29: reminders.v1.ReminderService.ImportReminders:output_type -> reminders.v1.ImportRemindersResponse
+	21, // [21:30] is the sub-list for method output_type
+	12, // [12:21] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_reminders_reminders_proto_init() }
+func file_reminders_reminders_proto_init() {
+	if File_reminders_reminders_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_reminders_reminders_proto_rawDesc), len(file_reminders_reminders_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   16,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_reminders_reminders_proto_goTypes,
+		DependencyIndexes: file_reminders_reminders_proto_depIdxs,
+		MessageInfos:      file_reminders_reminders_proto_msgTypes,
+	}.Build()
+	File_reminders_reminders_proto = out.File
+	file_reminders_reminders_proto_goTypes = nil
+	file_reminders_reminders_proto_depIdxs = nil
+}
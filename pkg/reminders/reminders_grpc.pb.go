@@ -0,0 +1,438 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: reminders/reminders.proto
+
+package reminders
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ReminderService_CreateReminder_FullMethodName         = "/reminders.v1.ReminderService/CreateReminder"
+	ReminderService_GetReminder_FullMethodName            = "/reminders.v1.ReminderService/GetReminder"
+	ReminderService_ListReminders_FullMethodName          = "/reminders.v1.ReminderService/ListReminders"
+	ReminderService_UpdateReminder_FullMethodName         = "/reminders.v1.ReminderService/UpdateReminder"
+	ReminderService_CancelReminder_FullMethodName         = "/reminders.v1.ReminderService/CancelReminder"
+	ReminderService_AcknowledgeReminder_FullMethodName    = "/reminders.v1.ReminderService/AcknowledgeReminder"
+	ReminderService_DeleteReminder_FullMethodName         = "/reminders.v1.ReminderService/DeleteReminder"
+	ReminderService_ListReminderDeliveries_FullMethodName = "/reminders.v1.ReminderService/ListReminderDeliveries"
+	ReminderService_ImportReminders_FullMethodName        = "/reminders.v1.ReminderService/ImportReminders"
+)
+
+// ReminderServiceClient is the client API for ReminderService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ReminderService — напоминания текущего пользователя (authenticated)
+type ReminderServiceClient interface {
+	CreateReminder(ctx context.Context, in *CreateReminderRequest, opts ...grpc.CallOption) (*Reminder, error)
+	GetReminder(ctx context.Context, in *GetReminderRequest, opts ...grpc.CallOption) (*Reminder, error)
+	ListReminders(ctx context.Context, in *ListRemindersRequest, opts ...grpc.CallOption) (*ListRemindersResponse, error)
+	UpdateReminder(ctx context.Context, in *UpdateReminderRequest, opts ...grpc.CallOption) (*Reminder, error)
+	CancelReminder(ctx context.Context, in *CancelReminderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	AcknowledgeReminder(ctx context.Context, in *AcknowledgeReminderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteReminder(ctx context.Context, in *DeleteReminderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListReminderDeliveries возвращает историю доставки напоминания:
+	// попытки отправки по каналам и подтверждения в хронологическом порядке
+	ListReminderDeliveries(ctx context.Context, in *ListReminderDeliveriesRequest, opts ...grpc.CallOption) (*ListReminderDeliveriesResponse, error)
+	// ImportReminders создаёт напоминания из файла iCalendar или CSV; с dry_run
+	// только возвращает разобранные строки с ошибками для предпросмотра
+	ImportReminders(ctx context.Context, in *ImportRemindersRequest, opts ...grpc.CallOption) (*ImportRemindersResponse, error)
+}
+
+type reminderServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewReminderServiceClient(cc grpc.ClientConnInterface) ReminderServiceClient {
+	return &reminderServiceClient{cc}
+}
+
+func (c *reminderServiceClient) CreateReminder(ctx context.Context, in *CreateReminderRequest, opts ...grpc.CallOption) (*Reminder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Reminder)
+	err := c.cc.Invoke(ctx, ReminderService_CreateReminder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reminderServiceClient) GetReminder(ctx context.Context, in *GetReminderRequest, opts ...grpc.CallOption) (*Reminder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Reminder)
+	err := c.cc.Invoke(ctx, ReminderService_GetReminder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reminderServiceClient) ListReminders(ctx context.Context, in *ListRemindersRequest, opts ...grpc.CallOption) (*ListRemindersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRemindersResponse)
+	err := c.cc.Invoke(ctx, ReminderService_ListReminders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reminderServiceClient) UpdateReminder(ctx context.Context, in *UpdateReminderRequest, opts ...grpc.CallOption) (*Reminder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Reminder)
+	err := c.cc.Invoke(ctx, ReminderService_UpdateReminder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reminderServiceClient) CancelReminder(ctx context.Context, in *CancelReminderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ReminderService_CancelReminder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reminderServiceClient) AcknowledgeReminder(ctx context.Context, in *AcknowledgeReminderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ReminderService_AcknowledgeReminder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reminderServiceClient) DeleteReminder(ctx context.Context, in *DeleteReminderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ReminderService_DeleteReminder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reminderServiceClient) ListReminderDeliveries(ctx context.Context, in *ListReminderDeliveriesRequest, opts ...grpc.CallOption) (*ListReminderDeliveriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReminderDeliveriesResponse)
+	err := c.cc.Invoke(ctx, ReminderService_ListReminderDeliveries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reminderServiceClient) ImportReminders(ctx context.Context, in *ImportRemindersRequest, opts ...grpc.CallOption) (*ImportRemindersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportRemindersResponse)
+	err := c.cc.Invoke(ctx, ReminderService_ImportReminders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ReminderServiceServer is the server API for ReminderService service.
+// All implementations must embed UnimplementedReminderServiceServer
+// for forward compatibility.
+//
+// ReminderService — напоминания текущего пользователя (authenticated)
+type ReminderServiceServer interface {
+	CreateReminder(context.Context, *CreateReminderRequest) (*Reminder, error)
+	GetReminder(context.Context, *GetReminderRequest) (*Reminder, error)
+	ListReminders(context.Context, *ListRemindersRequest) (*ListRemindersResponse, error)
+	UpdateReminder(context.Context, *UpdateReminderRequest) (*Reminder, error)
+	CancelReminder(context.Context, *CancelReminderRequest) (*emptypb.Empty, error)
+	AcknowledgeReminder(context.Context, *AcknowledgeReminderRequest) (*emptypb.Empty, error)
+	DeleteReminder(context.Context, *DeleteReminderRequest) (*emptypb.Empty, error)
+	// ListReminderDeliveries возвращает историю доставки напоминания:
+	// попытки отправки по каналам и подтверждения в хронологическом порядке
+	ListReminderDeliveries(context.Context, *ListReminderDeliveriesRequest) (*ListReminderDeliveriesResponse, error)
+	// ImportReminders создаёт напоминания из файла iCalendar или CSV; с dry_run
+	// только возвращает разобранные строки с ошибками для предпросмотра
+	ImportReminders(context.Context, *ImportRemindersRequest) (*ImportRemindersResponse, error)
+	mustEmbedUnimplementedReminderServiceServer()
+}
+
+// UnimplementedReminderServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedReminderServiceServer struct{}
+
+func (UnimplementedReminderServiceServer) CreateReminder(context.Context, *CreateReminderRequest) (*Reminder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReminder not implemented")
+}
+func (UnimplementedReminderServiceServer) GetReminder(context.Context, *GetReminderRequest) (*Reminder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReminder not implemented")
+}
+func (UnimplementedReminderServiceServer) ListReminders(context.Context, *ListRemindersRequest) (*ListRemindersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReminders not implemented")
+}
+func (UnimplementedReminderServiceServer) UpdateReminder(context.Context, *UpdateReminderRequest) (*Reminder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateReminder not implemented")
+}
+func (UnimplementedReminderServiceServer) CancelReminder(context.Context, *CancelReminderRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelReminder not implemented")
+}
+func (UnimplementedReminderServiceServer) AcknowledgeReminder(context.Context, *AcknowledgeReminderRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcknowledgeReminder not implemented")
+}
+func (UnimplementedReminderServiceServer) DeleteReminder(context.Context, *DeleteReminderRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteReminder not implemented")
+}
+func (UnimplementedReminderServiceServer) ListReminderDeliveries(context.Context, *ListReminderDeliveriesRequest) (*ListReminderDeliveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReminderDeliveries not implemented")
+}
+func (UnimplementedReminderServiceServer) ImportReminders(context.Context, *ImportRemindersRequest) (*ImportRemindersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportReminders not implemented")
+}
+func (UnimplementedReminderServiceServer) mustEmbedUnimplementedReminderServiceServer() {}
+func (UnimplementedReminderServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeReminderServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ReminderServiceServer will
+// result in compilation errors.
+type UnsafeReminderServiceServer interface {
+	mustEmbedUnimplementedReminderServiceServer()
+}
+
+func RegisterReminderServiceServer(s grpc.ServiceRegistrar, srv ReminderServiceServer) {
+	// If the following call pancis, it indicates UnimplementedReminderServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ReminderService_ServiceDesc, srv)
+}
+
+func _ReminderService_CreateReminder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReminderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReminderServiceServer).CreateReminder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReminderService_CreateReminder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReminderServiceServer).CreateReminder(ctx, req.(*CreateReminderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReminderService_GetReminder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReminderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReminderServiceServer).GetReminder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReminderService_GetReminder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReminderServiceServer).GetReminder(ctx, req.(*GetReminderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReminderService_ListReminders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRemindersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReminderServiceServer).ListReminders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReminderService_ListReminders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReminderServiceServer).ListReminders(ctx, req.(*ListRemindersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReminderService_UpdateReminder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateReminderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReminderServiceServer).UpdateReminder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReminderService_UpdateReminder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReminderServiceServer).UpdateReminder(ctx, req.(*UpdateReminderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReminderService_CancelReminder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelReminderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReminderServiceServer).CancelReminder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReminderService_CancelReminder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReminderServiceServer).CancelReminder(ctx, req.(*CancelReminderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReminderService_AcknowledgeReminder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcknowledgeReminderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReminderServiceServer).AcknowledgeReminder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReminderService_AcknowledgeReminder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReminderServiceServer).AcknowledgeReminder(ctx, req.(*AcknowledgeReminderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReminderService_DeleteReminder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteReminderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReminderServiceServer).DeleteReminder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReminderService_DeleteReminder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReminderServiceServer).DeleteReminder(ctx, req.(*DeleteReminderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReminderService_ListReminderDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReminderDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReminderServiceServer).ListReminderDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReminderService_ListReminderDeliveries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReminderServiceServer).ListReminderDeliveries(ctx, req.(*ListReminderDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReminderService_ImportReminders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportRemindersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReminderServiceServer).ImportReminders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReminderService_ImportReminders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReminderServiceServer).ImportReminders(ctx, req.(*ImportRemindersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ReminderService_ServiceDesc is the grpc.ServiceDesc for ReminderService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ReminderService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "reminders.v1.ReminderService",
+	HandlerType: (*ReminderServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateReminder",
+			Handler:    _ReminderService_CreateReminder_Handler,
+		},
+		{
+			MethodName: "GetReminder",
+			Handler:    _ReminderService_GetReminder_Handler,
+		},
+		{
+			MethodName: "ListReminders",
+			Handler:    _ReminderService_ListReminders_Handler,
+		},
+		{
+			MethodName: "UpdateReminder",
+			Handler:    _ReminderService_UpdateReminder_Handler,
+		},
+		{
+			MethodName: "CancelReminder",
+			Handler:    _ReminderService_CancelReminder_Handler,
+		},
+		{
+			MethodName: "AcknowledgeReminder",
+			Handler:    _ReminderService_AcknowledgeReminder_Handler,
+		},
+		{
+			MethodName: "DeleteReminder",
+			Handler:    _ReminderService_DeleteReminder_Handler,
+		},
+		{
+			MethodName: "ListReminderDeliveries",
+			Handler:    _ReminderService_ListReminderDeliveries_Handler,
+		},
+		{
+			MethodName: "ImportReminders",
+			Handler:    _ReminderService_ImportReminders_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "reminders/reminders.proto",
+}
@@ -43,8 +43,34 @@ type ScheduleReminderRequest struct {
 	RequireConfirmation bool `protobuf:"varint,7,opt,name=require_confirmation,json=requireConfirmation,proto3" json:"require_confirmation,omitempty"`
 	// Интервал повторной отправки в минутах (5, 10, 15, 30, 60)
 	RepeatIntervalMinutes int32 `protobuf:"varint,8,opt,name=repeat_interval_minutes,json=repeatIntervalMinutes,proto3" json:"repeat_interval_minutes,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+	// Запуск очередного срабатывания повторяющегося напоминания (из Temporal Schedule)
+	Recurring bool `protobuf:"varint,9,opt,name=recurring,proto3" json:"recurring,omitempty"`
+	// Каналы доставки в порядке попыток: telegram, inapp, email, webhook.
+	// Пустой список — только Telegram (workflow, запущенные до появления каналов)
+	Channels []string `protobuf:"bytes,10,rep,name=channels,proto3" json:"channels,omitempty"`
+	// Адрес для канала email
+	Email string `protobuf:"bytes,11,opt,name=email,proto3" json:"email,omitempty"`
+	// URL для канала webhook
+	WebhookUrl string `protobuf:"bytes,12,opt,name=webhook_url,json=webhookUrl,proto3" json:"webhook_url,omitempty"`
+	// Цепочка эскалации: кого уведомить, если напоминание долго не подтверждают
+	Escalation []*EscalationStep `protobuf:"bytes,13,rep,name=escalation,proto3" json:"escalation,omitempty"`
+	// ID создателя, если напоминание назначено другому пользователю (user_id — исполнитель).
+	// Создатель получает уведомление о подтверждении
+	CreatorId string `protobuf:"bytes,14,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	// Приоритет: low, normal, high; пусто — normal (workflow, запущенные до появления приоритета)
+	Priority string `protobuf:"bytes,15,opt,name=priority,proto3" json:"priority,omitempty"`
+	// Отправлять, не дожидаясь конца тихих часов и режима «не беспокоить» получателя.
+	// Высокий приоритет игнорирует тихие часы всегда
+	OverrideQuietHours bool `protobuf:"varint,16,opt,name=override_quiet_hours,json=overrideQuietHours,proto3" json:"override_quiet_hours,omitempty"`
+	// Окно ожидания подтверждения в минутах; 0 — 10 часов. Не подтверждённое
+	// в окно напоминание получает статус expired
+	ConfirmWindowMinutes int32 `protobuf:"varint,17,opt,name=confirm_window_minutes,json=confirmWindowMinutes,proto3" json:"confirm_window_minutes,omitempty"`
+	// Рост интервала между повторами: fixed или exponential; пусто — fixed
+	ResendBackoff string `protobuf:"bytes,18,opt,name=resend_backoff,json=resendBackoff,proto3" json:"resend_backoff,omitempty"`
+	// Сколько раз повторить уведомление; 0 — до конца окна подтверждения
+	MaxResends    int32 `protobuf:"varint,19,opt,name=max_resends,json=maxResends,proto3" json:"max_resends,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ScheduleReminderRequest) Reset() {
@@ -133,6 +159,147 @@ func (x *ScheduleReminderRequest) GetRepeatIntervalMinutes() int32 {
 	return 0
 }
 
+func (x *ScheduleReminderRequest) GetRecurring() bool {
+	if x != nil {
+		return x.Recurring
+	}
+	return false
+}
+
+func (x *ScheduleReminderRequest) GetChannels() []string {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *ScheduleReminderRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ScheduleReminderRequest) GetWebhookUrl() string {
+	if x != nil {
+		return x.WebhookUrl
+	}
+	return ""
+}
+
+func (x *ScheduleReminderRequest) GetEscalation() []*EscalationStep {
+	if x != nil {
+		return x.Escalation
+	}
+	return nil
+}
+
+func (x *ScheduleReminderRequest) GetCreatorId() string {
+	if x != nil {
+		return x.CreatorId
+	}
+	return ""
+}
+
+func (x *ScheduleReminderRequest) GetPriority() string {
+	if x != nil {
+		return x.Priority
+	}
+	return ""
+}
+
+func (x *ScheduleReminderRequest) GetOverrideQuietHours() bool {
+	if x != nil {
+		return x.OverrideQuietHours
+	}
+	return false
+}
+
+func (x *ScheduleReminderRequest) GetConfirmWindowMinutes() int32 {
+	if x != nil {
+		return x.ConfirmWindowMinutes
+	}
+	return 0
+}
+
+func (x *ScheduleReminderRequest) GetResendBackoff() string {
+	if x != nil {
+		return x.ResendBackoff
+	}
+	return ""
+}
+
+func (x *ScheduleReminderRequest) GetMaxResends() int32 {
+	if x != nil {
+		return x.MaxResends
+	}
+	return 0
+}
+
+// EscalationStep шаг цепочки эскалации
+type EscalationStep struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// После скольких повторов без подтверждения срабатывает шаг
+	AfterRepeats int32 `protobuf:"varint,1,opt,name=after_repeats,json=afterRepeats,proto3" json:"after_repeats,omitempty"`
+	// Канал: telegram, email или webhook
+	Channel string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	// Chat ID (пользователь или группа), email или URL webhook
+	Address       string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EscalationStep) Reset() {
+	*x = EscalationStep{}
+	mi := &file_reminder_reminder_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EscalationStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EscalationStep) ProtoMessage() {}
+
+func (x *EscalationStep) ProtoReflect() protoreflect.Message {
+	mi := &file_reminder_reminder_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EscalationStep.ProtoReflect.Descriptor instead.
+func (*EscalationStep) Descriptor() ([]byte, []int) {
+	return file_reminder_reminder_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EscalationStep) GetAfterRepeats() int32 {
+	if x != nil {
+		return x.AfterRepeats
+	}
+	return 0
+}
+
+func (x *EscalationStep) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *EscalationStep) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
 // ScheduleReminderResponse результат создания напоминания
 type ScheduleReminderResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -146,7 +313,7 @@ type ScheduleReminderResponse struct {
 
 func (x *ScheduleReminderResponse) Reset() {
 	*x = ScheduleReminderResponse{}
-	mi := &file_reminder_reminder_proto_msgTypes[1]
+	mi := &file_reminder_reminder_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -158,7 +325,7 @@ func (x *ScheduleReminderResponse) String() string {
 func (*ScheduleReminderResponse) ProtoMessage() {}
 
 func (x *ScheduleReminderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_reminder_reminder_proto_msgTypes[1]
+	mi := &file_reminder_reminder_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -171,7 +338,7 @@ func (x *ScheduleReminderResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScheduleReminderResponse.ProtoReflect.Descriptor instead.
 func (*ScheduleReminderResponse) Descriptor() ([]byte, []int) {
-	return file_reminder_reminder_proto_rawDescGZIP(), []int{1}
+	return file_reminder_reminder_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *ScheduleReminderResponse) GetWorkflowId() string {
@@ -200,14 +367,18 @@ type SendTelegramNotificationRequest struct {
 	// Требуется ли подтверждение (для отображения кнопки)
 	RequireConfirmation bool `protobuf:"varint,4,opt,name=require_confirmation,json=requireConfirmation,proto3" json:"require_confirmation,omitempty"`
 	// ID напоминания (для callback data кнопки подтверждения)
-	ReminderId    string `protobuf:"bytes,5,opt,name=reminder_id,json=reminderId,proto3" json:"reminder_id,omitempty"`
+	ReminderId string `protobuf:"bytes,5,opt,name=reminder_id,json=reminderId,proto3" json:"reminder_id,omitempty"`
+	// Приоритет: low, normal, high
+	Priority string `protobuf:"bytes,6,opt,name=priority,proto3" json:"priority,omitempty"`
+	// Номер отправки в workflow: 1 — первая, дальше повторы
+	Delivery      int32 `protobuf:"varint,7,opt,name=delivery,proto3" json:"delivery,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SendTelegramNotificationRequest) Reset() {
 	*x = SendTelegramNotificationRequest{}
-	mi := &file_reminder_reminder_proto_msgTypes[2]
+	mi := &file_reminder_reminder_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -219,7 +390,7 @@ func (x *SendTelegramNotificationRequest) String() string {
 func (*SendTelegramNotificationRequest) ProtoMessage() {}
 
 func (x *SendTelegramNotificationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_reminder_reminder_proto_msgTypes[2]
+	mi := &file_reminder_reminder_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -232,7 +403,7 @@ func (x *SendTelegramNotificationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendTelegramNotificationRequest.ProtoReflect.Descriptor instead.
 func (*SendTelegramNotificationRequest) Descriptor() ([]byte, []int) {
-	return file_reminder_reminder_proto_rawDescGZIP(), []int{2}
+	return file_reminder_reminder_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *SendTelegramNotificationRequest) GetChatId() int64 {
@@ -270,32 +441,56 @@ func (x *SendTelegramNotificationRequest) GetReminderId() string {
 	return ""
 }
 
-// UpdateReminderStatusRequest входные данные для обновления статуса
-type UpdateReminderStatusRequest struct {
+func (x *SendTelegramNotificationRequest) GetPriority() string {
+	if x != nil {
+		return x.Priority
+	}
+	return ""
+}
+
+func (x *SendTelegramNotificationRequest) GetDelivery() int32 {
+	if x != nil {
+		return x.Delivery
+	}
+	return 0
+}
+
+// ChannelNotificationRequest входные данные для отправки уведомления в канал inapp, email или webhook
+type ChannelNotificationRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// ID напоминания
 	ReminderId string `protobuf:"bytes,1,opt,name=reminder_id,json=reminderId,proto3" json:"reminder_id,omitempty"`
-	// Новый статус
-	Status        string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// Заголовок
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// Описание
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Требуется ли подтверждение
+	RequireConfirmation bool `protobuf:"varint,4,opt,name=require_confirmation,json=requireConfirmation,proto3" json:"require_confirmation,omitempty"`
+	// Адрес в канале: ID пользователя (inapp), email или URL webhook
+	Recipient string `protobuf:"bytes,5,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// Приоритет: low, normal, high
+	Priority string `protobuf:"bytes,6,opt,name=priority,proto3" json:"priority,omitempty"`
+	// Номер отправки в workflow: 1 — первая, дальше повторы
+	Delivery      int32 `protobuf:"varint,7,opt,name=delivery,proto3" json:"delivery,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateReminderStatusRequest) Reset() {
-	*x = UpdateReminderStatusRequest{}
-	mi := &file_reminder_reminder_proto_msgTypes[3]
+func (x *ChannelNotificationRequest) Reset() {
+	*x = ChannelNotificationRequest{}
+	mi := &file_reminder_reminder_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateReminderStatusRequest) String() string {
+func (x *ChannelNotificationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateReminderStatusRequest) ProtoMessage() {}
+func (*ChannelNotificationRequest) ProtoMessage() {}
 
-func (x *UpdateReminderStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_reminder_reminder_proto_msgTypes[3]
+func (x *ChannelNotificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reminder_reminder_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -306,49 +501,96 @@ func (x *UpdateReminderStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateReminderStatusRequest.ProtoReflect.Descriptor instead.
-func (*UpdateReminderStatusRequest) Descriptor() ([]byte, []int) {
-	return file_reminder_reminder_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use ChannelNotificationRequest.ProtoReflect.Descriptor instead.
+func (*ChannelNotificationRequest) Descriptor() ([]byte, []int) {
+	return file_reminder_reminder_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *UpdateReminderStatusRequest) GetReminderId() string {
+func (x *ChannelNotificationRequest) GetReminderId() string {
 	if x != nil {
 		return x.ReminderId
 	}
 	return ""
 }
 
-func (x *UpdateReminderStatusRequest) GetStatus() string {
+func (x *ChannelNotificationRequest) GetTitle() string {
 	if x != nil {
-		return x.Status
+		return x.Title
 	}
 	return ""
 }
 
-// GetReminderStatusResponse текущий статус напоминания
-type GetReminderStatusResponse struct {
+func (x *ChannelNotificationRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ChannelNotificationRequest) GetRequireConfirmation() bool {
+	if x != nil {
+		return x.RequireConfirmation
+	}
+	return false
+}
+
+func (x *ChannelNotificationRequest) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *ChannelNotificationRequest) GetPriority() string {
+	if x != nil {
+		return x.Priority
+	}
+	return ""
+}
+
+func (x *ChannelNotificationRequest) GetDelivery() int32 {
+	if x != nil {
+		return x.Delivery
+	}
+	return 0
+}
+
+// SendEscalationRequest входные данные для уведомления контакта эскалации
+type SendEscalationRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Статус: pending, sent, cancelled
-	Status        string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// ID напоминания
+	ReminderId string `protobuf:"bytes,1,opt,name=reminder_id,json=reminderId,proto3" json:"reminder_id,omitempty"`
+	// Заголовок
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// Описание
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Номер шага, начиная с 1
+	Step int32 `protobuf:"varint,4,opt,name=step,proto3" json:"step,omitempty"`
+	// Канал: telegram, email или webhook
+	Channel string `protobuf:"bytes,5,opt,name=channel,proto3" json:"channel,omitempty"`
+	// Адрес контакта в канале
+	Address string `protobuf:"bytes,6,opt,name=address,proto3" json:"address,omitempty"`
+	// Сколько раз напоминание отправлено повторно без подтверждения
+	Repeats       int32 `protobuf:"varint,7,opt,name=repeats,proto3" json:"repeats,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetReminderStatusResponse) Reset() {
-	*x = GetReminderStatusResponse{}
-	mi := &file_reminder_reminder_proto_msgTypes[4]
+func (x *SendEscalationRequest) Reset() {
+	*x = SendEscalationRequest{}
+	mi := &file_reminder_reminder_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetReminderStatusResponse) String() string {
+func (x *SendEscalationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetReminderStatusResponse) ProtoMessage() {}
+func (*SendEscalationRequest) ProtoMessage() {}
 
-func (x *GetReminderStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_reminder_reminder_proto_msgTypes[4]
+func (x *SendEscalationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reminder_reminder_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -359,63 +601,1091 @@ func (x *GetReminderStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetReminderStatusResponse.ProtoReflect.Descriptor instead.
-func (*GetReminderStatusResponse) Descriptor() ([]byte, []int) {
-	return file_reminder_reminder_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use SendEscalationRequest.ProtoReflect.Descriptor instead.
+func (*SendEscalationRequest) Descriptor() ([]byte, []int) {
+	return file_reminder_reminder_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetReminderStatusResponse) GetStatus() string {
+func (x *SendEscalationRequest) GetReminderId() string {
 	if x != nil {
-		return x.Status
+		return x.ReminderId
 	}
 	return ""
 }
 
-var File_reminder_reminder_proto protoreflect.FileDescriptor
+func (x *SendEscalationRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
 
-const file_reminder_reminder_proto_rawDesc = "" +
-	"\n" +
-	"\x17reminder/reminder.proto\x12\vreminder.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1atemporal/v1/temporal.proto\"\xd9\x02\n" +
-	"\x17ScheduleReminderRequest\x12\x1f\n" +
-	"\vreminder_id\x18\x01 \x01(\tR\n" +
-	"reminderId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x14\n" +
-	"\x05title\x18\x03 \x01(\tR\x05title\x12 \n" +
-	"\vdescription\x18\x04 \x01(\tR\vdescription\x127\n" +
-	"\tremind_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bremindAt\x12(\n" +
-	"\x10telegram_chat_id\x18\x06 \x01(\x03R\x0etelegramChatId\x121\n" +
-	"\x14require_confirmation\x18\a \x01(\bR\x13requireConfirmation\x126\n" +
-	"\x17repeat_interval_minutes\x18\b \x01(\x05R\x15repeatIntervalMinutes\"S\n" +
-	"\x18ScheduleReminderResponse\x12\x1f\n" +
-	"\vworkflow_id\x18\x01 \x01(\tR\n" +
-	"workflowId\x12\x16\n" +
-	"\x06status\x18\x02 \x01(\tR\x06status\"\xc6\x01\n" +
-	"\x1fSendTelegramNotificationRequest\x12\x17\n" +
-	"\achat_id\x18\x01 \x01(\x03R\x06chatId\x12\x14\n" +
-	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x121\n" +
-	"\x14require_confirmation\x18\x04 \x01(\bR\x13requireConfirmation\x12\x1f\n" +
-	"\vreminder_id\x18\x05 \x01(\tR\n" +
-	"reminderId\"V\n" +
-	"\x1bUpdateReminderStatusRequest\x12\x1f\n" +
-	"\vreminder_id\x18\x01 \x01(\tR\n" +
-	"reminderId\x12\x16\n" +
-	"\x06status\x18\x02 \x01(\tR\x06status\"3\n" +
-	"\x19GetReminderStatusResponse\x12\x16\n" +
-	"\x06status\x18\x01 \x01(\tR\x06status2\xae\x05\n" +
-	"\bReminder\x12\xc6\x01\n" +
-	"\x10ScheduleReminder\x12$.reminder.v1.ScheduleReminderRequest\x1a%.reminder.v1.ScheduleReminderResponse\"e\x8a\xc4\x03a\n" +
-	"\x13\n" +
-	"\x11GetReminderStatus\x12\x10\n" +
-	"\x0eCancelReminder\x12\x15\n" +
-	"\x13AcknowledgeReminder\"\x05\b\x80\x9a\x9e\x01*\x1areminder/${! reminder_id }\x12n\n" +
-	"\x18SendTelegramNotification\x12,.reminder.v1.SendTelegramNotificationRequest\x1a\x16.google.protobuf.Empty\"\f\x92\xc4\x03\b\"\x02\b\x1e2\x02 \x05\x12f\n" +
-	"\x14UpdateReminderStatus\x12(.reminder.v1.UpdateReminderStatusRequest\x1a\x16.google.protobuf.Empty\"\f\x92\xc4\x03\b\"\x02\b\n" +
+func (x *SendEscalationRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SendEscalationRequest) GetStep() int32 {
+	if x != nil {
+		return x.Step
+	}
+	return 0
+}
+
+func (x *SendEscalationRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *SendEscalationRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *SendEscalationRequest) GetRepeats() int32 {
+	if x != nil {
+		return x.Repeats
+	}
+	return 0
+}
+
+// RecordEscalationRequest входные данные для сохранения шага эскалации
+type RecordEscalationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID напоминания
+	ReminderId string `protobuf:"bytes,1,opt,name=reminder_id,json=reminderId,proto3" json:"reminder_id,omitempty"`
+	// ID workflow, в котором сработал шаг
+	WorkflowId string `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	// Номер шага, начиная с 1
+	Step int32 `protobuf:"varint,3,opt,name=step,proto3" json:"step,omitempty"`
+	// Канал
+	Channel string `protobuf:"bytes,4,opt,name=channel,proto3" json:"channel,omitempty"`
+	// Адрес контакта
+	Address string `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	// Результат: sent или failed
+	Status string `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	// Ошибка отправки (для failed)
+	Error         string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordEscalationRequest) Reset() {
+	*x = RecordEscalationRequest{}
+	mi := &file_reminder_reminder_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordEscalationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordEscalationRequest) ProtoMessage() {}
+
+func (x *RecordEscalationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reminder_reminder_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordEscalationRequest.ProtoReflect.Descriptor instead.
+func (*RecordEscalationRequest) Descriptor() ([]byte, []int) {
+	return file_reminder_reminder_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RecordEscalationRequest) GetReminderId() string {
+	if x != nil {
+		return x.ReminderId
+	}
+	return ""
+}
+
+func (x *RecordEscalationRequest) GetWorkflowId() string {
+	if x != nil {
+		return x.WorkflowId
+	}
+	return ""
+}
+
+func (x *RecordEscalationRequest) GetStep() int32 {
+	if x != nil {
+		return x.Step
+	}
+	return 0
+}
+
+func (x *RecordEscalationRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *RecordEscalationRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *RecordEscalationRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RecordEscalationRequest) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// CheckQuietHoursRequest входные данные для проверки тихих часов
+type CheckQuietHoursRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID получателя уведомления
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Момент отправки (время workflow)
+	At            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=at,proto3" json:"at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckQuietHoursRequest) Reset() {
+	*x = CheckQuietHoursRequest{}
+	mi := &file_reminder_reminder_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckQuietHoursRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckQuietHoursRequest) ProtoMessage() {}
+
+func (x *CheckQuietHoursRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reminder_reminder_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckQuietHoursRequest.ProtoReflect.Descriptor instead.
+func (*CheckQuietHoursRequest) Descriptor() ([]byte, []int) {
+	return file_reminder_reminder_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CheckQuietHoursRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CheckQuietHoursRequest) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+// CheckQuietHoursResponse результат проверки тихих часов
+type CheckQuietHoursResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// До какого момента отправлять нельзя; пусто — можно отправлять сразу
+	Until         *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=until,proto3" json:"until,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckQuietHoursResponse) Reset() {
+	*x = CheckQuietHoursResponse{}
+	mi := &file_reminder_reminder_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckQuietHoursResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckQuietHoursResponse) ProtoMessage() {}
+
+func (x *CheckQuietHoursResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reminder_reminder_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckQuietHoursResponse.ProtoReflect.Descriptor instead.
+func (*CheckQuietHoursResponse) Descriptor() ([]byte, []int) {
+	return file_reminder_reminder_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CheckQuietHoursResponse) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+// RecordAcknowledgementRequest входные данные для сохранения подтверждения
+type RecordAcknowledgementRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID напоминания
+	ReminderId string `protobuf:"bytes,1,opt,name=reminder_id,json=reminderId,proto3" json:"reminder_id,omitempty"`
+	// ID workflow, в котором получено подтверждение
+	WorkflowId string `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	// Сколько раз уведомление было отправлено до подтверждения
+	Delivery      int32 `protobuf:"varint,3,opt,name=delivery,proto3" json:"delivery,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordAcknowledgementRequest) Reset() {
+	*x = RecordAcknowledgementRequest{}
+	mi := &file_reminder_reminder_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordAcknowledgementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordAcknowledgementRequest) ProtoMessage() {}
+
+func (x *RecordAcknowledgementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reminder_reminder_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordAcknowledgementRequest.ProtoReflect.Descriptor instead.
+func (*RecordAcknowledgementRequest) Descriptor() ([]byte, []int) {
+	return file_reminder_reminder_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RecordAcknowledgementRequest) GetReminderId() string {
+	if x != nil {
+		return x.ReminderId
+	}
+	return ""
+}
+
+func (x *RecordAcknowledgementRequest) GetWorkflowId() string {
+	if x != nil {
+		return x.WorkflowId
+	}
+	return ""
+}
+
+func (x *RecordAcknowledgementRequest) GetDelivery() int32 {
+	if x != nil {
+		return x.Delivery
+	}
+	return 0
+}
+
+// NotifyAcknowledgedRequest входные данные для уведомления создателя о подтверждении
+type NotifyAcknowledgedRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID напоминания
+	ReminderId string `protobuf:"bytes,1,opt,name=reminder_id,json=reminderId,proto3" json:"reminder_id,omitempty"`
+	// Заголовок
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// ID создателя напоминания
+	CreatorId string `protobuf:"bytes,3,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	// ID исполнителя, подтвердившего напоминание
+	AssigneeId    string `protobuf:"bytes,4,opt,name=assignee_id,json=assigneeId,proto3" json:"assignee_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotifyAcknowledgedRequest) Reset() {
+	*x = NotifyAcknowledgedRequest{}
+	mi := &file_reminder_reminder_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotifyAcknowledgedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotifyAcknowledgedRequest) ProtoMessage() {}
+
+func (x *NotifyAcknowledgedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reminder_reminder_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotifyAcknowledgedRequest.ProtoReflect.Descriptor instead.
+func (*NotifyAcknowledgedRequest) Descriptor() ([]byte, []int) {
+	return file_reminder_reminder_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *NotifyAcknowledgedRequest) GetReminderId() string {
+	if x != nil {
+		return x.ReminderId
+	}
+	return ""
+}
+
+func (x *NotifyAcknowledgedRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *NotifyAcknowledgedRequest) GetCreatorId() string {
+	if x != nil {
+		return x.CreatorId
+	}
+	return ""
+}
+
+func (x *NotifyAcknowledgedRequest) GetAssigneeId() string {
+	if x != nil {
+		return x.AssigneeId
+	}
+	return ""
+}
+
+// UpdateReminderStatusRequest входные данные для обновления статуса
+type UpdateReminderStatusRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID напоминания
+	ReminderId string `protobuf:"bytes,1,opt,name=reminder_id,json=reminderId,proto3" json:"reminder_id,omitempty"`
+	// Новый статус
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// ID workflow срабатывания (только для повторяющихся напоминаний)
+	OccurrenceWorkflowId string `protobuf:"bytes,3,opt,name=occurrence_workflow_id,json=occurrenceWorkflowId,proto3" json:"occurrence_workflow_id,omitempty"`
+	// До какого момента отложено уведомление (только для статуса snoozed)
+	SnoozedUntil  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=snoozed_until,json=snoozedUntil,proto3" json:"snoozed_until,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateReminderStatusRequest) Reset() {
+	*x = UpdateReminderStatusRequest{}
+	mi := &file_reminder_reminder_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateReminderStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateReminderStatusRequest) ProtoMessage() {}
+
+func (x *UpdateReminderStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reminder_reminder_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateReminderStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateReminderStatusRequest) Descriptor() ([]byte, []int) {
+	return file_reminder_reminder_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpdateReminderStatusRequest) GetReminderId() string {
+	if x != nil {
+		return x.ReminderId
+	}
+	return ""
+}
+
+func (x *UpdateReminderStatusRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UpdateReminderStatusRequest) GetOccurrenceWorkflowId() string {
+	if x != nil {
+		return x.OccurrenceWorkflowId
+	}
+	return ""
+}
+
+func (x *UpdateReminderStatusRequest) GetSnoozedUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SnoozedUntil
+	}
+	return nil
+}
+
+// SnoozeReminderRequest входные данные сигнала откладывания
+type SnoozeReminderRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// На сколько секунд отложить уведомление
+	DurationSeconds int64 `protobuf:"varint,1,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SnoozeReminderRequest) Reset() {
+	*x = SnoozeReminderRequest{}
+	mi := &file_reminder_reminder_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SnoozeReminderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnoozeReminderRequest) ProtoMessage() {}
+
+func (x *SnoozeReminderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reminder_reminder_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnoozeReminderRequest.ProtoReflect.Descriptor instead.
+func (*SnoozeReminderRequest) Descriptor() ([]byte, []int) {
+	return file_reminder_reminder_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SnoozeReminderRequest) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+// GetReminderStatusResponse текущий статус напоминания
+type GetReminderStatusResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Статус: pending, sent, cancelled
+	Status        string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReminderStatusResponse) Reset() {
+	*x = GetReminderStatusResponse{}
+	mi := &file_reminder_reminder_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReminderStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReminderStatusResponse) ProtoMessage() {}
+
+func (x *GetReminderStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reminder_reminder_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReminderStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetReminderStatusResponse) Descriptor() ([]byte, []int) {
+	return file_reminder_reminder_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetReminderStatusResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// UpdateReminderRequest новые значения полей напоминания
+type UpdateReminderRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Новый заголовок
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// Новое описание
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Новое время напоминания; пустое значение — время не меняется
+	RemindAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=remind_at,json=remindAt,proto3" json:"remind_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateReminderRequest) Reset() {
+	*x = UpdateReminderRequest{}
+	mi := &file_reminder_reminder_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateReminderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateReminderRequest) ProtoMessage() {}
+
+func (x *UpdateReminderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reminder_reminder_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateReminderRequest.ProtoReflect.Descriptor instead.
+func (*UpdateReminderRequest) Descriptor() ([]byte, []int) {
+	return file_reminder_reminder_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UpdateReminderRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdateReminderRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateReminderRequest) GetRemindAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RemindAt
+	}
+	return nil
+}
+
+// UpdateReminderResponse состояние напоминания после изменения
+type UpdateReminderResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Текущий статус
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// Время, на которое запланировано уведомление
+	RemindAt      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=remind_at,json=remindAt,proto3" json:"remind_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateReminderResponse) Reset() {
+	*x = UpdateReminderResponse{}
+	mi := &file_reminder_reminder_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateReminderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateReminderResponse) ProtoMessage() {}
+
+func (x *UpdateReminderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reminder_reminder_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateReminderResponse.ProtoReflect.Descriptor instead.
+func (*UpdateReminderResponse) Descriptor() ([]byte, []int) {
+	return file_reminder_reminder_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UpdateReminderResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UpdateReminderResponse) GetRemindAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RemindAt
+	}
+	return nil
+}
+
+// SaveReminderDetailsRequest входные данные для сохранения изменённых полей
+type SaveReminderDetailsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID напоминания
+	ReminderId string `protobuf:"bytes,1,opt,name=reminder_id,json=reminderId,proto3" json:"reminder_id,omitempty"`
+	// Заголовок
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// Описание
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Время напоминания; пустое значение — время не меняется
+	RemindAt      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=remind_at,json=remindAt,proto3" json:"remind_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveReminderDetailsRequest) Reset() {
+	*x = SaveReminderDetailsRequest{}
+	mi := &file_reminder_reminder_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveReminderDetailsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveReminderDetailsRequest) ProtoMessage() {}
+
+func (x *SaveReminderDetailsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reminder_reminder_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveReminderDetailsRequest.ProtoReflect.Descriptor instead.
+func (*SaveReminderDetailsRequest) Descriptor() ([]byte, []int) {
+	return file_reminder_reminder_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SaveReminderDetailsRequest) GetReminderId() string {
+	if x != nil {
+		return x.ReminderId
+	}
+	return ""
+}
+
+func (x *SaveReminderDetailsRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SaveReminderDetailsRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SaveReminderDetailsRequest) GetRemindAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RemindAt
+	}
+	return nil
+}
+
+// DailyDigestRequest входные данные workflow утренней сводки
+type DailyDigestRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID пользователя
+	UserId        string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DailyDigestRequest) Reset() {
+	*x = DailyDigestRequest{}
+	mi := &file_reminder_reminder_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DailyDigestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyDigestRequest) ProtoMessage() {}
+
+func (x *DailyDigestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reminder_reminder_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyDigestRequest.ProtoReflect.Descriptor instead.
+func (*DailyDigestRequest) Descriptor() ([]byte, []int) {
+	return file_reminder_reminder_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DailyDigestRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// BuildDailyDigestRequest входные данные для сборки сводки
+type BuildDailyDigestRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID пользователя
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Момент отправки (время workflow): сводка строится на этот день по таймзоне пользователя
+	At            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=at,proto3" json:"at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuildDailyDigestRequest) Reset() {
+	*x = BuildDailyDigestRequest{}
+	mi := &file_reminder_reminder_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildDailyDigestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildDailyDigestRequest) ProtoMessage() {}
+
+func (x *BuildDailyDigestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reminder_reminder_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildDailyDigestRequest.ProtoReflect.Descriptor instead.
+func (*BuildDailyDigestRequest) Descriptor() ([]byte, []int) {
+	return file_reminder_reminder_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *BuildDailyDigestRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *BuildDailyDigestRequest) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+// BuildDailyDigestResponse сводка и адреса для её отправки
+type BuildDailyDigestResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Заголовок сводки
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// Текст сводки; пусто — на сегодня ничего нет, сводка не отправляется
+	Text string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	// Chat ID в Telegram; 0 — Telegram не привязан
+	TelegramChatId int64 `protobuf:"varint,3,opt,name=telegram_chat_id,json=telegramChatId,proto3" json:"telegram_chat_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BuildDailyDigestResponse) Reset() {
+	*x = BuildDailyDigestResponse{}
+	mi := &file_reminder_reminder_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildDailyDigestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildDailyDigestResponse) ProtoMessage() {}
+
+func (x *BuildDailyDigestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reminder_reminder_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildDailyDigestResponse.ProtoReflect.Descriptor instead.
+func (*BuildDailyDigestResponse) Descriptor() ([]byte, []int) {
+	return file_reminder_reminder_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *BuildDailyDigestResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *BuildDailyDigestResponse) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *BuildDailyDigestResponse) GetTelegramChatId() int64 {
+	if x != nil {
+		return x.TelegramChatId
+	}
+	return 0
+}
+
+var File_reminder_reminder_proto protoreflect.FileDescriptor
+
+const file_reminder_reminder_proto_rawDesc = "" +
+	"\n" +
+	"\x17reminder/reminder.proto\x12\vreminder.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1atemporal/v1/temporal.proto\"\xf2\x05\n" +
+	"\x17ScheduleReminderRequest\x12\x1f\n" +
+	"\vreminder_id\x18\x01 \x01(\tR\n" +
+	"reminderId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x127\n" +
+	"\tremind_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bremindAt\x12(\n" +
+	"\x10telegram_chat_id\x18\x06 \x01(\x03R\x0etelegramChatId\x121\n" +
+	"\x14require_confirmation\x18\a \x01(\bR\x13requireConfirmation\x126\n" +
+	"\x17repeat_interval_minutes\x18\b \x01(\x05R\x15repeatIntervalMinutes\x12\x1c\n" +
+	"\trecurring\x18\t \x01(\bR\trecurring\x12\x1a\n" +
+	"\bchannels\x18\n" +
+	" \x03(\tR\bchannels\x12\x14\n" +
+	"\x05email\x18\v \x01(\tR\x05email\x12\x1f\n" +
+	"\vwebhook_url\x18\f \x01(\tR\n" +
+	"webhookUrl\x12;\n" +
+	"\n" +
+	"escalation\x18\r \x03(\v2\x1b.reminder.v1.EscalationStepR\n" +
+	"escalation\x12\x1d\n" +
+	"\n" +
+	"creator_id\x18\x0e \x01(\tR\tcreatorId\x12\x1a\n" +
+	"\bpriority\x18\x0f \x01(\tR\bpriority\x120\n" +
+	"\x14override_quiet_hours\x18\x10 \x01(\bR\x12overrideQuietHours\x124\n" +
+	"\x16confirm_window_minutes\x18\x11 \x01(\x05R\x14confirmWindowMinutes\x12%\n" +
+	"\x0eresend_backoff\x18\x12 \x01(\tR\rresendBackoff\x12\x1f\n" +
+	"\vmax_resends\x18\x13 \x01(\x05R\n" +
+	"maxResends\"i\n" +
+	"\x0eEscalationStep\x12#\n" +
+	"\rafter_repeats\x18\x01 \x01(\x05R\fafterRepeats\x12\x18\n" +
+	"\achannel\x18\x02 \x01(\tR\achannel\x12\x18\n" +
+	"\aaddress\x18\x03 \x01(\tR\aaddress\"S\n" +
+	"\x18ScheduleReminderResponse\x12\x1f\n" +
+	"\vworkflow_id\x18\x01 \x01(\tR\n" +
+	"workflowId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"\xfe\x01\n" +
+	"\x1fSendTelegramNotificationRequest\x12\x17\n" +
+	"\achat_id\x18\x01 \x01(\x03R\x06chatId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x121\n" +
+	"\x14require_confirmation\x18\x04 \x01(\bR\x13requireConfirmation\x12\x1f\n" +
+	"\vreminder_id\x18\x05 \x01(\tR\n" +
+	"reminderId\x12\x1a\n" +
+	"\bpriority\x18\x06 \x01(\tR\bpriority\x12\x1a\n" +
+	"\bdelivery\x18\a \x01(\x05R\bdelivery\"\xfe\x01\n" +
+	"\x1aChannelNotificationRequest\x12\x1f\n" +
+	"\vreminder_id\x18\x01 \x01(\tR\n" +
+	"reminderId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x121\n" +
+	"\x14require_confirmation\x18\x04 \x01(\bR\x13requireConfirmation\x12\x1c\n" +
+	"\trecipient\x18\x05 \x01(\tR\trecipient\x12\x1a\n" +
+	"\bpriority\x18\x06 \x01(\tR\bpriority\x12\x1a\n" +
+	"\bdelivery\x18\a \x01(\x05R\bdelivery\"\xd2\x01\n" +
+	"\x15SendEscalationRequest\x12\x1f\n" +
+	"\vreminder_id\x18\x01 \x01(\tR\n" +
+	"reminderId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x12\n" +
+	"\x04step\x18\x04 \x01(\x05R\x04step\x12\x18\n" +
+	"\achannel\x18\x05 \x01(\tR\achannel\x12\x18\n" +
+	"\aaddress\x18\x06 \x01(\tR\aaddress\x12\x18\n" +
+	"\arepeats\x18\a \x01(\x05R\arepeats\"\xd1\x01\n" +
+	"\x17RecordEscalationRequest\x12\x1f\n" +
+	"\vreminder_id\x18\x01 \x01(\tR\n" +
+	"reminderId\x12\x1f\n" +
+	"\vworkflow_id\x18\x02 \x01(\tR\n" +
+	"workflowId\x12\x12\n" +
+	"\x04step\x18\x03 \x01(\x05R\x04step\x12\x18\n" +
+	"\achannel\x18\x04 \x01(\tR\achannel\x12\x18\n" +
+	"\aaddress\x18\x05 \x01(\tR\aaddress\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x14\n" +
+	"\x05error\x18\a \x01(\tR\x05error\"]\n" +
+	"\x16CheckQuietHoursRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12*\n" +
+	"\x02at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02at\"K\n" +
+	"\x17CheckQuietHoursResponse\x120\n" +
+	"\x05until\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x05until\"|\n" +
+	"\x1cRecordAcknowledgementRequest\x12\x1f\n" +
+	"\vreminder_id\x18\x01 \x01(\tR\n" +
+	"reminderId\x12\x1f\n" +
+	"\vworkflow_id\x18\x02 \x01(\tR\n" +
+	"workflowId\x12\x1a\n" +
+	"\bdelivery\x18\x03 \x01(\x05R\bdelivery\"\x92\x01\n" +
+	"\x19NotifyAcknowledgedRequest\x12\x1f\n" +
+	"\vreminder_id\x18\x01 \x01(\tR\n" +
+	"reminderId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1d\n" +
+	"\n" +
+	"creator_id\x18\x03 \x01(\tR\tcreatorId\x12\x1f\n" +
+	"\vassignee_id\x18\x04 \x01(\tR\n" +
+	"assigneeId\"\xcd\x01\n" +
+	"\x1bUpdateReminderStatusRequest\x12\x1f\n" +
+	"\vreminder_id\x18\x01 \x01(\tR\n" +
+	"reminderId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x124\n" +
+	"\x16occurrence_workflow_id\x18\x03 \x01(\tR\x14occurrenceWorkflowId\x12?\n" +
+	"\rsnoozed_until\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\fsnoozedUntil\"B\n" +
+	"\x15SnoozeReminderRequest\x12)\n" +
+	"\x10duration_seconds\x18\x01 \x01(\x03R\x0fdurationSeconds\"3\n" +
+	"\x19GetReminderStatusResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"\x88\x01\n" +
+	"\x15UpdateReminderRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x127\n" +
+	"\tremind_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bremindAt\"i\n" +
+	"\x16UpdateReminderResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x127\n" +
+	"\tremind_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bremindAt\"\xae\x01\n" +
+	"\x1aSaveReminderDetailsRequest\x12\x1f\n" +
+	"\vreminder_id\x18\x01 \x01(\tR\n" +
+	"reminderId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x127\n" +
+	"\tremind_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bremindAt\"-\n" +
+	"\x12DailyDigestRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"^\n" +
+	"\x17BuildDailyDigestRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12*\n" +
+	"\x02at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02at\"n\n" +
+	"\x18BuildDailyDigestResponse\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x12(\n" +
+	"\x10telegram_chat_id\x18\x03 \x01(\x03R\x0etelegramChatId2\xad\x10\n" +
+	"\bReminder\x12\xec\x01\n" +
+	"\x10ScheduleReminder\x12$.reminder.v1.ScheduleReminderRequest\x1a%.reminder.v1.ScheduleReminderResponse\"\x8a\x01\x8a\xc4\x03\x85\x01\n" +
+	"\x13\n" +
+	"\x11GetReminderStatus\x12\x10\n" +
+	"\x0eCancelReminder\x12\x15\n" +
+	"\x13AcknowledgeReminder\x12\x10\n" +
+	"\x0eSnoozeReminder\x1a\x10\n" +
+	"\x0eUpdateReminder\"\x05\b\x80\x9a\x9e\x01*\x1areminder/${! reminder_id }\x12g\n" +
+	"\vDailyDigest\x12\x1f.reminder.v1.DailyDigestRequest\x1a\x16.google.protobuf.Empty\"\x1f\x8a\xc4\x03\x1b\"\x03\b\x90\x1c*\x14digest/${! user_id }\x12n\n" +
+	"\x18SendTelegramNotification\x12,.reminder.v1.SendTelegramNotificationRequest\x1a\x16.google.protobuf.Empty\"\f\x92\xc4\x03\b\"\x02\b\x1e2\x02 \x05\x12f\n" +
+	"\x15SendInAppNotification\x12'.reminder.v1.ChannelNotificationRequest\x1a\x16.google.protobuf.Empty\"\f\x92\xc4\x03\b\"\x02\b\n" +
+	"2\x02 \x03\x12s\n" +
+	"\x15SendEmailNotification\x12'.reminder.v1.ChannelNotificationRequest\x1a\x16.google.protobuf.Empty\"\x19\x92\xc4\x03\x15\"\x02\b<2\x0f\n" +
+	"\x02\b\n" +
+	"\x11\x00\x00\x00\x00\x00\x00\x00@ \x04\x12u\n" +
+	"\x17SendWebhookNotification\x12'.reminder.v1.ChannelNotificationRequest\x1a\x16.google.protobuf.Empty\"\x19\x92\xc4\x03\x15\"\x02\b\x0f2\x0f\n" +
+	"\x02\b\x05\x11\x00\x00\x00\x00\x00\x00\x00@ \x06\x12g\n" +
+	"\x0eSendEscalation\x12\".reminder.v1.SendEscalationRequest\x1a\x16.google.protobuf.Empty\"\x19\x92\xc4\x03\x15\"\x02\b\x1e2\x0f\n" +
+	"\x02\b\n" +
+	"\x11\x00\x00\x00\x00\x00\x00\x00@ \x05\x12^\n" +
+	"\x10RecordEscalation\x12$.reminder.v1.RecordEscalationRequest\x1a\x16.google.protobuf.Empty\"\f\x92\xc4\x03\b\"\x02\b\n" +
+	"2\x02 \n" +
+	"\x12j\n" +
+	"\x0fCheckQuietHours\x12#.reminder.v1.CheckQuietHoursRequest\x1a$.reminder.v1.CheckQuietHoursResponse\"\f\x92\xc4\x03\b\"\x02\b\n" +
+	"2\x02 \x05\x12h\n" +
+	"\x15RecordAcknowledgement\x12).reminder.v1.RecordAcknowledgementRequest\x1a\x16.google.protobuf.Empty\"\f\x92\xc4\x03\b\"\x02\b\n" +
+	"2\x02 \n" +
+	"\x12o\n" +
+	"\x12NotifyAcknowledged\x12&.reminder.v1.NotifyAcknowledgedRequest\x1a\x16.google.protobuf.Empty\"\x19\x92\xc4\x03\x15\"\x02\b\x1e2\x0f\n" +
+	"\x02\b\n" +
+	"\x11\x00\x00\x00\x00\x00\x00\x00@ \x05\x12f\n" +
+	"\x14UpdateReminderStatus\x12(.reminder.v1.UpdateReminderStatusRequest\x1a\x16.google.protobuf.Empty\"\f\x92\xc4\x03\b\"\x02\b\n" +
+	"2\x02 \n" +
+	"\x12d\n" +
+	"\x13SaveReminderDetails\x12'.reminder.v1.SaveReminderDetailsRequest\x1a\x16.google.protobuf.Empty\"\f\x92\xc4\x03\b\"\x02\b\n" +
 	"2\x02 \n" +
-	"\x12F\n" +
+	"\x12m\n" +
+	"\x10BuildDailyDigest\x12$.reminder.v1.BuildDailyDigestRequest\x1a%.reminder.v1.BuildDailyDigestResponse\"\f\x92\xc4\x03\b\"\x02\b\x1e2\x02 \x05\x12F\n" +
 	"\x0eCancelReminder\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.Empty\"\x04\xa2\xc4\x03\x00\x12K\n" +
-	"\x13AcknowledgeReminder\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.Empty\"\x04\xa2\xc4\x03\x00\x12Y\n" +
-	"\x11GetReminderStatus\x12\x16.google.protobuf.Empty\x1a&.reminder.v1.GetReminderStatusResponse\"\x04\x9a\xc4\x03\x00\x1a\x11\x8a\xc4\x03\r\n" +
+	"\x13AcknowledgeReminder\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.Empty\"\x04\xa2\xc4\x03\x00\x12R\n" +
+	"\x0eSnoozeReminder\x12\".reminder.v1.SnoozeReminderRequest\x1a\x16.google.protobuf.Empty\"\x04\xa2\xc4\x03\x00\x12Y\n" +
+	"\x11GetReminderStatus\x12\x16.google.protobuf.Empty\x1a&.reminder.v1.GetReminderStatusResponse\"\x04\x9a\xc4\x03\x00\x12a\n" +
+	"\x0eUpdateReminder\x12\".reminder.v1.UpdateReminderRequest\x1a#.reminder.v1.UpdateReminderResponse\"\x06\xaa\xc4\x03\x02\x10\x01\x1a\x11\x8a\xc4\x03\r\n" +
 	"\vreminder-v1B\x8e\x01\n" +
 	"\x0fcom.reminder.v1B\rReminderProtoP\x01Z\x1fexample/gen/reminder;reminderv1\xa2\x02\x03RXX\xaa\x02\vReminder.V1\xca\x02\vReminder\\V1\xe2\x02\x17Reminder\\V1\\GPBMetadata\xea\x02\fReminder::V1b\x06proto3"
 
@@ -431,35 +1701,84 @@ func file_reminder_reminder_proto_rawDescGZIP() []byte {
 	return file_reminder_reminder_proto_rawDescData
 }
 
-var file_reminder_reminder_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_reminder_reminder_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_reminder_reminder_proto_goTypes = []any{
 	(*ScheduleReminderRequest)(nil),         // 0: reminder.v1.ScheduleReminderRequest
-	(*ScheduleReminderResponse)(nil),        // 1: reminder.v1.ScheduleReminderResponse
-	(*SendTelegramNotificationRequest)(nil), // 2: reminder.v1.SendTelegramNotificationRequest
-	(*UpdateReminderStatusRequest)(nil),     // 3: reminder.v1.UpdateReminderStatusRequest
-	(*GetReminderStatusResponse)(nil),       // 4: reminder.v1.GetReminderStatusResponse
-	(*timestamppb.Timestamp)(nil),           // 5: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),                   // 6: google.protobuf.Empty
+	(*EscalationStep)(nil),                  // 1: reminder.v1.EscalationStep
+	(*ScheduleReminderResponse)(nil),        // 2: reminder.v1.ScheduleReminderResponse
+	(*SendTelegramNotificationRequest)(nil), // 3: reminder.v1.SendTelegramNotificationRequest
+	(*ChannelNotificationRequest)(nil),      // 4: reminder.v1.ChannelNotificationRequest
+	(*SendEscalationRequest)(nil),           // 5: reminder.v1.SendEscalationRequest
+	(*RecordEscalationRequest)(nil),         // 6: reminder.v1.RecordEscalationRequest
+	(*CheckQuietHoursRequest)(nil),          // 7: reminder.v1.CheckQuietHoursRequest
+	(*CheckQuietHoursResponse)(nil),         // 8: reminder.v1.CheckQuietHoursResponse
+	(*RecordAcknowledgementRequest)(nil),    // 9: reminder.v1.RecordAcknowledgementRequest
+	(*NotifyAcknowledgedRequest)(nil),       // 10: reminder.v1.NotifyAcknowledgedRequest
+	(*UpdateReminderStatusRequest)(nil),     // 11: reminder.v1.UpdateReminderStatusRequest
+	(*SnoozeReminderRequest)(nil),           // 12: reminder.v1.SnoozeReminderRequest
+	(*GetReminderStatusResponse)(nil),       // 13: reminder.v1.GetReminderStatusResponse
+	(*UpdateReminderRequest)(nil),           // 14: reminder.v1.UpdateReminderRequest
+	(*UpdateReminderResponse)(nil),          // 15: reminder.v1.UpdateReminderResponse
+	(*SaveReminderDetailsRequest)(nil),      // 16: reminder.v1.SaveReminderDetailsRequest
+	(*DailyDigestRequest)(nil),              // 17: reminder.v1.DailyDigestRequest
+	(*BuildDailyDigestRequest)(nil),         // 18: reminder.v1.BuildDailyDigestRequest
+	(*BuildDailyDigestResponse)(nil),        // 19: reminder.v1.BuildDailyDigestResponse
+	(*timestamppb.Timestamp)(nil),           // 20: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                   // 21: google.protobuf.Empty
 }
 var file_reminder_reminder_proto_depIdxs = []int32{
-	5, // 0: reminder.v1.ScheduleReminderRequest.remind_at:type_name -> google.protobuf.Timestamp
-	0, // 1: reminder.v1.Reminder.ScheduleReminder:input_type -> reminder.v1.ScheduleReminderRequest
-	2, // 2: reminder.v1.Reminder.SendTelegramNotification:input_type -> reminder.v1.SendTelegramNotificationRequest
-	3, // 3: reminder.v1.Reminder.UpdateReminderStatus:input_type -> reminder.v1.UpdateReminderStatusRequest
-	6, // 4: reminder.v1.Reminder.CancelReminder:input_type -> google.protobuf.Empty
-	6, // 5: reminder.v1.Reminder.AcknowledgeReminder:input_type -> google.protobuf.Empty
-	6, // 6: reminder.v1.Reminder.GetReminderStatus:input_type -> google.protobuf.Empty
-	1, // 7: reminder.v1.Reminder.ScheduleReminder:output_type -> reminder.v1.ScheduleReminderResponse
-	6, // 8: reminder.v1.Reminder.SendTelegramNotification:output_type -> google.protobuf.Empty
-	6, // 9: reminder.v1.Reminder.UpdateReminderStatus:output_type -> google.protobuf.Empty
-	6, // 10: reminder.v1.Reminder.CancelReminder:output_type -> google.protobuf.Empty
-	6, // 11: reminder.v1.Reminder.AcknowledgeReminder:output_type -> google.protobuf.Empty
-	4, // 12: reminder.v1.Reminder.GetReminderStatus:output_type -> reminder.v1.GetReminderStatusResponse
-	7, // [7:13] is the sub-list for method output_type
-	1, // [1:7] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	20, // 0: reminder.v1.ScheduleReminderRequest.remind_at:type_name -> google.protobuf.Timestamp
+	1,  // 1: reminder.v1.ScheduleReminderRequest.escalation:type_name -> reminder.v1.EscalationStep
+	20, // 2: reminder.v1.CheckQuietHoursRequest.at:type_name -> google.protobuf.Timestamp
+	20, // 3: reminder.v1.CheckQuietHoursResponse.until:type_name -> google.protobuf.Timestamp
+	20, // 4: reminder.v1.UpdateReminderStatusRequest.snoozed_until:type_name -> google.protobuf.Timestamp
+	20, // 5: reminder.v1.UpdateReminderRequest.remind_at:type_name -> google.protobuf.Timestamp
+	20, // 6: reminder.v1.UpdateReminderResponse.remind_at:type_name -> google.protobuf.Timestamp
+	20, // 7: reminder.v1.SaveReminderDetailsRequest.remind_at:type_name -> google.protobuf.Timestamp
+	20, // 8: reminder.v1.BuildDailyDigestRequest.at:type_name -> google.protobuf.Timestamp
+	0,  // 9: reminder.v1.Reminder.ScheduleReminder:input_type -> reminder.v1.ScheduleReminderRequest
+	17, // 10: reminder.v1.Reminder.DailyDigest:input_type -> reminder.v1.DailyDigestRequest
+	3,  // 11: reminder.v1.Reminder.SendTelegramNotification:input_type -> reminder.v1.SendTelegramNotificationRequest
+	4,  // 12: reminder.v1.Reminder.SendInAppNotification:input_type -> reminder.v1.ChannelNotificationRequest
+	4,  // 13: reminder.v1.Reminder.SendEmailNotification:input_type -> reminder.v1.ChannelNotificationRequest
+	4,  // 14: reminder.v1.Reminder.SendWebhookNotification:input_type -> reminder.v1.ChannelNotificationRequest
+	5,  // 15: reminder.v1.Reminder.SendEscalation:input_type -> reminder.v1.SendEscalationRequest
+	6,  // 16: reminder.v1.Reminder.RecordEscalation:input_type -> reminder.v1.RecordEscalationRequest
+	7,  // 17: reminder.v1.Reminder.CheckQuietHours:input_type -> reminder.v1.CheckQuietHoursRequest
+	9,  // 18: reminder.v1.Reminder.RecordAcknowledgement:input_type -> reminder.v1.RecordAcknowledgementRequest
+	10, // 19: reminder.v1.Reminder.NotifyAcknowledged:input_type -> reminder.v1.NotifyAcknowledgedRequest
+	11, // 20: reminder.v1.Reminder.UpdateReminderStatus:input_type -> reminder.v1.UpdateReminderStatusRequest
+	16, // 21: reminder.v1.Reminder.SaveReminderDetails:input_type -> reminder.v1.SaveReminderDetailsRequest
+	18, // 22: reminder.v1.Reminder.BuildDailyDigest:input_type -> reminder.v1.BuildDailyDigestRequest
+	21, // 23: reminder.v1.Reminder.CancelReminder:input_type -> google.protobuf.Empty
+	21, // 24: reminder.v1.Reminder.AcknowledgeReminder:input_type -> google.protobuf.Empty
+	12, // 25: reminder.v1.Reminder.SnoozeReminder:input_type -> reminder.v1.SnoozeReminderRequest
+	21, // 26: reminder.v1.Reminder.GetReminderStatus:input_type -> google.protobuf.Empty
+	14, // 27: reminder.v1.Reminder.UpdateReminder:input_type -> reminder.v1.UpdateReminderRequest
+	2,  // 28: reminder.v1.Reminder.ScheduleReminder:output_type -> reminder.v1.ScheduleReminderResponse
+	21, // 29: reminder.v1.Reminder.DailyDigest:output_type -> google.protobuf.Empty
+	21, // 30: reminder.v1.Reminder.SendTelegramNotification:output_type -> google.protobuf.Empty
+	21, // 31: reminder.v1.Reminder.SendInAppNotification:output_type -> google.protobuf.Empty
+	21, // 32: reminder.v1.Reminder.SendEmailNotification:output_type -> google.protobuf.Empty
+	21, // 33: reminder.v1.Reminder.SendWebhookNotification:output_type -> google.protobuf.Empty
+	21, // 34: reminder.v1.Reminder.SendEscalation:output_type -> google.protobuf.Empty
+	21, // 35: reminder.v1.Reminder.RecordEscalation:output_type -> google.protobuf.Empty
+	8,  // 36: reminder.v1.Reminder.CheckQuietHours:output_type -> reminder.v1.CheckQuietHoursResponse
+	21, // 37: reminder.v1.Reminder.RecordAcknowledgement:output_type -> google.protobuf.Empty
+	21, // 38: reminder.v1.Reminder.NotifyAcknowledged:output_type -> google.protobuf.Empty
+	21, // 39: reminder.v1.Reminder.UpdateReminderStatus:output_type -> google.protobuf.Empty
+	21, // 40: reminder.v1.Reminder.SaveReminderDetails:output_type -> google.protobuf.Empty
+	19, // 41: reminder.v1.Reminder.BuildDailyDigest:output_type -> reminder.v1.BuildDailyDigestResponse
+	21, // 42: reminder.v1.Reminder.CancelReminder:output_type -> google.protobuf.Empty
+	21, // 43: reminder.v1.Reminder.AcknowledgeReminder:output_type -> google.protobuf.Empty
+	21, // 44: reminder.v1.Reminder.SnoozeReminder:output_type -> google.protobuf.Empty
+	13, // 45: reminder.v1.Reminder.GetReminderStatus:output_type -> reminder.v1.GetReminderStatusResponse
+	15, // 46: reminder.v1.Reminder.UpdateReminder:output_type -> reminder.v1.UpdateReminderResponse
+	28, // [28:47] is the sub-list for method output_type
+	9,  // [9:28] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_reminder_reminder_proto_init() }
@@ -473,7 +1792,7 @@ func file_reminder_reminder_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_reminder_reminder_proto_rawDesc), len(file_reminder_reminder_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
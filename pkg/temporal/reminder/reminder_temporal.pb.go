@@ -16,9 +16,12 @@ import (
 	convert "github.com/cludden/protoc-gen-go-temporal/pkg/convert"
 	expression "github.com/cludden/protoc-gen-go-temporal/pkg/expression"
 	helpers "github.com/cludden/protoc-gen-go-temporal/pkg/helpers"
+	testutil "github.com/cludden/protoc-gen-go-temporal/pkg/testutil"
+	uuid "github.com/google/uuid"
 	gohomedir "github.com/mitchellh/go-homedir"
 	cliv3 "github.com/urfave/cli/v3"
 	enumsv1 "go.temporal.io/api/enums/v1"
+	serviceerror "go.temporal.io/api/serviceerror"
 	activity "go.temporal.io/sdk/activity"
 	client "go.temporal.io/sdk/client"
 	converter "go.temporal.io/sdk/converter"
@@ -32,6 +35,7 @@ import (
 	"log/slog"
 	"os"
 	"sort"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -42,17 +46,29 @@ var ReminderTaskQueue = "reminder-v1"
 
 // reminder.v1.Reminder workflow names
 const (
+	DailyDigestWorkflowName      = "reminder.v1.Reminder.DailyDigest"
 	ScheduleReminderWorkflowName = "reminder.v1.Reminder.ScheduleReminder"
 )
 
 // reminder.v1.Reminder workflow id expressions
 var (
+	DailyDigestIdexpression      = expression.MustParseExpression("digest/${! user_id }")
 	ScheduleReminderIdexpression = expression.MustParseExpression("reminder/${! reminder_id }")
 )
 
 // reminder.v1.Reminder activity names
 const (
+	BuildDailyDigestActivityName         = "reminder.v1.Reminder.BuildDailyDigest"
+	CheckQuietHoursActivityName          = "reminder.v1.Reminder.CheckQuietHours"
+	NotifyAcknowledgedActivityName       = "reminder.v1.Reminder.NotifyAcknowledged"
+	RecordAcknowledgementActivityName    = "reminder.v1.Reminder.RecordAcknowledgement"
+	RecordEscalationActivityName         = "reminder.v1.Reminder.RecordEscalation"
+	SaveReminderDetailsActivityName      = "reminder.v1.Reminder.SaveReminderDetails"
+	SendEmailNotificationActivityName    = "reminder.v1.Reminder.SendEmailNotification"
+	SendEscalationActivityName           = "reminder.v1.Reminder.SendEscalation"
+	SendInAppNotificationActivityName    = "reminder.v1.Reminder.SendInAppNotification"
 	SendTelegramNotificationActivityName = "reminder.v1.Reminder.SendTelegramNotification"
+	SendWebhookNotificationActivityName  = "reminder.v1.Reminder.SendWebhookNotification"
 	UpdateReminderStatusActivityName     = "reminder.v1.Reminder.UpdateReminderStatus"
 )
 
@@ -65,6 +81,12 @@ const (
 const (
 	AcknowledgeReminderSignalName = "reminder.v1.Reminder.AcknowledgeReminder"
 	CancelReminderSignalName      = "reminder.v1.Reminder.CancelReminder"
+	SnoozeReminderSignalName      = "reminder.v1.Reminder.SnoozeReminder"
+)
+
+// reminder.v1.Reminder update names
+const (
+	UpdateReminderUpdateName = "reminder.v1.Reminder.UpdateReminder"
 )
 
 // ReminderClient describes a client for a(n) reminder.v1.Reminder worker
@@ -92,6 +114,18 @@ type ReminderClient interface {
 
 	// CancelReminder сигнал для отмены напоминания
 	CancelReminder(ctx context.Context, workflowID string, runID string) error
+
+	// SnoozeReminder сигнал откладывания уведомления на заданное время
+	SnoozeReminder(ctx context.Context, workflowID string, runID string, signal *SnoozeReminderRequest) error
+
+	// UpdateReminder изменяет заголовок, описание и время ожидающего напоминания
+	UpdateReminder(ctx context.Context, workflowID string, runID string, req *UpdateReminderRequest, opts ...*UpdateReminderOptions) (*UpdateReminderResponse, error)
+
+	// UpdateReminderAsync starts a(n) reminder.v1.Reminder.UpdateReminder update and returns a handle to the workflow update
+	UpdateReminderAsync(ctx context.Context, workflowID string, runID string, req *UpdateReminderRequest, opts ...*UpdateReminderOptions) (UpdateReminderHandle, error)
+
+	// GetUpdateReminder retrieves a handle to an existing reminder.v1.Reminder.UpdateReminder update
+	GetUpdateReminder(ctx context.Context, req client.GetWorkflowUpdateHandleOptions) (UpdateReminderHandle, error)
 }
 
 // reminderClient implements a temporal client for a reminder.v1.Reminder service
@@ -232,6 +266,61 @@ func (c *reminderClient) CancelReminder(ctx context.Context, workflowID string,
 	return c.client.SignalWorkflow(ctx, workflowID, runID, CancelReminderSignalName, nil)
 }
 
+// SnoozeReminder сигнал откладывания уведомления на заданное время
+func (c *reminderClient) SnoozeReminder(ctx context.Context, workflowID string, runID string, signal *SnoozeReminderRequest) error {
+	return c.client.SignalWorkflow(ctx, workflowID, runID, SnoozeReminderSignalName, signal)
+}
+
+// UpdateReminder изменяет заголовок, описание и время ожидающего напоминания
+func (c *reminderClient) UpdateReminder(ctx context.Context, workflowID string, runID string, req *UpdateReminderRequest, opts ...*UpdateReminderOptions) (*UpdateReminderResponse, error) {
+	// initialize update options
+	o := NewUpdateReminderOptions()
+	if len(opts) > 0 && opts[0].Options != nil {
+		o = opts[0]
+	}
+
+	// call sync update with WorkflowUpdateStageCompleted wait policy
+	handle, err := c.UpdateReminderAsync(ctx, workflowID, runID, req, o.WithWaitPolicy(client.WorkflowUpdateStageCompleted))
+	if err != nil {
+		return nil, err
+	}
+
+	// block on update completion
+	return handle.Get(ctx)
+}
+
+// UpdateReminder изменяет заголовок, описание и время ожидающего напоминания
+func (c *reminderClient) UpdateReminderAsync(ctx context.Context, workflowID string, runID string, req *UpdateReminderRequest, opts ...*UpdateReminderOptions) (UpdateReminderHandle, error) {
+	// initialize update options
+	var o *UpdateReminderOptions
+	if len(opts) > 0 && opts[0] != nil {
+		o = opts[0]
+	} else {
+		o = NewUpdateReminderOptions()
+	}
+
+	// build UpdateWorkflowOptions
+	options, err := o.Build(workflowID, runID, req)
+	if err != nil {
+		return nil, fmt.Errorf("error initializing UpdateWorkflowWithOptions: %w", err)
+	}
+
+	// update workflow
+	handle, err := c.client.UpdateWorkflow(ctx, *options)
+	if err != nil {
+		return nil, err
+	}
+	return &updateReminderHandle{client: c, handle: handle}, nil
+}
+
+// GetUpdateReminder retrieves a handle to an existing reminder.v1.Reminder.UpdateReminder update
+func (c *reminderClient) GetUpdateReminder(ctx context.Context, req client.GetWorkflowUpdateHandleOptions) (UpdateReminderHandle, error) {
+	return &updateReminderHandle{
+		client: c,
+		handle: c.client.GetWorkflowUpdateHandle(req),
+	}, nil
+}
+
 // ScheduleReminderOptions provides configuration for a reminder.v1.Reminder.ScheduleReminder workflow operation
 type ScheduleReminderOptions struct {
 	options                  client.StartWorkflowOptions
@@ -402,6 +491,15 @@ type ScheduleReminderRun interface {
 
 	// AcknowledgeReminder сигнал подтверждения получения напоминания
 	AcknowledgeReminder(ctx context.Context) error
+
+	// SnoozeReminder сигнал откладывания уведомления на заданное время
+	SnoozeReminder(ctx context.Context, req *SnoozeReminderRequest) error
+
+	// UpdateReminder изменяет заголовок, описание и время ожидающего напоминания
+	UpdateReminder(ctx context.Context, req *UpdateReminderRequest, opts ...*UpdateReminderOptions) (*UpdateReminderResponse, error)
+
+	// UpdateReminder изменяет заголовок, описание и время ожидающего напоминания
+	UpdateReminderAsync(ctx context.Context, req *UpdateReminderRequest, opts ...*UpdateReminderOptions) (UpdateReminderHandle, error)
 }
 
 // scheduleReminderRun provides an internal implementation of a(n) ScheduleReminderRunRun
@@ -459,10 +557,149 @@ func (r *scheduleReminderRun) AcknowledgeReminder(ctx context.Context) error {
 	return r.client.AcknowledgeReminder(ctx, r.ID(), "")
 }
 
+// SnoozeReminder сигнал откладывания уведомления на заданное время
+func (r *scheduleReminderRun) SnoozeReminder(ctx context.Context, req *SnoozeReminderRequest) error {
+	return r.client.SnoozeReminder(ctx, r.ID(), "", req)
+}
+
+// UpdateReminder изменяет заголовок, описание и время ожидающего напоминания
+func (r *scheduleReminderRun) UpdateReminder(ctx context.Context, req *UpdateReminderRequest, opts ...*UpdateReminderOptions) (*UpdateReminderResponse, error) {
+	return r.client.UpdateReminder(ctx, r.ID(), r.RunID(), req, opts...)
+}
+
+// UpdateReminder изменяет заголовок, описание и время ожидающего напоминания
+func (r *scheduleReminderRun) UpdateReminderAsync(ctx context.Context, req *UpdateReminderRequest, opts ...*UpdateReminderOptions) (UpdateReminderHandle, error) {
+	return r.client.UpdateReminderAsync(ctx, r.ID(), r.RunID(), req, opts...)
+}
+
+// UpdateReminderHandle describes a(n) reminder.v1.Reminder.UpdateReminder update handle
+type UpdateReminderHandle interface {
+	// WorkflowID returns the workflow ID
+	WorkflowID() string
+	// RunID returns the workflow instance ID
+	RunID() string
+	// UpdateID returns the update ID
+	UpdateID() string
+	// Get blocks until the workflow is complete and returns the result
+	Get(ctx context.Context) (*UpdateReminderResponse, error)
+}
+
+// updateReminderHandle provides an internal implementation of a(n) UpdateReminderHandle
+type updateReminderHandle struct {
+	client *reminderClient
+	handle client.WorkflowUpdateHandle
+}
+
+// WorkflowID returns the workflow ID
+func (h *updateReminderHandle) WorkflowID() string {
+	return h.handle.WorkflowID()
+}
+
+// RunID returns the execution ID
+func (h *updateReminderHandle) RunID() string {
+	return h.handle.RunID()
+}
+
+// UpdateID returns the update ID
+func (h *updateReminderHandle) UpdateID() string {
+	return h.handle.UpdateID()
+}
+
+// Get blocks until the update wait policy is met, returning the result if applicable
+func (h *updateReminderHandle) Get(ctx context.Context) (*UpdateReminderResponse, error) {
+	var resp UpdateReminderResponse
+	var err error
+	doneCh := make(chan struct{})
+	gctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go func() {
+		for {
+			var deadlineExceeded *serviceerror.DeadlineExceeded
+			if err = h.handle.Get(gctx, &resp); err != nil && ctx.Err() == nil && (errors.As(err, &deadlineExceeded) || strings.Contains(err.Error(), context.DeadlineExceeded.Error())) {
+				continue
+			}
+			break
+		}
+		close(doneCh)
+	}()
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-doneCh:
+		if err != nil {
+			return nil, err
+		}
+		return &resp, nil
+	}
+}
+
+// UpdateReminderOptions provides configuration for a reminder.v1.Reminder.UpdateReminder update operation
+type UpdateReminderOptions struct {
+	Options    *client.UpdateWorkflowOptions
+	id         *string
+	waitPolicy client.WorkflowUpdateStage
+}
+
+// NewUpdateReminderOptions initializes a new UpdateReminderOptions value
+func NewUpdateReminderOptions() *UpdateReminderOptions {
+	return &UpdateReminderOptions{Options: &client.UpdateWorkflowOptions{}}
+}
+
+// Build initializes a new client.UpdateWorkflowOptions with defaults and overrides applied
+func (o *UpdateReminderOptions) Build(workflowID string, runID string, req *UpdateReminderRequest) (opts *client.UpdateWorkflowOptions, err error) {
+	// use user-provided UpdateWorkflowOptions if exists
+	if o.Options != nil {
+		opts = o.Options
+	} else {
+		opts = &client.UpdateWorkflowOptions{}
+	}
+
+	// set constants
+	opts.Args = []any{req}
+	opts.RunID = runID
+	opts.UpdateName = UpdateReminderUpdateName
+	opts.WorkflowID = workflowID
+
+	// set UpdateID
+	if v := o.id; v != nil {
+		opts.UpdateID = *v
+	}
+
+	// set WaitPolicy
+	if v := o.waitPolicy; v != client.WorkflowUpdateStageUnspecified {
+		opts.WaitForStage = v
+	} else if opts.WaitForStage == client.WorkflowUpdateStageUnspecified {
+		opts.WaitForStage = client.WorkflowUpdateStageAccepted
+	}
+	return opts, nil
+}
+
+// WithUpdateID sets the UpdateID
+func (o *UpdateReminderOptions) WithUpdateID(id string) *UpdateReminderOptions {
+	o.id = &id
+	return o
+}
+
+// WithUpdateWorkflowOptions sets the initial client.UpdateWorkflowOptions
+func (o *UpdateReminderOptions) WithUpdateWorkflowOptions(options client.UpdateWorkflowOptions) *UpdateReminderOptions {
+	o.Options = &options
+	return o
+}
+
+// WithWaitPolicy sets the WaitPolicy
+func (o *UpdateReminderOptions) WithWaitPolicy(policy client.WorkflowUpdateStage) *UpdateReminderOptions {
+	o.waitPolicy = policy
+	return o
+}
+
 // Reference to generated workflow functions
 var (
 	// reminderRegistrationMutex is a mutex for registering reminder.v1.Reminder workflows
 	reminderRegistrationMutex sync.Mutex
+	// DailyDigest запускается расписанием пользователя и отправляет утреннюю сводку напоминаний
+	DailyDigestFunction func(workflow.Context, *DailyDigestRequest) error
 	// ScheduleReminder запускает workflow, который ждёт до remind_at и отправляет уведомление
 	ScheduleReminderFunction func(workflow.Context, *ScheduleReminderRequest) (*ScheduleReminderResponse, error)
 )
@@ -471,6 +708,8 @@ var (
 type (
 	// ReminderWorkflowFunctions describes a mockable dependency for inlining workflows within other workflows
 	ReminderWorkflowFunctions interface {
+		// DailyDigest запускается расписанием пользователя и отправляет утреннюю сводку напоминаний
+		DailyDigest(workflow.Context, *DailyDigestRequest) error
 		// ScheduleReminder запускает workflow, который ждёт до remind_at и отправляет уведомление
 		ScheduleReminder(workflow.Context, *ScheduleReminderRequest) (*ScheduleReminderResponse, error)
 	}
@@ -482,6 +721,14 @@ func NewReminderWorkflowFunctions() ReminderWorkflowFunctions {
 	return &reminderWorkflowFunctions{}
 }
 
+// DailyDigest запускается расписанием пользователя и отправляет утреннюю сводку напоминаний
+func (f *reminderWorkflowFunctions) DailyDigest(ctx workflow.Context, req *DailyDigestRequest) error {
+	if DailyDigestFunction == nil {
+		return errors.New("DailyDigest requires workflow registration via RegisterReminderWorkflows or RegisterDailyDigestWorkflow")
+	}
+	return DailyDigestFunction(ctx, req)
+}
+
 // ScheduleReminder запускает workflow, который ждёт до remind_at и отправляет уведомление
 func (f *reminderWorkflowFunctions) ScheduleReminder(ctx workflow.Context, req *ScheduleReminderRequest) (*ScheduleReminderResponse, error) {
 	if ScheduleReminderFunction == nil {
@@ -492,15 +739,71 @@ func (f *reminderWorkflowFunctions) ScheduleReminder(ctx workflow.Context, req *
 
 // ReminderWorkflows provides methods for initializing new reminder.v1.Reminder workflow values
 type ReminderWorkflows interface {
+	// DailyDigest запускается расписанием пользователя и отправляет утреннюю сводку напоминаний
+	DailyDigest(ctx workflow.Context, input *DailyDigestWorkflowInput) (DailyDigestWorkflow, error)
+
 	// ScheduleReminder запускает workflow, который ждёт до remind_at и отправляет уведомление
 	ScheduleReminder(ctx workflow.Context, input *ScheduleReminderWorkflowInput) (ScheduleReminderWorkflow, error)
 }
 
 // RegisterReminderWorkflows registers reminder.v1.Reminder workflows with the given worker
 func RegisterReminderWorkflows(r worker.WorkflowRegistry, workflows ReminderWorkflows) {
+	RegisterDailyDigestWorkflow(r, workflows.DailyDigest)
 	RegisterScheduleReminderWorkflow(r, workflows.ScheduleReminder)
 }
 
+// RegisterDailyDigestWorkflow registers a reminder.v1.Reminder.DailyDigest workflow with the given worker
+func RegisterDailyDigestWorkflow(r worker.WorkflowRegistry, wf func(workflow.Context, *DailyDigestWorkflowInput) (DailyDigestWorkflow, error)) {
+	reminderRegistrationMutex.Lock()
+	defer reminderRegistrationMutex.Unlock()
+	DailyDigestFunction = buildDailyDigest(wf)
+	r.RegisterWorkflowWithOptions(DailyDigestFunction, workflow.RegisterOptions{Name: DailyDigestWorkflowName})
+}
+
+// buildDailyDigest converts a DailyDigest workflow struct into a valid workflow function
+func buildDailyDigest(ctor func(workflow.Context, *DailyDigestWorkflowInput) (DailyDigestWorkflow, error)) func(workflow.Context, *DailyDigestRequest) error {
+	return func(ctx workflow.Context, req *DailyDigestRequest) error {
+		input := &DailyDigestWorkflowInput{
+			Req: req,
+		}
+		wf, err := ctor(ctx, input)
+		if err != nil {
+			return err
+		}
+		if initializable, ok := wf.(helpers.Initializable); ok {
+			if err := initializable.Initialize(ctx); err != nil {
+				return err
+			}
+		}
+		return wf.Execute(ctx)
+	}
+}
+
+// DailyDigestWorkflowInput describes the input to a(n) reminder.v1.Reminder.DailyDigest workflow constructor
+type DailyDigestWorkflowInput struct {
+	Req *DailyDigestRequest
+}
+
+// ContinueAsNew returns an appropriately configured ContinueAsNewError
+func (i *DailyDigestWorkflowInput) ContinueAsNew(ctx workflow.Context, input *DailyDigestRequest, options ...workflow.ContinueAsNewErrorOptions) error {
+	next := i.Req
+	if input != nil {
+		next = input
+	}
+	if len(options) > 0 {
+		return workflow.NewContinueAsNewErrorWithOptions(ctx, options[0], DailyDigestWorkflowName, next)
+	}
+	return workflow.NewContinueAsNewError(ctx, DailyDigestWorkflowName, next)
+}
+
+// DailyDigest запускается расписанием пользователя и отправляет утреннюю сводку напоминаний
+//
+// workflow details: (name: "reminder.v1.Reminder.DailyDigest", id: "digest/${! user_id }")
+type DailyDigestWorkflow interface {
+	// Execute defines the entrypoint to a(n) reminder.v1.Reminder.DailyDigest workflow
+	Execute(ctx workflow.Context) error
+}
+
 // RegisterScheduleReminderWorkflow registers a reminder.v1.Reminder.ScheduleReminder workflow with the given worker
 func RegisterScheduleReminderWorkflow(r worker.WorkflowRegistry, wf func(workflow.Context, *ScheduleReminderWorkflowInput) (ScheduleReminderWorkflow, error)) {
 	reminderRegistrationMutex.Lock()
@@ -520,6 +823,9 @@ func buildScheduleReminder(ctor func(workflow.Context, *ScheduleReminderWorkflow
 			AcknowledgeReminder: &AcknowledgeReminderSignal{
 				Channel: workflow.GetSignalChannel(ctx, AcknowledgeReminderSignalName),
 			},
+			SnoozeReminder: &SnoozeReminderSignal{
+				Channel: workflow.GetSignalChannel(ctx, SnoozeReminderSignalName),
+			},
 		}
 		wf, err := ctor(ctx, input)
 		if err != nil {
@@ -533,6 +839,13 @@ func buildScheduleReminder(ctor func(workflow.Context, *ScheduleReminderWorkflow
 		if err := workflow.SetQueryHandler(ctx, GetReminderStatusQueryName, wf.GetReminderStatus); err != nil {
 			return nil, err
 		}
+		{
+			opts := workflow.UpdateHandlerOptions{}
+			opts.Validator = wf.ValidateUpdateReminder
+			if err := workflow.SetUpdateHandlerWithOptions(ctx, UpdateReminderUpdateName, wf.UpdateReminder, opts); err != nil {
+				return nil, err
+			}
+		}
 		return wf.Execute(ctx)
 	}
 }
@@ -542,6 +855,7 @@ type ScheduleReminderWorkflowInput struct {
 	Req                 *ScheduleReminderRequest
 	CancelReminder      *CancelReminderSignal
 	AcknowledgeReminder *AcknowledgeReminderSignal
+	SnoozeReminder      *SnoozeReminderSignal
 }
 
 // ContinueAsNew returns an appropriately configured ContinueAsNewError
@@ -565,6 +879,12 @@ type ScheduleReminderWorkflow interface {
 
 	// GetReminderStatus запрос текущего статуса напоминания
 	GetReminderStatus() (*GetReminderStatusResponse, error)
+
+	// UpdateReminder изменяет заголовок, описание и время ожидающего напоминания
+	UpdateReminder(workflow.Context, *UpdateReminderRequest) (*UpdateReminderResponse, error)
+
+	// ValidateUpdateReminder validates a(n) reminder.v1.Reminder.UpdateReminder update
+	ValidateUpdateReminder(workflow.Context, *UpdateReminderRequest) error
 }
 
 // ScheduleReminder запускает workflow, который ждёт до remind_at и отправляет уведомление
@@ -821,6 +1141,16 @@ func (r *ScheduleReminderChildRun) AcknowledgeReminderAsync(ctx workflow.Context
 	return r.Future.SignalChildWorkflow(ctx, AcknowledgeReminderSignalName, nil)
 }
 
+// SnoozeReminder sends a(n) "reminder.v1.Reminder.SnoozeReminder" signal request to the child workflow
+func (r *ScheduleReminderChildRun) SnoozeReminder(ctx workflow.Context, input *SnoozeReminderRequest) error {
+	return r.SnoozeReminderAsync(ctx, input).Get(ctx, nil)
+}
+
+// SnoozeReminderAsync sends a(n) "reminder.v1.Reminder.SnoozeReminder" signal request to the child workflow
+func (r *ScheduleReminderChildRun) SnoozeReminderAsync(ctx workflow.Context, input *SnoozeReminderRequest) workflow.Future {
+	return r.Future.SignalChildWorkflow(ctx, SnoozeReminderSignalName, input)
+}
+
 // AcknowledgeReminderSignal describes a(n) reminder.v1.Reminder.AcknowledgeReminder signal
 type AcknowledgeReminderSignal struct {
 	Channel workflow.ReceiveChannel
@@ -923,40 +1253,142 @@ func CancelReminderExternalAsync(ctx workflow.Context, workflowID string, runID
 	return workflow.SignalExternalWorkflow(ctx, workflowID, runID, CancelReminderSignalName, nil)
 }
 
+// SnoozeReminderSignal describes a(n) reminder.v1.Reminder.SnoozeReminder signal
+type SnoozeReminderSignal struct {
+	Channel workflow.ReceiveChannel
+}
+
+// NewSnoozeReminderSignal initializes a new reminder.v1.Reminder.SnoozeReminder signal wrapper
+func NewSnoozeReminderSignal(ctx workflow.Context) *SnoozeReminderSignal {
+	return &SnoozeReminderSignal{Channel: workflow.GetSignalChannel(ctx, SnoozeReminderSignalName)}
+}
+
+// Receive blocks until a(n) reminder.v1.Reminder.SnoozeReminder signal is received
+func (s *SnoozeReminderSignal) Receive(ctx workflow.Context) (*SnoozeReminderRequest, bool) {
+	var resp SnoozeReminderRequest
+	more := s.Channel.Receive(ctx, &resp)
+	return &resp, more
+}
+
+// ReceiveAsync checks for a reminder.v1.Reminder.SnoozeReminder signal without blocking
+func (s *SnoozeReminderSignal) ReceiveAsync() *SnoozeReminderRequest {
+	var resp SnoozeReminderRequest
+	if ok := s.Channel.ReceiveAsync(&resp); !ok {
+		return nil
+	}
+	return &resp
+}
+
+// ReceiveWithTimeout blocks until a(n) reminder.v1.Reminder.SnoozeReminder signal is received or timeout expires.
+// Returns more value of false when Channel is closed.
+// Returns ok value of false when no value was found in the channel for the duration of timeout or the ctx was canceled.
+// resp will be nil if ok is false.
+func (s *SnoozeReminderSignal) ReceiveWithTimeout(ctx workflow.Context, timeout time.Duration) (resp *SnoozeReminderRequest, ok bool, more bool) {
+	resp = &SnoozeReminderRequest{}
+	if ok, more = s.Channel.ReceiveWithTimeout(ctx, timeout, &resp); !ok {
+		return nil, false, more
+	}
+	return
+}
+
+// Select checks for a(n) reminder.v1.Reminder.SnoozeReminder signal without blocking
+func (s *SnoozeReminderSignal) Select(sel workflow.Selector, fn func(*SnoozeReminderRequest)) workflow.Selector {
+	return sel.AddReceive(s.Channel, func(workflow.ReceiveChannel, bool) {
+		req := s.ReceiveAsync()
+		if fn != nil {
+			fn(req)
+		}
+	})
+}
+
+// SnoozeReminder сигнал откладывания уведомления на заданное время
+func SnoozeReminderExternal(ctx workflow.Context, workflowID string, runID string, req *SnoozeReminderRequest) error {
+	return SnoozeReminderExternalAsync(ctx, workflowID, runID, req).Get(ctx, nil)
+}
+
+// SnoozeReminder сигнал откладывания уведомления на заданное время
+func SnoozeReminderExternalAsync(ctx workflow.Context, workflowID string, runID string, req *SnoozeReminderRequest) workflow.Future {
+	return workflow.SignalExternalWorkflow(ctx, workflowID, runID, SnoozeReminderSignalName, req)
+}
+
 // ReminderActivities describes available worker activities
 type ReminderActivities interface {
+	// BuildDailyDigest activity — собирает сводку напоминаний пользователя на день
+	BuildDailyDigest(ctx context.Context, req *BuildDailyDigestRequest) (*BuildDailyDigestResponse, error)
+
+	// CheckQuietHours activity — проверяет тихие часы и режим «не беспокоить» получателя
+	CheckQuietHours(ctx context.Context, req *CheckQuietHoursRequest) (*CheckQuietHoursResponse, error)
+
+	// NotifyAcknowledged activity — сообщает создателю, что исполнитель подтвердил напоминание
+	NotifyAcknowledged(ctx context.Context, req *NotifyAcknowledgedRequest) error
+
+	// RecordAcknowledgement activity — сохраняет подтверждение в историю доставки
+	RecordAcknowledgement(ctx context.Context, req *RecordAcknowledgementRequest) error
+
+	// RecordEscalation activity — сохраняет шаг эскалации в БД
+	RecordEscalation(ctx context.Context, req *RecordEscalationRequest) error
+
+	// SaveReminderDetails activity — сохраняет изменённые поля напоминания в БД
+	SaveReminderDetails(ctx context.Context, req *SaveReminderDetailsRequest) error
+
+	// SendEmailNotification activity — отправляет письмо через SMTP
+	SendEmailNotification(ctx context.Context, req *ChannelNotificationRequest) error
+
+	// SendEscalation activity — уведомляет контакт из цепочки эскалации
+	SendEscalation(ctx context.Context, req *SendEscalationRequest) error
+
+	// SendInAppNotification activity — публикует уведомление в персональный канал Centrifugo
+	SendInAppNotification(ctx context.Context, req *ChannelNotificationRequest) error
+
 	// SendTelegramNotification activity — отправляет сообщение в Telegram
 	SendTelegramNotification(ctx context.Context, req *SendTelegramNotificationRequest) error
 
+	// SendWebhookNotification activity — отправляет POST-запрос на webhook пользователя
+	SendWebhookNotification(ctx context.Context, req *ChannelNotificationRequest) error
+
 	// UpdateReminderStatus activity — обновляет статус в БД
 	UpdateReminderStatus(ctx context.Context, req *UpdateReminderStatusRequest) error
 }
 
 // RegisterReminderActivities registers activities with a worker
 func RegisterReminderActivities(r worker.ActivityRegistry, activities ReminderActivities) {
+	RegisterBuildDailyDigestActivity(r, activities.BuildDailyDigest)
+	RegisterCheckQuietHoursActivity(r, activities.CheckQuietHours)
+	RegisterNotifyAcknowledgedActivity(r, activities.NotifyAcknowledged)
+	RegisterRecordAcknowledgementActivity(r, activities.RecordAcknowledgement)
+	RegisterRecordEscalationActivity(r, activities.RecordEscalation)
+	RegisterSaveReminderDetailsActivity(r, activities.SaveReminderDetails)
+	RegisterSendEmailNotificationActivity(r, activities.SendEmailNotification)
+	RegisterSendEscalationActivity(r, activities.SendEscalation)
+	RegisterSendInAppNotificationActivity(r, activities.SendInAppNotification)
 	RegisterSendTelegramNotificationActivity(r, activities.SendTelegramNotification)
+	RegisterSendWebhookNotificationActivity(r, activities.SendWebhookNotification)
 	RegisterUpdateReminderStatusActivity(r, activities.UpdateReminderStatus)
 }
 
-// RegisterSendTelegramNotificationActivity registers a reminder.v1.Reminder.SendTelegramNotification activity
-func RegisterSendTelegramNotificationActivity(r worker.ActivityRegistry, fn func(context.Context, *SendTelegramNotificationRequest) error) {
+// RegisterBuildDailyDigestActivity registers a reminder.v1.Reminder.BuildDailyDigest activity
+func RegisterBuildDailyDigestActivity(r worker.ActivityRegistry, fn func(context.Context, *BuildDailyDigestRequest) (*BuildDailyDigestResponse, error)) {
 	r.RegisterActivityWithOptions(fn, activity.RegisterOptions{
-		Name: SendTelegramNotificationActivityName,
+		Name: BuildDailyDigestActivityName,
 	})
 }
 
-// SendTelegramNotificationFuture describes a(n) reminder.v1.Reminder.SendTelegramNotification activity execution
-type SendTelegramNotificationFuture struct {
+// BuildDailyDigestFuture describes a(n) reminder.v1.Reminder.BuildDailyDigest activity execution
+type BuildDailyDigestFuture struct {
 	Future workflow.Future
 }
 
 // Get blocks on the activity's completion, returning the response
-func (f *SendTelegramNotificationFuture) Get(ctx workflow.Context) error {
-	return f.Future.Get(ctx, nil)
+func (f *BuildDailyDigestFuture) Get(ctx workflow.Context) (*BuildDailyDigestResponse, error) {
+	var resp BuildDailyDigestResponse
+	if err := f.Future.Get(ctx, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
 }
 
 // Select adds the activity's completion to the selector, callback can be nil
-func (f *SendTelegramNotificationFuture) Select(sel workflow.Selector, fn func(*SendTelegramNotificationFuture)) workflow.Selector {
+func (f *BuildDailyDigestFuture) Select(sel workflow.Selector, fn func(*BuildDailyDigestFuture)) workflow.Selector {
 	return sel.AddFuture(f.Future, func(workflow.Future) {
 		if fn != nil {
 			fn(f)
@@ -964,67 +1396,67 @@ func (f *SendTelegramNotificationFuture) Select(sel workflow.Selector, fn func(*
 	})
 }
 
-// SendTelegramNotification activity — отправляет сообщение в Telegram
-func SendTelegramNotification(ctx workflow.Context, req *SendTelegramNotificationRequest, options ...*SendTelegramNotificationActivityOptions) error {
-	return SendTelegramNotificationAsync(ctx, req, options...).Get(ctx)
+// BuildDailyDigest activity — собирает сводку напоминаний пользователя на день
+func BuildDailyDigest(ctx workflow.Context, req *BuildDailyDigestRequest, options ...*BuildDailyDigestActivityOptions) (*BuildDailyDigestResponse, error) {
+	return BuildDailyDigestAsync(ctx, req, options...).Get(ctx)
 }
 
-// SendTelegramNotification activity — отправляет сообщение в Telegram
-func SendTelegramNotificationAsync(ctx workflow.Context, req *SendTelegramNotificationRequest, options ...*SendTelegramNotificationActivityOptions) *SendTelegramNotificationFuture {
-	var o *SendTelegramNotificationActivityOptions
+// BuildDailyDigest activity — собирает сводку напоминаний пользователя на день
+func BuildDailyDigestAsync(ctx workflow.Context, req *BuildDailyDigestRequest, options ...*BuildDailyDigestActivityOptions) *BuildDailyDigestFuture {
+	var o *BuildDailyDigestActivityOptions
 	if len(options) > 0 && options[0] != nil {
 		o = options[0]
 	} else {
-		o = NewSendTelegramNotificationActivityOptions()
+		o = NewBuildDailyDigestActivityOptions()
 	}
 	var err error
 	if ctx, err = o.Build(ctx); err != nil {
 		errF, errS := workflow.NewFuture(ctx)
 		errS.SetError(err)
-		return &SendTelegramNotificationFuture{Future: errF}
+		return &BuildDailyDigestFuture{Future: errF}
 	}
-	activity := SendTelegramNotificationActivityName
+	activity := BuildDailyDigestActivityName
 	if o.dc != nil {
 		ctx = workflow.WithDataConverter(ctx, o.dc)
 	}
-	future := &SendTelegramNotificationFuture{Future: workflow.ExecuteActivity(ctx, activity, req)}
+	future := &BuildDailyDigestFuture{Future: workflow.ExecuteActivity(ctx, activity, req)}
 	return future
 }
 
-// SendTelegramNotification activity — отправляет сообщение в Telegram
-func SendTelegramNotificationLocal(ctx workflow.Context, req *SendTelegramNotificationRequest, options ...*SendTelegramNotificationLocalActivityOptions) error {
-	return SendTelegramNotificationLocalAsync(ctx, req, options...).Get(ctx)
+// BuildDailyDigest activity — собирает сводку напоминаний пользователя на день
+func BuildDailyDigestLocal(ctx workflow.Context, req *BuildDailyDigestRequest, options ...*BuildDailyDigestLocalActivityOptions) (*BuildDailyDigestResponse, error) {
+	return BuildDailyDigestLocalAsync(ctx, req, options...).Get(ctx)
 }
 
-// SendTelegramNotification activity — отправляет сообщение в Telegram
-func SendTelegramNotificationLocalAsync(ctx workflow.Context, req *SendTelegramNotificationRequest, options ...*SendTelegramNotificationLocalActivityOptions) *SendTelegramNotificationFuture {
-	var o *SendTelegramNotificationLocalActivityOptions
+// BuildDailyDigest activity — собирает сводку напоминаний пользователя на день
+func BuildDailyDigestLocalAsync(ctx workflow.Context, req *BuildDailyDigestRequest, options ...*BuildDailyDigestLocalActivityOptions) *BuildDailyDigestFuture {
+	var o *BuildDailyDigestLocalActivityOptions
 	if len(options) > 0 && options[0] != nil {
 		o = options[0]
 	} else {
-		o = NewSendTelegramNotificationLocalActivityOptions()
+		o = NewBuildDailyDigestLocalActivityOptions()
 	}
 	var err error
 	if ctx, err = o.Build(ctx); err != nil {
 		errF, errS := workflow.NewFuture(ctx)
 		errS.SetError(err)
-		return &SendTelegramNotificationFuture{Future: errF}
+		return &BuildDailyDigestFuture{Future: errF}
 	}
 	var activity any
 	if o.fn != nil {
 		activity = o.fn
 	} else {
-		activity = SendTelegramNotificationActivityName
+		activity = BuildDailyDigestActivityName
 	}
 	if o.dc != nil {
 		ctx = workflow.WithDataConverter(ctx, o.dc)
 	}
-	future := &SendTelegramNotificationFuture{Future: workflow.ExecuteLocalActivity(ctx, activity, req)}
+	future := &BuildDailyDigestFuture{Future: workflow.ExecuteLocalActivity(ctx, activity, req)}
 	return future
 }
 
-// SendTelegramNotificationActivityOptions provides configuration for a(n) reminder.v1.Reminder.SendTelegramNotification activity
-type SendTelegramNotificationActivityOptions struct {
+// BuildDailyDigestActivityOptions provides configuration for a(n) reminder.v1.Reminder.BuildDailyDigest activity
+type BuildDailyDigestActivityOptions struct {
 	options                workflow.ActivityOptions
 	retryPolicy            *temporal.RetryPolicy
 	scheduleToCloseTimeout *time.Duration
@@ -1036,13 +1468,13 @@ type SendTelegramNotificationActivityOptions struct {
 	waitForCancellation    *bool
 }
 
-// NewSendTelegramNotificationActivityOptions initializes a new SendTelegramNotificationActivityOptions value
-func NewSendTelegramNotificationActivityOptions() *SendTelegramNotificationActivityOptions {
-	return &SendTelegramNotificationActivityOptions{}
+// NewBuildDailyDigestActivityOptions initializes a new BuildDailyDigestActivityOptions value
+func NewBuildDailyDigestActivityOptions() *BuildDailyDigestActivityOptions {
+	return &BuildDailyDigestActivityOptions{}
 }
 
 // Build initializes a workflow.Context with appropriate ActivityOptions values derived from schema defaults and any user-defined overrides
-func (o *SendTelegramNotificationActivityOptions) Build(ctx workflow.Context) (workflow.Context, error) {
+func (o *BuildDailyDigestActivityOptions) Build(ctx workflow.Context) (workflow.Context, error) {
 	opts := o.options
 	if v := o.heartbeatTimeout; v != nil {
 		opts.HeartbeatTimeout = *v
@@ -1075,76 +1507,76 @@ func (o *SendTelegramNotificationActivityOptions) Build(ctx workflow.Context) (w
 }
 
 // WithActivityOptions specifies an initial ActivityOptions value to which defaults will be applied
-func (o *SendTelegramNotificationActivityOptions) WithActivityOptions(options workflow.ActivityOptions) *SendTelegramNotificationActivityOptions {
+func (o *BuildDailyDigestActivityOptions) WithActivityOptions(options workflow.ActivityOptions) *BuildDailyDigestActivityOptions {
 	o.options = options
 	return o
 }
 
 // WithDataConverter registers a DataConverter for the (local) activity
-func (o *SendTelegramNotificationActivityOptions) WithDataConverter(dc converter.DataConverter) *SendTelegramNotificationActivityOptions {
+func (o *BuildDailyDigestActivityOptions) WithDataConverter(dc converter.DataConverter) *BuildDailyDigestActivityOptions {
 	o.dc = dc
 	return o
 }
 
 // WithHeartbeatTimeout sets the HeartbeatTimeout value
-func (o *SendTelegramNotificationActivityOptions) WithHeartbeatTimeout(d time.Duration) *SendTelegramNotificationActivityOptions {
+func (o *BuildDailyDigestActivityOptions) WithHeartbeatTimeout(d time.Duration) *BuildDailyDigestActivityOptions {
 	o.heartbeatTimeout = &d
 	return o
 }
 
 // WithRetryPolicy sets the RetryPolicy value
-func (o *SendTelegramNotificationActivityOptions) WithRetryPolicy(policy *temporal.RetryPolicy) *SendTelegramNotificationActivityOptions {
+func (o *BuildDailyDigestActivityOptions) WithRetryPolicy(policy *temporal.RetryPolicy) *BuildDailyDigestActivityOptions {
 	o.retryPolicy = policy
 	return o
 }
 
 // WithScheduleToCloseTimeout sets the ScheduleToCloseTimeout value
-func (o *SendTelegramNotificationActivityOptions) WithScheduleToCloseTimeout(d time.Duration) *SendTelegramNotificationActivityOptions {
+func (o *BuildDailyDigestActivityOptions) WithScheduleToCloseTimeout(d time.Duration) *BuildDailyDigestActivityOptions {
 	o.scheduleToCloseTimeout = &d
 	return o
 }
 
 // WithScheduleToStartTimeout sets the ScheduleToStartTimeout value
-func (o *SendTelegramNotificationActivityOptions) WithScheduleToStartTimeout(d time.Duration) *SendTelegramNotificationActivityOptions {
+func (o *BuildDailyDigestActivityOptions) WithScheduleToStartTimeout(d time.Duration) *BuildDailyDigestActivityOptions {
 	o.scheduleToStartTimeout = &d
 	return o
 }
 
 // WithStartToCloseTimeout sets the StartToCloseTimeout value
-func (o *SendTelegramNotificationActivityOptions) WithStartToCloseTimeout(d time.Duration) *SendTelegramNotificationActivityOptions {
+func (o *BuildDailyDigestActivityOptions) WithStartToCloseTimeout(d time.Duration) *BuildDailyDigestActivityOptions {
 	o.startToCloseTimeout = &d
 	return o
 }
 
 // WithTaskQueue sets the TaskQueue value
-func (o *SendTelegramNotificationActivityOptions) WithTaskQueue(tq string) *SendTelegramNotificationActivityOptions {
+func (o *BuildDailyDigestActivityOptions) WithTaskQueue(tq string) *BuildDailyDigestActivityOptions {
 	o.taskQueue = &tq
 	return o
 }
 
 // WithWaitForCancellation sets the WaitForCancellation value
-func (o *SendTelegramNotificationActivityOptions) WithWaitForCancellation(wait bool) *SendTelegramNotificationActivityOptions {
+func (o *BuildDailyDigestActivityOptions) WithWaitForCancellation(wait bool) *BuildDailyDigestActivityOptions {
 	o.waitForCancellation = &wait
 	return o
 }
 
-// SendTelegramNotificationLocalActivityOptions provides configuration for a(n) reminder.v1.Reminder.SendTelegramNotification activity
-type SendTelegramNotificationLocalActivityOptions struct {
+// BuildDailyDigestLocalActivityOptions provides configuration for a(n) reminder.v1.Reminder.BuildDailyDigest activity
+type BuildDailyDigestLocalActivityOptions struct {
 	options                workflow.LocalActivityOptions
 	retryPolicy            *temporal.RetryPolicy
 	scheduleToCloseTimeout *time.Duration
 	startToCloseTimeout    *time.Duration
 	dc                     converter.DataConverter
-	fn                     func(context.Context, *SendTelegramNotificationRequest) error
+	fn                     func(context.Context, *BuildDailyDigestRequest) (*BuildDailyDigestResponse, error)
 }
 
-// NewSendTelegramNotificationLocalActivityOptions initializes a new SendTelegramNotificationLocalActivityOptions value
-func NewSendTelegramNotificationLocalActivityOptions() *SendTelegramNotificationLocalActivityOptions {
-	return &SendTelegramNotificationLocalActivityOptions{}
+// NewBuildDailyDigestLocalActivityOptions initializes a new BuildDailyDigestLocalActivityOptions value
+func NewBuildDailyDigestLocalActivityOptions() *BuildDailyDigestLocalActivityOptions {
+	return &BuildDailyDigestLocalActivityOptions{}
 }
 
 // Build initializes a workflow.Context with appropriate LocalActivityOptions values derived from schema defaults and any user-defined overrides
-func (o *SendTelegramNotificationLocalActivityOptions) Build(ctx workflow.Context) (workflow.Context, error) {
+func (o *BuildDailyDigestLocalActivityOptions) Build(ctx workflow.Context) (workflow.Context, error) {
 	opts := o.options
 	if v := o.retryPolicy; v != nil {
 		opts.RetryPolicy = v
@@ -1162,61 +1594,65 @@ func (o *SendTelegramNotificationLocalActivityOptions) Build(ctx workflow.Contex
 	return workflow.WithLocalActivityOptions(ctx, opts), nil
 }
 
-// Local specifies a custom reminder.v1.Reminder.SendTelegramNotification implementation
-func (o *SendTelegramNotificationLocalActivityOptions) Local(fn func(context.Context, *SendTelegramNotificationRequest) error) *SendTelegramNotificationLocalActivityOptions {
+// Local specifies a custom reminder.v1.Reminder.BuildDailyDigest implementation
+func (o *BuildDailyDigestLocalActivityOptions) Local(fn func(context.Context, *BuildDailyDigestRequest) (*BuildDailyDigestResponse, error)) *BuildDailyDigestLocalActivityOptions {
 	o.fn = fn
 	return o
 }
 
 // WithLocalActivityOptions specifies an initial LocalActivityOptions value to which defaults will be applied
-func (o *SendTelegramNotificationLocalActivityOptions) WithLocalActivityOptions(options workflow.LocalActivityOptions) *SendTelegramNotificationLocalActivityOptions {
+func (o *BuildDailyDigestLocalActivityOptions) WithLocalActivityOptions(options workflow.LocalActivityOptions) *BuildDailyDigestLocalActivityOptions {
 	o.options = options
 	return o
 }
 
 // WithDataConverter registers a DataConverter for the (local) activity
-func (o *SendTelegramNotificationLocalActivityOptions) WithDataConverter(dc converter.DataConverter) *SendTelegramNotificationLocalActivityOptions {
+func (o *BuildDailyDigestLocalActivityOptions) WithDataConverter(dc converter.DataConverter) *BuildDailyDigestLocalActivityOptions {
 	o.dc = dc
 	return o
 }
 
 // WithRetryPolicy sets the RetryPolicy value
-func (o *SendTelegramNotificationLocalActivityOptions) WithRetryPolicy(policy *temporal.RetryPolicy) *SendTelegramNotificationLocalActivityOptions {
+func (o *BuildDailyDigestLocalActivityOptions) WithRetryPolicy(policy *temporal.RetryPolicy) *BuildDailyDigestLocalActivityOptions {
 	o.retryPolicy = policy
 	return o
 }
 
 // WithScheduleToCloseTimeout sets the ScheduleToCloseTimeout value
-func (o *SendTelegramNotificationLocalActivityOptions) WithScheduleToCloseTimeout(d time.Duration) *SendTelegramNotificationLocalActivityOptions {
+func (o *BuildDailyDigestLocalActivityOptions) WithScheduleToCloseTimeout(d time.Duration) *BuildDailyDigestLocalActivityOptions {
 	o.scheduleToCloseTimeout = &d
 	return o
 }
 
 // WithStartToCloseTimeout sets the StartToCloseTimeout value
-func (o *SendTelegramNotificationLocalActivityOptions) WithStartToCloseTimeout(d time.Duration) *SendTelegramNotificationLocalActivityOptions {
+func (o *BuildDailyDigestLocalActivityOptions) WithStartToCloseTimeout(d time.Duration) *BuildDailyDigestLocalActivityOptions {
 	o.startToCloseTimeout = &d
 	return o
 }
 
-// RegisterUpdateReminderStatusActivity registers a reminder.v1.Reminder.UpdateReminderStatus activity
-func RegisterUpdateReminderStatusActivity(r worker.ActivityRegistry, fn func(context.Context, *UpdateReminderStatusRequest) error) {
+// RegisterCheckQuietHoursActivity registers a reminder.v1.Reminder.CheckQuietHours activity
+func RegisterCheckQuietHoursActivity(r worker.ActivityRegistry, fn func(context.Context, *CheckQuietHoursRequest) (*CheckQuietHoursResponse, error)) {
 	r.RegisterActivityWithOptions(fn, activity.RegisterOptions{
-		Name: UpdateReminderStatusActivityName,
+		Name: CheckQuietHoursActivityName,
 	})
 }
 
-// UpdateReminderStatusFuture describes a(n) reminder.v1.Reminder.UpdateReminderStatus activity execution
-type UpdateReminderStatusFuture struct {
+// CheckQuietHoursFuture describes a(n) reminder.v1.Reminder.CheckQuietHours activity execution
+type CheckQuietHoursFuture struct {
 	Future workflow.Future
 }
 
 // Get blocks on the activity's completion, returning the response
-func (f *UpdateReminderStatusFuture) Get(ctx workflow.Context) error {
-	return f.Future.Get(ctx, nil)
+func (f *CheckQuietHoursFuture) Get(ctx workflow.Context) (*CheckQuietHoursResponse, error) {
+	var resp CheckQuietHoursResponse
+	if err := f.Future.Get(ctx, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
 }
 
 // Select adds the activity's completion to the selector, callback can be nil
-func (f *UpdateReminderStatusFuture) Select(sel workflow.Selector, fn func(*UpdateReminderStatusFuture)) workflow.Selector {
+func (f *CheckQuietHoursFuture) Select(sel workflow.Selector, fn func(*CheckQuietHoursFuture)) workflow.Selector {
 	return sel.AddFuture(f.Future, func(workflow.Future) {
 		if fn != nil {
 			fn(f)
@@ -1224,35 +1660,2667 @@ func (f *UpdateReminderStatusFuture) Select(sel workflow.Selector, fn func(*Upda
 	})
 }
 
-// UpdateReminderStatus activity — обновляет статус в БД
-func UpdateReminderStatus(ctx workflow.Context, req *UpdateReminderStatusRequest, options ...*UpdateReminderStatusActivityOptions) error {
-	return UpdateReminderStatusAsync(ctx, req, options...).Get(ctx)
+// CheckQuietHours activity — проверяет тихие часы и режим «не беспокоить» получателя
+func CheckQuietHours(ctx workflow.Context, req *CheckQuietHoursRequest, options ...*CheckQuietHoursActivityOptions) (*CheckQuietHoursResponse, error) {
+	return CheckQuietHoursAsync(ctx, req, options...).Get(ctx)
 }
 
-// UpdateReminderStatus activity — обновляет статус в БД
-func UpdateReminderStatusAsync(ctx workflow.Context, req *UpdateReminderStatusRequest, options ...*UpdateReminderStatusActivityOptions) *UpdateReminderStatusFuture {
-	var o *UpdateReminderStatusActivityOptions
+// CheckQuietHours activity — проверяет тихие часы и режим «не беспокоить» получателя
+func CheckQuietHoursAsync(ctx workflow.Context, req *CheckQuietHoursRequest, options ...*CheckQuietHoursActivityOptions) *CheckQuietHoursFuture {
+	var o *CheckQuietHoursActivityOptions
 	if len(options) > 0 && options[0] != nil {
 		o = options[0]
 	} else {
-		o = NewUpdateReminderStatusActivityOptions()
+		o = NewCheckQuietHoursActivityOptions()
 	}
 	var err error
 	if ctx, err = o.Build(ctx); err != nil {
 		errF, errS := workflow.NewFuture(ctx)
 		errS.SetError(err)
-		return &UpdateReminderStatusFuture{Future: errF}
+		return &CheckQuietHoursFuture{Future: errF}
 	}
-	activity := UpdateReminderStatusActivityName
+	activity := CheckQuietHoursActivityName
 	if o.dc != nil {
 		ctx = workflow.WithDataConverter(ctx, o.dc)
 	}
-	future := &UpdateReminderStatusFuture{Future: workflow.ExecuteActivity(ctx, activity, req)}
+	future := &CheckQuietHoursFuture{Future: workflow.ExecuteActivity(ctx, activity, req)}
 	return future
 }
 
-// UpdateReminderStatus activity — обновляет статус в БД
-func UpdateReminderStatusLocal(ctx workflow.Context, req *UpdateReminderStatusRequest, options ...*UpdateReminderStatusLocalActivityOptions) error {
+// CheckQuietHours activity — проверяет тихие часы и режим «не беспокоить» получателя
+func CheckQuietHoursLocal(ctx workflow.Context, req *CheckQuietHoursRequest, options ...*CheckQuietHoursLocalActivityOptions) (*CheckQuietHoursResponse, error) {
+	return CheckQuietHoursLocalAsync(ctx, req, options...).Get(ctx)
+}
+
+// CheckQuietHours activity — проверяет тихие часы и режим «не беспокоить» получателя
+func CheckQuietHoursLocalAsync(ctx workflow.Context, req *CheckQuietHoursRequest, options ...*CheckQuietHoursLocalActivityOptions) *CheckQuietHoursFuture {
+	var o *CheckQuietHoursLocalActivityOptions
+	if len(options) > 0 && options[0] != nil {
+		o = options[0]
+	} else {
+		o = NewCheckQuietHoursLocalActivityOptions()
+	}
+	var err error
+	if ctx, err = o.Build(ctx); err != nil {
+		errF, errS := workflow.NewFuture(ctx)
+		errS.SetError(err)
+		return &CheckQuietHoursFuture{Future: errF}
+	}
+	var activity any
+	if o.fn != nil {
+		activity = o.fn
+	} else {
+		activity = CheckQuietHoursActivityName
+	}
+	if o.dc != nil {
+		ctx = workflow.WithDataConverter(ctx, o.dc)
+	}
+	future := &CheckQuietHoursFuture{Future: workflow.ExecuteLocalActivity(ctx, activity, req)}
+	return future
+}
+
+// CheckQuietHoursActivityOptions provides configuration for a(n) reminder.v1.Reminder.CheckQuietHours activity
+type CheckQuietHoursActivityOptions struct {
+	options                workflow.ActivityOptions
+	retryPolicy            *temporal.RetryPolicy
+	scheduleToCloseTimeout *time.Duration
+	startToCloseTimeout    *time.Duration
+	dc                     converter.DataConverter
+	heartbeatTimeout       *time.Duration
+	scheduleToStartTimeout *time.Duration
+	taskQueue              *string
+	waitForCancellation    *bool
+}
+
+// NewCheckQuietHoursActivityOptions initializes a new CheckQuietHoursActivityOptions value
+func NewCheckQuietHoursActivityOptions() *CheckQuietHoursActivityOptions {
+	return &CheckQuietHoursActivityOptions{}
+}
+
+// Build initializes a workflow.Context with appropriate ActivityOptions values derived from schema defaults and any user-defined overrides
+func (o *CheckQuietHoursActivityOptions) Build(ctx workflow.Context) (workflow.Context, error) {
+	opts := o.options
+	if v := o.heartbeatTimeout; v != nil {
+		opts.HeartbeatTimeout = *v
+	}
+	if v := o.retryPolicy; v != nil {
+		opts.RetryPolicy = v
+	} else if opts.RetryPolicy == nil {
+		opts.RetryPolicy = &temporal.RetryPolicy{MaximumAttempts: int32(5)}
+	}
+	if v := o.scheduleToCloseTimeout; v != nil {
+		opts.ScheduleToCloseTimeout = *v
+	}
+	if v := o.scheduleToStartTimeout; v != nil {
+		opts.ScheduleToStartTimeout = *v
+	}
+	if v := o.startToCloseTimeout; v != nil {
+		opts.StartToCloseTimeout = *v
+	} else if opts.StartToCloseTimeout == 0 {
+		opts.StartToCloseTimeout = 10000000000 // 10 seconds
+	}
+	if v := o.taskQueue; v != nil {
+		opts.TaskQueue = *v
+	} else if opts.TaskQueue == "" {
+		opts.TaskQueue = ReminderTaskQueue
+	}
+	if v := o.waitForCancellation; v != nil {
+		opts.WaitForCancellation = *v
+	}
+	return workflow.WithActivityOptions(ctx, opts), nil
+}
+
+// WithActivityOptions specifies an initial ActivityOptions value to which defaults will be applied
+func (o *CheckQuietHoursActivityOptions) WithActivityOptions(options workflow.ActivityOptions) *CheckQuietHoursActivityOptions {
+	o.options = options
+	return o
+}
+
+// WithDataConverter registers a DataConverter for the (local) activity
+func (o *CheckQuietHoursActivityOptions) WithDataConverter(dc converter.DataConverter) *CheckQuietHoursActivityOptions {
+	o.dc = dc
+	return o
+}
+
+// WithHeartbeatTimeout sets the HeartbeatTimeout value
+func (o *CheckQuietHoursActivityOptions) WithHeartbeatTimeout(d time.Duration) *CheckQuietHoursActivityOptions {
+	o.heartbeatTimeout = &d
+	return o
+}
+
+// WithRetryPolicy sets the RetryPolicy value
+func (o *CheckQuietHoursActivityOptions) WithRetryPolicy(policy *temporal.RetryPolicy) *CheckQuietHoursActivityOptions {
+	o.retryPolicy = policy
+	return o
+}
+
+// WithScheduleToCloseTimeout sets the ScheduleToCloseTimeout value
+func (o *CheckQuietHoursActivityOptions) WithScheduleToCloseTimeout(d time.Duration) *CheckQuietHoursActivityOptions {
+	o.scheduleToCloseTimeout = &d
+	return o
+}
+
+// WithScheduleToStartTimeout sets the ScheduleToStartTimeout value
+func (o *CheckQuietHoursActivityOptions) WithScheduleToStartTimeout(d time.Duration) *CheckQuietHoursActivityOptions {
+	o.scheduleToStartTimeout = &d
+	return o
+}
+
+// WithStartToCloseTimeout sets the StartToCloseTimeout value
+func (o *CheckQuietHoursActivityOptions) WithStartToCloseTimeout(d time.Duration) *CheckQuietHoursActivityOptions {
+	o.startToCloseTimeout = &d
+	return o
+}
+
+// WithTaskQueue sets the TaskQueue value
+func (o *CheckQuietHoursActivityOptions) WithTaskQueue(tq string) *CheckQuietHoursActivityOptions {
+	o.taskQueue = &tq
+	return o
+}
+
+// WithWaitForCancellation sets the WaitForCancellation value
+func (o *CheckQuietHoursActivityOptions) WithWaitForCancellation(wait bool) *CheckQuietHoursActivityOptions {
+	o.waitForCancellation = &wait
+	return o
+}
+
+// CheckQuietHoursLocalActivityOptions provides configuration for a(n) reminder.v1.Reminder.CheckQuietHours activity
+type CheckQuietHoursLocalActivityOptions struct {
+	options                workflow.LocalActivityOptions
+	retryPolicy            *temporal.RetryPolicy
+	scheduleToCloseTimeout *time.Duration
+	startToCloseTimeout    *time.Duration
+	dc                     converter.DataConverter
+	fn                     func(context.Context, *CheckQuietHoursRequest) (*CheckQuietHoursResponse, error)
+}
+
+// NewCheckQuietHoursLocalActivityOptions initializes a new CheckQuietHoursLocalActivityOptions value
+func NewCheckQuietHoursLocalActivityOptions() *CheckQuietHoursLocalActivityOptions {
+	return &CheckQuietHoursLocalActivityOptions{}
+}
+
+// Build initializes a workflow.Context with appropriate LocalActivityOptions values derived from schema defaults and any user-defined overrides
+func (o *CheckQuietHoursLocalActivityOptions) Build(ctx workflow.Context) (workflow.Context, error) {
+	opts := o.options
+	if v := o.retryPolicy; v != nil {
+		opts.RetryPolicy = v
+	} else if opts.RetryPolicy == nil {
+		opts.RetryPolicy = &temporal.RetryPolicy{MaximumAttempts: int32(5)}
+	}
+	if v := o.scheduleToCloseTimeout; v != nil {
+		opts.ScheduleToCloseTimeout = *v
+	}
+	if v := o.startToCloseTimeout; v != nil {
+		opts.StartToCloseTimeout = *v
+	} else if opts.StartToCloseTimeout == 0 {
+		opts.StartToCloseTimeout = 10000000000 // 10 seconds
+	}
+	return workflow.WithLocalActivityOptions(ctx, opts), nil
+}
+
+// Local specifies a custom reminder.v1.Reminder.CheckQuietHours implementation
+func (o *CheckQuietHoursLocalActivityOptions) Local(fn func(context.Context, *CheckQuietHoursRequest) (*CheckQuietHoursResponse, error)) *CheckQuietHoursLocalActivityOptions {
+	o.fn = fn
+	return o
+}
+
+// WithLocalActivityOptions specifies an initial LocalActivityOptions value to which defaults will be applied
+func (o *CheckQuietHoursLocalActivityOptions) WithLocalActivityOptions(options workflow.LocalActivityOptions) *CheckQuietHoursLocalActivityOptions {
+	o.options = options
+	return o
+}
+
+// WithDataConverter registers a DataConverter for the (local) activity
+func (o *CheckQuietHoursLocalActivityOptions) WithDataConverter(dc converter.DataConverter) *CheckQuietHoursLocalActivityOptions {
+	o.dc = dc
+	return o
+}
+
+// WithRetryPolicy sets the RetryPolicy value
+func (o *CheckQuietHoursLocalActivityOptions) WithRetryPolicy(policy *temporal.RetryPolicy) *CheckQuietHoursLocalActivityOptions {
+	o.retryPolicy = policy
+	return o
+}
+
+// WithScheduleToCloseTimeout sets the ScheduleToCloseTimeout value
+func (o *CheckQuietHoursLocalActivityOptions) WithScheduleToCloseTimeout(d time.Duration) *CheckQuietHoursLocalActivityOptions {
+	o.scheduleToCloseTimeout = &d
+	return o
+}
+
+// WithStartToCloseTimeout sets the StartToCloseTimeout value
+func (o *CheckQuietHoursLocalActivityOptions) WithStartToCloseTimeout(d time.Duration) *CheckQuietHoursLocalActivityOptions {
+	o.startToCloseTimeout = &d
+	return o
+}
+
+// RegisterNotifyAcknowledgedActivity registers a reminder.v1.Reminder.NotifyAcknowledged activity
+func RegisterNotifyAcknowledgedActivity(r worker.ActivityRegistry, fn func(context.Context, *NotifyAcknowledgedRequest) error) {
+	r.RegisterActivityWithOptions(fn, activity.RegisterOptions{
+		Name: NotifyAcknowledgedActivityName,
+	})
+}
+
+// NotifyAcknowledgedFuture describes a(n) reminder.v1.Reminder.NotifyAcknowledged activity execution
+type NotifyAcknowledgedFuture struct {
+	Future workflow.Future
+}
+
+// Get blocks on the activity's completion, returning the response
+func (f *NotifyAcknowledgedFuture) Get(ctx workflow.Context) error {
+	return f.Future.Get(ctx, nil)
+}
+
+// Select adds the activity's completion to the selector, callback can be nil
+func (f *NotifyAcknowledgedFuture) Select(sel workflow.Selector, fn func(*NotifyAcknowledgedFuture)) workflow.Selector {
+	return sel.AddFuture(f.Future, func(workflow.Future) {
+		if fn != nil {
+			fn(f)
+		}
+	})
+}
+
+// NotifyAcknowledged activity — сообщает создателю, что исполнитель подтвердил напоминание
+func NotifyAcknowledged(ctx workflow.Context, req *NotifyAcknowledgedRequest, options ...*NotifyAcknowledgedActivityOptions) error {
+	return NotifyAcknowledgedAsync(ctx, req, options...).Get(ctx)
+}
+
+// NotifyAcknowledged activity — сообщает создателю, что исполнитель подтвердил напоминание
+func NotifyAcknowledgedAsync(ctx workflow.Context, req *NotifyAcknowledgedRequest, options ...*NotifyAcknowledgedActivityOptions) *NotifyAcknowledgedFuture {
+	var o *NotifyAcknowledgedActivityOptions
+	if len(options) > 0 && options[0] != nil {
+		o = options[0]
+	} else {
+		o = NewNotifyAcknowledgedActivityOptions()
+	}
+	var err error
+	if ctx, err = o.Build(ctx); err != nil {
+		errF, errS := workflow.NewFuture(ctx)
+		errS.SetError(err)
+		return &NotifyAcknowledgedFuture{Future: errF}
+	}
+	activity := NotifyAcknowledgedActivityName
+	if o.dc != nil {
+		ctx = workflow.WithDataConverter(ctx, o.dc)
+	}
+	future := &NotifyAcknowledgedFuture{Future: workflow.ExecuteActivity(ctx, activity, req)}
+	return future
+}
+
+// NotifyAcknowledged activity — сообщает создателю, что исполнитель подтвердил напоминание
+func NotifyAcknowledgedLocal(ctx workflow.Context, req *NotifyAcknowledgedRequest, options ...*NotifyAcknowledgedLocalActivityOptions) error {
+	return NotifyAcknowledgedLocalAsync(ctx, req, options...).Get(ctx)
+}
+
+// NotifyAcknowledged activity — сообщает создателю, что исполнитель подтвердил напоминание
+func NotifyAcknowledgedLocalAsync(ctx workflow.Context, req *NotifyAcknowledgedRequest, options ...*NotifyAcknowledgedLocalActivityOptions) *NotifyAcknowledgedFuture {
+	var o *NotifyAcknowledgedLocalActivityOptions
+	if len(options) > 0 && options[0] != nil {
+		o = options[0]
+	} else {
+		o = NewNotifyAcknowledgedLocalActivityOptions()
+	}
+	var err error
+	if ctx, err = o.Build(ctx); err != nil {
+		errF, errS := workflow.NewFuture(ctx)
+		errS.SetError(err)
+		return &NotifyAcknowledgedFuture{Future: errF}
+	}
+	var activity any
+	if o.fn != nil {
+		activity = o.fn
+	} else {
+		activity = NotifyAcknowledgedActivityName
+	}
+	if o.dc != nil {
+		ctx = workflow.WithDataConverter(ctx, o.dc)
+	}
+	future := &NotifyAcknowledgedFuture{Future: workflow.ExecuteLocalActivity(ctx, activity, req)}
+	return future
+}
+
+// NotifyAcknowledgedActivityOptions provides configuration for a(n) reminder.v1.Reminder.NotifyAcknowledged activity
+type NotifyAcknowledgedActivityOptions struct {
+	options                workflow.ActivityOptions
+	retryPolicy            *temporal.RetryPolicy
+	scheduleToCloseTimeout *time.Duration
+	startToCloseTimeout    *time.Duration
+	dc                     converter.DataConverter
+	heartbeatTimeout       *time.Duration
+	scheduleToStartTimeout *time.Duration
+	taskQueue              *string
+	waitForCancellation    *bool
+}
+
+// NewNotifyAcknowledgedActivityOptions initializes a new NotifyAcknowledgedActivityOptions value
+func NewNotifyAcknowledgedActivityOptions() *NotifyAcknowledgedActivityOptions {
+	return &NotifyAcknowledgedActivityOptions{}
+}
+
+// Build initializes a workflow.Context with appropriate ActivityOptions values derived from schema defaults and any user-defined overrides
+func (o *NotifyAcknowledgedActivityOptions) Build(ctx workflow.Context) (workflow.Context, error) {
+	opts := o.options
+	if v := o.heartbeatTimeout; v != nil {
+		opts.HeartbeatTimeout = *v
+	}
+	if v := o.retryPolicy; v != nil {
+		opts.RetryPolicy = v
+	} else if opts.RetryPolicy == nil {
+		opts.RetryPolicy = &temporal.RetryPolicy{
+			InitialInterval:    10000000000, // 10 seconds
+			BackoffCoefficient: 2,
+			MaximumAttempts:    int32(5),
+		}
+	}
+	if v := o.scheduleToCloseTimeout; v != nil {
+		opts.ScheduleToCloseTimeout = *v
+	}
+	if v := o.scheduleToStartTimeout; v != nil {
+		opts.ScheduleToStartTimeout = *v
+	}
+	if v := o.startToCloseTimeout; v != nil {
+		opts.StartToCloseTimeout = *v
+	} else if opts.StartToCloseTimeout == 0 {
+		opts.StartToCloseTimeout = 30000000000 // 30 seconds
+	}
+	if v := o.taskQueue; v != nil {
+		opts.TaskQueue = *v
+	} else if opts.TaskQueue == "" {
+		opts.TaskQueue = ReminderTaskQueue
+	}
+	if v := o.waitForCancellation; v != nil {
+		opts.WaitForCancellation = *v
+	}
+	return workflow.WithActivityOptions(ctx, opts), nil
+}
+
+// WithActivityOptions specifies an initial ActivityOptions value to which defaults will be applied
+func (o *NotifyAcknowledgedActivityOptions) WithActivityOptions(options workflow.ActivityOptions) *NotifyAcknowledgedActivityOptions {
+	o.options = options
+	return o
+}
+
+// WithDataConverter registers a DataConverter for the (local) activity
+func (o *NotifyAcknowledgedActivityOptions) WithDataConverter(dc converter.DataConverter) *NotifyAcknowledgedActivityOptions {
+	o.dc = dc
+	return o
+}
+
+// WithHeartbeatTimeout sets the HeartbeatTimeout value
+func (o *NotifyAcknowledgedActivityOptions) WithHeartbeatTimeout(d time.Duration) *NotifyAcknowledgedActivityOptions {
+	o.heartbeatTimeout = &d
+	return o
+}
+
+// WithRetryPolicy sets the RetryPolicy value
+func (o *NotifyAcknowledgedActivityOptions) WithRetryPolicy(policy *temporal.RetryPolicy) *NotifyAcknowledgedActivityOptions {
+	o.retryPolicy = policy
+	return o
+}
+
+// WithScheduleToCloseTimeout sets the ScheduleToCloseTimeout value
+func (o *NotifyAcknowledgedActivityOptions) WithScheduleToCloseTimeout(d time.Duration) *NotifyAcknowledgedActivityOptions {
+	o.scheduleToCloseTimeout = &d
+	return o
+}
+
+// WithScheduleToStartTimeout sets the ScheduleToStartTimeout value
+func (o *NotifyAcknowledgedActivityOptions) WithScheduleToStartTimeout(d time.Duration) *NotifyAcknowledgedActivityOptions {
+	o.scheduleToStartTimeout = &d
+	return o
+}
+
+// WithStartToCloseTimeout sets the StartToCloseTimeout value
+func (o *NotifyAcknowledgedActivityOptions) WithStartToCloseTimeout(d time.Duration) *NotifyAcknowledgedActivityOptions {
+	o.startToCloseTimeout = &d
+	return o
+}
+
+// WithTaskQueue sets the TaskQueue value
+func (o *NotifyAcknowledgedActivityOptions) WithTaskQueue(tq string) *NotifyAcknowledgedActivityOptions {
+	o.taskQueue = &tq
+	return o
+}
+
+// WithWaitForCancellation sets the WaitForCancellation value
+func (o *NotifyAcknowledgedActivityOptions) WithWaitForCancellation(wait bool) *NotifyAcknowledgedActivityOptions {
+	o.waitForCancellation = &wait
+	return o
+}
+
+// NotifyAcknowledgedLocalActivityOptions provides configuration for a(n) reminder.v1.Reminder.NotifyAcknowledged activity
+type NotifyAcknowledgedLocalActivityOptions struct {
+	options                workflow.LocalActivityOptions
+	retryPolicy            *temporal.RetryPolicy
+	scheduleToCloseTimeout *time.Duration
+	startToCloseTimeout    *time.Duration
+	dc                     converter.DataConverter
+	fn                     func(context.Context, *NotifyAcknowledgedRequest) error
+}
+
+// NewNotifyAcknowledgedLocalActivityOptions initializes a new NotifyAcknowledgedLocalActivityOptions value
+func NewNotifyAcknowledgedLocalActivityOptions() *NotifyAcknowledgedLocalActivityOptions {
+	return &NotifyAcknowledgedLocalActivityOptions{}
+}
+
+// Build initializes a workflow.Context with appropriate LocalActivityOptions values derived from schema defaults and any user-defined overrides
+func (o *NotifyAcknowledgedLocalActivityOptions) Build(ctx workflow.Context) (workflow.Context, error) {
+	opts := o.options
+	if v := o.retryPolicy; v != nil {
+		opts.RetryPolicy = v
+	} else if opts.RetryPolicy == nil {
+		opts.RetryPolicy = &temporal.RetryPolicy{
+			InitialInterval:    10000000000, // 10 seconds
+			BackoffCoefficient: 2,
+			MaximumAttempts:    int32(5),
+		}
+	}
+	if v := o.scheduleToCloseTimeout; v != nil {
+		opts.ScheduleToCloseTimeout = *v
+	}
+	if v := o.startToCloseTimeout; v != nil {
+		opts.StartToCloseTimeout = *v
+	} else if opts.StartToCloseTimeout == 0 {
+		opts.StartToCloseTimeout = 30000000000 // 30 seconds
+	}
+	return workflow.WithLocalActivityOptions(ctx, opts), nil
+}
+
+// Local specifies a custom reminder.v1.Reminder.NotifyAcknowledged implementation
+func (o *NotifyAcknowledgedLocalActivityOptions) Local(fn func(context.Context, *NotifyAcknowledgedRequest) error) *NotifyAcknowledgedLocalActivityOptions {
+	o.fn = fn
+	return o
+}
+
+// WithLocalActivityOptions specifies an initial LocalActivityOptions value to which defaults will be applied
+func (o *NotifyAcknowledgedLocalActivityOptions) WithLocalActivityOptions(options workflow.LocalActivityOptions) *NotifyAcknowledgedLocalActivityOptions {
+	o.options = options
+	return o
+}
+
+// WithDataConverter registers a DataConverter for the (local) activity
+func (o *NotifyAcknowledgedLocalActivityOptions) WithDataConverter(dc converter.DataConverter) *NotifyAcknowledgedLocalActivityOptions {
+	o.dc = dc
+	return o
+}
+
+// WithRetryPolicy sets the RetryPolicy value
+func (o *NotifyAcknowledgedLocalActivityOptions) WithRetryPolicy(policy *temporal.RetryPolicy) *NotifyAcknowledgedLocalActivityOptions {
+	o.retryPolicy = policy
+	return o
+}
+
+// WithScheduleToCloseTimeout sets the ScheduleToCloseTimeout value
+func (o *NotifyAcknowledgedLocalActivityOptions) WithScheduleToCloseTimeout(d time.Duration) *NotifyAcknowledgedLocalActivityOptions {
+	o.scheduleToCloseTimeout = &d
+	return o
+}
+
+// WithStartToCloseTimeout sets the StartToCloseTimeout value
+func (o *NotifyAcknowledgedLocalActivityOptions) WithStartToCloseTimeout(d time.Duration) *NotifyAcknowledgedLocalActivityOptions {
+	o.startToCloseTimeout = &d
+	return o
+}
+
+// RegisterRecordAcknowledgementActivity registers a reminder.v1.Reminder.RecordAcknowledgement activity
+func RegisterRecordAcknowledgementActivity(r worker.ActivityRegistry, fn func(context.Context, *RecordAcknowledgementRequest) error) {
+	r.RegisterActivityWithOptions(fn, activity.RegisterOptions{
+		Name: RecordAcknowledgementActivityName,
+	})
+}
+
+// RecordAcknowledgementFuture describes a(n) reminder.v1.Reminder.RecordAcknowledgement activity execution
+type RecordAcknowledgementFuture struct {
+	Future workflow.Future
+}
+
+// Get blocks on the activity's completion, returning the response
+func (f *RecordAcknowledgementFuture) Get(ctx workflow.Context) error {
+	return f.Future.Get(ctx, nil)
+}
+
+// Select adds the activity's completion to the selector, callback can be nil
+func (f *RecordAcknowledgementFuture) Select(sel workflow.Selector, fn func(*RecordAcknowledgementFuture)) workflow.Selector {
+	return sel.AddFuture(f.Future, func(workflow.Future) {
+		if fn != nil {
+			fn(f)
+		}
+	})
+}
+
+// RecordAcknowledgement activity — сохраняет подтверждение в историю доставки
+func RecordAcknowledgement(ctx workflow.Context, req *RecordAcknowledgementRequest, options ...*RecordAcknowledgementActivityOptions) error {
+	return RecordAcknowledgementAsync(ctx, req, options...).Get(ctx)
+}
+
+// RecordAcknowledgement activity — сохраняет подтверждение в историю доставки
+func RecordAcknowledgementAsync(ctx workflow.Context, req *RecordAcknowledgementRequest, options ...*RecordAcknowledgementActivityOptions) *RecordAcknowledgementFuture {
+	var o *RecordAcknowledgementActivityOptions
+	if len(options) > 0 && options[0] != nil {
+		o = options[0]
+	} else {
+		o = NewRecordAcknowledgementActivityOptions()
+	}
+	var err error
+	if ctx, err = o.Build(ctx); err != nil {
+		errF, errS := workflow.NewFuture(ctx)
+		errS.SetError(err)
+		return &RecordAcknowledgementFuture{Future: errF}
+	}
+	activity := RecordAcknowledgementActivityName
+	if o.dc != nil {
+		ctx = workflow.WithDataConverter(ctx, o.dc)
+	}
+	future := &RecordAcknowledgementFuture{Future: workflow.ExecuteActivity(ctx, activity, req)}
+	return future
+}
+
+// RecordAcknowledgement activity — сохраняет подтверждение в историю доставки
+func RecordAcknowledgementLocal(ctx workflow.Context, req *RecordAcknowledgementRequest, options ...*RecordAcknowledgementLocalActivityOptions) error {
+	return RecordAcknowledgementLocalAsync(ctx, req, options...).Get(ctx)
+}
+
+// RecordAcknowledgement activity — сохраняет подтверждение в историю доставки
+func RecordAcknowledgementLocalAsync(ctx workflow.Context, req *RecordAcknowledgementRequest, options ...*RecordAcknowledgementLocalActivityOptions) *RecordAcknowledgementFuture {
+	var o *RecordAcknowledgementLocalActivityOptions
+	if len(options) > 0 && options[0] != nil {
+		o = options[0]
+	} else {
+		o = NewRecordAcknowledgementLocalActivityOptions()
+	}
+	var err error
+	if ctx, err = o.Build(ctx); err != nil {
+		errF, errS := workflow.NewFuture(ctx)
+		errS.SetError(err)
+		return &RecordAcknowledgementFuture{Future: errF}
+	}
+	var activity any
+	if o.fn != nil {
+		activity = o.fn
+	} else {
+		activity = RecordAcknowledgementActivityName
+	}
+	if o.dc != nil {
+		ctx = workflow.WithDataConverter(ctx, o.dc)
+	}
+	future := &RecordAcknowledgementFuture{Future: workflow.ExecuteLocalActivity(ctx, activity, req)}
+	return future
+}
+
+// RecordAcknowledgementActivityOptions provides configuration for a(n) reminder.v1.Reminder.RecordAcknowledgement activity
+type RecordAcknowledgementActivityOptions struct {
+	options                workflow.ActivityOptions
+	retryPolicy            *temporal.RetryPolicy
+	scheduleToCloseTimeout *time.Duration
+	startToCloseTimeout    *time.Duration
+	dc                     converter.DataConverter
+	heartbeatTimeout       *time.Duration
+	scheduleToStartTimeout *time.Duration
+	taskQueue              *string
+	waitForCancellation    *bool
+}
+
+// NewRecordAcknowledgementActivityOptions initializes a new RecordAcknowledgementActivityOptions value
+func NewRecordAcknowledgementActivityOptions() *RecordAcknowledgementActivityOptions {
+	return &RecordAcknowledgementActivityOptions{}
+}
+
+// Build initializes a workflow.Context with appropriate ActivityOptions values derived from schema defaults and any user-defined overrides
+func (o *RecordAcknowledgementActivityOptions) Build(ctx workflow.Context) (workflow.Context, error) {
+	opts := o.options
+	if v := o.heartbeatTimeout; v != nil {
+		opts.HeartbeatTimeout = *v
+	}
+	if v := o.retryPolicy; v != nil {
+		opts.RetryPolicy = v
+	} else if opts.RetryPolicy == nil {
+		opts.RetryPolicy = &temporal.RetryPolicy{MaximumAttempts: int32(10)}
+	}
+	if v := o.scheduleToCloseTimeout; v != nil {
+		opts.ScheduleToCloseTimeout = *v
+	}
+	if v := o.scheduleToStartTimeout; v != nil {
+		opts.ScheduleToStartTimeout = *v
+	}
+	if v := o.startToCloseTimeout; v != nil {
+		opts.StartToCloseTimeout = *v
+	} else if opts.StartToCloseTimeout == 0 {
+		opts.StartToCloseTimeout = 10000000000 // 10 seconds
+	}
+	if v := o.taskQueue; v != nil {
+		opts.TaskQueue = *v
+	} else if opts.TaskQueue == "" {
+		opts.TaskQueue = ReminderTaskQueue
+	}
+	if v := o.waitForCancellation; v != nil {
+		opts.WaitForCancellation = *v
+	}
+	return workflow.WithActivityOptions(ctx, opts), nil
+}
+
+// WithActivityOptions specifies an initial ActivityOptions value to which defaults will be applied
+func (o *RecordAcknowledgementActivityOptions) WithActivityOptions(options workflow.ActivityOptions) *RecordAcknowledgementActivityOptions {
+	o.options = options
+	return o
+}
+
+// WithDataConverter registers a DataConverter for the (local) activity
+func (o *RecordAcknowledgementActivityOptions) WithDataConverter(dc converter.DataConverter) *RecordAcknowledgementActivityOptions {
+	o.dc = dc
+	return o
+}
+
+// WithHeartbeatTimeout sets the HeartbeatTimeout value
+func (o *RecordAcknowledgementActivityOptions) WithHeartbeatTimeout(d time.Duration) *RecordAcknowledgementActivityOptions {
+	o.heartbeatTimeout = &d
+	return o
+}
+
+// WithRetryPolicy sets the RetryPolicy value
+func (o *RecordAcknowledgementActivityOptions) WithRetryPolicy(policy *temporal.RetryPolicy) *RecordAcknowledgementActivityOptions {
+	o.retryPolicy = policy
+	return o
+}
+
+// WithScheduleToCloseTimeout sets the ScheduleToCloseTimeout value
+func (o *RecordAcknowledgementActivityOptions) WithScheduleToCloseTimeout(d time.Duration) *RecordAcknowledgementActivityOptions {
+	o.scheduleToCloseTimeout = &d
+	return o
+}
+
+// WithScheduleToStartTimeout sets the ScheduleToStartTimeout value
+func (o *RecordAcknowledgementActivityOptions) WithScheduleToStartTimeout(d time.Duration) *RecordAcknowledgementActivityOptions {
+	o.scheduleToStartTimeout = &d
+	return o
+}
+
+// WithStartToCloseTimeout sets the StartToCloseTimeout value
+func (o *RecordAcknowledgementActivityOptions) WithStartToCloseTimeout(d time.Duration) *RecordAcknowledgementActivityOptions {
+	o.startToCloseTimeout = &d
+	return o
+}
+
+// WithTaskQueue sets the TaskQueue value
+func (o *RecordAcknowledgementActivityOptions) WithTaskQueue(tq string) *RecordAcknowledgementActivityOptions {
+	o.taskQueue = &tq
+	return o
+}
+
+// WithWaitForCancellation sets the WaitForCancellation value
+func (o *RecordAcknowledgementActivityOptions) WithWaitForCancellation(wait bool) *RecordAcknowledgementActivityOptions {
+	o.waitForCancellation = &wait
+	return o
+}
+
+// RecordAcknowledgementLocalActivityOptions provides configuration for a(n) reminder.v1.Reminder.RecordAcknowledgement activity
+type RecordAcknowledgementLocalActivityOptions struct {
+	options                workflow.LocalActivityOptions
+	retryPolicy            *temporal.RetryPolicy
+	scheduleToCloseTimeout *time.Duration
+	startToCloseTimeout    *time.Duration
+	dc                     converter.DataConverter
+	fn                     func(context.Context, *RecordAcknowledgementRequest) error
+}
+
+// NewRecordAcknowledgementLocalActivityOptions initializes a new RecordAcknowledgementLocalActivityOptions value
+func NewRecordAcknowledgementLocalActivityOptions() *RecordAcknowledgementLocalActivityOptions {
+	return &RecordAcknowledgementLocalActivityOptions{}
+}
+
+// Build initializes a workflow.Context with appropriate LocalActivityOptions values derived from schema defaults and any user-defined overrides
+func (o *RecordAcknowledgementLocalActivityOptions) Build(ctx workflow.Context) (workflow.Context, error) {
+	opts := o.options
+	if v := o.retryPolicy; v != nil {
+		opts.RetryPolicy = v
+	} else if opts.RetryPolicy == nil {
+		opts.RetryPolicy = &temporal.RetryPolicy{MaximumAttempts: int32(10)}
+	}
+	if v := o.scheduleToCloseTimeout; v != nil {
+		opts.ScheduleToCloseTimeout = *v
+	}
+	if v := o.startToCloseTimeout; v != nil {
+		opts.StartToCloseTimeout = *v
+	} else if opts.StartToCloseTimeout == 0 {
+		opts.StartToCloseTimeout = 10000000000 // 10 seconds
+	}
+	return workflow.WithLocalActivityOptions(ctx, opts), nil
+}
+
+// Local specifies a custom reminder.v1.Reminder.RecordAcknowledgement implementation
+func (o *RecordAcknowledgementLocalActivityOptions) Local(fn func(context.Context, *RecordAcknowledgementRequest) error) *RecordAcknowledgementLocalActivityOptions {
+	o.fn = fn
+	return o
+}
+
+// WithLocalActivityOptions specifies an initial LocalActivityOptions value to which defaults will be applied
+func (o *RecordAcknowledgementLocalActivityOptions) WithLocalActivityOptions(options workflow.LocalActivityOptions) *RecordAcknowledgementLocalActivityOptions {
+	o.options = options
+	return o
+}
+
+// WithDataConverter registers a DataConverter for the (local) activity
+func (o *RecordAcknowledgementLocalActivityOptions) WithDataConverter(dc converter.DataConverter) *RecordAcknowledgementLocalActivityOptions {
+	o.dc = dc
+	return o
+}
+
+// WithRetryPolicy sets the RetryPolicy value
+func (o *RecordAcknowledgementLocalActivityOptions) WithRetryPolicy(policy *temporal.RetryPolicy) *RecordAcknowledgementLocalActivityOptions {
+	o.retryPolicy = policy
+	return o
+}
+
+// WithScheduleToCloseTimeout sets the ScheduleToCloseTimeout value
+func (o *RecordAcknowledgementLocalActivityOptions) WithScheduleToCloseTimeout(d time.Duration) *RecordAcknowledgementLocalActivityOptions {
+	o.scheduleToCloseTimeout = &d
+	return o
+}
+
+// WithStartToCloseTimeout sets the StartToCloseTimeout value
+func (o *RecordAcknowledgementLocalActivityOptions) WithStartToCloseTimeout(d time.Duration) *RecordAcknowledgementLocalActivityOptions {
+	o.startToCloseTimeout = &d
+	return o
+}
+
+// RegisterRecordEscalationActivity registers a reminder.v1.Reminder.RecordEscalation activity
+func RegisterRecordEscalationActivity(r worker.ActivityRegistry, fn func(context.Context, *RecordEscalationRequest) error) {
+	r.RegisterActivityWithOptions(fn, activity.RegisterOptions{
+		Name: RecordEscalationActivityName,
+	})
+}
+
+// RecordEscalationFuture describes a(n) reminder.v1.Reminder.RecordEscalation activity execution
+type RecordEscalationFuture struct {
+	Future workflow.Future
+}
+
+// Get blocks on the activity's completion, returning the response
+func (f *RecordEscalationFuture) Get(ctx workflow.Context) error {
+	return f.Future.Get(ctx, nil)
+}
+
+// Select adds the activity's completion to the selector, callback can be nil
+func (f *RecordEscalationFuture) Select(sel workflow.Selector, fn func(*RecordEscalationFuture)) workflow.Selector {
+	return sel.AddFuture(f.Future, func(workflow.Future) {
+		if fn != nil {
+			fn(f)
+		}
+	})
+}
+
+// RecordEscalation activity — сохраняет шаг эскалации в БД
+func RecordEscalation(ctx workflow.Context, req *RecordEscalationRequest, options ...*RecordEscalationActivityOptions) error {
+	return RecordEscalationAsync(ctx, req, options...).Get(ctx)
+}
+
+// RecordEscalation activity — сохраняет шаг эскалации в БД
+func RecordEscalationAsync(ctx workflow.Context, req *RecordEscalationRequest, options ...*RecordEscalationActivityOptions) *RecordEscalationFuture {
+	var o *RecordEscalationActivityOptions
+	if len(options) > 0 && options[0] != nil {
+		o = options[0]
+	} else {
+		o = NewRecordEscalationActivityOptions()
+	}
+	var err error
+	if ctx, err = o.Build(ctx); err != nil {
+		errF, errS := workflow.NewFuture(ctx)
+		errS.SetError(err)
+		return &RecordEscalationFuture{Future: errF}
+	}
+	activity := RecordEscalationActivityName
+	if o.dc != nil {
+		ctx = workflow.WithDataConverter(ctx, o.dc)
+	}
+	future := &RecordEscalationFuture{Future: workflow.ExecuteActivity(ctx, activity, req)}
+	return future
+}
+
+// RecordEscalation activity — сохраняет шаг эскалации в БД
+func RecordEscalationLocal(ctx workflow.Context, req *RecordEscalationRequest, options ...*RecordEscalationLocalActivityOptions) error {
+	return RecordEscalationLocalAsync(ctx, req, options...).Get(ctx)
+}
+
+// RecordEscalation activity — сохраняет шаг эскалации в БД
+func RecordEscalationLocalAsync(ctx workflow.Context, req *RecordEscalationRequest, options ...*RecordEscalationLocalActivityOptions) *RecordEscalationFuture {
+	var o *RecordEscalationLocalActivityOptions
+	if len(options) > 0 && options[0] != nil {
+		o = options[0]
+	} else {
+		o = NewRecordEscalationLocalActivityOptions()
+	}
+	var err error
+	if ctx, err = o.Build(ctx); err != nil {
+		errF, errS := workflow.NewFuture(ctx)
+		errS.SetError(err)
+		return &RecordEscalationFuture{Future: errF}
+	}
+	var activity any
+	if o.fn != nil {
+		activity = o.fn
+	} else {
+		activity = RecordEscalationActivityName
+	}
+	if o.dc != nil {
+		ctx = workflow.WithDataConverter(ctx, o.dc)
+	}
+	future := &RecordEscalationFuture{Future: workflow.ExecuteLocalActivity(ctx, activity, req)}
+	return future
+}
+
+// RecordEscalationActivityOptions provides configuration for a(n) reminder.v1.Reminder.RecordEscalation activity
+type RecordEscalationActivityOptions struct {
+	options                workflow.ActivityOptions
+	retryPolicy            *temporal.RetryPolicy
+	scheduleToCloseTimeout *time.Duration
+	startToCloseTimeout    *time.Duration
+	dc                     converter.DataConverter
+	heartbeatTimeout       *time.Duration
+	scheduleToStartTimeout *time.Duration
+	taskQueue              *string
+	waitForCancellation    *bool
+}
+
+// NewRecordEscalationActivityOptions initializes a new RecordEscalationActivityOptions value
+func NewRecordEscalationActivityOptions() *RecordEscalationActivityOptions {
+	return &RecordEscalationActivityOptions{}
+}
+
+// Build initializes a workflow.Context with appropriate ActivityOptions values derived from schema defaults and any user-defined overrides
+func (o *RecordEscalationActivityOptions) Build(ctx workflow.Context) (workflow.Context, error) {
+	opts := o.options
+	if v := o.heartbeatTimeout; v != nil {
+		opts.HeartbeatTimeout = *v
+	}
+	if v := o.retryPolicy; v != nil {
+		opts.RetryPolicy = v
+	} else if opts.RetryPolicy == nil {
+		opts.RetryPolicy = &temporal.RetryPolicy{MaximumAttempts: int32(10)}
+	}
+	if v := o.scheduleToCloseTimeout; v != nil {
+		opts.ScheduleToCloseTimeout = *v
+	}
+	if v := o.scheduleToStartTimeout; v != nil {
+		opts.ScheduleToStartTimeout = *v
+	}
+	if v := o.startToCloseTimeout; v != nil {
+		opts.StartToCloseTimeout = *v
+	} else if opts.StartToCloseTimeout == 0 {
+		opts.StartToCloseTimeout = 10000000000 // 10 seconds
+	}
+	if v := o.taskQueue; v != nil {
+		opts.TaskQueue = *v
+	} else if opts.TaskQueue == "" {
+		opts.TaskQueue = ReminderTaskQueue
+	}
+	if v := o.waitForCancellation; v != nil {
+		opts.WaitForCancellation = *v
+	}
+	return workflow.WithActivityOptions(ctx, opts), nil
+}
+
+// WithActivityOptions specifies an initial ActivityOptions value to which defaults will be applied
+func (o *RecordEscalationActivityOptions) WithActivityOptions(options workflow.ActivityOptions) *RecordEscalationActivityOptions {
+	o.options = options
+	return o
+}
+
+// WithDataConverter registers a DataConverter for the (local) activity
+func (o *RecordEscalationActivityOptions) WithDataConverter(dc converter.DataConverter) *RecordEscalationActivityOptions {
+	o.dc = dc
+	return o
+}
+
+// WithHeartbeatTimeout sets the HeartbeatTimeout value
+func (o *RecordEscalationActivityOptions) WithHeartbeatTimeout(d time.Duration) *RecordEscalationActivityOptions {
+	o.heartbeatTimeout = &d
+	return o
+}
+
+// WithRetryPolicy sets the RetryPolicy value
+func (o *RecordEscalationActivityOptions) WithRetryPolicy(policy *temporal.RetryPolicy) *RecordEscalationActivityOptions {
+	o.retryPolicy = policy
+	return o
+}
+
+// WithScheduleToCloseTimeout sets the ScheduleToCloseTimeout value
+func (o *RecordEscalationActivityOptions) WithScheduleToCloseTimeout(d time.Duration) *RecordEscalationActivityOptions {
+	o.scheduleToCloseTimeout = &d
+	return o
+}
+
+// WithScheduleToStartTimeout sets the ScheduleToStartTimeout value
+func (o *RecordEscalationActivityOptions) WithScheduleToStartTimeout(d time.Duration) *RecordEscalationActivityOptions {
+	o.scheduleToStartTimeout = &d
+	return o
+}
+
+// WithStartToCloseTimeout sets the StartToCloseTimeout value
+func (o *RecordEscalationActivityOptions) WithStartToCloseTimeout(d time.Duration) *RecordEscalationActivityOptions {
+	o.startToCloseTimeout = &d
+	return o
+}
+
+// WithTaskQueue sets the TaskQueue value
+func (o *RecordEscalationActivityOptions) WithTaskQueue(tq string) *RecordEscalationActivityOptions {
+	o.taskQueue = &tq
+	return o
+}
+
+// WithWaitForCancellation sets the WaitForCancellation value
+func (o *RecordEscalationActivityOptions) WithWaitForCancellation(wait bool) *RecordEscalationActivityOptions {
+	o.waitForCancellation = &wait
+	return o
+}
+
+// RecordEscalationLocalActivityOptions provides configuration for a(n) reminder.v1.Reminder.RecordEscalation activity
+type RecordEscalationLocalActivityOptions struct {
+	options                workflow.LocalActivityOptions
+	retryPolicy            *temporal.RetryPolicy
+	scheduleToCloseTimeout *time.Duration
+	startToCloseTimeout    *time.Duration
+	dc                     converter.DataConverter
+	fn                     func(context.Context, *RecordEscalationRequest) error
+}
+
+// NewRecordEscalationLocalActivityOptions initializes a new RecordEscalationLocalActivityOptions value
+func NewRecordEscalationLocalActivityOptions() *RecordEscalationLocalActivityOptions {
+	return &RecordEscalationLocalActivityOptions{}
+}
+
+// Build initializes a workflow.Context with appropriate LocalActivityOptions values derived from schema defaults and any user-defined overrides
+func (o *RecordEscalationLocalActivityOptions) Build(ctx workflow.Context) (workflow.Context, error) {
+	opts := o.options
+	if v := o.retryPolicy; v != nil {
+		opts.RetryPolicy = v
+	} else if opts.RetryPolicy == nil {
+		opts.RetryPolicy = &temporal.RetryPolicy{MaximumAttempts: int32(10)}
+	}
+	if v := o.scheduleToCloseTimeout; v != nil {
+		opts.ScheduleToCloseTimeout = *v
+	}
+	if v := o.startToCloseTimeout; v != nil {
+		opts.StartToCloseTimeout = *v
+	} else if opts.StartToCloseTimeout == 0 {
+		opts.StartToCloseTimeout = 10000000000 // 10 seconds
+	}
+	return workflow.WithLocalActivityOptions(ctx, opts), nil
+}
+
+// Local specifies a custom reminder.v1.Reminder.RecordEscalation implementation
+func (o *RecordEscalationLocalActivityOptions) Local(fn func(context.Context, *RecordEscalationRequest) error) *RecordEscalationLocalActivityOptions {
+	o.fn = fn
+	return o
+}
+
+// WithLocalActivityOptions specifies an initial LocalActivityOptions value to which defaults will be applied
+func (o *RecordEscalationLocalActivityOptions) WithLocalActivityOptions(options workflow.LocalActivityOptions) *RecordEscalationLocalActivityOptions {
+	o.options = options
+	return o
+}
+
+// WithDataConverter registers a DataConverter for the (local) activity
+func (o *RecordEscalationLocalActivityOptions) WithDataConverter(dc converter.DataConverter) *RecordEscalationLocalActivityOptions {
+	o.dc = dc
+	return o
+}
+
+// WithRetryPolicy sets the RetryPolicy value
+func (o *RecordEscalationLocalActivityOptions) WithRetryPolicy(policy *temporal.RetryPolicy) *RecordEscalationLocalActivityOptions {
+	o.retryPolicy = policy
+	return o
+}
+
+// WithScheduleToCloseTimeout sets the ScheduleToCloseTimeout value
+func (o *RecordEscalationLocalActivityOptions) WithScheduleToCloseTimeout(d time.Duration) *RecordEscalationLocalActivityOptions {
+	o.scheduleToCloseTimeout = &d
+	return o
+}
+
+// WithStartToCloseTimeout sets the StartToCloseTimeout value
+func (o *RecordEscalationLocalActivityOptions) WithStartToCloseTimeout(d time.Duration) *RecordEscalationLocalActivityOptions {
+	o.startToCloseTimeout = &d
+	return o
+}
+
+// RegisterSaveReminderDetailsActivity registers a reminder.v1.Reminder.SaveReminderDetails activity
+func RegisterSaveReminderDetailsActivity(r worker.ActivityRegistry, fn func(context.Context, *SaveReminderDetailsRequest) error) {
+	r.RegisterActivityWithOptions(fn, activity.RegisterOptions{
+		Name: SaveReminderDetailsActivityName,
+	})
+}
+
+// SaveReminderDetailsFuture describes a(n) reminder.v1.Reminder.SaveReminderDetails activity execution
+type SaveReminderDetailsFuture struct {
+	Future workflow.Future
+}
+
+// Get blocks on the activity's completion, returning the response
+func (f *SaveReminderDetailsFuture) Get(ctx workflow.Context) error {
+	return f.Future.Get(ctx, nil)
+}
+
+// Select adds the activity's completion to the selector, callback can be nil
+func (f *SaveReminderDetailsFuture) Select(sel workflow.Selector, fn func(*SaveReminderDetailsFuture)) workflow.Selector {
+	return sel.AddFuture(f.Future, func(workflow.Future) {
+		if fn != nil {
+			fn(f)
+		}
+	})
+}
+
+// SaveReminderDetails activity — сохраняет изменённые поля напоминания в БД
+func SaveReminderDetails(ctx workflow.Context, req *SaveReminderDetailsRequest, options ...*SaveReminderDetailsActivityOptions) error {
+	return SaveReminderDetailsAsync(ctx, req, options...).Get(ctx)
+}
+
+// SaveReminderDetails activity — сохраняет изменённые поля напоминания в БД
+func SaveReminderDetailsAsync(ctx workflow.Context, req *SaveReminderDetailsRequest, options ...*SaveReminderDetailsActivityOptions) *SaveReminderDetailsFuture {
+	var o *SaveReminderDetailsActivityOptions
+	if len(options) > 0 && options[0] != nil {
+		o = options[0]
+	} else {
+		o = NewSaveReminderDetailsActivityOptions()
+	}
+	var err error
+	if ctx, err = o.Build(ctx); err != nil {
+		errF, errS := workflow.NewFuture(ctx)
+		errS.SetError(err)
+		return &SaveReminderDetailsFuture{Future: errF}
+	}
+	activity := SaveReminderDetailsActivityName
+	if o.dc != nil {
+		ctx = workflow.WithDataConverter(ctx, o.dc)
+	}
+	future := &SaveReminderDetailsFuture{Future: workflow.ExecuteActivity(ctx, activity, req)}
+	return future
+}
+
+// SaveReminderDetails activity — сохраняет изменённые поля напоминания в БД
+func SaveReminderDetailsLocal(ctx workflow.Context, req *SaveReminderDetailsRequest, options ...*SaveReminderDetailsLocalActivityOptions) error {
+	return SaveReminderDetailsLocalAsync(ctx, req, options...).Get(ctx)
+}
+
+// SaveReminderDetails activity — сохраняет изменённые поля напоминания в БД
+func SaveReminderDetailsLocalAsync(ctx workflow.Context, req *SaveReminderDetailsRequest, options ...*SaveReminderDetailsLocalActivityOptions) *SaveReminderDetailsFuture {
+	var o *SaveReminderDetailsLocalActivityOptions
+	if len(options) > 0 && options[0] != nil {
+		o = options[0]
+	} else {
+		o = NewSaveReminderDetailsLocalActivityOptions()
+	}
+	var err error
+	if ctx, err = o.Build(ctx); err != nil {
+		errF, errS := workflow.NewFuture(ctx)
+		errS.SetError(err)
+		return &SaveReminderDetailsFuture{Future: errF}
+	}
+	var activity any
+	if o.fn != nil {
+		activity = o.fn
+	} else {
+		activity = SaveReminderDetailsActivityName
+	}
+	if o.dc != nil {
+		ctx = workflow.WithDataConverter(ctx, o.dc)
+	}
+	future := &SaveReminderDetailsFuture{Future: workflow.ExecuteLocalActivity(ctx, activity, req)}
+	return future
+}
+
+// SaveReminderDetailsActivityOptions provides configuration for a(n) reminder.v1.Reminder.SaveReminderDetails activity
+type SaveReminderDetailsActivityOptions struct {
+	options                workflow.ActivityOptions
+	retryPolicy            *temporal.RetryPolicy
+	scheduleToCloseTimeout *time.Duration
+	startToCloseTimeout    *time.Duration
+	dc                     converter.DataConverter
+	heartbeatTimeout       *time.Duration
+	scheduleToStartTimeout *time.Duration
+	taskQueue              *string
+	waitForCancellation    *bool
+}
+
+// NewSaveReminderDetailsActivityOptions initializes a new SaveReminderDetailsActivityOptions value
+func NewSaveReminderDetailsActivityOptions() *SaveReminderDetailsActivityOptions {
+	return &SaveReminderDetailsActivityOptions{}
+}
+
+// Build initializes a workflow.Context with appropriate ActivityOptions values derived from schema defaults and any user-defined overrides
+func (o *SaveReminderDetailsActivityOptions) Build(ctx workflow.Context) (workflow.Context, error) {
+	opts := o.options
+	if v := o.heartbeatTimeout; v != nil {
+		opts.HeartbeatTimeout = *v
+	}
+	if v := o.retryPolicy; v != nil {
+		opts.RetryPolicy = v
+	} else if opts.RetryPolicy == nil {
+		opts.RetryPolicy = &temporal.RetryPolicy{MaximumAttempts: int32(10)}
+	}
+	if v := o.scheduleToCloseTimeout; v != nil {
+		opts.ScheduleToCloseTimeout = *v
+	}
+	if v := o.scheduleToStartTimeout; v != nil {
+		opts.ScheduleToStartTimeout = *v
+	}
+	if v := o.startToCloseTimeout; v != nil {
+		opts.StartToCloseTimeout = *v
+	} else if opts.StartToCloseTimeout == 0 {
+		opts.StartToCloseTimeout = 10000000000 // 10 seconds
+	}
+	if v := o.taskQueue; v != nil {
+		opts.TaskQueue = *v
+	} else if opts.TaskQueue == "" {
+		opts.TaskQueue = ReminderTaskQueue
+	}
+	if v := o.waitForCancellation; v != nil {
+		opts.WaitForCancellation = *v
+	}
+	return workflow.WithActivityOptions(ctx, opts), nil
+}
+
+// WithActivityOptions specifies an initial ActivityOptions value to which defaults will be applied
+func (o *SaveReminderDetailsActivityOptions) WithActivityOptions(options workflow.ActivityOptions) *SaveReminderDetailsActivityOptions {
+	o.options = options
+	return o
+}
+
+// WithDataConverter registers a DataConverter for the (local) activity
+func (o *SaveReminderDetailsActivityOptions) WithDataConverter(dc converter.DataConverter) *SaveReminderDetailsActivityOptions {
+	o.dc = dc
+	return o
+}
+
+// WithHeartbeatTimeout sets the HeartbeatTimeout value
+func (o *SaveReminderDetailsActivityOptions) WithHeartbeatTimeout(d time.Duration) *SaveReminderDetailsActivityOptions {
+	o.heartbeatTimeout = &d
+	return o
+}
+
+// WithRetryPolicy sets the RetryPolicy value
+func (o *SaveReminderDetailsActivityOptions) WithRetryPolicy(policy *temporal.RetryPolicy) *SaveReminderDetailsActivityOptions {
+	o.retryPolicy = policy
+	return o
+}
+
+// WithScheduleToCloseTimeout sets the ScheduleToCloseTimeout value
+func (o *SaveReminderDetailsActivityOptions) WithScheduleToCloseTimeout(d time.Duration) *SaveReminderDetailsActivityOptions {
+	o.scheduleToCloseTimeout = &d
+	return o
+}
+
+// WithScheduleToStartTimeout sets the ScheduleToStartTimeout value
+func (o *SaveReminderDetailsActivityOptions) WithScheduleToStartTimeout(d time.Duration) *SaveReminderDetailsActivityOptions {
+	o.scheduleToStartTimeout = &d
+	return o
+}
+
+// WithStartToCloseTimeout sets the StartToCloseTimeout value
+func (o *SaveReminderDetailsActivityOptions) WithStartToCloseTimeout(d time.Duration) *SaveReminderDetailsActivityOptions {
+	o.startToCloseTimeout = &d
+	return o
+}
+
+// WithTaskQueue sets the TaskQueue value
+func (o *SaveReminderDetailsActivityOptions) WithTaskQueue(tq string) *SaveReminderDetailsActivityOptions {
+	o.taskQueue = &tq
+	return o
+}
+
+// WithWaitForCancellation sets the WaitForCancellation value
+func (o *SaveReminderDetailsActivityOptions) WithWaitForCancellation(wait bool) *SaveReminderDetailsActivityOptions {
+	o.waitForCancellation = &wait
+	return o
+}
+
+// SaveReminderDetailsLocalActivityOptions provides configuration for a(n) reminder.v1.Reminder.SaveReminderDetails activity
+type SaveReminderDetailsLocalActivityOptions struct {
+	options                workflow.LocalActivityOptions
+	retryPolicy            *temporal.RetryPolicy
+	scheduleToCloseTimeout *time.Duration
+	startToCloseTimeout    *time.Duration
+	dc                     converter.DataConverter
+	fn                     func(context.Context, *SaveReminderDetailsRequest) error
+}
+
+// NewSaveReminderDetailsLocalActivityOptions initializes a new SaveReminderDetailsLocalActivityOptions value
+func NewSaveReminderDetailsLocalActivityOptions() *SaveReminderDetailsLocalActivityOptions {
+	return &SaveReminderDetailsLocalActivityOptions{}
+}
+
+// Build initializes a workflow.Context with appropriate LocalActivityOptions values derived from schema defaults and any user-defined overrides
+func (o *SaveReminderDetailsLocalActivityOptions) Build(ctx workflow.Context) (workflow.Context, error) {
+	opts := o.options
+	if v := o.retryPolicy; v != nil {
+		opts.RetryPolicy = v
+	} else if opts.RetryPolicy == nil {
+		opts.RetryPolicy = &temporal.RetryPolicy{MaximumAttempts: int32(10)}
+	}
+	if v := o.scheduleToCloseTimeout; v != nil {
+		opts.ScheduleToCloseTimeout = *v
+	}
+	if v := o.startToCloseTimeout; v != nil {
+		opts.StartToCloseTimeout = *v
+	} else if opts.StartToCloseTimeout == 0 {
+		opts.StartToCloseTimeout = 10000000000 // 10 seconds
+	}
+	return workflow.WithLocalActivityOptions(ctx, opts), nil
+}
+
+// Local specifies a custom reminder.v1.Reminder.SaveReminderDetails implementation
+func (o *SaveReminderDetailsLocalActivityOptions) Local(fn func(context.Context, *SaveReminderDetailsRequest) error) *SaveReminderDetailsLocalActivityOptions {
+	o.fn = fn
+	return o
+}
+
+// WithLocalActivityOptions specifies an initial LocalActivityOptions value to which defaults will be applied
+func (o *SaveReminderDetailsLocalActivityOptions) WithLocalActivityOptions(options workflow.LocalActivityOptions) *SaveReminderDetailsLocalActivityOptions {
+	o.options = options
+	return o
+}
+
+// WithDataConverter registers a DataConverter for the (local) activity
+func (o *SaveReminderDetailsLocalActivityOptions) WithDataConverter(dc converter.DataConverter) *SaveReminderDetailsLocalActivityOptions {
+	o.dc = dc
+	return o
+}
+
+// WithRetryPolicy sets the RetryPolicy value
+func (o *SaveReminderDetailsLocalActivityOptions) WithRetryPolicy(policy *temporal.RetryPolicy) *SaveReminderDetailsLocalActivityOptions {
+	o.retryPolicy = policy
+	return o
+}
+
+// WithScheduleToCloseTimeout sets the ScheduleToCloseTimeout value
+func (o *SaveReminderDetailsLocalActivityOptions) WithScheduleToCloseTimeout(d time.Duration) *SaveReminderDetailsLocalActivityOptions {
+	o.scheduleToCloseTimeout = &d
+	return o
+}
+
+// WithStartToCloseTimeout sets the StartToCloseTimeout value
+func (o *SaveReminderDetailsLocalActivityOptions) WithStartToCloseTimeout(d time.Duration) *SaveReminderDetailsLocalActivityOptions {
+	o.startToCloseTimeout = &d
+	return o
+}
+
+// RegisterSendEmailNotificationActivity registers a reminder.v1.Reminder.SendEmailNotification activity
+func RegisterSendEmailNotificationActivity(r worker.ActivityRegistry, fn func(context.Context, *ChannelNotificationRequest) error) {
+	r.RegisterActivityWithOptions(fn, activity.RegisterOptions{
+		Name: SendEmailNotificationActivityName,
+	})
+}
+
+// SendEmailNotificationFuture describes a(n) reminder.v1.Reminder.SendEmailNotification activity execution
+type SendEmailNotificationFuture struct {
+	Future workflow.Future
+}
+
+// Get blocks on the activity's completion, returning the response
+func (f *SendEmailNotificationFuture) Get(ctx workflow.Context) error {
+	return f.Future.Get(ctx, nil)
+}
+
+// Select adds the activity's completion to the selector, callback can be nil
+func (f *SendEmailNotificationFuture) Select(sel workflow.Selector, fn func(*SendEmailNotificationFuture)) workflow.Selector {
+	return sel.AddFuture(f.Future, func(workflow.Future) {
+		if fn != nil {
+			fn(f)
+		}
+	})
+}
+
+// SendEmailNotification activity — отправляет письмо через SMTP
+func SendEmailNotification(ctx workflow.Context, req *ChannelNotificationRequest, options ...*SendEmailNotificationActivityOptions) error {
+	return SendEmailNotificationAsync(ctx, req, options...).Get(ctx)
+}
+
+// SendEmailNotification activity — отправляет письмо через SMTP
+func SendEmailNotificationAsync(ctx workflow.Context, req *ChannelNotificationRequest, options ...*SendEmailNotificationActivityOptions) *SendEmailNotificationFuture {
+	var o *SendEmailNotificationActivityOptions
+	if len(options) > 0 && options[0] != nil {
+		o = options[0]
+	} else {
+		o = NewSendEmailNotificationActivityOptions()
+	}
+	var err error
+	if ctx, err = o.Build(ctx); err != nil {
+		errF, errS := workflow.NewFuture(ctx)
+		errS.SetError(err)
+		return &SendEmailNotificationFuture{Future: errF}
+	}
+	activity := SendEmailNotificationActivityName
+	if o.dc != nil {
+		ctx = workflow.WithDataConverter(ctx, o.dc)
+	}
+	future := &SendEmailNotificationFuture{Future: workflow.ExecuteActivity(ctx, activity, req)}
+	return future
+}
+
+// SendEmailNotification activity — отправляет письмо через SMTP
+func SendEmailNotificationLocal(ctx workflow.Context, req *ChannelNotificationRequest, options ...*SendEmailNotificationLocalActivityOptions) error {
+	return SendEmailNotificationLocalAsync(ctx, req, options...).Get(ctx)
+}
+
+// SendEmailNotification activity — отправляет письмо через SMTP
+func SendEmailNotificationLocalAsync(ctx workflow.Context, req *ChannelNotificationRequest, options ...*SendEmailNotificationLocalActivityOptions) *SendEmailNotificationFuture {
+	var o *SendEmailNotificationLocalActivityOptions
+	if len(options) > 0 && options[0] != nil {
+		o = options[0]
+	} else {
+		o = NewSendEmailNotificationLocalActivityOptions()
+	}
+	var err error
+	if ctx, err = o.Build(ctx); err != nil {
+		errF, errS := workflow.NewFuture(ctx)
+		errS.SetError(err)
+		return &SendEmailNotificationFuture{Future: errF}
+	}
+	var activity any
+	if o.fn != nil {
+		activity = o.fn
+	} else {
+		activity = SendEmailNotificationActivityName
+	}
+	if o.dc != nil {
+		ctx = workflow.WithDataConverter(ctx, o.dc)
+	}
+	future := &SendEmailNotificationFuture{Future: workflow.ExecuteLocalActivity(ctx, activity, req)}
+	return future
+}
+
+// SendEmailNotificationActivityOptions provides configuration for a(n) reminder.v1.Reminder.SendEmailNotification activity
+type SendEmailNotificationActivityOptions struct {
+	options                workflow.ActivityOptions
+	retryPolicy            *temporal.RetryPolicy
+	scheduleToCloseTimeout *time.Duration
+	startToCloseTimeout    *time.Duration
+	dc                     converter.DataConverter
+	heartbeatTimeout       *time.Duration
+	scheduleToStartTimeout *time.Duration
+	taskQueue              *string
+	waitForCancellation    *bool
+}
+
+// NewSendEmailNotificationActivityOptions initializes a new SendEmailNotificationActivityOptions value
+func NewSendEmailNotificationActivityOptions() *SendEmailNotificationActivityOptions {
+	return &SendEmailNotificationActivityOptions{}
+}
+
+// Build initializes a workflow.Context with appropriate ActivityOptions values derived from schema defaults and any user-defined overrides
+func (o *SendEmailNotificationActivityOptions) Build(ctx workflow.Context) (workflow.Context, error) {
+	opts := o.options
+	if v := o.heartbeatTimeout; v != nil {
+		opts.HeartbeatTimeout = *v
+	}
+	if v := o.retryPolicy; v != nil {
+		opts.RetryPolicy = v
+	} else if opts.RetryPolicy == nil {
+		opts.RetryPolicy = &temporal.RetryPolicy{
+			InitialInterval:    10000000000, // 10 seconds
+			BackoffCoefficient: 2,
+			MaximumAttempts:    int32(4),
+		}
+	}
+	if v := o.scheduleToCloseTimeout; v != nil {
+		opts.ScheduleToCloseTimeout = *v
+	}
+	if v := o.scheduleToStartTimeout; v != nil {
+		opts.ScheduleToStartTimeout = *v
+	}
+	if v := o.startToCloseTimeout; v != nil {
+		opts.StartToCloseTimeout = *v
+	} else if opts.StartToCloseTimeout == 0 {
+		opts.StartToCloseTimeout = 60000000000 // 1 minute
+	}
+	if v := o.taskQueue; v != nil {
+		opts.TaskQueue = *v
+	} else if opts.TaskQueue == "" {
+		opts.TaskQueue = ReminderTaskQueue
+	}
+	if v := o.waitForCancellation; v != nil {
+		opts.WaitForCancellation = *v
+	}
+	return workflow.WithActivityOptions(ctx, opts), nil
+}
+
+// WithActivityOptions specifies an initial ActivityOptions value to which defaults will be applied
+func (o *SendEmailNotificationActivityOptions) WithActivityOptions(options workflow.ActivityOptions) *SendEmailNotificationActivityOptions {
+	o.options = options
+	return o
+}
+
+// WithDataConverter registers a DataConverter for the (local) activity
+func (o *SendEmailNotificationActivityOptions) WithDataConverter(dc converter.DataConverter) *SendEmailNotificationActivityOptions {
+	o.dc = dc
+	return o
+}
+
+// WithHeartbeatTimeout sets the HeartbeatTimeout value
+func (o *SendEmailNotificationActivityOptions) WithHeartbeatTimeout(d time.Duration) *SendEmailNotificationActivityOptions {
+	o.heartbeatTimeout = &d
+	return o
+}
+
+// WithRetryPolicy sets the RetryPolicy value
+func (o *SendEmailNotificationActivityOptions) WithRetryPolicy(policy *temporal.RetryPolicy) *SendEmailNotificationActivityOptions {
+	o.retryPolicy = policy
+	return o
+}
+
+// WithScheduleToCloseTimeout sets the ScheduleToCloseTimeout value
+func (o *SendEmailNotificationActivityOptions) WithScheduleToCloseTimeout(d time.Duration) *SendEmailNotificationActivityOptions {
+	o.scheduleToCloseTimeout = &d
+	return o
+}
+
+// WithScheduleToStartTimeout sets the ScheduleToStartTimeout value
+func (o *SendEmailNotificationActivityOptions) WithScheduleToStartTimeout(d time.Duration) *SendEmailNotificationActivityOptions {
+	o.scheduleToStartTimeout = &d
+	return o
+}
+
+// WithStartToCloseTimeout sets the StartToCloseTimeout value
+func (o *SendEmailNotificationActivityOptions) WithStartToCloseTimeout(d time.Duration) *SendEmailNotificationActivityOptions {
+	o.startToCloseTimeout = &d
+	return o
+}
+
+// WithTaskQueue sets the TaskQueue value
+func (o *SendEmailNotificationActivityOptions) WithTaskQueue(tq string) *SendEmailNotificationActivityOptions {
+	o.taskQueue = &tq
+	return o
+}
+
+// WithWaitForCancellation sets the WaitForCancellation value
+func (o *SendEmailNotificationActivityOptions) WithWaitForCancellation(wait bool) *SendEmailNotificationActivityOptions {
+	o.waitForCancellation = &wait
+	return o
+}
+
+// SendEmailNotificationLocalActivityOptions provides configuration for a(n) reminder.v1.Reminder.SendEmailNotification activity
+type SendEmailNotificationLocalActivityOptions struct {
+	options                workflow.LocalActivityOptions
+	retryPolicy            *temporal.RetryPolicy
+	scheduleToCloseTimeout *time.Duration
+	startToCloseTimeout    *time.Duration
+	dc                     converter.DataConverter
+	fn                     func(context.Context, *ChannelNotificationRequest) error
+}
+
+// NewSendEmailNotificationLocalActivityOptions initializes a new SendEmailNotificationLocalActivityOptions value
+func NewSendEmailNotificationLocalActivityOptions() *SendEmailNotificationLocalActivityOptions {
+	return &SendEmailNotificationLocalActivityOptions{}
+}
+
+// Build initializes a workflow.Context with appropriate LocalActivityOptions values derived from schema defaults and any user-defined overrides
+func (o *SendEmailNotificationLocalActivityOptions) Build(ctx workflow.Context) (workflow.Context, error) {
+	opts := o.options
+	if v := o.retryPolicy; v != nil {
+		opts.RetryPolicy = v
+	} else if opts.RetryPolicy == nil {
+		opts.RetryPolicy = &temporal.RetryPolicy{
+			InitialInterval:    10000000000, // 10 seconds
+			BackoffCoefficient: 2,
+			MaximumAttempts:    int32(4),
+		}
+	}
+	if v := o.scheduleToCloseTimeout; v != nil {
+		opts.ScheduleToCloseTimeout = *v
+	}
+	if v := o.startToCloseTimeout; v != nil {
+		opts.StartToCloseTimeout = *v
+	} else if opts.StartToCloseTimeout == 0 {
+		opts.StartToCloseTimeout = 60000000000 // 1 minute
+	}
+	return workflow.WithLocalActivityOptions(ctx, opts), nil
+}
+
+// Local specifies a custom reminder.v1.Reminder.SendEmailNotification implementation
+func (o *SendEmailNotificationLocalActivityOptions) Local(fn func(context.Context, *ChannelNotificationRequest) error) *SendEmailNotificationLocalActivityOptions {
+	o.fn = fn
+	return o
+}
+
+// WithLocalActivityOptions specifies an initial LocalActivityOptions value to which defaults will be applied
+func (o *SendEmailNotificationLocalActivityOptions) WithLocalActivityOptions(options workflow.LocalActivityOptions) *SendEmailNotificationLocalActivityOptions {
+	o.options = options
+	return o
+}
+
+// WithDataConverter registers a DataConverter for the (local) activity
+func (o *SendEmailNotificationLocalActivityOptions) WithDataConverter(dc converter.DataConverter) *SendEmailNotificationLocalActivityOptions {
+	o.dc = dc
+	return o
+}
+
+// WithRetryPolicy sets the RetryPolicy value
+func (o *SendEmailNotificationLocalActivityOptions) WithRetryPolicy(policy *temporal.RetryPolicy) *SendEmailNotificationLocalActivityOptions {
+	o.retryPolicy = policy
+	return o
+}
+
+// WithScheduleToCloseTimeout sets the ScheduleToCloseTimeout value
+func (o *SendEmailNotificationLocalActivityOptions) WithScheduleToCloseTimeout(d time.Duration) *SendEmailNotificationLocalActivityOptions {
+	o.scheduleToCloseTimeout = &d
+	return o
+}
+
+// WithStartToCloseTimeout sets the StartToCloseTimeout value
+func (o *SendEmailNotificationLocalActivityOptions) WithStartToCloseTimeout(d time.Duration) *SendEmailNotificationLocalActivityOptions {
+	o.startToCloseTimeout = &d
+	return o
+}
+
+// RegisterSendEscalationActivity registers a reminder.v1.Reminder.SendEscalation activity
+func RegisterSendEscalationActivity(r worker.ActivityRegistry, fn func(context.Context, *SendEscalationRequest) error) {
+	r.RegisterActivityWithOptions(fn, activity.RegisterOptions{
+		Name: SendEscalationActivityName,
+	})
+}
+
+// SendEscalationFuture describes a(n) reminder.v1.Reminder.SendEscalation activity execution
+type SendEscalationFuture struct {
+	Future workflow.Future
+}
+
+// Get blocks on the activity's completion, returning the response
+func (f *SendEscalationFuture) Get(ctx workflow.Context) error {
+	return f.Future.Get(ctx, nil)
+}
+
+// Select adds the activity's completion to the selector, callback can be nil
+func (f *SendEscalationFuture) Select(sel workflow.Selector, fn func(*SendEscalationFuture)) workflow.Selector {
+	return sel.AddFuture(f.Future, func(workflow.Future) {
+		if fn != nil {
+			fn(f)
+		}
+	})
+}
+
+// SendEscalation activity — уведомляет контакт из цепочки эскалации
+func SendEscalation(ctx workflow.Context, req *SendEscalationRequest, options ...*SendEscalationActivityOptions) error {
+	return SendEscalationAsync(ctx, req, options...).Get(ctx)
+}
+
+// SendEscalation activity — уведомляет контакт из цепочки эскалации
+func SendEscalationAsync(ctx workflow.Context, req *SendEscalationRequest, options ...*SendEscalationActivityOptions) *SendEscalationFuture {
+	var o *SendEscalationActivityOptions
+	if len(options) > 0 && options[0] != nil {
+		o = options[0]
+	} else {
+		o = NewSendEscalationActivityOptions()
+	}
+	var err error
+	if ctx, err = o.Build(ctx); err != nil {
+		errF, errS := workflow.NewFuture(ctx)
+		errS.SetError(err)
+		return &SendEscalationFuture{Future: errF}
+	}
+	activity := SendEscalationActivityName
+	if o.dc != nil {
+		ctx = workflow.WithDataConverter(ctx, o.dc)
+	}
+	future := &SendEscalationFuture{Future: workflow.ExecuteActivity(ctx, activity, req)}
+	return future
+}
+
+// SendEscalation activity — уведомляет контакт из цепочки эскалации
+func SendEscalationLocal(ctx workflow.Context, req *SendEscalationRequest, options ...*SendEscalationLocalActivityOptions) error {
+	return SendEscalationLocalAsync(ctx, req, options...).Get(ctx)
+}
+
+// SendEscalation activity — уведомляет контакт из цепочки эскалации
+func SendEscalationLocalAsync(ctx workflow.Context, req *SendEscalationRequest, options ...*SendEscalationLocalActivityOptions) *SendEscalationFuture {
+	var o *SendEscalationLocalActivityOptions
+	if len(options) > 0 && options[0] != nil {
+		o = options[0]
+	} else {
+		o = NewSendEscalationLocalActivityOptions()
+	}
+	var err error
+	if ctx, err = o.Build(ctx); err != nil {
+		errF, errS := workflow.NewFuture(ctx)
+		errS.SetError(err)
+		return &SendEscalationFuture{Future: errF}
+	}
+	var activity any
+	if o.fn != nil {
+		activity = o.fn
+	} else {
+		activity = SendEscalationActivityName
+	}
+	if o.dc != nil {
+		ctx = workflow.WithDataConverter(ctx, o.dc)
+	}
+	future := &SendEscalationFuture{Future: workflow.ExecuteLocalActivity(ctx, activity, req)}
+	return future
+}
+
+// SendEscalationActivityOptions provides configuration for a(n) reminder.v1.Reminder.SendEscalation activity
+type SendEscalationActivityOptions struct {
+	options                workflow.ActivityOptions
+	retryPolicy            *temporal.RetryPolicy
+	scheduleToCloseTimeout *time.Duration
+	startToCloseTimeout    *time.Duration
+	dc                     converter.DataConverter
+	heartbeatTimeout       *time.Duration
+	scheduleToStartTimeout *time.Duration
+	taskQueue              *string
+	waitForCancellation    *bool
+}
+
+// NewSendEscalationActivityOptions initializes a new SendEscalationActivityOptions value
+func NewSendEscalationActivityOptions() *SendEscalationActivityOptions {
+	return &SendEscalationActivityOptions{}
+}
+
+// Build initializes a workflow.Context with appropriate ActivityOptions values derived from schema defaults and any user-defined overrides
+func (o *SendEscalationActivityOptions) Build(ctx workflow.Context) (workflow.Context, error) {
+	opts := o.options
+	if v := o.heartbeatTimeout; v != nil {
+		opts.HeartbeatTimeout = *v
+	}
+	if v := o.retryPolicy; v != nil {
+		opts.RetryPolicy = v
+	} else if opts.RetryPolicy == nil {
+		opts.RetryPolicy = &temporal.RetryPolicy{
+			InitialInterval:    10000000000, // 10 seconds
+			BackoffCoefficient: 2,
+			MaximumAttempts:    int32(5),
+		}
+	}
+	if v := o.scheduleToCloseTimeout; v != nil {
+		opts.ScheduleToCloseTimeout = *v
+	}
+	if v := o.scheduleToStartTimeout; v != nil {
+		opts.ScheduleToStartTimeout = *v
+	}
+	if v := o.startToCloseTimeout; v != nil {
+		opts.StartToCloseTimeout = *v
+	} else if opts.StartToCloseTimeout == 0 {
+		opts.StartToCloseTimeout = 30000000000 // 30 seconds
+	}
+	if v := o.taskQueue; v != nil {
+		opts.TaskQueue = *v
+	} else if opts.TaskQueue == "" {
+		opts.TaskQueue = ReminderTaskQueue
+	}
+	if v := o.waitForCancellation; v != nil {
+		opts.WaitForCancellation = *v
+	}
+	return workflow.WithActivityOptions(ctx, opts), nil
+}
+
+// WithActivityOptions specifies an initial ActivityOptions value to which defaults will be applied
+func (o *SendEscalationActivityOptions) WithActivityOptions(options workflow.ActivityOptions) *SendEscalationActivityOptions {
+	o.options = options
+	return o
+}
+
+// WithDataConverter registers a DataConverter for the (local) activity
+func (o *SendEscalationActivityOptions) WithDataConverter(dc converter.DataConverter) *SendEscalationActivityOptions {
+	o.dc = dc
+	return o
+}
+
+// WithHeartbeatTimeout sets the HeartbeatTimeout value
+func (o *SendEscalationActivityOptions) WithHeartbeatTimeout(d time.Duration) *SendEscalationActivityOptions {
+	o.heartbeatTimeout = &d
+	return o
+}
+
+// WithRetryPolicy sets the RetryPolicy value
+func (o *SendEscalationActivityOptions) WithRetryPolicy(policy *temporal.RetryPolicy) *SendEscalationActivityOptions {
+	o.retryPolicy = policy
+	return o
+}
+
+// WithScheduleToCloseTimeout sets the ScheduleToCloseTimeout value
+func (o *SendEscalationActivityOptions) WithScheduleToCloseTimeout(d time.Duration) *SendEscalationActivityOptions {
+	o.scheduleToCloseTimeout = &d
+	return o
+}
+
+// WithScheduleToStartTimeout sets the ScheduleToStartTimeout value
+func (o *SendEscalationActivityOptions) WithScheduleToStartTimeout(d time.Duration) *SendEscalationActivityOptions {
+	o.scheduleToStartTimeout = &d
+	return o
+}
+
+// WithStartToCloseTimeout sets the StartToCloseTimeout value
+func (o *SendEscalationActivityOptions) WithStartToCloseTimeout(d time.Duration) *SendEscalationActivityOptions {
+	o.startToCloseTimeout = &d
+	return o
+}
+
+// WithTaskQueue sets the TaskQueue value
+func (o *SendEscalationActivityOptions) WithTaskQueue(tq string) *SendEscalationActivityOptions {
+	o.taskQueue = &tq
+	return o
+}
+
+// WithWaitForCancellation sets the WaitForCancellation value
+func (o *SendEscalationActivityOptions) WithWaitForCancellation(wait bool) *SendEscalationActivityOptions {
+	o.waitForCancellation = &wait
+	return o
+}
+
+// SendEscalationLocalActivityOptions provides configuration for a(n) reminder.v1.Reminder.SendEscalation activity
+type SendEscalationLocalActivityOptions struct {
+	options                workflow.LocalActivityOptions
+	retryPolicy            *temporal.RetryPolicy
+	scheduleToCloseTimeout *time.Duration
+	startToCloseTimeout    *time.Duration
+	dc                     converter.DataConverter
+	fn                     func(context.Context, *SendEscalationRequest) error
+}
+
+// NewSendEscalationLocalActivityOptions initializes a new SendEscalationLocalActivityOptions value
+func NewSendEscalationLocalActivityOptions() *SendEscalationLocalActivityOptions {
+	return &SendEscalationLocalActivityOptions{}
+}
+
+// Build initializes a workflow.Context with appropriate LocalActivityOptions values derived from schema defaults and any user-defined overrides
+func (o *SendEscalationLocalActivityOptions) Build(ctx workflow.Context) (workflow.Context, error) {
+	opts := o.options
+	if v := o.retryPolicy; v != nil {
+		opts.RetryPolicy = v
+	} else if opts.RetryPolicy == nil {
+		opts.RetryPolicy = &temporal.RetryPolicy{
+			InitialInterval:    10000000000, // 10 seconds
+			BackoffCoefficient: 2,
+			MaximumAttempts:    int32(5),
+		}
+	}
+	if v := o.scheduleToCloseTimeout; v != nil {
+		opts.ScheduleToCloseTimeout = *v
+	}
+	if v := o.startToCloseTimeout; v != nil {
+		opts.StartToCloseTimeout = *v
+	} else if opts.StartToCloseTimeout == 0 {
+		opts.StartToCloseTimeout = 30000000000 // 30 seconds
+	}
+	return workflow.WithLocalActivityOptions(ctx, opts), nil
+}
+
+// Local specifies a custom reminder.v1.Reminder.SendEscalation implementation
+func (o *SendEscalationLocalActivityOptions) Local(fn func(context.Context, *SendEscalationRequest) error) *SendEscalationLocalActivityOptions {
+	o.fn = fn
+	return o
+}
+
+// WithLocalActivityOptions specifies an initial LocalActivityOptions value to which defaults will be applied
+func (o *SendEscalationLocalActivityOptions) WithLocalActivityOptions(options workflow.LocalActivityOptions) *SendEscalationLocalActivityOptions {
+	o.options = options
+	return o
+}
+
+// WithDataConverter registers a DataConverter for the (local) activity
+func (o *SendEscalationLocalActivityOptions) WithDataConverter(dc converter.DataConverter) *SendEscalationLocalActivityOptions {
+	o.dc = dc
+	return o
+}
+
+// WithRetryPolicy sets the RetryPolicy value
+func (o *SendEscalationLocalActivityOptions) WithRetryPolicy(policy *temporal.RetryPolicy) *SendEscalationLocalActivityOptions {
+	o.retryPolicy = policy
+	return o
+}
+
+// WithScheduleToCloseTimeout sets the ScheduleToCloseTimeout value
+func (o *SendEscalationLocalActivityOptions) WithScheduleToCloseTimeout(d time.Duration) *SendEscalationLocalActivityOptions {
+	o.scheduleToCloseTimeout = &d
+	return o
+}
+
+// WithStartToCloseTimeout sets the StartToCloseTimeout value
+func (o *SendEscalationLocalActivityOptions) WithStartToCloseTimeout(d time.Duration) *SendEscalationLocalActivityOptions {
+	o.startToCloseTimeout = &d
+	return o
+}
+
+// RegisterSendInAppNotificationActivity registers a reminder.v1.Reminder.SendInAppNotification activity
+func RegisterSendInAppNotificationActivity(r worker.ActivityRegistry, fn func(context.Context, *ChannelNotificationRequest) error) {
+	r.RegisterActivityWithOptions(fn, activity.RegisterOptions{
+		Name: SendInAppNotificationActivityName,
+	})
+}
+
+// SendInAppNotificationFuture describes a(n) reminder.v1.Reminder.SendInAppNotification activity execution
+type SendInAppNotificationFuture struct {
+	Future workflow.Future
+}
+
+// Get blocks on the activity's completion, returning the response
+func (f *SendInAppNotificationFuture) Get(ctx workflow.Context) error {
+	return f.Future.Get(ctx, nil)
+}
+
+// Select adds the activity's completion to the selector, callback can be nil
+func (f *SendInAppNotificationFuture) Select(sel workflow.Selector, fn func(*SendInAppNotificationFuture)) workflow.Selector {
+	return sel.AddFuture(f.Future, func(workflow.Future) {
+		if fn != nil {
+			fn(f)
+		}
+	})
+}
+
+// SendInAppNotification activity — публикует уведомление в персональный канал Centrifugo
+func SendInAppNotification(ctx workflow.Context, req *ChannelNotificationRequest, options ...*SendInAppNotificationActivityOptions) error {
+	return SendInAppNotificationAsync(ctx, req, options...).Get(ctx)
+}
+
+// SendInAppNotification activity — публикует уведомление в персональный канал Centrifugo
+func SendInAppNotificationAsync(ctx workflow.Context, req *ChannelNotificationRequest, options ...*SendInAppNotificationActivityOptions) *SendInAppNotificationFuture {
+	var o *SendInAppNotificationActivityOptions
+	if len(options) > 0 && options[0] != nil {
+		o = options[0]
+	} else {
+		o = NewSendInAppNotificationActivityOptions()
+	}
+	var err error
+	if ctx, err = o.Build(ctx); err != nil {
+		errF, errS := workflow.NewFuture(ctx)
+		errS.SetError(err)
+		return &SendInAppNotificationFuture{Future: errF}
+	}
+	activity := SendInAppNotificationActivityName
+	if o.dc != nil {
+		ctx = workflow.WithDataConverter(ctx, o.dc)
+	}
+	future := &SendInAppNotificationFuture{Future: workflow.ExecuteActivity(ctx, activity, req)}
+	return future
+}
+
+// SendInAppNotification activity — публикует уведомление в персональный канал Centrifugo
+func SendInAppNotificationLocal(ctx workflow.Context, req *ChannelNotificationRequest, options ...*SendInAppNotificationLocalActivityOptions) error {
+	return SendInAppNotificationLocalAsync(ctx, req, options...).Get(ctx)
+}
+
+// SendInAppNotification activity — публикует уведомление в персональный канал Centrifugo
+func SendInAppNotificationLocalAsync(ctx workflow.Context, req *ChannelNotificationRequest, options ...*SendInAppNotificationLocalActivityOptions) *SendInAppNotificationFuture {
+	var o *SendInAppNotificationLocalActivityOptions
+	if len(options) > 0 && options[0] != nil {
+		o = options[0]
+	} else {
+		o = NewSendInAppNotificationLocalActivityOptions()
+	}
+	var err error
+	if ctx, err = o.Build(ctx); err != nil {
+		errF, errS := workflow.NewFuture(ctx)
+		errS.SetError(err)
+		return &SendInAppNotificationFuture{Future: errF}
+	}
+	var activity any
+	if o.fn != nil {
+		activity = o.fn
+	} else {
+		activity = SendInAppNotificationActivityName
+	}
+	if o.dc != nil {
+		ctx = workflow.WithDataConverter(ctx, o.dc)
+	}
+	future := &SendInAppNotificationFuture{Future: workflow.ExecuteLocalActivity(ctx, activity, req)}
+	return future
+}
+
+// SendInAppNotificationActivityOptions provides configuration for a(n) reminder.v1.Reminder.SendInAppNotification activity
+type SendInAppNotificationActivityOptions struct {
+	options                workflow.ActivityOptions
+	retryPolicy            *temporal.RetryPolicy
+	scheduleToCloseTimeout *time.Duration
+	startToCloseTimeout    *time.Duration
+	dc                     converter.DataConverter
+	heartbeatTimeout       *time.Duration
+	scheduleToStartTimeout *time.Duration
+	taskQueue              *string
+	waitForCancellation    *bool
+}
+
+// NewSendInAppNotificationActivityOptions initializes a new SendInAppNotificationActivityOptions value
+func NewSendInAppNotificationActivityOptions() *SendInAppNotificationActivityOptions {
+	return &SendInAppNotificationActivityOptions{}
+}
+
+// Build initializes a workflow.Context with appropriate ActivityOptions values derived from schema defaults and any user-defined overrides
+func (o *SendInAppNotificationActivityOptions) Build(ctx workflow.Context) (workflow.Context, error) {
+	opts := o.options
+	if v := o.heartbeatTimeout; v != nil {
+		opts.HeartbeatTimeout = *v
+	}
+	if v := o.retryPolicy; v != nil {
+		opts.RetryPolicy = v
+	} else if opts.RetryPolicy == nil {
+		opts.RetryPolicy = &temporal.RetryPolicy{MaximumAttempts: int32(3)}
+	}
+	if v := o.scheduleToCloseTimeout; v != nil {
+		opts.ScheduleToCloseTimeout = *v
+	}
+	if v := o.scheduleToStartTimeout; v != nil {
+		opts.ScheduleToStartTimeout = *v
+	}
+	if v := o.startToCloseTimeout; v != nil {
+		opts.StartToCloseTimeout = *v
+	} else if opts.StartToCloseTimeout == 0 {
+		opts.StartToCloseTimeout = 10000000000 // 10 seconds
+	}
+	if v := o.taskQueue; v != nil {
+		opts.TaskQueue = *v
+	} else if opts.TaskQueue == "" {
+		opts.TaskQueue = ReminderTaskQueue
+	}
+	if v := o.waitForCancellation; v != nil {
+		opts.WaitForCancellation = *v
+	}
+	return workflow.WithActivityOptions(ctx, opts), nil
+}
+
+// WithActivityOptions specifies an initial ActivityOptions value to which defaults will be applied
+func (o *SendInAppNotificationActivityOptions) WithActivityOptions(options workflow.ActivityOptions) *SendInAppNotificationActivityOptions {
+	o.options = options
+	return o
+}
+
+// WithDataConverter registers a DataConverter for the (local) activity
+func (o *SendInAppNotificationActivityOptions) WithDataConverter(dc converter.DataConverter) *SendInAppNotificationActivityOptions {
+	o.dc = dc
+	return o
+}
+
+// WithHeartbeatTimeout sets the HeartbeatTimeout value
+func (o *SendInAppNotificationActivityOptions) WithHeartbeatTimeout(d time.Duration) *SendInAppNotificationActivityOptions {
+	o.heartbeatTimeout = &d
+	return o
+}
+
+// WithRetryPolicy sets the RetryPolicy value
+func (o *SendInAppNotificationActivityOptions) WithRetryPolicy(policy *temporal.RetryPolicy) *SendInAppNotificationActivityOptions {
+	o.retryPolicy = policy
+	return o
+}
+
+// WithScheduleToCloseTimeout sets the ScheduleToCloseTimeout value
+func (o *SendInAppNotificationActivityOptions) WithScheduleToCloseTimeout(d time.Duration) *SendInAppNotificationActivityOptions {
+	o.scheduleToCloseTimeout = &d
+	return o
+}
+
+// WithScheduleToStartTimeout sets the ScheduleToStartTimeout value
+func (o *SendInAppNotificationActivityOptions) WithScheduleToStartTimeout(d time.Duration) *SendInAppNotificationActivityOptions {
+	o.scheduleToStartTimeout = &d
+	return o
+}
+
+// WithStartToCloseTimeout sets the StartToCloseTimeout value
+func (o *SendInAppNotificationActivityOptions) WithStartToCloseTimeout(d time.Duration) *SendInAppNotificationActivityOptions {
+	o.startToCloseTimeout = &d
+	return o
+}
+
+// WithTaskQueue sets the TaskQueue value
+func (o *SendInAppNotificationActivityOptions) WithTaskQueue(tq string) *SendInAppNotificationActivityOptions {
+	o.taskQueue = &tq
+	return o
+}
+
+// WithWaitForCancellation sets the WaitForCancellation value
+func (o *SendInAppNotificationActivityOptions) WithWaitForCancellation(wait bool) *SendInAppNotificationActivityOptions {
+	o.waitForCancellation = &wait
+	return o
+}
+
+// SendInAppNotificationLocalActivityOptions provides configuration for a(n) reminder.v1.Reminder.SendInAppNotification activity
+type SendInAppNotificationLocalActivityOptions struct {
+	options                workflow.LocalActivityOptions
+	retryPolicy            *temporal.RetryPolicy
+	scheduleToCloseTimeout *time.Duration
+	startToCloseTimeout    *time.Duration
+	dc                     converter.DataConverter
+	fn                     func(context.Context, *ChannelNotificationRequest) error
+}
+
+// NewSendInAppNotificationLocalActivityOptions initializes a new SendInAppNotificationLocalActivityOptions value
+func NewSendInAppNotificationLocalActivityOptions() *SendInAppNotificationLocalActivityOptions {
+	return &SendInAppNotificationLocalActivityOptions{}
+}
+
+// Build initializes a workflow.Context with appropriate LocalActivityOptions values derived from schema defaults and any user-defined overrides
+func (o *SendInAppNotificationLocalActivityOptions) Build(ctx workflow.Context) (workflow.Context, error) {
+	opts := o.options
+	if v := o.retryPolicy; v != nil {
+		opts.RetryPolicy = v
+	} else if opts.RetryPolicy == nil {
+		opts.RetryPolicy = &temporal.RetryPolicy{MaximumAttempts: int32(3)}
+	}
+	if v := o.scheduleToCloseTimeout; v != nil {
+		opts.ScheduleToCloseTimeout = *v
+	}
+	if v := o.startToCloseTimeout; v != nil {
+		opts.StartToCloseTimeout = *v
+	} else if opts.StartToCloseTimeout == 0 {
+		opts.StartToCloseTimeout = 10000000000 // 10 seconds
+	}
+	return workflow.WithLocalActivityOptions(ctx, opts), nil
+}
+
+// Local specifies a custom reminder.v1.Reminder.SendInAppNotification implementation
+func (o *SendInAppNotificationLocalActivityOptions) Local(fn func(context.Context, *ChannelNotificationRequest) error) *SendInAppNotificationLocalActivityOptions {
+	o.fn = fn
+	return o
+}
+
+// WithLocalActivityOptions specifies an initial LocalActivityOptions value to which defaults will be applied
+func (o *SendInAppNotificationLocalActivityOptions) WithLocalActivityOptions(options workflow.LocalActivityOptions) *SendInAppNotificationLocalActivityOptions {
+	o.options = options
+	return o
+}
+
+// WithDataConverter registers a DataConverter for the (local) activity
+func (o *SendInAppNotificationLocalActivityOptions) WithDataConverter(dc converter.DataConverter) *SendInAppNotificationLocalActivityOptions {
+	o.dc = dc
+	return o
+}
+
+// WithRetryPolicy sets the RetryPolicy value
+func (o *SendInAppNotificationLocalActivityOptions) WithRetryPolicy(policy *temporal.RetryPolicy) *SendInAppNotificationLocalActivityOptions {
+	o.retryPolicy = policy
+	return o
+}
+
+// WithScheduleToCloseTimeout sets the ScheduleToCloseTimeout value
+func (o *SendInAppNotificationLocalActivityOptions) WithScheduleToCloseTimeout(d time.Duration) *SendInAppNotificationLocalActivityOptions {
+	o.scheduleToCloseTimeout = &d
+	return o
+}
+
+// WithStartToCloseTimeout sets the StartToCloseTimeout value
+func (o *SendInAppNotificationLocalActivityOptions) WithStartToCloseTimeout(d time.Duration) *SendInAppNotificationLocalActivityOptions {
+	o.startToCloseTimeout = &d
+	return o
+}
+
+// RegisterSendTelegramNotificationActivity registers a reminder.v1.Reminder.SendTelegramNotification activity
+func RegisterSendTelegramNotificationActivity(r worker.ActivityRegistry, fn func(context.Context, *SendTelegramNotificationRequest) error) {
+	r.RegisterActivityWithOptions(fn, activity.RegisterOptions{
+		Name: SendTelegramNotificationActivityName,
+	})
+}
+
+// SendTelegramNotificationFuture describes a(n) reminder.v1.Reminder.SendTelegramNotification activity execution
+type SendTelegramNotificationFuture struct {
+	Future workflow.Future
+}
+
+// Get blocks on the activity's completion, returning the response
+func (f *SendTelegramNotificationFuture) Get(ctx workflow.Context) error {
+	return f.Future.Get(ctx, nil)
+}
+
+// Select adds the activity's completion to the selector, callback can be nil
+func (f *SendTelegramNotificationFuture) Select(sel workflow.Selector, fn func(*SendTelegramNotificationFuture)) workflow.Selector {
+	return sel.AddFuture(f.Future, func(workflow.Future) {
+		if fn != nil {
+			fn(f)
+		}
+	})
+}
+
+// SendTelegramNotification activity — отправляет сообщение в Telegram
+func SendTelegramNotification(ctx workflow.Context, req *SendTelegramNotificationRequest, options ...*SendTelegramNotificationActivityOptions) error {
+	return SendTelegramNotificationAsync(ctx, req, options...).Get(ctx)
+}
+
+// SendTelegramNotification activity — отправляет сообщение в Telegram
+func SendTelegramNotificationAsync(ctx workflow.Context, req *SendTelegramNotificationRequest, options ...*SendTelegramNotificationActivityOptions) *SendTelegramNotificationFuture {
+	var o *SendTelegramNotificationActivityOptions
+	if len(options) > 0 && options[0] != nil {
+		o = options[0]
+	} else {
+		o = NewSendTelegramNotificationActivityOptions()
+	}
+	var err error
+	if ctx, err = o.Build(ctx); err != nil {
+		errF, errS := workflow.NewFuture(ctx)
+		errS.SetError(err)
+		return &SendTelegramNotificationFuture{Future: errF}
+	}
+	activity := SendTelegramNotificationActivityName
+	if o.dc != nil {
+		ctx = workflow.WithDataConverter(ctx, o.dc)
+	}
+	future := &SendTelegramNotificationFuture{Future: workflow.ExecuteActivity(ctx, activity, req)}
+	return future
+}
+
+// SendTelegramNotification activity — отправляет сообщение в Telegram
+func SendTelegramNotificationLocal(ctx workflow.Context, req *SendTelegramNotificationRequest, options ...*SendTelegramNotificationLocalActivityOptions) error {
+	return SendTelegramNotificationLocalAsync(ctx, req, options...).Get(ctx)
+}
+
+// SendTelegramNotification activity — отправляет сообщение в Telegram
+func SendTelegramNotificationLocalAsync(ctx workflow.Context, req *SendTelegramNotificationRequest, options ...*SendTelegramNotificationLocalActivityOptions) *SendTelegramNotificationFuture {
+	var o *SendTelegramNotificationLocalActivityOptions
+	if len(options) > 0 && options[0] != nil {
+		o = options[0]
+	} else {
+		o = NewSendTelegramNotificationLocalActivityOptions()
+	}
+	var err error
+	if ctx, err = o.Build(ctx); err != nil {
+		errF, errS := workflow.NewFuture(ctx)
+		errS.SetError(err)
+		return &SendTelegramNotificationFuture{Future: errF}
+	}
+	var activity any
+	if o.fn != nil {
+		activity = o.fn
+	} else {
+		activity = SendTelegramNotificationActivityName
+	}
+	if o.dc != nil {
+		ctx = workflow.WithDataConverter(ctx, o.dc)
+	}
+	future := &SendTelegramNotificationFuture{Future: workflow.ExecuteLocalActivity(ctx, activity, req)}
+	return future
+}
+
+// SendTelegramNotificationActivityOptions provides configuration for a(n) reminder.v1.Reminder.SendTelegramNotification activity
+type SendTelegramNotificationActivityOptions struct {
+	options                workflow.ActivityOptions
+	retryPolicy            *temporal.RetryPolicy
+	scheduleToCloseTimeout *time.Duration
+	startToCloseTimeout    *time.Duration
+	dc                     converter.DataConverter
+	heartbeatTimeout       *time.Duration
+	scheduleToStartTimeout *time.Duration
+	taskQueue              *string
+	waitForCancellation    *bool
+}
+
+// NewSendTelegramNotificationActivityOptions initializes a new SendTelegramNotificationActivityOptions value
+func NewSendTelegramNotificationActivityOptions() *SendTelegramNotificationActivityOptions {
+	return &SendTelegramNotificationActivityOptions{}
+}
+
+// Build initializes a workflow.Context with appropriate ActivityOptions values derived from schema defaults and any user-defined overrides
+func (o *SendTelegramNotificationActivityOptions) Build(ctx workflow.Context) (workflow.Context, error) {
+	opts := o.options
+	if v := o.heartbeatTimeout; v != nil {
+		opts.HeartbeatTimeout = *v
+	}
+	if v := o.retryPolicy; v != nil {
+		opts.RetryPolicy = v
+	} else if opts.RetryPolicy == nil {
+		opts.RetryPolicy = &temporal.RetryPolicy{MaximumAttempts: int32(5)}
+	}
+	if v := o.scheduleToCloseTimeout; v != nil {
+		opts.ScheduleToCloseTimeout = *v
+	}
+	if v := o.scheduleToStartTimeout; v != nil {
+		opts.ScheduleToStartTimeout = *v
+	}
+	if v := o.startToCloseTimeout; v != nil {
+		opts.StartToCloseTimeout = *v
+	} else if opts.StartToCloseTimeout == 0 {
+		opts.StartToCloseTimeout = 30000000000 // 30 seconds
+	}
+	if v := o.taskQueue; v != nil {
+		opts.TaskQueue = *v
+	} else if opts.TaskQueue == "" {
+		opts.TaskQueue = ReminderTaskQueue
+	}
+	if v := o.waitForCancellation; v != nil {
+		opts.WaitForCancellation = *v
+	}
+	return workflow.WithActivityOptions(ctx, opts), nil
+}
+
+// WithActivityOptions specifies an initial ActivityOptions value to which defaults will be applied
+func (o *SendTelegramNotificationActivityOptions) WithActivityOptions(options workflow.ActivityOptions) *SendTelegramNotificationActivityOptions {
+	o.options = options
+	return o
+}
+
+// WithDataConverter registers a DataConverter for the (local) activity
+func (o *SendTelegramNotificationActivityOptions) WithDataConverter(dc converter.DataConverter) *SendTelegramNotificationActivityOptions {
+	o.dc = dc
+	return o
+}
+
+// WithHeartbeatTimeout sets the HeartbeatTimeout value
+func (o *SendTelegramNotificationActivityOptions) WithHeartbeatTimeout(d time.Duration) *SendTelegramNotificationActivityOptions {
+	o.heartbeatTimeout = &d
+	return o
+}
+
+// WithRetryPolicy sets the RetryPolicy value
+func (o *SendTelegramNotificationActivityOptions) WithRetryPolicy(policy *temporal.RetryPolicy) *SendTelegramNotificationActivityOptions {
+	o.retryPolicy = policy
+	return o
+}
+
+// WithScheduleToCloseTimeout sets the ScheduleToCloseTimeout value
+func (o *SendTelegramNotificationActivityOptions) WithScheduleToCloseTimeout(d time.Duration) *SendTelegramNotificationActivityOptions {
+	o.scheduleToCloseTimeout = &d
+	return o
+}
+
+// WithScheduleToStartTimeout sets the ScheduleToStartTimeout value
+func (o *SendTelegramNotificationActivityOptions) WithScheduleToStartTimeout(d time.Duration) *SendTelegramNotificationActivityOptions {
+	o.scheduleToStartTimeout = &d
+	return o
+}
+
+// WithStartToCloseTimeout sets the StartToCloseTimeout value
+func (o *SendTelegramNotificationActivityOptions) WithStartToCloseTimeout(d time.Duration) *SendTelegramNotificationActivityOptions {
+	o.startToCloseTimeout = &d
+	return o
+}
+
+// WithTaskQueue sets the TaskQueue value
+func (o *SendTelegramNotificationActivityOptions) WithTaskQueue(tq string) *SendTelegramNotificationActivityOptions {
+	o.taskQueue = &tq
+	return o
+}
+
+// WithWaitForCancellation sets the WaitForCancellation value
+func (o *SendTelegramNotificationActivityOptions) WithWaitForCancellation(wait bool) *SendTelegramNotificationActivityOptions {
+	o.waitForCancellation = &wait
+	return o
+}
+
+// SendTelegramNotificationLocalActivityOptions provides configuration for a(n) reminder.v1.Reminder.SendTelegramNotification activity
+type SendTelegramNotificationLocalActivityOptions struct {
+	options                workflow.LocalActivityOptions
+	retryPolicy            *temporal.RetryPolicy
+	scheduleToCloseTimeout *time.Duration
+	startToCloseTimeout    *time.Duration
+	dc                     converter.DataConverter
+	fn                     func(context.Context, *SendTelegramNotificationRequest) error
+}
+
+// NewSendTelegramNotificationLocalActivityOptions initializes a new SendTelegramNotificationLocalActivityOptions value
+func NewSendTelegramNotificationLocalActivityOptions() *SendTelegramNotificationLocalActivityOptions {
+	return &SendTelegramNotificationLocalActivityOptions{}
+}
+
+// Build initializes a workflow.Context with appropriate LocalActivityOptions values derived from schema defaults and any user-defined overrides
+func (o *SendTelegramNotificationLocalActivityOptions) Build(ctx workflow.Context) (workflow.Context, error) {
+	opts := o.options
+	if v := o.retryPolicy; v != nil {
+		opts.RetryPolicy = v
+	} else if opts.RetryPolicy == nil {
+		opts.RetryPolicy = &temporal.RetryPolicy{MaximumAttempts: int32(5)}
+	}
+	if v := o.scheduleToCloseTimeout; v != nil {
+		opts.ScheduleToCloseTimeout = *v
+	}
+	if v := o.startToCloseTimeout; v != nil {
+		opts.StartToCloseTimeout = *v
+	} else if opts.StartToCloseTimeout == 0 {
+		opts.StartToCloseTimeout = 30000000000 // 30 seconds
+	}
+	return workflow.WithLocalActivityOptions(ctx, opts), nil
+}
+
+// Local specifies a custom reminder.v1.Reminder.SendTelegramNotification implementation
+func (o *SendTelegramNotificationLocalActivityOptions) Local(fn func(context.Context, *SendTelegramNotificationRequest) error) *SendTelegramNotificationLocalActivityOptions {
+	o.fn = fn
+	return o
+}
+
+// WithLocalActivityOptions specifies an initial LocalActivityOptions value to which defaults will be applied
+func (o *SendTelegramNotificationLocalActivityOptions) WithLocalActivityOptions(options workflow.LocalActivityOptions) *SendTelegramNotificationLocalActivityOptions {
+	o.options = options
+	return o
+}
+
+// WithDataConverter registers a DataConverter for the (local) activity
+func (o *SendTelegramNotificationLocalActivityOptions) WithDataConverter(dc converter.DataConverter) *SendTelegramNotificationLocalActivityOptions {
+	o.dc = dc
+	return o
+}
+
+// WithRetryPolicy sets the RetryPolicy value
+func (o *SendTelegramNotificationLocalActivityOptions) WithRetryPolicy(policy *temporal.RetryPolicy) *SendTelegramNotificationLocalActivityOptions {
+	o.retryPolicy = policy
+	return o
+}
+
+// WithScheduleToCloseTimeout sets the ScheduleToCloseTimeout value
+func (o *SendTelegramNotificationLocalActivityOptions) WithScheduleToCloseTimeout(d time.Duration) *SendTelegramNotificationLocalActivityOptions {
+	o.scheduleToCloseTimeout = &d
+	return o
+}
+
+// WithStartToCloseTimeout sets the StartToCloseTimeout value
+func (o *SendTelegramNotificationLocalActivityOptions) WithStartToCloseTimeout(d time.Duration) *SendTelegramNotificationLocalActivityOptions {
+	o.startToCloseTimeout = &d
+	return o
+}
+
+// RegisterSendWebhookNotificationActivity registers a reminder.v1.Reminder.SendWebhookNotification activity
+func RegisterSendWebhookNotificationActivity(r worker.ActivityRegistry, fn func(context.Context, *ChannelNotificationRequest) error) {
+	r.RegisterActivityWithOptions(fn, activity.RegisterOptions{
+		Name: SendWebhookNotificationActivityName,
+	})
+}
+
+// SendWebhookNotificationFuture describes a(n) reminder.v1.Reminder.SendWebhookNotification activity execution
+type SendWebhookNotificationFuture struct {
+	Future workflow.Future
+}
+
+// Get blocks on the activity's completion, returning the response
+func (f *SendWebhookNotificationFuture) Get(ctx workflow.Context) error {
+	return f.Future.Get(ctx, nil)
+}
+
+// Select adds the activity's completion to the selector, callback can be nil
+func (f *SendWebhookNotificationFuture) Select(sel workflow.Selector, fn func(*SendWebhookNotificationFuture)) workflow.Selector {
+	return sel.AddFuture(f.Future, func(workflow.Future) {
+		if fn != nil {
+			fn(f)
+		}
+	})
+}
+
+// SendWebhookNotification activity — отправляет POST-запрос на webhook пользователя
+func SendWebhookNotification(ctx workflow.Context, req *ChannelNotificationRequest, options ...*SendWebhookNotificationActivityOptions) error {
+	return SendWebhookNotificationAsync(ctx, req, options...).Get(ctx)
+}
+
+// SendWebhookNotification activity — отправляет POST-запрос на webhook пользователя
+func SendWebhookNotificationAsync(ctx workflow.Context, req *ChannelNotificationRequest, options ...*SendWebhookNotificationActivityOptions) *SendWebhookNotificationFuture {
+	var o *SendWebhookNotificationActivityOptions
+	if len(options) > 0 && options[0] != nil {
+		o = options[0]
+	} else {
+		o = NewSendWebhookNotificationActivityOptions()
+	}
+	var err error
+	if ctx, err = o.Build(ctx); err != nil {
+		errF, errS := workflow.NewFuture(ctx)
+		errS.SetError(err)
+		return &SendWebhookNotificationFuture{Future: errF}
+	}
+	activity := SendWebhookNotificationActivityName
+	if o.dc != nil {
+		ctx = workflow.WithDataConverter(ctx, o.dc)
+	}
+	future := &SendWebhookNotificationFuture{Future: workflow.ExecuteActivity(ctx, activity, req)}
+	return future
+}
+
+// SendWebhookNotification activity — отправляет POST-запрос на webhook пользователя
+func SendWebhookNotificationLocal(ctx workflow.Context, req *ChannelNotificationRequest, options ...*SendWebhookNotificationLocalActivityOptions) error {
+	return SendWebhookNotificationLocalAsync(ctx, req, options...).Get(ctx)
+}
+
+// SendWebhookNotification activity — отправляет POST-запрос на webhook пользователя
+func SendWebhookNotificationLocalAsync(ctx workflow.Context, req *ChannelNotificationRequest, options ...*SendWebhookNotificationLocalActivityOptions) *SendWebhookNotificationFuture {
+	var o *SendWebhookNotificationLocalActivityOptions
+	if len(options) > 0 && options[0] != nil {
+		o = options[0]
+	} else {
+		o = NewSendWebhookNotificationLocalActivityOptions()
+	}
+	var err error
+	if ctx, err = o.Build(ctx); err != nil {
+		errF, errS := workflow.NewFuture(ctx)
+		errS.SetError(err)
+		return &SendWebhookNotificationFuture{Future: errF}
+	}
+	var activity any
+	if o.fn != nil {
+		activity = o.fn
+	} else {
+		activity = SendWebhookNotificationActivityName
+	}
+	if o.dc != nil {
+		ctx = workflow.WithDataConverter(ctx, o.dc)
+	}
+	future := &SendWebhookNotificationFuture{Future: workflow.ExecuteLocalActivity(ctx, activity, req)}
+	return future
+}
+
+// SendWebhookNotificationActivityOptions provides configuration for a(n) reminder.v1.Reminder.SendWebhookNotification activity
+type SendWebhookNotificationActivityOptions struct {
+	options                workflow.ActivityOptions
+	retryPolicy            *temporal.RetryPolicy
+	scheduleToCloseTimeout *time.Duration
+	startToCloseTimeout    *time.Duration
+	dc                     converter.DataConverter
+	heartbeatTimeout       *time.Duration
+	scheduleToStartTimeout *time.Duration
+	taskQueue              *string
+	waitForCancellation    *bool
+}
+
+// NewSendWebhookNotificationActivityOptions initializes a new SendWebhookNotificationActivityOptions value
+func NewSendWebhookNotificationActivityOptions() *SendWebhookNotificationActivityOptions {
+	return &SendWebhookNotificationActivityOptions{}
+}
+
+// Build initializes a workflow.Context with appropriate ActivityOptions values derived from schema defaults and any user-defined overrides
+func (o *SendWebhookNotificationActivityOptions) Build(ctx workflow.Context) (workflow.Context, error) {
+	opts := o.options
+	if v := o.heartbeatTimeout; v != nil {
+		opts.HeartbeatTimeout = *v
+	}
+	if v := o.retryPolicy; v != nil {
+		opts.RetryPolicy = v
+	} else if opts.RetryPolicy == nil {
+		opts.RetryPolicy = &temporal.RetryPolicy{
+			InitialInterval:    5000000000, // 5 seconds
+			BackoffCoefficient: 2,
+			MaximumAttempts:    int32(6),
+		}
+	}
+	if v := o.scheduleToCloseTimeout; v != nil {
+		opts.ScheduleToCloseTimeout = *v
+	}
+	if v := o.scheduleToStartTimeout; v != nil {
+		opts.ScheduleToStartTimeout = *v
+	}
+	if v := o.startToCloseTimeout; v != nil {
+		opts.StartToCloseTimeout = *v
+	} else if opts.StartToCloseTimeout == 0 {
+		opts.StartToCloseTimeout = 15000000000 // 15 seconds
+	}
+	if v := o.taskQueue; v != nil {
+		opts.TaskQueue = *v
+	} else if opts.TaskQueue == "" {
+		opts.TaskQueue = ReminderTaskQueue
+	}
+	if v := o.waitForCancellation; v != nil {
+		opts.WaitForCancellation = *v
+	}
+	return workflow.WithActivityOptions(ctx, opts), nil
+}
+
+// WithActivityOptions specifies an initial ActivityOptions value to which defaults will be applied
+func (o *SendWebhookNotificationActivityOptions) WithActivityOptions(options workflow.ActivityOptions) *SendWebhookNotificationActivityOptions {
+	o.options = options
+	return o
+}
+
+// WithDataConverter registers a DataConverter for the (local) activity
+func (o *SendWebhookNotificationActivityOptions) WithDataConverter(dc converter.DataConverter) *SendWebhookNotificationActivityOptions {
+	o.dc = dc
+	return o
+}
+
+// WithHeartbeatTimeout sets the HeartbeatTimeout value
+func (o *SendWebhookNotificationActivityOptions) WithHeartbeatTimeout(d time.Duration) *SendWebhookNotificationActivityOptions {
+	o.heartbeatTimeout = &d
+	return o
+}
+
+// WithRetryPolicy sets the RetryPolicy value
+func (o *SendWebhookNotificationActivityOptions) WithRetryPolicy(policy *temporal.RetryPolicy) *SendWebhookNotificationActivityOptions {
+	o.retryPolicy = policy
+	return o
+}
+
+// WithScheduleToCloseTimeout sets the ScheduleToCloseTimeout value
+func (o *SendWebhookNotificationActivityOptions) WithScheduleToCloseTimeout(d time.Duration) *SendWebhookNotificationActivityOptions {
+	o.scheduleToCloseTimeout = &d
+	return o
+}
+
+// WithScheduleToStartTimeout sets the ScheduleToStartTimeout value
+func (o *SendWebhookNotificationActivityOptions) WithScheduleToStartTimeout(d time.Duration) *SendWebhookNotificationActivityOptions {
+	o.scheduleToStartTimeout = &d
+	return o
+}
+
+// WithStartToCloseTimeout sets the StartToCloseTimeout value
+func (o *SendWebhookNotificationActivityOptions) WithStartToCloseTimeout(d time.Duration) *SendWebhookNotificationActivityOptions {
+	o.startToCloseTimeout = &d
+	return o
+}
+
+// WithTaskQueue sets the TaskQueue value
+func (o *SendWebhookNotificationActivityOptions) WithTaskQueue(tq string) *SendWebhookNotificationActivityOptions {
+	o.taskQueue = &tq
+	return o
+}
+
+// WithWaitForCancellation sets the WaitForCancellation value
+func (o *SendWebhookNotificationActivityOptions) WithWaitForCancellation(wait bool) *SendWebhookNotificationActivityOptions {
+	o.waitForCancellation = &wait
+	return o
+}
+
+// SendWebhookNotificationLocalActivityOptions provides configuration for a(n) reminder.v1.Reminder.SendWebhookNotification activity
+type SendWebhookNotificationLocalActivityOptions struct {
+	options                workflow.LocalActivityOptions
+	retryPolicy            *temporal.RetryPolicy
+	scheduleToCloseTimeout *time.Duration
+	startToCloseTimeout    *time.Duration
+	dc                     converter.DataConverter
+	fn                     func(context.Context, *ChannelNotificationRequest) error
+}
+
+// NewSendWebhookNotificationLocalActivityOptions initializes a new SendWebhookNotificationLocalActivityOptions value
+func NewSendWebhookNotificationLocalActivityOptions() *SendWebhookNotificationLocalActivityOptions {
+	return &SendWebhookNotificationLocalActivityOptions{}
+}
+
+// Build initializes a workflow.Context with appropriate LocalActivityOptions values derived from schema defaults and any user-defined overrides
+func (o *SendWebhookNotificationLocalActivityOptions) Build(ctx workflow.Context) (workflow.Context, error) {
+	opts := o.options
+	if v := o.retryPolicy; v != nil {
+		opts.RetryPolicy = v
+	} else if opts.RetryPolicy == nil {
+		opts.RetryPolicy = &temporal.RetryPolicy{
+			InitialInterval:    5000000000, // 5 seconds
+			BackoffCoefficient: 2,
+			MaximumAttempts:    int32(6),
+		}
+	}
+	if v := o.scheduleToCloseTimeout; v != nil {
+		opts.ScheduleToCloseTimeout = *v
+	}
+	if v := o.startToCloseTimeout; v != nil {
+		opts.StartToCloseTimeout = *v
+	} else if opts.StartToCloseTimeout == 0 {
+		opts.StartToCloseTimeout = 15000000000 // 15 seconds
+	}
+	return workflow.WithLocalActivityOptions(ctx, opts), nil
+}
+
+// Local specifies a custom reminder.v1.Reminder.SendWebhookNotification implementation
+func (o *SendWebhookNotificationLocalActivityOptions) Local(fn func(context.Context, *ChannelNotificationRequest) error) *SendWebhookNotificationLocalActivityOptions {
+	o.fn = fn
+	return o
+}
+
+// WithLocalActivityOptions specifies an initial LocalActivityOptions value to which defaults will be applied
+func (o *SendWebhookNotificationLocalActivityOptions) WithLocalActivityOptions(options workflow.LocalActivityOptions) *SendWebhookNotificationLocalActivityOptions {
+	o.options = options
+	return o
+}
+
+// WithDataConverter registers a DataConverter for the (local) activity
+func (o *SendWebhookNotificationLocalActivityOptions) WithDataConverter(dc converter.DataConverter) *SendWebhookNotificationLocalActivityOptions {
+	o.dc = dc
+	return o
+}
+
+// WithRetryPolicy sets the RetryPolicy value
+func (o *SendWebhookNotificationLocalActivityOptions) WithRetryPolicy(policy *temporal.RetryPolicy) *SendWebhookNotificationLocalActivityOptions {
+	o.retryPolicy = policy
+	return o
+}
+
+// WithScheduleToCloseTimeout sets the ScheduleToCloseTimeout value
+func (o *SendWebhookNotificationLocalActivityOptions) WithScheduleToCloseTimeout(d time.Duration) *SendWebhookNotificationLocalActivityOptions {
+	o.scheduleToCloseTimeout = &d
+	return o
+}
+
+// WithStartToCloseTimeout sets the StartToCloseTimeout value
+func (o *SendWebhookNotificationLocalActivityOptions) WithStartToCloseTimeout(d time.Duration) *SendWebhookNotificationLocalActivityOptions {
+	o.startToCloseTimeout = &d
+	return o
+}
+
+// RegisterUpdateReminderStatusActivity registers a reminder.v1.Reminder.UpdateReminderStatus activity
+func RegisterUpdateReminderStatusActivity(r worker.ActivityRegistry, fn func(context.Context, *UpdateReminderStatusRequest) error) {
+	r.RegisterActivityWithOptions(fn, activity.RegisterOptions{
+		Name: UpdateReminderStatusActivityName,
+	})
+}
+
+// UpdateReminderStatusFuture describes a(n) reminder.v1.Reminder.UpdateReminderStatus activity execution
+type UpdateReminderStatusFuture struct {
+	Future workflow.Future
+}
+
+// Get blocks on the activity's completion, returning the response
+func (f *UpdateReminderStatusFuture) Get(ctx workflow.Context) error {
+	return f.Future.Get(ctx, nil)
+}
+
+// Select adds the activity's completion to the selector, callback can be nil
+func (f *UpdateReminderStatusFuture) Select(sel workflow.Selector, fn func(*UpdateReminderStatusFuture)) workflow.Selector {
+	return sel.AddFuture(f.Future, func(workflow.Future) {
+		if fn != nil {
+			fn(f)
+		}
+	})
+}
+
+// UpdateReminderStatus activity — обновляет статус в БД
+func UpdateReminderStatus(ctx workflow.Context, req *UpdateReminderStatusRequest, options ...*UpdateReminderStatusActivityOptions) error {
+	return UpdateReminderStatusAsync(ctx, req, options...).Get(ctx)
+}
+
+// UpdateReminderStatus activity — обновляет статус в БД
+func UpdateReminderStatusAsync(ctx workflow.Context, req *UpdateReminderStatusRequest, options ...*UpdateReminderStatusActivityOptions) *UpdateReminderStatusFuture {
+	var o *UpdateReminderStatusActivityOptions
+	if len(options) > 0 && options[0] != nil {
+		o = options[0]
+	} else {
+		o = NewUpdateReminderStatusActivityOptions()
+	}
+	var err error
+	if ctx, err = o.Build(ctx); err != nil {
+		errF, errS := workflow.NewFuture(ctx)
+		errS.SetError(err)
+		return &UpdateReminderStatusFuture{Future: errF}
+	}
+	activity := UpdateReminderStatusActivityName
+	if o.dc != nil {
+		ctx = workflow.WithDataConverter(ctx, o.dc)
+	}
+	future := &UpdateReminderStatusFuture{Future: workflow.ExecuteActivity(ctx, activity, req)}
+	return future
+}
+
+// UpdateReminderStatus activity — обновляет статус в БД
+func UpdateReminderStatusLocal(ctx workflow.Context, req *UpdateReminderStatusRequest, options ...*UpdateReminderStatusLocalActivityOptions) error {
 	return UpdateReminderStatusLocalAsync(ctx, req, options...).Get(ctx)
 }
 
@@ -1545,6 +4613,99 @@ func (c *TestReminderClient) CancelReminder(ctx context.Context, workflowID stri
 	return nil
 }
 
+// SnoozeReminder executes a reminder.v1.Reminder.SnoozeReminder signal
+func (c *TestReminderClient) SnoozeReminder(ctx context.Context, workflowID string, runID string, req *SnoozeReminderRequest) error {
+	c.env.SignalWorkflow(SnoozeReminderSignalName, req)
+	return nil
+}
+
+// UpdateReminder executes a(n) reminder.v1.Reminder.UpdateReminder update in the test environment
+func (c *TestReminderClient) UpdateReminder(ctx context.Context, workflowID string, runID string, req *UpdateReminderRequest, opts ...*UpdateReminderOptions) (*UpdateReminderResponse, error) {
+	options := NewUpdateReminderOptions()
+	if len(opts) > 0 && opts[0].Options != nil {
+		options = opts[0]
+	}
+	options.Options.WaitForStage = client.WorkflowUpdateStageCompleted
+	handle, err := c.UpdateReminderAsync(ctx, workflowID, runID, req, options)
+	if err != nil {
+		return nil, err
+	}
+	return handle.Get(ctx)
+}
+
+// UpdateReminderAsync executes a(n) reminder.v1.Reminder.UpdateReminder update in the test environment
+func (c *TestReminderClient) UpdateReminderAsync(ctx context.Context, workflowID string, runID string, req *UpdateReminderRequest, opts ...*UpdateReminderOptions) (UpdateReminderHandle, error) {
+	var o *UpdateReminderOptions
+	if len(opts) > 0 && opts[0] != nil {
+		o = opts[0]
+	} else {
+		o = NewUpdateReminderOptions()
+	}
+	options, err := o.Build(workflowID, runID, req)
+	if err != nil {
+		return nil, fmt.Errorf("error initializing UpdateWorkflowWithOptions: %w", err)
+	}
+
+	if options.UpdateID == "" {
+		options.UpdateID = uuid.New().String()
+	}
+
+	uc := testutil.NewUpdateCallbacks()
+	c.env.UpdateWorkflow(UpdateReminderUpdateName, options.UpdateID, uc, req)
+	return &testUpdateReminderHandle{
+		callbacks:  uc,
+		env:        c.env,
+		opts:       options,
+		runID:      runID,
+		workflowID: workflowID,
+		req:        req,
+	}, nil
+}
+
+// GetUpdateReminder retrieves a handle to an existing reminder.v1.Reminder.UpdateReminder update
+func (c *TestReminderClient) GetUpdateReminder(ctx context.Context, req client.GetWorkflowUpdateHandleOptions) (UpdateReminderHandle, error) {
+	return nil, errors.New("unimplemented")
+}
+
+var _ UpdateReminderHandle = &testUpdateReminderHandle{}
+
+// testUpdateReminderHandle provides an internal implementation of a(n) UpdateReminderHandle
+type testUpdateReminderHandle struct {
+	callbacks  *testutil.UpdateCallbacks
+	env        *testsuite.TestWorkflowEnvironment
+	opts       *client.UpdateWorkflowOptions
+	req        *UpdateReminderRequest
+	runID      string
+	workflowID string
+}
+
+// Get retrieves a test reminder.v1.Reminder.UpdateReminder update result
+func (h *testUpdateReminderHandle) Get(ctx context.Context) (*UpdateReminderResponse, error) {
+	if resp, err := h.callbacks.Get(ctx); err != nil {
+		return nil, err
+	} else {
+		return resp.(*UpdateReminderResponse), nil
+	}
+}
+
+// RunID implementation
+func (h *testUpdateReminderHandle) RunID() string {
+	return h.runID
+}
+
+// UpdateID implementation
+func (h *testUpdateReminderHandle) UpdateID() string {
+	if h.opts != nil {
+		return h.opts.UpdateID
+	}
+	return ""
+}
+
+// WorkflowID implementation
+func (h *testUpdateReminderHandle) WorkflowID() string {
+	return h.workflowID
+}
+
 var _ ScheduleReminderRun = &testScheduleReminderRun{}
 
 // testScheduleReminderRun provides convenience methods for interacting with a(n) reminder.v1.Reminder.ScheduleReminder workflow in the test environment
@@ -1618,6 +4779,21 @@ func (r *testScheduleReminderRun) AcknowledgeReminder(ctx context.Context) error
 	return r.client.AcknowledgeReminder(ctx, r.ID(), r.RunID())
 }
 
+// SnoozeReminder executes a reminder.v1.Reminder.SnoozeReminder signal against a test reminder.v1.Reminder.ScheduleReminder workflow
+func (r *testScheduleReminderRun) SnoozeReminder(ctx context.Context, req *SnoozeReminderRequest) error {
+	return r.client.SnoozeReminder(ctx, r.ID(), r.RunID(), req)
+}
+
+// UpdateReminder executes a(n) reminder.v1.Reminder.UpdateReminder update against a test reminder.v1.Reminder.ScheduleReminder workflow
+func (r *testScheduleReminderRun) UpdateReminder(ctx context.Context, req *UpdateReminderRequest, opts ...*UpdateReminderOptions) (*UpdateReminderResponse, error) {
+	return r.client.UpdateReminder(ctx, r.ID(), r.RunID(), req, opts...)
+}
+
+// UpdateReminderAsync executes a(n) reminder.v1.Reminder.UpdateReminder update against a test reminder.v1.Reminder.ScheduleReminder workflow
+func (r *testScheduleReminderRun) UpdateReminderAsync(ctx context.Context, req *UpdateReminderRequest, opts ...*UpdateReminderOptions) (UpdateReminderHandle, error) {
+	return r.client.UpdateReminderAsync(ctx, r.ID(), r.RunID(), req, opts...)
+}
+
 // ReminderCliOptions describes runtime configuration for reminder.v1.Reminder cli v3
 type ReminderCliOptions struct {
 	after            func(context.Context, *cliv3.Command) error
@@ -1792,6 +4968,140 @@ func newReminderCommands(options ...*ReminderCliOptions) ([]*cliv3.Command, erro
 				return nil
 			},
 		},
+		{
+			Name:                   "snooze-reminder",
+			Usage:                  "SnoozeReminder сигнал откладывания уведомления на заданное время",
+			Category:               "SIGNALS",
+			UseShortOptionHandling: true,
+			Before:                 opts.before,
+			After:                  opts.after,
+			Flags: []cliv3.Flag{
+				&cliv3.StringFlag{
+					Name:     "workflow-id",
+					Usage:    "workflow id",
+					Required: true,
+					Aliases:  []string{"w"},
+				},
+				&cliv3.StringFlag{
+					Name:    "run-id",
+					Usage:   "run id",
+					Aliases: []string{"r"},
+				},
+				&cliv3.StringFlag{
+					Name:     "input-file",
+					Usage:    "path to json-formatted input file",
+					Aliases:  []string{"f"},
+					Category: "INPUT",
+				},
+				&cliv3.Int64Flag{
+					Name:     "duration-seconds",
+					Usage:    "На сколько секунд отложить уведомление",
+					Category: "INPUT",
+				},
+			},
+			Action: func(ctx context.Context, cmd *cliv3.Command) error {
+				c, err := opts.clientForCommand(ctx, cmd)
+				if err != nil {
+					return fmt.Errorf("error initializing client for command: %w", err)
+				}
+				defer c.Close()
+				client := NewReminderClient(c)
+				req, err := UnmarshalCliFlagsToSnoozeReminderRequest(cmd, helpers.UnmarshalCliFlagsOptions{FromFile: "input-file"})
+				if err != nil {
+					return fmt.Errorf("error unmarshalling request: %w", err)
+				}
+				if err := client.SnoozeReminder(ctx, cmd.String("workflow-id"), cmd.String("run-id"), req); err != nil {
+					return fmt.Errorf("error sending %q signal: %w", SnoozeReminderSignalName, err)
+				}
+				fmt.Println("success")
+				return nil
+			},
+		},
+		{
+			Name:                   "update-reminder",
+			Usage:                  "UpdateReminder изменяет заголовок, описание и время ожидающего напоминания",
+			Category:               "UPDATES",
+			UseShortOptionHandling: true,
+			Before:                 opts.before,
+			After:                  opts.after,
+			Flags: []cliv3.Flag{
+				&cliv3.BoolFlag{
+					Name:    "detach",
+					Usage:   "run workflow update in the background and print workflow, execution, and udpate id",
+					Aliases: []string{"d"},
+				},
+				&cliv3.StringFlag{
+					Name:     "workflow-id",
+					Usage:    "workflow id",
+					Required: true,
+					Aliases:  []string{"w"},
+				},
+				&cliv3.StringFlag{
+					Name:    "run-id",
+					Usage:   "run id",
+					Aliases: []string{"r"},
+				},
+				&cliv3.StringFlag{
+					Name:     "input-file",
+					Usage:    "path to json-formatted input file",
+					Aliases:  []string{"f"},
+					Category: "INPUT",
+				},
+				&cliv3.StringFlag{
+					Name:     "title",
+					Usage:    "Новый заголовок",
+					Category: "INPUT",
+				},
+				&cliv3.StringFlag{
+					Name:     "description",
+					Usage:    "Новое описание",
+					Category: "INPUT",
+				},
+				&cliv3.TimestampFlag{
+					Name:     "remind-at",
+					Usage:    "Новое время напоминания; пустое значение — время не меняется (e.g. \"2017-01-15T01:30:15.01Z\")",
+					Category: "INPUT",
+					Config:   cliv3.TimestampConfig{Layouts: []string{time.RFC3339Nano}},
+				},
+			},
+			Action: func(ctx context.Context, cmd *cliv3.Command) error {
+				c, err := opts.clientForCommand(ctx, cmd)
+				if err != nil {
+					return fmt.Errorf("error initializing client for command: %w", err)
+				}
+				defer c.Close()
+				client := NewReminderClient(c)
+				req, err := UnmarshalCliFlagsToUpdateReminderRequest(cmd, helpers.UnmarshalCliFlagsOptions{FromFile: "input-file"})
+				if err != nil {
+					return fmt.Errorf("error unmarshalling request: %w", err)
+				}
+				handle, err := client.UpdateReminderAsync(ctx, cmd.String("workflow-id"), cmd.String("run-id"), req)
+				if err != nil {
+					return fmt.Errorf("error executing %s update: %w", UpdateReminderUpdateName, err)
+				}
+				if cmd.Bool("detach") {
+					fmt.Println("success")
+					fmt.Printf("workflow id: %s\n", handle.WorkflowID())
+					fmt.Printf("run id: %s\n", handle.RunID())
+					fmt.Printf("update id: %s\n", handle.UpdateID())
+					return nil
+				}
+				if resp, err := handle.Get(ctx); err != nil {
+					return err
+				} else {
+					b, err := protojson.Marshal(resp)
+					if err != nil {
+						return fmt.Errorf("error serializing response json: %w", err)
+					}
+					var out bytes.Buffer
+					if err := json.Indent(&out, b, "", "  "); err != nil {
+						return fmt.Errorf("error formatting json: %w", err)
+					}
+					fmt.Println(out.String())
+					return nil
+				}
+			},
+		},
 		{
 			Name:                   "schedule-reminder",
 			Usage:                  "ScheduleReminder запускает workflow, который ждёт до remind_at и отправляет уведомление",
@@ -1859,6 +5169,11 @@ func newReminderCommands(options ...*ReminderCliOptions) ([]*cliv3.Command, erro
 					Usage:    "Интервал повторной отправки в минутах (5, 10, 15, 30, 60)",
 					Category: "INPUT",
 				},
+				&cliv3.BoolFlag{
+					Name:     "recurring",
+					Usage:    "Запуск очередного срабатывания повторяющегося напоминания (из Temporal Schedule)",
+					Category: "INPUT",
+				},
 			},
 			Action: func(ctx context.Context, cmd *cliv3.Command) error {
 				tc, err := opts.clientForCommand(ctx, cmd)
@@ -1938,6 +5253,63 @@ func newReminderCommands(options ...*ReminderCliOptions) ([]*cliv3.Command, erro
 	return commands, nil
 }
 
+// UnmarshalCliFlagsToSnoozeReminderRequest unmarshals a SnoozeReminderRequest from command line flags
+func UnmarshalCliFlagsToSnoozeReminderRequest(cmd *cliv3.Command, options ...helpers.UnmarshalCliFlagsOptions) (*SnoozeReminderRequest, error) {
+	opts := helpers.FlattenUnmarshalCliFlagsOptions(options...)
+	var result SnoozeReminderRequest
+	if opts.FromFile != "" && cmd.IsSet(opts.FromFile) {
+		f, err := gohomedir.Expand(cmd.String(opts.FromFile))
+		if err != nil {
+			f = cmd.String(opts.FromFile)
+		}
+		b, err := os.ReadFile(f)
+		if err != nil {
+			return nil, fmt.Errorf("error reading %s: %w", opts.FromFile, err)
+		}
+		if err := protojson.Unmarshal(b, &result); err != nil {
+			return nil, fmt.Errorf("error parsing %s json: %w", opts.FromFile, err)
+		}
+	}
+	if flag := opts.FlagName("duration-seconds"); cmd.IsSet(flag) {
+		value := cmd.Int64(flag)
+		result.DurationSeconds = value
+	}
+	return &result, nil
+}
+
+// UnmarshalCliFlagsToUpdateReminderRequest unmarshals a UpdateReminderRequest from command line flags
+func UnmarshalCliFlagsToUpdateReminderRequest(cmd *cliv3.Command, options ...helpers.UnmarshalCliFlagsOptions) (*UpdateReminderRequest, error) {
+	opts := helpers.FlattenUnmarshalCliFlagsOptions(options...)
+	var result UpdateReminderRequest
+	if opts.FromFile != "" && cmd.IsSet(opts.FromFile) {
+		f, err := gohomedir.Expand(cmd.String(opts.FromFile))
+		if err != nil {
+			f = cmd.String(opts.FromFile)
+		}
+		b, err := os.ReadFile(f)
+		if err != nil {
+			return nil, fmt.Errorf("error reading %s: %w", opts.FromFile, err)
+		}
+		if err := protojson.Unmarshal(b, &result); err != nil {
+			return nil, fmt.Errorf("error parsing %s json: %w", opts.FromFile, err)
+		}
+	}
+	if flag := opts.FlagName("title"); cmd.IsSet(flag) {
+		value := cmd.String(flag)
+		result.Title = value
+	}
+	if flag := opts.FlagName("description"); cmd.IsSet(flag) {
+		value := cmd.String(flag)
+		result.Description = value
+	}
+	if flag := opts.FlagName("remind-at"); cmd.IsSet(flag) {
+		v := cmd.Timestamp(flag)
+		value := timestamppb.New(v)
+		result.RemindAt = value
+	}
+	return &result, nil
+}
+
 // UnmarshalCliFlagsToScheduleReminderRequest unmarshals a ScheduleReminderRequest from command line flags
 func UnmarshalCliFlagsToScheduleReminderRequest(cmd *cliv3.Command, options ...helpers.UnmarshalCliFlagsOptions) (*ScheduleReminderRequest, error) {
 	opts := helpers.FlattenUnmarshalCliFlagsOptions(options...)
@@ -1991,5 +5363,9 @@ func UnmarshalCliFlagsToScheduleReminderRequest(cmd *cliv3.Command, options ...h
 		}
 		result.RepeatIntervalMinutes = value
 	}
+	if flag := opts.FlagName("recurring"); cmd.IsSet(flag) {
+		value := cmd.Bool(flag)
+		result.Recurring = value
+	}
 	return &result, nil
 }